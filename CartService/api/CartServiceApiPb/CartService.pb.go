@@ -9,6 +9,7 @@ package CartServiceApiPb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,12 +23,14 @@ const (
 )
 
 type AddToCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SkuId         uint64                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	Count         uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId           uint64                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Count           uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,5,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AddToCartRequest) Reset() {
@@ -81,17 +84,178 @@ func (x *AddToCartRequest) GetCount() uint64 {
 	return 0
 }
 
+func (x *AddToCartRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *AddToCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// count = 0 removes the position.
+type UpdateItemCountRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId           uint64                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Count           uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,5,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateItemCountRequest) Reset() {
+	*x = UpdateItemCountRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateItemCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateItemCountRequest) ProtoMessage() {}
+
+func (x *UpdateItemCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateItemCountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateItemCountRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateItemCountRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateItemCountRequest) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *UpdateItemCountRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UpdateItemCountRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateItemCountRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+type DecrementItemRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId           uint64                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,4,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DecrementItemRequest) Reset() {
+	*x = DecrementItemRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecrementItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecrementItemRequest) ProtoMessage() {}
+
+func (x *DecrementItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecrementItemRequest.ProtoReflect.Descriptor instead.
+func (*DecrementItemRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DecrementItemRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DecrementItemRequest) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *DecrementItemRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *DecrementItemRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
 type DeleteItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SkuId         uint64                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId           uint64                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,4,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteItemRequest) Reset() {
 	*x = DeleteItemRequest{}
-	mi := &file_CartService_api_CartService_proto_msgTypes[1]
+	mi := &file_CartService_api_CartService_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -103,7 +267,7 @@ func (x *DeleteItemRequest) String() string {
 func (*DeleteItemRequest) ProtoMessage() {}
 
 func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_CartService_api_CartService_proto_msgTypes[1]
+	mi := &file_CartService_api_CartService_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,7 +280,7 @@ func (x *DeleteItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteItemRequest.ProtoReflect.Descriptor instead.
 func (*DeleteItemRequest) Descriptor() ([]byte, []int) {
-	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{1}
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DeleteItemRequest) GetUserId() uint64 {
@@ -133,16 +297,32 @@ func (x *DeleteItemRequest) GetSkuId() uint64 {
 	return 0
 }
 
+func (x *DeleteItemRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *DeleteItemRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
 type ClearCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,3,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ClearCartRequest) Reset() {
 	*x = ClearCartRequest{}
-	mi := &file_CartService_api_CartService_proto_msgTypes[2]
+	mi := &file_CartService_api_CartService_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -154,7 +334,7 @@ func (x *ClearCartRequest) String() string {
 func (*ClearCartRequest) ProtoMessage() {}
 
 func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_CartService_api_CartService_proto_msgTypes[2]
+	mi := &file_CartService_api_CartService_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +347,7 @@ func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
 func (*ClearCartRequest) Descriptor() ([]byte, []int) {
-	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{2}
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ClearCartRequest) GetUserId() uint64 {
@@ -177,16 +357,31 @@ func (x *ClearCartRequest) GetUserId() uint64 {
 	return 0
 }
 
+func (x *ClearCartRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *ClearCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
 type GetCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCartRequest) Reset() {
 	*x = GetCartRequest{}
-	mi := &file_CartService_api_CartService_proto_msgTypes[3]
+	mi := &file_CartService_api_CartService_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -198,7 +393,7 @@ func (x *GetCartRequest) String() string {
 func (*GetCartRequest) ProtoMessage() {}
 
 func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_CartService_api_CartService_proto_msgTypes[3]
+	mi := &file_CartService_api_CartService_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,7 +406,7 @@ func (x *GetCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCartRequest.ProtoReflect.Descriptor instead.
 func (*GetCartRequest) Descriptor() ([]byte, []int) {
-	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{3}
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetCartRequest) GetUserId() uint64 {
@@ -221,19 +416,84 @@ func (x *GetCartRequest) GetUserId() uint64 {
 	return 0
 }
 
+func (x *GetCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// Money is an amount in minor units (kopecks, cents) of an ISO 4217 currency.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Money) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// In a cart price is the current price and added_price the one seen when the
+// sku was added. Orders leave added_price and current_price unset.
 type CartItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SkuId         uint64                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Count         uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Price         uint64                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *Money                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	AddedPrice    *Money                 `protobuf:"bytes,5,opt,name=added_price,json=addedPrice,proto3" json:"added_price,omitempty"`
+	CurrentPrice  *Money                 `protobuf:"bytes,6,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	WeightGrams   uint64                 `protobuf:"varint,7,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CartItem) Reset() {
 	*x = CartItem{}
-	mi := &file_CartService_api_CartService_proto_msgTypes[4]
+	mi := &file_CartService_api_CartService_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -245,7 +505,7 @@ func (x *CartItem) String() string {
 func (*CartItem) ProtoMessage() {}
 
 func (x *CartItem) ProtoReflect() protoreflect.Message {
-	mi := &file_CartService_api_CartService_proto_msgTypes[4]
+	mi := &file_CartService_api_CartService_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +518,7 @@ func (x *CartItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
 func (*CartItem) Descriptor() ([]byte, []int) {
-	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{4}
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CartItem) GetSkuId() uint64 {
@@ -282,36 +542,63 @@ func (x *CartItem) GetCount() uint64 {
 	return 0
 }
 
-func (x *CartItem) GetPrice() uint64 {
+func (x *CartItem) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
+	return nil
+}
+
+func (x *CartItem) GetAddedPrice() *Money {
+	if x != nil {
+		return x.AddedPrice
+	}
+	return nil
+}
+
+func (x *CartItem) GetCurrentPrice() *Money {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return nil
+}
+
+func (x *CartItem) GetWeightGrams() uint64 {
+	if x != nil {
+		return x.WeightGrams
+	}
 	return 0
 }
 
-type GetCartResponse struct {
+// UnavailableItem is a position left out of items and total_price. reason is
+// "delisted" for a sku ProductService no longer knows or "currency" for a
+// product priced in a currency the cart cannot convert, removed tells that
+// the position has been dropped from the cart.
+type UnavailableItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalPrice    uint64                 `protobuf:"varint,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	SkuId         uint64                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Removed       bool                   `protobuf:"varint,4,opt,name=removed,proto3" json:"removed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCartResponse) Reset() {
-	*x = GetCartResponse{}
-	mi := &file_CartService_api_CartService_proto_msgTypes[5]
+func (x *UnavailableItem) Reset() {
+	*x = UnavailableItem{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCartResponse) String() string {
+func (x *UnavailableItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCartResponse) ProtoMessage() {}
+func (*UnavailableItem) ProtoMessage() {}
 
-func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_CartService_api_CartService_proto_msgTypes[5]
+func (x *UnavailableItem) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,56 +609,2841 @@ func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
-func (*GetCartResponse) Descriptor() ([]byte, []int) {
-	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use UnavailableItem.ProtoReflect.Descriptor instead.
+func (*UnavailableItem) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetCartResponse) GetItems() []*CartItem {
+func (x *UnavailableItem) GetSkuId() uint64 {
 	if x != nil {
-		return x.Items
+		return x.SkuId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetCartResponse) GetTotalPrice() uint64 {
+func (x *UnavailableItem) GetCount() uint64 {
 	if x != nil {
-		return x.TotalPrice
+		return x.Count
 	}
 	return 0
 }
 
-var File_CartService_api_CartService_proto protoreflect.FileDescriptor
+func (x *UnavailableItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
 
-const file_CartService_api_CartService_proto_rawDesc = "" +
-	"\n" +
-	"!CartService/api/CartService.proto\x12\x04cart\"X\n" +
-	"\x10AddToCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
-	"\x06sku_id\x18\x02 \x01(\x04R\x05skuId\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x04R\x05count\"C\n" +
-	"\x11DeleteItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
-	"\x06sku_id\x18\x02 \x01(\x04R\x05skuId\"+\n" +
-	"\x10ClearCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\")\n" +
-	"\x0eGetCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\"a\n" +
-	"\bCartItem\x12\x15\n" +
-	"\x06sku_id\x18\x01 \x01(\x04R\x05skuId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x04R\x05count\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x04R\x05price\"X\n" +
-	"\x0fGetCartResponse\x12$\n" +
-	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\x12\x1f\n" +
-	"\vtotal_price\x18\x02 \x01(\x04R\n" +
-	"totalPrice2\xfb\x01\n" +
-	"\vCartService\x12:\n" +
-	"\tAddToCart\x12\x16.cart.AddToCartRequest\x1a\x15.cart.GetCartResponse\x12<\n" +
-	"\n" +
+func (x *UnavailableItem) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+type Discount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Discount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Discount) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Discount) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// PriceBreakdown.total is the subtotal less the discounts.
+type PriceBreakdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subtotal      *Money                 `protobuf:"bytes,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discounts     []*Discount            `protobuf:"bytes,2,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	Total         *Money                 `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBreakdown) ProtoMessage() {}
+
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PriceBreakdown) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+// prices_changed is set when some item costs other than when it was added.
+// promo_code is the code attached to the cart, it gives no discount while
+// disabled or below its threshold. total_price equals price.total. All
+// prices are in the cart currency.
+type GetCartResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Items            []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalPrice       *Money                 `protobuf:"bytes,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	Version          uint64                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	PricesChanged    bool                   `protobuf:"varint,4,opt,name=prices_changed,json=pricesChanged,proto3" json:"prices_changed,omitempty"`
+	UnavailableItems []*UnavailableItem     `protobuf:"bytes,5,rep,name=unavailable_items,json=unavailableItems,proto3" json:"unavailable_items,omitempty"`
+	PromoCode        string                 `protobuf:"bytes,6,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	Price            *PriceBreakdown        `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetCartResponse) Reset() {
+	*x = GetCartResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartResponse) ProtoMessage() {}
+
+func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
+func (*GetCartResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCartResponse) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetCartResponse) GetTotalPrice() *Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *GetCartResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetCartResponse) GetPricesChanged() bool {
+	if x != nil {
+		return x.PricesChanged
+	}
+	return false
+}
+
+func (x *GetCartResponse) GetUnavailableItems() []*UnavailableItem {
+	if x != nil {
+		return x.UnavailableItems
+	}
+	return nil
+}
+
+func (x *GetCartResponse) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *GetCartResponse) GetPrice() *PriceBreakdown {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// GetCartHistoryRequest pages through the changes of a cart made since the
+// given time, oldest first. page_token is the next_page_token of the
+// previous page, page_size defaults to 50.
+type GetCartHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartHistoryRequest) Reset() {
+	*x = GetCartHistoryRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartHistoryRequest) ProtoMessage() {}
+
+func (x *GetCartHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCartHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCartHistoryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCartHistoryRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetCartHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetCartHistoryRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CartHistoryEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	Operation     string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	SkuId         uint64                 `protobuf:"varint,4,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Delta         int64                  `protobuf:"varint,5,opt,name=delta,proto3" json:"delta,omitempty"`
+	OldCount      uint64                 `protobuf:"varint,6,opt,name=old_count,json=oldCount,proto3" json:"old_count,omitempty"`
+	NewCount      uint64                 `protobuf:"varint,7,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`
+	Caller        string                 `protobuf:"bytes,8,opt,name=caller,proto3" json:"caller,omitempty"`
+	RequestId     string                 `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Version       uint64                 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartHistoryEvent) Reset() {
+	*x = CartHistoryEvent{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartHistoryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartHistoryEvent) ProtoMessage() {}
+
+func (x *CartHistoryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartHistoryEvent.ProtoReflect.Descriptor instead.
+func (*CartHistoryEvent) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CartHistoryEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CartHistoryEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *CartHistoryEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *CartHistoryEvent) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *CartHistoryEvent) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *CartHistoryEvent) GetOldCount() uint64 {
+	if x != nil {
+		return x.OldCount
+	}
+	return 0
+}
+
+func (x *CartHistoryEvent) GetNewCount() uint64 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+func (x *CartHistoryEvent) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *CartHistoryEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *CartHistoryEvent) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetCartHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*CartHistoryEvent    `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartHistoryResponse) Reset() {
+	*x = GetCartHistoryResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartHistoryResponse) ProtoMessage() {}
+
+func (x *GetCartHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCartHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCartHistoryResponse) GetEvents() []*CartHistoryEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetCartHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateGuestCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestCartRequest) Reset() {
+	*x = CreateGuestCartRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestCartRequest) ProtoMessage() {}
+
+func (x *CreateGuestCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestCartRequest.ProtoReflect.Descriptor instead.
+func (*CreateGuestCartRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{15}
+}
+
+type CreateGuestCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestToken    string                 `protobuf:"bytes,1,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGuestCartResponse) Reset() {
+	*x = CreateGuestCartResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGuestCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestCartResponse) ProtoMessage() {}
+
+func (x *CreateGuestCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestCartResponse.ProtoReflect.Descriptor instead.
+func (*CreateGuestCartResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateGuestCartResponse) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// MergeCartsRequest moves the guest cart into the user's cart and deletes it.
+// strategy is "sum", "max" or "prefer-user", empty uses the configured one.
+type MergeCartsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestToken    string                 `protobuf:"bytes,1,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Strategy      string                 `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartsRequest) Reset() {
+	*x = MergeCartsRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartsRequest) ProtoMessage() {}
+
+func (x *MergeCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartsRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartsRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MergeCartsRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *MergeCartsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MergeCartsRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+type ApplyPromoRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,4,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApplyPromoRequest) Reset() {
+	*x = ApplyPromoRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyPromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPromoRequest) ProtoMessage() {}
+
+func (x *ApplyPromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPromoRequest.ProtoReflect.Descriptor instead.
+func (*ApplyPromoRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ApplyPromoRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApplyPromoRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ApplyPromoRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *ApplyPromoRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+type RemovePromoRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,3,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RemovePromoRequest) Reset() {
+	*x = RemovePromoRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePromoRequest) ProtoMessage() {}
+
+func (x *RemovePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePromoRequest.ProtoReflect.Descriptor instead.
+func (*RemovePromoRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemovePromoRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemovePromoRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *RemovePromoRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// Address is where a cart is shipped: country is ISO 3166-1 alpha-2 and
+// region ISO 3166-2, e.g. "RU" and "RU-MOW".
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Country       string                 `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	Region        string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	PostalCode    string                 `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+type QuoteCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Address       *Address               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,3,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteCartRequest) Reset() {
+	*x = QuoteCartRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteCartRequest) ProtoMessage() {}
+
+func (x *QuoteCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteCartRequest.ProtoReflect.Descriptor instead.
+func (*QuoteCartRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QuoteCartRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *QuoteCartRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *QuoteCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// QuoteCartResponse estimates the price of the cart shipped to the address:
+// total is subtotal less discount plus tax and shipping.
+type QuoteCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subtotal      *Money                 `protobuf:"bytes,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	Discount      *Money                 `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+	Tax           *Money                 `protobuf:"bytes,3,opt,name=tax,proto3" json:"tax,omitempty"`
+	Shipping      *Money                 `protobuf:"bytes,4,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	Total         *Money                 `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	WeightGrams   uint64                 `protobuf:"varint,6,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteCartResponse) Reset() {
+	*x = QuoteCartResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteCartResponse) ProtoMessage() {}
+
+func (x *QuoteCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteCartResponse.ProtoReflect.Descriptor instead.
+func (*QuoteCartResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QuoteCartResponse) GetSubtotal() *Money {
+	if x != nil {
+		return x.Subtotal
+	}
+	return nil
+}
+
+func (x *QuoteCartResponse) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *QuoteCartResponse) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *QuoteCartResponse) GetShipping() *Money {
+	if x != nil {
+		return x.Shipping
+	}
+	return nil
+}
+
+func (x *QuoteCartResponse) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *QuoteCartResponse) GetWeightGrams() uint64 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+// MoveToSavedRequest moves the whole cart position of the sku to the saved
+// list, its stock is released.
+type MoveToSavedRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId           uint64                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,4,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MoveToSavedRequest) Reset() {
+	*x = MoveToSavedRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveToSavedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToSavedRequest) ProtoMessage() {}
+
+func (x *MoveToSavedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToSavedRequest.ProtoReflect.Descriptor instead.
+func (*MoveToSavedRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MoveToSavedRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MoveToSavedRequest) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *MoveToSavedRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *MoveToSavedRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// MoveToCartRequest moves the saved position of the sku back to the cart at
+// the current price, reserving its stock.
+type MoveToCartRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId           uint64                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,4,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MoveToCartRequest) Reset() {
+	*x = MoveToCartRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToCartRequest) ProtoMessage() {}
+
+func (x *MoveToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToCartRequest.ProtoReflect.Descriptor instead.
+func (*MoveToCartRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MoveToCartRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MoveToCartRequest) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *MoveToCartRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *MoveToCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+type ListSavedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedRequest) Reset() {
+	*x = ListSavedRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedRequest) ProtoMessage() {}
+
+func (x *ListSavedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSavedRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSavedRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// SavedItem price is the current price in the cart currency.
+type SavedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         uint64                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Price         *Money                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	SavedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SavedItem) Reset() {
+	*x = SavedItem{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedItem) ProtoMessage() {}
+
+func (x *SavedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedItem.ProtoReflect.Descriptor instead.
+func (*SavedItem) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SavedItem) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SavedItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedItem) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SavedItem) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *SavedItem) GetSavedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SavedAt
+	}
+	return nil
+}
+
+// ListSavedResponse lists the latest saved first, unavailable_items are the
+// saved items that cannot be bought as in GetCartResponse.
+type ListSavedResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Items            []*SavedItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	UnavailableItems []*UnavailableItem     `protobuf:"bytes,2,rep,name=unavailable_items,json=unavailableItems,proto3" json:"unavailable_items,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListSavedResponse) Reset() {
+	*x = ListSavedResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedResponse) ProtoMessage() {}
+
+func (x *ListSavedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListSavedResponse) GetItems() []*SavedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListSavedResponse) GetUnavailableItems() []*UnavailableItem {
+	if x != nil {
+		return x.UnavailableItems
+	}
+	return nil
+}
+
+type ShareCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareCartRequest) Reset() {
+	*x = ShareCartRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCartRequest) ProtoMessage() {}
+
+func (x *ShareCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareCartRequest.ProtoReflect.Descriptor instead.
+func (*ShareCartRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ShareCartRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ShareCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// ShareCartResponse token reads a snapshot of the cart until expires_at.
+type ShareCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareCartResponse) Reset() {
+	*x = ShareCartResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCartResponse) ProtoMessage() {}
+
+func (x *ShareCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareCartResponse.ProtoReflect.Descriptor instead.
+func (*ShareCartResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ShareCartResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareCartResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GetSharedCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedCartRequest) Reset() {
+	*x = GetSharedCartRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedCartRequest) ProtoMessage() {}
+
+func (x *GetSharedCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedCartRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedCartRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSharedCartRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// SharedCart is a cart as it was shared, items keep the names and prices of
+// then. total_price leaves out the discounts of the sharer.
+type SharedCart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalPrice    *Money                 `protobuf:"bytes,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SharedCart) Reset() {
+	*x = SharedCart{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SharedCart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedCart) ProtoMessage() {}
+
+func (x *SharedCart) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedCart.ProtoReflect.Descriptor instead.
+func (*SharedCart) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SharedCart) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SharedCart) GetTotalPrice() *Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *SharedCart) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SharedCart) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// ImportSharedCartRequest adds the items of the shared cart to the user's
+// cart at current prices, all of them or none. Items no longer available
+// are skipped.
+type ImportSharedCartRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token           string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,4,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportSharedCartRequest) Reset() {
+	*x = ImportSharedCartRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSharedCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSharedCartRequest) ProtoMessage() {}
+
+func (x *ImportSharedCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSharedCartRequest.ProtoReflect.Descriptor instead.
+func (*ImportSharedCartRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportSharedCartRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportSharedCartRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ImportSharedCartRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *ImportSharedCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// ImportSharedCartResponse lists the skipped skus and the cart after the
+// import.
+type ImportSharedCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkippedSkuIds []uint64               `protobuf:"varint,1,rep,packed,name=skipped_sku_ids,json=skippedSkuIds,proto3" json:"skipped_sku_ids,omitempty"`
+	Cart          *GetCartResponse       `protobuf:"bytes,2,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSharedCartResponse) Reset() {
+	*x = ImportSharedCartResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSharedCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSharedCartResponse) ProtoMessage() {}
+
+func (x *ImportSharedCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSharedCartResponse.ProtoReflect.Descriptor instead.
+func (*ImportSharedCartResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportSharedCartResponse) GetSkippedSkuIds() []uint64 {
+	if x != nil {
+		return x.SkippedSkuIds
+	}
+	return nil
+}
+
+func (x *ImportSharedCartResponse) GetCart() *GetCartResponse {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// BulkOp is "add" of count more units, "set" to count units, zero removing
+// the position, or "delete" of the position.
+type BulkOp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	SkuId         uint64                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Count         uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkOp) Reset() {
+	*x = BulkOp{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkOp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkOp) ProtoMessage() {}
+
+func (x *BulkOp) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkOp.ProtoReflect.Descriptor instead.
+func (*BulkOp) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *BulkOp) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *BulkOp) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *BulkOp) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// BulkUpdateCartRequest applies ops in order with one product lookup. With
+// all_or_nothing a failed op leaves the cart as it was, otherwise only the
+// failed ops are left out.
+type BulkUpdateCartRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ops             []*BulkOp              `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops,omitempty"`
+	AllOrNothing    bool                   `protobuf:"varint,3,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,5,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BulkUpdateCartRequest) Reset() {
+	*x = BulkUpdateCartRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateCartRequest) ProtoMessage() {}
+
+func (x *BulkUpdateCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateCartRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateCartRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BulkUpdateCartRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BulkUpdateCartRequest) GetOps() []*BulkOp {
+	if x != nil {
+		return x.Ops
+	}
+	return nil
+}
+
+func (x *BulkUpdateCartRequest) GetAllOrNothing() bool {
+	if x != nil {
+		return x.AllOrNothing
+	}
+	return false
+}
+
+func (x *BulkUpdateCartRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *BulkUpdateCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+// BulkOpResult is the outcome of the op at the same index: status is
+// "applied", "failed" or "skipped" when another op of an all-or-nothing
+// batch failed. count is the count of the position after the op.
+type BulkOpResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuId         uint64                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Op            string                 `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Count         uint64                 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkOpResult) Reset() {
+	*x = BulkOpResult{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkOpResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkOpResult) ProtoMessage() {}
+
+func (x *BulkOpResult) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkOpResult.ProtoReflect.Descriptor instead.
+func (*BulkOpResult) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BulkOpResult) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *BulkOpResult) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *BulkOpResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkOpResult) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BulkOpResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BulkUpdateCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkOpResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Cart          *GetCartResponse       `protobuf:"bytes,2,opt,name=cart,proto3" json:"cart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateCartResponse) Reset() {
+	*x = BulkUpdateCartResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateCartResponse) ProtoMessage() {}
+
+func (x *BulkUpdateCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateCartResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateCartResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BulkUpdateCartResponse) GetResults() []*BulkOpResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkUpdateCartResponse) GetCart() *GetCartResponse {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+// Promo kind is "percent" with percent, "fixed" with amount or "buy_n_get_m"
+// with sku_id, buy_count and free_count: of every buy_count + free_count
+// units of the sku free_count are free. Any kind applies only from a
+// subtotal of min_subtotal.
+type Promo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Percent       uint32                 `protobuf:"varint,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	Amount        uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	SkuId         uint64                 `protobuf:"varint,5,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	BuyCount      uint64                 `protobuf:"varint,6,opt,name=buy_count,json=buyCount,proto3" json:"buy_count,omitempty"`
+	FreeCount     uint64                 `protobuf:"varint,7,opt,name=free_count,json=freeCount,proto3" json:"free_count,omitempty"`
+	MinSubtotal   uint64                 `protobuf:"varint,8,opt,name=min_subtotal,json=minSubtotal,proto3" json:"min_subtotal,omitempty"`
+	Disabled      bool                   `protobuf:"varint,9,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Promo) Reset() {
+	*x = Promo{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promo) ProtoMessage() {}
+
+func (x *Promo) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promo.ProtoReflect.Descriptor instead.
+func (*Promo) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Promo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Promo) GetPercent() uint32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *Promo) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Promo) GetSkuId() uint64 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *Promo) GetBuyCount() uint64 {
+	if x != nil {
+		return x.BuyCount
+	}
+	return 0
+}
+
+func (x *Promo) GetFreeCount() uint64 {
+	if x != nil {
+		return x.FreeCount
+	}
+	return 0
+}
+
+func (x *Promo) GetMinSubtotal() uint64 {
+	if x != nil {
+		return x.MinSubtotal
+	}
+	return 0
+}
+
+func (x *Promo) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Promo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreatePromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promo         *Promo                 `protobuf:"bytes,1,opt,name=promo,proto3" json:"promo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoRequest) Reset() {
+	*x = CreatePromoRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoRequest) ProtoMessage() {}
+
+func (x *CreatePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreatePromoRequest) GetPromo() *Promo {
+	if x != nil {
+		return x.Promo
+	}
+	return nil
+}
+
+type DisablePromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisablePromoRequest) Reset() {
+	*x = DisablePromoRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisablePromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisablePromoRequest) ProtoMessage() {}
+
+func (x *DisablePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisablePromoRequest.ProtoReflect.Descriptor instead.
+func (*DisablePromoRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DisablePromoRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisablePromoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisablePromoResponse) Reset() {
+	*x = DisablePromoResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisablePromoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisablePromoResponse) ProtoMessage() {}
+
+func (x *DisablePromoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisablePromoResponse.ProtoReflect.Descriptor instead.
+func (*DisablePromoResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{41}
+}
+
+type ListPromosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromosRequest) Reset() {
+	*x = ListPromosRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromosRequest) ProtoMessage() {}
+
+func (x *ListPromosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromosRequest.ProtoReflect.Descriptor instead.
+func (*ListPromosRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{42}
+}
+
+type ListPromosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promos        []*Promo               `protobuf:"bytes,1,rep,name=promos,proto3" json:"promos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromosResponse) Reset() {
+	*x = ListPromosResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromosResponse) ProtoMessage() {}
+
+func (x *ListPromosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromosResponse.ProtoReflect.Descriptor instead.
+func (*ListPromosResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListPromosResponse) GetPromos() []*Promo {
+	if x != nil {
+		return x.Promos
+	}
+	return nil
+}
+
+type CheckoutRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ExpectedVersion *uint64                `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckoutRequest) Reset() {
+	*x = CheckoutRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutRequest) ProtoMessage() {}
+
+func (x *CheckoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutRequest.ProtoReflect.Descriptor instead.
+func (*CheckoutRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CheckoutRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckoutRequest) GetExpectedVersion() uint64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type CheckoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutResponse) Reset() {
+	*x = CheckoutResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutResponse) ProtoMessage() {}
+
+func (x *CheckoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutResponse.ProtoReflect.Descriptor instead.
+func (*CheckoutResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CheckoutResponse) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       uint64                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetOrderRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetOrderRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListOrdersRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Order struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items         []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	TotalPrice    *Money                 `protobuf:"bytes,3,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Order) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Order) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetTotalPrice() *Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_CartService_api_CartService_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_CartService_api_CartService_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_CartService_api_CartService_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+var File_CartService_api_CartService_proto protoreflect.FileDescriptor
+
+const file_CartService_api_CartService_proto_rawDesc = "" +
+	"\n" +
+	"!CartService/api/CartService.proto\x12\x04cart\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbe\x01\n" +
+	"\x10AddToCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x04R\x05skuId\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\x12.\n" +
+	"\x10expected_version\x18\x04 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x05 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"\xc4\x01\n" +
+	"\x16UpdateItemCountRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x04R\x05skuId\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\x12.\n" +
+	"\x10expected_version\x18\x04 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x05 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"\xac\x01\n" +
+	"\x14DecrementItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x04R\x05skuId\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x04 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"\xa9\x01\n" +
+	"\x11DeleteItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x04R\x05skuId\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x04 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"\x91\x01\n" +
+	"\x10ClearCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12.\n" +
+	"\x10expected_version\x18\x02 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x03 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"J\n" +
+	"\x0eGetCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xf1\x01\n" +
+	"\bCartItem\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x04R\x05skuId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\x12!\n" +
+	"\x05price\x18\x04 \x01(\v2\v.cart.MoneyR\x05price\x12,\n" +
+	"\vadded_price\x18\x05 \x01(\v2\v.cart.MoneyR\n" +
+	"addedPrice\x120\n" +
+	"\rcurrent_price\x18\x06 \x01(\v2\v.cart.MoneyR\fcurrentPrice\x12!\n" +
+	"\fweight_grams\x18\a \x01(\x04R\vweightGrams\"p\n" +
+	"\x0fUnavailableItem\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x04R\x05skuId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x18\n" +
+	"\aremoved\x18\x04 \x01(\bR\aremoved\"W\n" +
+	"\bDiscount\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12#\n" +
+	"\x06amount\x18\x03 \x01(\v2\v.cart.MoneyR\x06amount\"\x8a\x01\n" +
+	"\x0ePriceBreakdown\x12'\n" +
+	"\bsubtotal\x18\x01 \x01(\v2\v.cart.MoneyR\bsubtotal\x12,\n" +
+	"\tdiscounts\x18\x02 \x03(\v2\x0e.cart.DiscountR\tdiscounts\x12!\n" +
+	"\x05total\x18\x03 \x01(\v2\v.cart.MoneyR\x05total\"\xb5\x02\n" +
+	"\x0fGetCartResponse\x12$\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\x12,\n" +
+	"\vtotal_price\x18\x02 \x01(\v2\v.cart.MoneyR\n" +
+	"totalPrice\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\x12%\n" +
+	"\x0eprices_changed\x18\x04 \x01(\bR\rpricesChanged\x12B\n" +
+	"\x11unavailable_items\x18\x05 \x03(\v2\x15.cart.UnavailableItemR\x10unavailableItems\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x06 \x01(\tR\tpromoCode\x12*\n" +
+	"\x05price\x18\a \x01(\v2\x14.cart.PriceBreakdownR\x05price\"\x9e\x01\n" +
+	"\x15GetCartHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\"\xa4\x02\n" +
+	"\x10CartHistoryEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\x12\x15\n" +
+	"\x06sku_id\x18\x04 \x01(\x04R\x05skuId\x12\x14\n" +
+	"\x05delta\x18\x05 \x01(\x03R\x05delta\x12\x1b\n" +
+	"\told_count\x18\x06 \x01(\x04R\boldCount\x12\x1b\n" +
+	"\tnew_count\x18\a \x01(\x04R\bnewCount\x12\x16\n" +
+	"\x06caller\x18\b \x01(\tR\x06caller\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\t \x01(\tR\trequestId\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x04R\aversion\"p\n" +
+	"\x16GetCartHistoryResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.cart.CartHistoryEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x18\n" +
+	"\x16CreateGuestCartRequest\":\n" +
+	"\x17CreateGuestCartResponse\x12\x1f\n" +
+	"\vguest_token\x18\x01 \x01(\tR\n" +
+	"guestToken\"i\n" +
+	"\x11MergeCartsRequest\x12\x1f\n" +
+	"\vguest_token\x18\x01 \x01(\tR\n" +
+	"guestToken\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\bstrategy\x18\x03 \x01(\tR\bstrategy\"\xa6\x01\n" +
+	"\x11ApplyPromoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x04 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"\x93\x01\n" +
+	"\x12RemovePromoRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12.\n" +
+	"\x10expected_version\x18\x02 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x03 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"p\n" +
+	"\aAddress\x12\x18\n" +
+	"\acountry\x18\x01 \x01(\tR\acountry\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x1f\n" +
+	"\vpostal_code\x18\x04 \x01(\tR\n" +
+	"postalCode\"u\n" +
+	"\x10QuoteCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12'\n" +
+	"\aaddress\x18\x02 \x01(\v2\r.cart.AddressR\aaddress\x12\x1f\n" +
+	"\vguest_token\x18\x03 \x01(\tR\n" +
+	"guestToken\"\xf3\x01\n" +
+	"\x11QuoteCartResponse\x12'\n" +
+	"\bsubtotal\x18\x01 \x01(\v2\v.cart.MoneyR\bsubtotal\x12'\n" +
+	"\bdiscount\x18\x02 \x01(\v2\v.cart.MoneyR\bdiscount\x12\x1d\n" +
+	"\x03tax\x18\x03 \x01(\v2\v.cart.MoneyR\x03tax\x12'\n" +
+	"\bshipping\x18\x04 \x01(\v2\v.cart.MoneyR\bshipping\x12!\n" +
+	"\x05total\x18\x05 \x01(\v2\v.cart.MoneyR\x05total\x12!\n" +
+	"\fweight_grams\x18\x06 \x01(\x04R\vweightGrams\"\xaa\x01\n" +
+	"\x12MoveToSavedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x04R\x05skuId\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x04 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"\xa9\x01\n" +
+	"\x11MoveToCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x04R\x05skuId\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x04 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"L\n" +
+	"\x10ListSavedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\"\xa6\x01\n" +
+	"\tSavedItem\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x04R\x05skuId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\x12!\n" +
+	"\x05price\x18\x04 \x01(\v2\v.cart.MoneyR\x05price\x125\n" +
+	"\bsaved_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\asavedAt\"~\n" +
+	"\x11ListSavedResponse\x12%\n" +
+	"\x05items\x18\x01 \x03(\v2\x0f.cart.SavedItemR\x05items\x12B\n" +
+	"\x11unavailable_items\x18\x02 \x03(\v2\x15.cart.UnavailableItemR\x10unavailableItems\"L\n" +
+	"\x10ShareCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\"d\n" +
+	"\x11ShareCartResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\",\n" +
+	"\x14GetSharedCartRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xd6\x01\n" +
+	"\n" +
+	"SharedCart\x12$\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\x12,\n" +
+	"\vtotal_price\x18\x02 \x01(\v2\v.cart.MoneyR\n" +
+	"totalPrice\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xae\x01\n" +
+	"\x17ImportSharedCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x04 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"m\n" +
+	"\x18ImportSharedCartResponse\x12&\n" +
+	"\x0fskipped_sku_ids\x18\x01 \x03(\x04R\rskippedSkuIds\x12)\n" +
+	"\x04cart\x18\x02 \x01(\v2\x15.cart.GetCartResponseR\x04cart\"E\n" +
+	"\x06BulkOp\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\x04R\x05skuId\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\"\xdc\x01\n" +
+	"\x15BulkUpdateCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1e\n" +
+	"\x03ops\x18\x02 \x03(\v2\f.cart.BulkOpR\x03ops\x12$\n" +
+	"\x0eall_or_nothing\x18\x03 \x01(\bR\fallOrNothing\x12.\n" +
+	"\x10expected_version\x18\x04 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01\x12\x1f\n" +
+	"\vguest_token\x18\x05 \x01(\tR\n" +
+	"guestTokenB\x13\n" +
+	"\x11_expected_version\"y\n" +
+	"\fBulkOpResult\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\x04R\x05skuId\x12\x0e\n" +
+	"\x02op\x18\x02 \x01(\tR\x02op\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x04R\x05count\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"q\n" +
+	"\x16BulkUpdateCartResponse\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.cart.BulkOpResultR\aresults\x12)\n" +
+	"\x04cart\x18\x02 \x01(\v2\x15.cart.GetCartResponseR\x04cart\"\xae\x02\n" +
+	"\x05Promo\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\rR\apercent\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\x12\x15\n" +
+	"\x06sku_id\x18\x05 \x01(\x04R\x05skuId\x12\x1b\n" +
+	"\tbuy_count\x18\x06 \x01(\x04R\bbuyCount\x12\x1d\n" +
+	"\n" +
+	"free_count\x18\a \x01(\x04R\tfreeCount\x12!\n" +
+	"\fmin_subtotal\x18\b \x01(\x04R\vminSubtotal\x12\x1a\n" +
+	"\bdisabled\x18\t \x01(\bR\bdisabled\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"7\n" +
+	"\x12CreatePromoRequest\x12!\n" +
+	"\x05promo\x18\x01 \x01(\v2\v.cart.PromoR\x05promo\")\n" +
+	"\x13DisablePromoRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x16\n" +
+	"\x14DisablePromoResponse\"\x13\n" +
+	"\x11ListPromosRequest\"9\n" +
+	"\x12ListPromosResponse\x12#\n" +
+	"\x06promos\x18\x01 \x03(\v2\v.cart.PromoR\x06promos\"o\n" +
+	"\x0fCheckoutRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12.\n" +
+	"\x10expected_version\x18\x02 \x01(\x04H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"-\n" +
+	"\x10CheckoutResponse\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\"E\n" +
+	"\x0fGetOrderRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x04R\aorderId\",\n" +
+	"\x11ListOrdersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\xb1\x01\n" +
+	"\x05Order\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12$\n" +
+	"\x05items\x18\x02 \x03(\v2\x0e.cart.CartItemR\x05items\x12,\n" +
+	"\vtotal_price\x18\x03 \x01(\v2\v.cart.MoneyR\n" +
+	"totalPrice\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"9\n" +
+	"\x12ListOrdersResponse\x12#\n" +
+	"\x06orders\x18\x01 \x03(\v2\v.cart.OrderR\x06orders2\xe1\f\n" +
+	"\vCartService\x12:\n" +
+	"\tAddToCart\x12\x16.cart.AddToCartRequest\x1a\x15.cart.GetCartResponse\x12F\n" +
+	"\x0fUpdateItemCount\x12\x1c.cart.UpdateItemCountRequest\x1a\x15.cart.GetCartResponse\x12B\n" +
+	"\rDecrementItem\x12\x1a.cart.DecrementItemRequest\x1a\x15.cart.GetCartResponse\x12<\n" +
+	"\n" +
 	"DeleteItem\x12\x17.cart.DeleteItemRequest\x1a\x15.cart.GetCartResponse\x12:\n" +
 	"\tClearCart\x12\x16.cart.ClearCartRequest\x1a\x15.cart.GetCartResponse\x126\n" +
-	"\aGetCart\x12\x14.cart.GetCartRequest\x1a\x15.cart.GetCartResponseB\x16Z\x14api/CartServiceApiPbb\x06proto3"
+	"\aGetCart\x12\x14.cart.GetCartRequest\x1a\x15.cart.GetCartResponse\x12K\n" +
+	"\x0eGetCartHistory\x12\x1b.cart.GetCartHistoryRequest\x1a\x1c.cart.GetCartHistoryResponse\x12N\n" +
+	"\x0fCreateGuestCart\x12\x1c.cart.CreateGuestCartRequest\x1a\x1d.cart.CreateGuestCartResponse\x12<\n" +
+	"\n" +
+	"MergeCarts\x12\x17.cart.MergeCartsRequest\x1a\x15.cart.GetCartResponse\x12<\n" +
+	"\n" +
+	"ApplyPromo\x12\x17.cart.ApplyPromoRequest\x1a\x15.cart.GetCartResponse\x12>\n" +
+	"\vRemovePromo\x12\x18.cart.RemovePromoRequest\x1a\x15.cart.GetCartResponse\x12<\n" +
+	"\tQuoteCart\x12\x16.cart.QuoteCartRequest\x1a\x17.cart.QuoteCartResponse\x12>\n" +
+	"\vMoveToSaved\x12\x18.cart.MoveToSavedRequest\x1a\x15.cart.GetCartResponse\x12<\n" +
+	"\n" +
+	"MoveToCart\x12\x17.cart.MoveToCartRequest\x1a\x15.cart.GetCartResponse\x12<\n" +
+	"\tListSaved\x12\x16.cart.ListSavedRequest\x1a\x17.cart.ListSavedResponse\x12<\n" +
+	"\tShareCart\x12\x16.cart.ShareCartRequest\x1a\x17.cart.ShareCartResponse\x12=\n" +
+	"\rGetSharedCart\x12\x1a.cart.GetSharedCartRequest\x1a\x10.cart.SharedCart\x12Q\n" +
+	"\x10ImportSharedCart\x12\x1d.cart.ImportSharedCartRequest\x1a\x1e.cart.ImportSharedCartResponse\x12K\n" +
+	"\x0eBulkUpdateCart\x12\x1b.cart.BulkUpdateCartRequest\x1a\x1c.cart.BulkUpdateCartResponse\x124\n" +
+	"\vCreatePromo\x12\x18.cart.CreatePromoRequest\x1a\v.cart.Promo\x12E\n" +
+	"\fDisablePromo\x12\x19.cart.DisablePromoRequest\x1a\x1a.cart.DisablePromoResponse\x12?\n" +
+	"\n" +
+	"ListPromos\x12\x17.cart.ListPromosRequest\x1a\x18.cart.ListPromosResponse\x129\n" +
+	"\bCheckout\x12\x15.cart.CheckoutRequest\x1a\x16.cart.CheckoutResponse\x12.\n" +
+	"\bGetOrder\x12\x15.cart.GetOrderRequest\x1a\v.cart.Order\x12?\n" +
+	"\n" +
+	"ListOrders\x12\x17.cart.ListOrdersRequest\x1a\x18.cart.ListOrdersResponseB\x16Z\x14api/CartServiceApiPbb\x06proto3"
 
 var (
 	file_CartService_api_CartService_proto_rawDescOnce sync.Once
@@ -385,30 +3457,156 @@ func file_CartService_api_CartService_proto_rawDescGZIP() []byte {
 	return file_CartService_api_CartService_proto_rawDescData
 }
 
-var file_CartService_api_CartService_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_CartService_api_CartService_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_CartService_api_CartService_proto_goTypes = []any{
-	(*AddToCartRequest)(nil),  // 0: cart.AddToCartRequest
-	(*DeleteItemRequest)(nil), // 1: cart.DeleteItemRequest
-	(*ClearCartRequest)(nil),  // 2: cart.ClearCartRequest
-	(*GetCartRequest)(nil),    // 3: cart.GetCartRequest
-	(*CartItem)(nil),          // 4: cart.CartItem
-	(*GetCartResponse)(nil),   // 5: cart.GetCartResponse
+	(*AddToCartRequest)(nil),         // 0: cart.AddToCartRequest
+	(*UpdateItemCountRequest)(nil),   // 1: cart.UpdateItemCountRequest
+	(*DecrementItemRequest)(nil),     // 2: cart.DecrementItemRequest
+	(*DeleteItemRequest)(nil),        // 3: cart.DeleteItemRequest
+	(*ClearCartRequest)(nil),         // 4: cart.ClearCartRequest
+	(*GetCartRequest)(nil),           // 5: cart.GetCartRequest
+	(*Money)(nil),                    // 6: cart.Money
+	(*CartItem)(nil),                 // 7: cart.CartItem
+	(*UnavailableItem)(nil),          // 8: cart.UnavailableItem
+	(*Discount)(nil),                 // 9: cart.Discount
+	(*PriceBreakdown)(nil),           // 10: cart.PriceBreakdown
+	(*GetCartResponse)(nil),          // 11: cart.GetCartResponse
+	(*GetCartHistoryRequest)(nil),    // 12: cart.GetCartHistoryRequest
+	(*CartHistoryEvent)(nil),         // 13: cart.CartHistoryEvent
+	(*GetCartHistoryResponse)(nil),   // 14: cart.GetCartHistoryResponse
+	(*CreateGuestCartRequest)(nil),   // 15: cart.CreateGuestCartRequest
+	(*CreateGuestCartResponse)(nil),  // 16: cart.CreateGuestCartResponse
+	(*MergeCartsRequest)(nil),        // 17: cart.MergeCartsRequest
+	(*ApplyPromoRequest)(nil),        // 18: cart.ApplyPromoRequest
+	(*RemovePromoRequest)(nil),       // 19: cart.RemovePromoRequest
+	(*Address)(nil),                  // 20: cart.Address
+	(*QuoteCartRequest)(nil),         // 21: cart.QuoteCartRequest
+	(*QuoteCartResponse)(nil),        // 22: cart.QuoteCartResponse
+	(*MoveToSavedRequest)(nil),       // 23: cart.MoveToSavedRequest
+	(*MoveToCartRequest)(nil),        // 24: cart.MoveToCartRequest
+	(*ListSavedRequest)(nil),         // 25: cart.ListSavedRequest
+	(*SavedItem)(nil),                // 26: cart.SavedItem
+	(*ListSavedResponse)(nil),        // 27: cart.ListSavedResponse
+	(*ShareCartRequest)(nil),         // 28: cart.ShareCartRequest
+	(*ShareCartResponse)(nil),        // 29: cart.ShareCartResponse
+	(*GetSharedCartRequest)(nil),     // 30: cart.GetSharedCartRequest
+	(*SharedCart)(nil),               // 31: cart.SharedCart
+	(*ImportSharedCartRequest)(nil),  // 32: cart.ImportSharedCartRequest
+	(*ImportSharedCartResponse)(nil), // 33: cart.ImportSharedCartResponse
+	(*BulkOp)(nil),                   // 34: cart.BulkOp
+	(*BulkUpdateCartRequest)(nil),    // 35: cart.BulkUpdateCartRequest
+	(*BulkOpResult)(nil),             // 36: cart.BulkOpResult
+	(*BulkUpdateCartResponse)(nil),   // 37: cart.BulkUpdateCartResponse
+	(*Promo)(nil),                    // 38: cart.Promo
+	(*CreatePromoRequest)(nil),       // 39: cart.CreatePromoRequest
+	(*DisablePromoRequest)(nil),      // 40: cart.DisablePromoRequest
+	(*DisablePromoResponse)(nil),     // 41: cart.DisablePromoResponse
+	(*ListPromosRequest)(nil),        // 42: cart.ListPromosRequest
+	(*ListPromosResponse)(nil),       // 43: cart.ListPromosResponse
+	(*CheckoutRequest)(nil),          // 44: cart.CheckoutRequest
+	(*CheckoutResponse)(nil),         // 45: cart.CheckoutResponse
+	(*GetOrderRequest)(nil),          // 46: cart.GetOrderRequest
+	(*ListOrdersRequest)(nil),        // 47: cart.ListOrdersRequest
+	(*Order)(nil),                    // 48: cart.Order
+	(*ListOrdersResponse)(nil),       // 49: cart.ListOrdersResponse
+	(*timestamppb.Timestamp)(nil),    // 50: google.protobuf.Timestamp
 }
 var file_CartService_api_CartService_proto_depIdxs = []int32{
-	4, // 0: cart.GetCartResponse.items:type_name -> cart.CartItem
-	0, // 1: cart.CartService.AddToCart:input_type -> cart.AddToCartRequest
-	1, // 2: cart.CartService.DeleteItem:input_type -> cart.DeleteItemRequest
-	2, // 3: cart.CartService.ClearCart:input_type -> cart.ClearCartRequest
-	3, // 4: cart.CartService.GetCart:input_type -> cart.GetCartRequest
-	5, // 5: cart.CartService.AddToCart:output_type -> cart.GetCartResponse
-	5, // 6: cart.CartService.DeleteItem:output_type -> cart.GetCartResponse
-	5, // 7: cart.CartService.ClearCart:output_type -> cart.GetCartResponse
-	5, // 8: cart.CartService.GetCart:output_type -> cart.GetCartResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6,  // 0: cart.CartItem.price:type_name -> cart.Money
+	6,  // 1: cart.CartItem.added_price:type_name -> cart.Money
+	6,  // 2: cart.CartItem.current_price:type_name -> cart.Money
+	6,  // 3: cart.Discount.amount:type_name -> cart.Money
+	6,  // 4: cart.PriceBreakdown.subtotal:type_name -> cart.Money
+	9,  // 5: cart.PriceBreakdown.discounts:type_name -> cart.Discount
+	6,  // 6: cart.PriceBreakdown.total:type_name -> cart.Money
+	7,  // 7: cart.GetCartResponse.items:type_name -> cart.CartItem
+	6,  // 8: cart.GetCartResponse.total_price:type_name -> cart.Money
+	8,  // 9: cart.GetCartResponse.unavailable_items:type_name -> cart.UnavailableItem
+	10, // 10: cart.GetCartResponse.price:type_name -> cart.PriceBreakdown
+	50, // 11: cart.GetCartHistoryRequest.since:type_name -> google.protobuf.Timestamp
+	50, // 12: cart.CartHistoryEvent.at:type_name -> google.protobuf.Timestamp
+	13, // 13: cart.GetCartHistoryResponse.events:type_name -> cart.CartHistoryEvent
+	20, // 14: cart.QuoteCartRequest.address:type_name -> cart.Address
+	6,  // 15: cart.QuoteCartResponse.subtotal:type_name -> cart.Money
+	6,  // 16: cart.QuoteCartResponse.discount:type_name -> cart.Money
+	6,  // 17: cart.QuoteCartResponse.tax:type_name -> cart.Money
+	6,  // 18: cart.QuoteCartResponse.shipping:type_name -> cart.Money
+	6,  // 19: cart.QuoteCartResponse.total:type_name -> cart.Money
+	6,  // 20: cart.SavedItem.price:type_name -> cart.Money
+	50, // 21: cart.SavedItem.saved_at:type_name -> google.protobuf.Timestamp
+	26, // 22: cart.ListSavedResponse.items:type_name -> cart.SavedItem
+	8,  // 23: cart.ListSavedResponse.unavailable_items:type_name -> cart.UnavailableItem
+	50, // 24: cart.ShareCartResponse.expires_at:type_name -> google.protobuf.Timestamp
+	7,  // 25: cart.SharedCart.items:type_name -> cart.CartItem
+	6,  // 26: cart.SharedCart.total_price:type_name -> cart.Money
+	50, // 27: cart.SharedCart.created_at:type_name -> google.protobuf.Timestamp
+	50, // 28: cart.SharedCart.expires_at:type_name -> google.protobuf.Timestamp
+	11, // 29: cart.ImportSharedCartResponse.cart:type_name -> cart.GetCartResponse
+	34, // 30: cart.BulkUpdateCartRequest.ops:type_name -> cart.BulkOp
+	36, // 31: cart.BulkUpdateCartResponse.results:type_name -> cart.BulkOpResult
+	11, // 32: cart.BulkUpdateCartResponse.cart:type_name -> cart.GetCartResponse
+	50, // 33: cart.Promo.created_at:type_name -> google.protobuf.Timestamp
+	38, // 34: cart.CreatePromoRequest.promo:type_name -> cart.Promo
+	38, // 35: cart.ListPromosResponse.promos:type_name -> cart.Promo
+	7,  // 36: cart.Order.items:type_name -> cart.CartItem
+	6,  // 37: cart.Order.total_price:type_name -> cart.Money
+	50, // 38: cart.Order.created_at:type_name -> google.protobuf.Timestamp
+	48, // 39: cart.ListOrdersResponse.orders:type_name -> cart.Order
+	0,  // 40: cart.CartService.AddToCart:input_type -> cart.AddToCartRequest
+	1,  // 41: cart.CartService.UpdateItemCount:input_type -> cart.UpdateItemCountRequest
+	2,  // 42: cart.CartService.DecrementItem:input_type -> cart.DecrementItemRequest
+	3,  // 43: cart.CartService.DeleteItem:input_type -> cart.DeleteItemRequest
+	4,  // 44: cart.CartService.ClearCart:input_type -> cart.ClearCartRequest
+	5,  // 45: cart.CartService.GetCart:input_type -> cart.GetCartRequest
+	12, // 46: cart.CartService.GetCartHistory:input_type -> cart.GetCartHistoryRequest
+	15, // 47: cart.CartService.CreateGuestCart:input_type -> cart.CreateGuestCartRequest
+	17, // 48: cart.CartService.MergeCarts:input_type -> cart.MergeCartsRequest
+	18, // 49: cart.CartService.ApplyPromo:input_type -> cart.ApplyPromoRequest
+	19, // 50: cart.CartService.RemovePromo:input_type -> cart.RemovePromoRequest
+	21, // 51: cart.CartService.QuoteCart:input_type -> cart.QuoteCartRequest
+	23, // 52: cart.CartService.MoveToSaved:input_type -> cart.MoveToSavedRequest
+	24, // 53: cart.CartService.MoveToCart:input_type -> cart.MoveToCartRequest
+	25, // 54: cart.CartService.ListSaved:input_type -> cart.ListSavedRequest
+	28, // 55: cart.CartService.ShareCart:input_type -> cart.ShareCartRequest
+	30, // 56: cart.CartService.GetSharedCart:input_type -> cart.GetSharedCartRequest
+	32, // 57: cart.CartService.ImportSharedCart:input_type -> cart.ImportSharedCartRequest
+	35, // 58: cart.CartService.BulkUpdateCart:input_type -> cart.BulkUpdateCartRequest
+	39, // 59: cart.CartService.CreatePromo:input_type -> cart.CreatePromoRequest
+	40, // 60: cart.CartService.DisablePromo:input_type -> cart.DisablePromoRequest
+	42, // 61: cart.CartService.ListPromos:input_type -> cart.ListPromosRequest
+	44, // 62: cart.CartService.Checkout:input_type -> cart.CheckoutRequest
+	46, // 63: cart.CartService.GetOrder:input_type -> cart.GetOrderRequest
+	47, // 64: cart.CartService.ListOrders:input_type -> cart.ListOrdersRequest
+	11, // 65: cart.CartService.AddToCart:output_type -> cart.GetCartResponse
+	11, // 66: cart.CartService.UpdateItemCount:output_type -> cart.GetCartResponse
+	11, // 67: cart.CartService.DecrementItem:output_type -> cart.GetCartResponse
+	11, // 68: cart.CartService.DeleteItem:output_type -> cart.GetCartResponse
+	11, // 69: cart.CartService.ClearCart:output_type -> cart.GetCartResponse
+	11, // 70: cart.CartService.GetCart:output_type -> cart.GetCartResponse
+	14, // 71: cart.CartService.GetCartHistory:output_type -> cart.GetCartHistoryResponse
+	16, // 72: cart.CartService.CreateGuestCart:output_type -> cart.CreateGuestCartResponse
+	11, // 73: cart.CartService.MergeCarts:output_type -> cart.GetCartResponse
+	11, // 74: cart.CartService.ApplyPromo:output_type -> cart.GetCartResponse
+	11, // 75: cart.CartService.RemovePromo:output_type -> cart.GetCartResponse
+	22, // 76: cart.CartService.QuoteCart:output_type -> cart.QuoteCartResponse
+	11, // 77: cart.CartService.MoveToSaved:output_type -> cart.GetCartResponse
+	11, // 78: cart.CartService.MoveToCart:output_type -> cart.GetCartResponse
+	27, // 79: cart.CartService.ListSaved:output_type -> cart.ListSavedResponse
+	29, // 80: cart.CartService.ShareCart:output_type -> cart.ShareCartResponse
+	31, // 81: cart.CartService.GetSharedCart:output_type -> cart.SharedCart
+	33, // 82: cart.CartService.ImportSharedCart:output_type -> cart.ImportSharedCartResponse
+	37, // 83: cart.CartService.BulkUpdateCart:output_type -> cart.BulkUpdateCartResponse
+	38, // 84: cart.CartService.CreatePromo:output_type -> cart.Promo
+	41, // 85: cart.CartService.DisablePromo:output_type -> cart.DisablePromoResponse
+	43, // 86: cart.CartService.ListPromos:output_type -> cart.ListPromosResponse
+	45, // 87: cart.CartService.Checkout:output_type -> cart.CheckoutResponse
+	48, // 88: cart.CartService.GetOrder:output_type -> cart.Order
+	49, // 89: cart.CartService.ListOrders:output_type -> cart.ListOrdersResponse
+	65, // [65:90] is the sub-list for method output_type
+	40, // [40:65] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_CartService_api_CartService_proto_init() }
@@ -416,13 +3614,25 @@ func file_CartService_api_CartService_proto_init() {
 	if File_CartService_api_CartService_proto != nil {
 		return
 	}
+	file_CartService_api_CartService_proto_msgTypes[0].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[1].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[2].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[3].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[4].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[18].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[19].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[23].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[24].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[32].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[35].OneofWrappers = []any{}
+	file_CartService_api_CartService_proto_msgTypes[44].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_CartService_api_CartService_proto_rawDesc), len(file_CartService_api_CartService_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
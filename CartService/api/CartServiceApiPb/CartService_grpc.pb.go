@@ -19,10 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CartService_AddToCart_FullMethodName  = "/cart.CartService/AddToCart"
-	CartService_DeleteItem_FullMethodName = "/cart.CartService/DeleteItem"
-	CartService_ClearCart_FullMethodName  = "/cart.CartService/ClearCart"
-	CartService_GetCart_FullMethodName    = "/cart.CartService/GetCart"
+	CartService_AddToCart_FullMethodName        = "/cart.CartService/AddToCart"
+	CartService_UpdateItemCount_FullMethodName  = "/cart.CartService/UpdateItemCount"
+	CartService_DecrementItem_FullMethodName    = "/cart.CartService/DecrementItem"
+	CartService_DeleteItem_FullMethodName       = "/cart.CartService/DeleteItem"
+	CartService_ClearCart_FullMethodName        = "/cart.CartService/ClearCart"
+	CartService_GetCart_FullMethodName          = "/cart.CartService/GetCart"
+	CartService_GetCartHistory_FullMethodName   = "/cart.CartService/GetCartHistory"
+	CartService_CreateGuestCart_FullMethodName  = "/cart.CartService/CreateGuestCart"
+	CartService_MergeCarts_FullMethodName       = "/cart.CartService/MergeCarts"
+	CartService_ApplyPromo_FullMethodName       = "/cart.CartService/ApplyPromo"
+	CartService_RemovePromo_FullMethodName      = "/cart.CartService/RemovePromo"
+	CartService_QuoteCart_FullMethodName        = "/cart.CartService/QuoteCart"
+	CartService_MoveToSaved_FullMethodName      = "/cart.CartService/MoveToSaved"
+	CartService_MoveToCart_FullMethodName       = "/cart.CartService/MoveToCart"
+	CartService_ListSaved_FullMethodName        = "/cart.CartService/ListSaved"
+	CartService_ShareCart_FullMethodName        = "/cart.CartService/ShareCart"
+	CartService_GetSharedCart_FullMethodName    = "/cart.CartService/GetSharedCart"
+	CartService_ImportSharedCart_FullMethodName = "/cart.CartService/ImportSharedCart"
+	CartService_BulkUpdateCart_FullMethodName   = "/cart.CartService/BulkUpdateCart"
+	CartService_CreatePromo_FullMethodName      = "/cart.CartService/CreatePromo"
+	CartService_DisablePromo_FullMethodName     = "/cart.CartService/DisablePromo"
+	CartService_ListPromos_FullMethodName       = "/cart.CartService/ListPromos"
+	CartService_Checkout_FullMethodName         = "/cart.CartService/Checkout"
+	CartService_GetOrder_FullMethodName         = "/cart.CartService/GetOrder"
+	CartService_ListOrders_FullMethodName       = "/cart.CartService/ListOrders"
 )
 
 // CartServiceClient is the client API for CartService service.
@@ -30,9 +51,31 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CartServiceClient interface {
 	AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	UpdateItemCount(ctx context.Context, in *UpdateItemCountRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	DecrementItem(ctx context.Context, in *DecrementItemRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
 	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
 	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
 	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	GetCartHistory(ctx context.Context, in *GetCartHistoryRequest, opts ...grpc.CallOption) (*GetCartHistoryResponse, error)
+	CreateGuestCart(ctx context.Context, in *CreateGuestCartRequest, opts ...grpc.CallOption) (*CreateGuestCartResponse, error)
+	MergeCarts(ctx context.Context, in *MergeCartsRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	ApplyPromo(ctx context.Context, in *ApplyPromoRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	RemovePromo(ctx context.Context, in *RemovePromoRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	QuoteCart(ctx context.Context, in *QuoteCartRequest, opts ...grpc.CallOption) (*QuoteCartResponse, error)
+	MoveToSaved(ctx context.Context, in *MoveToSavedRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
+	ListSaved(ctx context.Context, in *ListSavedRequest, opts ...grpc.CallOption) (*ListSavedResponse, error)
+	ShareCart(ctx context.Context, in *ShareCartRequest, opts ...grpc.CallOption) (*ShareCartResponse, error)
+	GetSharedCart(ctx context.Context, in *GetSharedCartRequest, opts ...grpc.CallOption) (*SharedCart, error)
+	ImportSharedCart(ctx context.Context, in *ImportSharedCartRequest, opts ...grpc.CallOption) (*ImportSharedCartResponse, error)
+	BulkUpdateCart(ctx context.Context, in *BulkUpdateCartRequest, opts ...grpc.CallOption) (*BulkUpdateCartResponse, error)
+	// Admin calls, they need "authorization: Bearer <admin token>" metadata.
+	CreatePromo(ctx context.Context, in *CreatePromoRequest, opts ...grpc.CallOption) (*Promo, error)
+	DisablePromo(ctx context.Context, in *DisablePromoRequest, opts ...grpc.CallOption) (*DisablePromoResponse, error)
+	ListPromos(ctx context.Context, in *ListPromosRequest, opts ...grpc.CallOption) (*ListPromosResponse, error)
+	Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 }
 
 type cartServiceClient struct {
@@ -53,6 +96,26 @@ func (c *cartServiceClient) AddToCart(ctx context.Context, in *AddToCartRequest,
 	return out, nil
 }
 
+func (c *cartServiceClient) UpdateItemCount(ctx context.Context, in *UpdateItemCountRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, CartService_UpdateItemCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) DecrementItem(ctx context.Context, in *DecrementItemRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, CartService_DecrementItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartServiceClient) DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCartResponse)
@@ -83,14 +146,226 @@ func (c *cartServiceClient) GetCart(ctx context.Context, in *GetCartRequest, opt
 	return out, nil
 }
 
+func (c *cartServiceClient) GetCartHistory(ctx context.Context, in *GetCartHistoryRequest, opts ...grpc.CallOption) (*GetCartHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartHistoryResponse)
+	err := c.cc.Invoke(ctx, CartService_GetCartHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) CreateGuestCart(ctx context.Context, in *CreateGuestCartRequest, opts ...grpc.CallOption) (*CreateGuestCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGuestCartResponse)
+	err := c.cc.Invoke(ctx, CartService_CreateGuestCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) MergeCarts(ctx context.Context, in *MergeCartsRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, CartService_MergeCarts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ApplyPromo(ctx context.Context, in *ApplyPromoRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, CartService_ApplyPromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) RemovePromo(ctx context.Context, in *RemovePromoRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, CartService_RemovePromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) QuoteCart(ctx context.Context, in *QuoteCartRequest, opts ...grpc.CallOption) (*QuoteCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteCartResponse)
+	err := c.cc.Invoke(ctx, CartService_QuoteCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) MoveToSaved(ctx context.Context, in *MoveToSavedRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, CartService_MoveToSaved_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCartResponse)
+	err := c.cc.Invoke(ctx, CartService_MoveToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ListSaved(ctx context.Context, in *ListSavedRequest, opts ...grpc.CallOption) (*ListSavedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedResponse)
+	err := c.cc.Invoke(ctx, CartService_ListSaved_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ShareCart(ctx context.Context, in *ShareCartRequest, opts ...grpc.CallOption) (*ShareCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareCartResponse)
+	err := c.cc.Invoke(ctx, CartService_ShareCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) GetSharedCart(ctx context.Context, in *GetSharedCartRequest, opts ...grpc.CallOption) (*SharedCart, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SharedCart)
+	err := c.cc.Invoke(ctx, CartService_GetSharedCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ImportSharedCart(ctx context.Context, in *ImportSharedCartRequest, opts ...grpc.CallOption) (*ImportSharedCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportSharedCartResponse)
+	err := c.cc.Invoke(ctx, CartService_ImportSharedCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) BulkUpdateCart(ctx context.Context, in *BulkUpdateCartRequest, opts ...grpc.CallOption) (*BulkUpdateCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkUpdateCartResponse)
+	err := c.cc.Invoke(ctx, CartService_BulkUpdateCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) CreatePromo(ctx context.Context, in *CreatePromoRequest, opts ...grpc.CallOption) (*Promo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Promo)
+	err := c.cc.Invoke(ctx, CartService_CreatePromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) DisablePromo(ctx context.Context, in *DisablePromoRequest, opts ...grpc.CallOption) (*DisablePromoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisablePromoResponse)
+	err := c.cc.Invoke(ctx, CartService_DisablePromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ListPromos(ctx context.Context, in *ListPromosRequest, opts ...grpc.CallOption) (*ListPromosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromosResponse)
+	err := c.cc.Invoke(ctx, CartService_ListPromos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckoutResponse)
+	err := c.cc.Invoke(ctx, CartService_Checkout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, CartService_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, CartService_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServiceServer is the server API for CartService service.
 // All implementations must embed UnimplementedCartServiceServer
 // for forward compatibility.
 type CartServiceServer interface {
 	AddToCart(context.Context, *AddToCartRequest) (*GetCartResponse, error)
+	UpdateItemCount(context.Context, *UpdateItemCountRequest) (*GetCartResponse, error)
+	DecrementItem(context.Context, *DecrementItemRequest) (*GetCartResponse, error)
 	DeleteItem(context.Context, *DeleteItemRequest) (*GetCartResponse, error)
 	ClearCart(context.Context, *ClearCartRequest) (*GetCartResponse, error)
 	GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error)
+	GetCartHistory(context.Context, *GetCartHistoryRequest) (*GetCartHistoryResponse, error)
+	CreateGuestCart(context.Context, *CreateGuestCartRequest) (*CreateGuestCartResponse, error)
+	MergeCarts(context.Context, *MergeCartsRequest) (*GetCartResponse, error)
+	ApplyPromo(context.Context, *ApplyPromoRequest) (*GetCartResponse, error)
+	RemovePromo(context.Context, *RemovePromoRequest) (*GetCartResponse, error)
+	QuoteCart(context.Context, *QuoteCartRequest) (*QuoteCartResponse, error)
+	MoveToSaved(context.Context, *MoveToSavedRequest) (*GetCartResponse, error)
+	MoveToCart(context.Context, *MoveToCartRequest) (*GetCartResponse, error)
+	ListSaved(context.Context, *ListSavedRequest) (*ListSavedResponse, error)
+	ShareCart(context.Context, *ShareCartRequest) (*ShareCartResponse, error)
+	GetSharedCart(context.Context, *GetSharedCartRequest) (*SharedCart, error)
+	ImportSharedCart(context.Context, *ImportSharedCartRequest) (*ImportSharedCartResponse, error)
+	BulkUpdateCart(context.Context, *BulkUpdateCartRequest) (*BulkUpdateCartResponse, error)
+	// Admin calls, they need "authorization: Bearer <admin token>" metadata.
+	CreatePromo(context.Context, *CreatePromoRequest) (*Promo, error)
+	DisablePromo(context.Context, *DisablePromoRequest) (*DisablePromoResponse, error)
+	ListPromos(context.Context, *ListPromosRequest) (*ListPromosResponse, error)
+	Checkout(context.Context, *CheckoutRequest) (*CheckoutResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*Order, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	mustEmbedUnimplementedCartServiceServer()
 }
 
@@ -104,6 +379,12 @@ type UnimplementedCartServiceServer struct{}
 func (UnimplementedCartServiceServer) AddToCart(context.Context, *AddToCartRequest) (*GetCartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToCart not implemented")
 }
+func (UnimplementedCartServiceServer) UpdateItemCount(context.Context, *UpdateItemCountRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItemCount not implemented")
+}
+func (UnimplementedCartServiceServer) DecrementItem(context.Context, *DecrementItemRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecrementItem not implemented")
+}
 func (UnimplementedCartServiceServer) DeleteItem(context.Context, *DeleteItemRequest) (*GetCartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
 }
@@ -113,6 +394,63 @@ func (UnimplementedCartServiceServer) ClearCart(context.Context, *ClearCartReque
 func (UnimplementedCartServiceServer) GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
 }
+func (UnimplementedCartServiceServer) GetCartHistory(context.Context, *GetCartHistoryRequest) (*GetCartHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCartHistory not implemented")
+}
+func (UnimplementedCartServiceServer) CreateGuestCart(context.Context, *CreateGuestCartRequest) (*CreateGuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuestCart not implemented")
+}
+func (UnimplementedCartServiceServer) MergeCarts(context.Context, *MergeCartsRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCarts not implemented")
+}
+func (UnimplementedCartServiceServer) ApplyPromo(context.Context, *ApplyPromoRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPromo not implemented")
+}
+func (UnimplementedCartServiceServer) RemovePromo(context.Context, *RemovePromoRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePromo not implemented")
+}
+func (UnimplementedCartServiceServer) QuoteCart(context.Context, *QuoteCartRequest) (*QuoteCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteCart not implemented")
+}
+func (UnimplementedCartServiceServer) MoveToSaved(context.Context, *MoveToSavedRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToSaved not implemented")
+}
+func (UnimplementedCartServiceServer) MoveToCart(context.Context, *MoveToCartRequest) (*GetCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToCart not implemented")
+}
+func (UnimplementedCartServiceServer) ListSaved(context.Context, *ListSavedRequest) (*ListSavedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSaved not implemented")
+}
+func (UnimplementedCartServiceServer) ShareCart(context.Context, *ShareCartRequest) (*ShareCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareCart not implemented")
+}
+func (UnimplementedCartServiceServer) GetSharedCart(context.Context, *GetSharedCartRequest) (*SharedCart, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedCart not implemented")
+}
+func (UnimplementedCartServiceServer) ImportSharedCart(context.Context, *ImportSharedCartRequest) (*ImportSharedCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSharedCart not implemented")
+}
+func (UnimplementedCartServiceServer) BulkUpdateCart(context.Context, *BulkUpdateCartRequest) (*BulkUpdateCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateCart not implemented")
+}
+func (UnimplementedCartServiceServer) CreatePromo(context.Context, *CreatePromoRequest) (*Promo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromo not implemented")
+}
+func (UnimplementedCartServiceServer) DisablePromo(context.Context, *DisablePromoRequest) (*DisablePromoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePromo not implemented")
+}
+func (UnimplementedCartServiceServer) ListPromos(context.Context, *ListPromosRequest) (*ListPromosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromos not implemented")
+}
+func (UnimplementedCartServiceServer) Checkout(context.Context, *CheckoutRequest) (*CheckoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Checkout not implemented")
+}
+func (UnimplementedCartServiceServer) GetOrder(context.Context, *GetOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedCartServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
 func (UnimplementedCartServiceServer) mustEmbedUnimplementedCartServiceServer() {}
 func (UnimplementedCartServiceServer) testEmbeddedByValue()                     {}
 
@@ -152,6 +490,42 @@ func _CartService_AddToCart_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_UpdateItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).UpdateItemCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_UpdateItemCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).UpdateItemCount(ctx, req.(*UpdateItemCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_DecrementItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecrementItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).DecrementItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_DecrementItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).DecrementItem(ctx, req.(*DecrementItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CartService_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteItemRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +580,348 @@ func _CartService_GetCart_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_GetCartHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).GetCartHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_GetCartHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).GetCartHistory(ctx, req.(*GetCartHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_CreateGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuestCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).CreateGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_CreateGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).CreateGuestCart(ctx, req.(*CreateGuestCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_MergeCarts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MergeCarts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MergeCarts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MergeCarts(ctx, req.(*MergeCartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ApplyPromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ApplyPromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ApplyPromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ApplyPromo(ctx, req.(*ApplyPromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_RemovePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).RemovePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_RemovePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).RemovePromo(ctx, req.(*RemovePromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_QuoteCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).QuoteCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_QuoteCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).QuoteCart(ctx, req.(*QuoteCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_MoveToSaved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToSavedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MoveToSaved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MoveToSaved_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MoveToSaved(ctx, req.(*MoveToSavedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_MoveToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MoveToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MoveToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MoveToCart(ctx, req.(*MoveToCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ListSaved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ListSaved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ListSaved_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ListSaved(ctx, req.(*ListSavedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ShareCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ShareCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ShareCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ShareCart(ctx, req.(*ShareCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_GetSharedCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).GetSharedCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_GetSharedCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).GetSharedCart(ctx, req.(*GetSharedCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ImportSharedCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSharedCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ImportSharedCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ImportSharedCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ImportSharedCart(ctx, req.(*ImportSharedCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_BulkUpdateCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).BulkUpdateCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_BulkUpdateCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).BulkUpdateCart(ctx, req.(*BulkUpdateCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_CreatePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).CreatePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_CreatePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).CreatePromo(ctx, req.(*CreatePromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_DisablePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisablePromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).DisablePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_DisablePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).DisablePromo(ctx, req.(*DisablePromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ListPromos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ListPromos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ListPromos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ListPromos(ctx, req.(*ListPromosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).Checkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_Checkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).Checkout(ctx, req.(*CheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CartService_ServiceDesc is the grpc.ServiceDesc for CartService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -217,6 +933,14 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddToCart",
 			Handler:    _CartService_AddToCart_Handler,
 		},
+		{
+			MethodName: "UpdateItemCount",
+			Handler:    _CartService_UpdateItemCount_Handler,
+		},
+		{
+			MethodName: "DecrementItem",
+			Handler:    _CartService_DecrementItem_Handler,
+		},
 		{
 			MethodName: "DeleteItem",
 			Handler:    _CartService_DeleteItem_Handler,
@@ -229,6 +953,82 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCart",
 			Handler:    _CartService_GetCart_Handler,
 		},
+		{
+			MethodName: "GetCartHistory",
+			Handler:    _CartService_GetCartHistory_Handler,
+		},
+		{
+			MethodName: "CreateGuestCart",
+			Handler:    _CartService_CreateGuestCart_Handler,
+		},
+		{
+			MethodName: "MergeCarts",
+			Handler:    _CartService_MergeCarts_Handler,
+		},
+		{
+			MethodName: "ApplyPromo",
+			Handler:    _CartService_ApplyPromo_Handler,
+		},
+		{
+			MethodName: "RemovePromo",
+			Handler:    _CartService_RemovePromo_Handler,
+		},
+		{
+			MethodName: "QuoteCart",
+			Handler:    _CartService_QuoteCart_Handler,
+		},
+		{
+			MethodName: "MoveToSaved",
+			Handler:    _CartService_MoveToSaved_Handler,
+		},
+		{
+			MethodName: "MoveToCart",
+			Handler:    _CartService_MoveToCart_Handler,
+		},
+		{
+			MethodName: "ListSaved",
+			Handler:    _CartService_ListSaved_Handler,
+		},
+		{
+			MethodName: "ShareCart",
+			Handler:    _CartService_ShareCart_Handler,
+		},
+		{
+			MethodName: "GetSharedCart",
+			Handler:    _CartService_GetSharedCart_Handler,
+		},
+		{
+			MethodName: "ImportSharedCart",
+			Handler:    _CartService_ImportSharedCart_Handler,
+		},
+		{
+			MethodName: "BulkUpdateCart",
+			Handler:    _CartService_BulkUpdateCart_Handler,
+		},
+		{
+			MethodName: "CreatePromo",
+			Handler:    _CartService_CreatePromo_Handler,
+		},
+		{
+			MethodName: "DisablePromo",
+			Handler:    _CartService_DisablePromo_Handler,
+		},
+		{
+			MethodName: "ListPromos",
+			Handler:    _CartService_ListPromos_Handler,
+		},
+		{
+			MethodName: "Checkout",
+			Handler:    _CartService_Checkout_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _CartService_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _CartService_ListOrders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "CartService/api/CartService.proto",
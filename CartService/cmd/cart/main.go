@@ -1,21 +1,27 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"net/http"
+	"os"
 	"time"
 
 	httpSwagger "github.com/swaggo/http-swagger"
 	"github.com/verbovyar/OzonCart/api/CartServiceApiPb"
 	"github.com/verbovyar/OzonCart/config"
+	"github.com/verbovyar/OzonCart/internal/events"
 	"github.com/verbovyar/OzonCart/internal/handlers"
 	"github.com/verbovyar/OzonCart/internal/middleware"
 	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
 	"github.com/verbovyar/OzonCart/internal/repositories/interfaces"
 	"github.com/verbovyar/OzonCart/internal/service"
 	"github.com/verbovyar/OzonCart/pkg"
+	"github.com/verbovyar/OzonCart/pkg/money"
+	"github.com/verbovyar/OzonCart/pkg/retry"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
@@ -36,9 +42,12 @@ func main() {
 	log.Printf("%s", conf.ConnectingString)
 
 	postgresStore := RunPostgres(conf.ConnectingString)
-	cartService := RunService(conf.ProductURL, conf.ProductToken, postgresStore)
-	RunGrpc(cartService, conf.Port, conf.NetworkType)
-	//RunHttp(cartService, conf.Port)
+	cartService := RunService(conf, postgresStore)
+	go RunIdempotencyCleanup(cartService, conf.IdempotencyCleanupInterval)
+	go RunOutboxRelay(conf, postgresStore)
+	go RunCartSweeper(cartService, conf.CartSweepInterval)
+	RunGrpc(cartService, conf.Port, conf.NetworkType, conf.AdminToken)
+	//RunHttp(cartService, conf.Port, conf.AdminToken)
 }
 
 func RunPostgres(connectionString string) *postgres.Store {
@@ -48,22 +57,187 @@ func RunPostgres(connectionString string) *postgres.Store {
 	return store
 }
 
-func RunService(productURL, productToken string, store interfaces.RepositoryIface) *service.CartService {
-	pc := service.NewClient(productURL, productToken, 3, 300*time.Millisecond)
-	cs := service.New(store, pc)
+func RunService(conf config.Config, store interfaces.RepositoryIface) *service.CartService {
+	policy := retry.Policy{
+		MaxAttempts:    conf.ProductRetryAttempts,
+		BaseDelay:      conf.ProductRetryBaseDelay,
+		MaxDelay:       conf.ProductRetryMaxDelay,
+		MaxElapsedTime: conf.ProductRetryMaxElapsed,
+	}
+
+	pc := newProductClient(conf, policy)
+	if conf.ProductBreakerThreshold > 0 {
+		cb := service.NewCircuitBreaker(conf.ProductBreakerThreshold, conf.ProductBreakerCoolDown)
+		pc = service.NewBreakerProductClient(pc, cb)
+	}
+	if conf.ProductCacheEnabled {
+		cached := service.NewCachedProductClient(pc, conf.ProductCacheSize, conf.ProductCacheTTL, conf.ProductCacheNegativeTTL)
+		go RunCacheStats(cached, conf.ProductCacheStatsInterval)
+		pc = cached
+	}
+	cs := service.New(store, pc,
+		service.WithBatchLookup(conf.ProductBatchLookup),
+		service.WithProductConcurrency(conf.ProductConcurrency),
+		service.WithLimits(service.Limits{
+			MaxItemCount:    conf.CartMaxItemCount,
+			MaxDistinctSkus: conf.CartMaxDistinctSkus,
+			MaxTotalPrice:   conf.CartMaxTotalPrice,
+		}),
+		service.WithUserLockStripes(conf.CartUserLockStripes),
+		service.WithIdempotencyTTL(conf.IdempotencyTTL),
+		service.WithMergeStrategy(mergeStrategy(conf.CartMergeStrategy)),
+		service.WithDelistedCleanup(conf.CartRemoveDelisted),
+		service.WithCurrency(conf.CartCurrency),
+		service.WithRateProvider(rateProvider(conf.CartExchangeRates)),
+		quotes(conf.QuoteRulesFile),
+		service.WithCartSharing([]byte(conf.CartShareKey), conf.CartShareTTL),
+		service.WithSweeper(service.SweepPolicy{
+			CartTTL:        conf.CartTTL,
+			AbandonedAfter: conf.CartAbandonedAfter,
+			Batch:          conf.CartSweepBatch,
+		}, service.NewLogNotifier(os.Stdout)),
+	)
 
 	return cs
 }
 
-func RunHttp(cs *service.CartService, port string) {
+func newProductClient(conf config.Config, policy retry.Policy) service.ClientIface {
+	switch conf.ProductTransport {
+	case "grpc":
+		conn, err := grpc.NewClient(conf.ProductGrpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return service.NewGrpcClient(conn, conf.ProductToken, policy)
+	case "", "http":
+		return service.NewClient(conf.ProductURL, conf.ProductToken, policy)
+	default:
+		log.Fatalf("unknown PRODUCT_TRANSPORT %q", conf.ProductTransport)
+		return nil
+	}
+}
+
+func mergeStrategy(s string) service.MergeStrategy {
+	if s == "" {
+		return ""
+	}
+	st, err := service.ParseMergeStrategy(s)
+	if err != nil {
+		log.Fatalf("unknown CART_MERGE_STRATEGY %q", s)
+	}
+	return st
+}
+
+func rateProvider(s string) money.RateProvider {
+	if s == "" {
+		return nil
+	}
+	rates, err := money.ParseRates(s)
+	if err != nil {
+		log.Fatalf("invalid CART_EXCHANGE_RATES: %v", err)
+	}
+	return rates
+}
+
+// quotes enables QuoteCart with the rule table in path, if any.
+func quotes(path string) service.Option {
+	if path == "" {
+		return service.WithQuotes(nil, nil)
+	}
+	rules, err := service.LoadQuoteRules(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return service.WithQuotes(rules, rules)
+}
+
+// RunIdempotencyCleanup purges expired idempotency keys every interval.
+func RunIdempotencyCleanup(cs *service.CartService, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for range t.C {
+		n, err := cs.PurgeIdempotencyKeys(context.Background())
+		if err != nil {
+			log.Printf("purge idempotency keys: %v", err)
+			continue
+		}
+		log.Printf("purged %d idempotency keys", n)
+	}
+}
+
+// RunCacheStats logs the hits and misses of the product cache every interval.
+func RunCacheStats(c *service.CachedProductClient, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for range t.C {
+		hits, misses := c.Stats()
+		log.Printf("product cache: %d hits, %d misses", hits, misses)
+	}
+}
+
+// RunCartSweeper expires stale carts and reports abandoned ones every interval.
+func RunCartSweeper(cs *service.CartService, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for range t.C {
+		res, err := cs.SweepCarts(context.Background())
+		if err != nil {
+			log.Printf("sweep carts: %v", err)
+			continue
+		}
+		if !res.Skipped {
+			log.Printf("swept carts: %d expired, %d reported abandoned, %d share links deleted", res.Expired, res.Notified, res.SharesDeleted)
+		}
+	}
+}
+
+// RunOutboxRelay publishes the cart events of the outbox with the configured publisher.
+func RunOutboxRelay(conf config.Config, store *postgres.Store) {
+	var pub events.EventPublisher
+	switch conf.EventsPublisher {
+	case "":
+		return
+	case "log":
+		pub = events.NewLogPublisher(os.Stdout)
+	case "file":
+		f, err := os.OpenFile(conf.EventsFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pub = events.NewLogPublisher(f)
+	case "webhook":
+		pub = events.NewWebhookPublisher(conf.EventsWebhookURL, conf.EventsWebhookToken, 5*time.Second)
+	default:
+		log.Fatalf("unknown EVENTS_PUBLISHER %q", conf.EventsPublisher)
+	}
+
+	events.NewRelay(store, pub, conf.EventsRelayBatch, conf.EventsRelayInterval).Run(context.Background())
+}
+
+func RunHttp(cs *service.CartService, port string, adminToken string) {
 	mux := http.NewServeMux()
-	mux.Handle("/user/", handlers.New(cs)) // handlers
+	cartHandler := handlers.New(cs)
+	mux.Handle("/user/", cartHandler) // handlers
+	mux.Handle("/guest/", cartHandler)
+	mux.Handle("/admin/", handlers.NewAdmin(cs, adminToken))
 
 	mux.Handle("/swagger/", httpSwagger.Handler(
 		httpSwagger.URL("/swagger/doc.json"),
 	))
 
-	handlerWithMW := middleware.Logging(mux)
+	handlerWithMW := middleware.Logging(middleware.RequestContext(mux))
 
 	log.Printf("HTTP server is listening on port%s", port)
 	log.Printf("Swagger UI: http://localhost%s/swagger/index.html", port)
@@ -74,13 +248,17 @@ func RunHttp(cs *service.CartService, port string) {
 	}
 }
 
-func RunGrpc(cs *service.CartService, port string, networkType string) {
+func RunGrpc(cs *service.CartService, port string, networkType string, adminToken string) {
 	listener, err := net.Listen(networkType, port)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	grpcSrv := grpc.NewServer()
+	grpcSrv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		middleware.UnaryRequestContext,
+		handlers.AdminAuthInterceptor(adminToken),
+		handlers.IdempotencyInterceptor(cs),
+	))
 	CartServiceApiPb.RegisterCartServiceServer(grpcSrv, handlers.NewGrpsRouter(cs))
 
 	// health + reflection
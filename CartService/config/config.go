@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -12,6 +13,75 @@ type Config struct {
 	ProductURL       string `mapstructure:"PRODUCT_URL"`
 	ProductToken     string `mapstructure:"PRODUCT_TOKEN"`
 	NetworkType      string `mapstructure:"NETWORK_TYPE"`
+
+	// ProductTransport is "http" (default) or "grpc".
+	ProductTransport string `mapstructure:"PRODUCT_TRANSPORT"`
+	ProductGrpcAddr  string `mapstructure:"PRODUCT_GRPC_ADDR"`
+
+	ProductBatchLookup bool `mapstructure:"PRODUCT_BATCH_LOOKUP"`
+	ProductConcurrency int  `mapstructure:"PRODUCT_CONCURRENCY"`
+
+	ProductCacheEnabled       bool          `mapstructure:"PRODUCT_CACHE_ENABLED"`
+	ProductCacheSize          int           `mapstructure:"PRODUCT_CACHE_SIZE"`
+	ProductCacheTTL           time.Duration `mapstructure:"PRODUCT_CACHE_TTL"`
+	ProductCacheNegativeTTL   time.Duration `mapstructure:"PRODUCT_CACHE_NEGATIVE_TTL"`
+	ProductCacheStatsInterval time.Duration `mapstructure:"PRODUCT_CACHE_STATS_INTERVAL"`
+
+	ProductRetryAttempts   int           `mapstructure:"PRODUCT_RETRY_ATTEMPTS"`
+	ProductRetryBaseDelay  time.Duration `mapstructure:"PRODUCT_RETRY_BASE_DELAY"`
+	ProductRetryMaxDelay   time.Duration `mapstructure:"PRODUCT_RETRY_MAX_DELAY"`
+	ProductRetryMaxElapsed time.Duration `mapstructure:"PRODUCT_RETRY_MAX_ELAPSED"`
+
+	ProductBreakerThreshold int           `mapstructure:"PRODUCT_BREAKER_THRESHOLD"`
+	ProductBreakerCoolDown  time.Duration `mapstructure:"PRODUCT_BREAKER_COOLDOWN"`
+
+	// zero disables a limit
+	CartMaxItemCount    uint64 `mapstructure:"CART_MAX_ITEM_COUNT"`
+	CartMaxDistinctSkus int    `mapstructure:"CART_MAX_DISTINCT_SKUS"`
+	CartMaxTotalPrice   uint64 `mapstructure:"CART_MAX_TOTAL_PRICE"`
+
+	CartUserLockStripes int `mapstructure:"CART_USER_LOCK_STRIPES"`
+
+	// CartRemoveDelisted makes GetCart drop positions of unknown skus.
+	CartRemoveDelisted bool `mapstructure:"CART_REMOVE_DELISTED"`
+
+	// CartCurrency is the ISO 4217 currency of the carts, RUB by default.
+	// CartExchangeRates such as "USD/RUB=92.5,EUR/RUB=101" lets carts hold
+	// products priced in other currencies, empty refuses them.
+	CartCurrency      string `mapstructure:"CART_CURRENCY"`
+	CartExchangeRates string `mapstructure:"CART_EXCHANGE_RATES"`
+
+	// QuoteRulesFile is the JSON tax and shipping table of QuoteCart, empty
+	// turns quotes off.
+	QuoteRulesFile string `mapstructure:"QUOTE_RULES_FILE"`
+
+	// zero CartTTL or CartAbandonedAfter disables that part of the sweep
+	CartTTL            time.Duration `mapstructure:"CART_TTL"`
+	CartAbandonedAfter time.Duration `mapstructure:"CART_ABANDONED_AFTER"`
+	CartSweepInterval  time.Duration `mapstructure:"CART_SWEEP_INTERVAL"`
+	CartSweepBatch     int           `mapstructure:"CART_SWEEP_BATCH"`
+
+	// CartShareKey signs the tokens of shared carts, empty turns sharing
+	// off. CartShareTTL is how long a token works, a week by default.
+	CartShareKey string        `mapstructure:"CART_SHARE_KEY"`
+	CartShareTTL time.Duration `mapstructure:"CART_SHARE_TTL"`
+
+	// AdminToken guards the promo admin API, empty turns it off.
+	AdminToken string `mapstructure:"ADMIN_TOKEN"`
+
+	// CartMergeStrategy is "sum" (default), "max" or "prefer-user".
+	CartMergeStrategy string `mapstructure:"CART_MERGE_STRATEGY"`
+
+	IdempotencyTTL             time.Duration `mapstructure:"IDEMPOTENCY_TTL"`
+	IdempotencyCleanupInterval time.Duration `mapstructure:"IDEMPOTENCY_CLEANUP_INTERVAL"`
+
+	// EventsPublisher is "log", "file", "webhook" or empty to keep events in the outbox.
+	EventsPublisher     string        `mapstructure:"EVENTS_PUBLISHER"`
+	EventsFile          string        `mapstructure:"EVENTS_FILE"`
+	EventsWebhookURL    string        `mapstructure:"EVENTS_WEBHOOK_URL"`
+	EventsWebhookToken  string        `mapstructure:"EVENTS_WEBHOOK_TOKEN"`
+	EventsRelayBatch    int           `mapstructure:"EVENTS_RELAY_BATCH"`
+	EventsRelayInterval time.Duration `mapstructure:"EVENTS_RELAY_INTERVAL"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
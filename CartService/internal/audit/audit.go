@@ -0,0 +1,42 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// Headers and gRPC metadata keys the caller is read from. The caller
+// identity is set by the gateway or by support tooling and is trusted as is.
+const (
+	CallerHeader      = "X-Caller-Id"
+	RequestIDHeader   = "X-Request-Id"
+	CallerMetadata    = "x-caller-id"
+	RequestIDMetadata = "x-request-id"
+)
+
+// Caller is who made a request, recorded in the cart history.
+type Caller struct {
+	Identity  string
+	RequestID string
+}
+
+type callerKey struct{}
+
+func WithCaller(ctx context.Context, c Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, c)
+}
+
+// FromContext returns the caller of the request, zero if it is not known.
+func FromContext(ctx context.Context) Caller {
+	c, _ := ctx.Value(callerKey{}).(Caller)
+	return c
+}
+
+// NewRequestID returns a random ID for requests that came without one.
+func NewRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
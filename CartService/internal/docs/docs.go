@@ -15,6 +15,212 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/promos": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Список промокодов",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cadmin token\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ListPromosResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Правила: percent (процент от суммы), fixed (фиксированная скидка), buy_n_get_m (из каждых buy_count+free_count единиц SKU free_count бесплатно). min_subtotal задаёт минимальную сумму корзины для любого правила",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Создать промокод",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cadmin token\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Промокод",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.Promo"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Promo"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid promo",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "promo code already exists",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/promos/{code}/disable": {
+            "post": {
+                "description": "Промокод перестаёт давать скидку, корзины сохраняют его",
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Отключить промокод",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bearer \u003cadmin token\u003e",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Промокод",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "promo code not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/guest/carts": {
+            "post": {
+                "description": "Создаёт корзину анонимного покупателя. Токен передаётся в заголовке X-Guest-Token на маршрутах /guest/cart/..., которые повторяют /user/{user_id}/cart/... кроме оформления заказа",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guest"
+                ],
+                "summary": "Создать гостевую корзину",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateGuestCartResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/shared/{token}": {
+            "get": {
+                "description": "Возвращает снимок корзины в том виде, в каком им поделились",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "share"
+                ],
+                "summary": "Получить корзину по ссылке",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Токен из ShareCart",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SharedCart"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid share token",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "shared cart not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "410": {
+                        "description": "share link expired",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/user/{user_id}/cart": {
             "get": {
                 "tags": [
@@ -35,6 +241,12 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/domain.GetCartResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия корзины"
+                            }
                         }
                     },
                     "404": {
@@ -42,6 +254,12 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "503": {
+                        "description": "product service unavailable",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             },
@@ -57,25 +275,46 @@ const docTemplate = `{
                         "name": "user_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "204": {
                         "description": "No Content"
+                    },
+                    "412": {
+                        "description": "cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/user/{user_id}/cart/{sku_id}": {
+        "/user/{user_id}/cart/bulk": {
             "post": {
-                "description": "Добавляет SKU в корзину пользователя после проверки существования во внешнем ProductService",
+                "description": "Применяет по порядку операции add, set и delete с одной пакетной проверкой товаров в ProductService и в одной транзакции. Возвращает результат каждой операции; с all_or_nothing при ошибке любой операции корзина не меняется, иначе применяются остальные",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "cart"
                 ],
-                "summary": "Добавить товар в корзину",
+                "summary": "Изменить несколько позиций корзины",
                 "parameters": [
                     {
                         "type": "integer",
@@ -85,25 +324,39 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "SKU товара",
-                        "name": "sku_id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Количество",
+                        "description": "Операции",
                         "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/domain.AddToCartRequest"
+                            "$ref": "#/definitions/domain.BulkUpdateCartRequest"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.BulkUpdateCartResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия корзины"
+                            }
+                        }
                     },
                     "400": {
                         "description": "invalid input",
@@ -111,8 +364,64 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "product not found",
+                    "412": {
+                        "description": "cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "product service unavailable",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/cart/checkout": {
+            "post": {
+                "description": "Фиксирует доступные позиции корзины с ценами в заказ и убирает их из корзины в одной транзакции. Недоступные позиции остаются в корзине",
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Оформить заказ из корзины",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CheckoutResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "cart is empty or changed",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "cart version mismatch",
                         "schema": {
                             "type": "string"
                         }
@@ -122,14 +431,26 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "503": {
+                        "description": "product service unavailable",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/user/{user_id}/cart/history": {
+            "get": {
+                "description": "Возвращает изменения позиций корзины начиная с since, от старых к новым, с постраничной выдачей по курсору",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "cart"
                 ],
-                "summary": "Удалить товар из корзины",
+                "summary": "История изменений корзины",
                 "parameters": [
                     {
                         "type": "integer",
@@ -138,36 +459,1470 @@ const docTemplate = `{
                         "in": "path",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "Время в RFC 3339, с которого выдаётся история",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_page_token предыдущей страницы",
+                        "name": "page_token",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "SKU",
-                        "name": "sku_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Размер страницы, по умолчанию 50",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CartHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
-        }
+        },
+        "/user/{user_id}/cart/import": {
+            "post": {
+                "description": "Добавляет товары снимка в корзину пользователя по текущим ценам с проверкой лимитов и резервом остатков: все или ничего. Недоступные товары пропускаются, их sku возвращаются в skipped_sku_ids",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "share"
+                ],
+                "summary": "Добавить в корзину товары из чужой корзины",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Токен из ShareCart",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.ImportSharedCartRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ImportSharedCartResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия корзины"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "shared cart not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "nothing left to import",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "410": {
+                        "description": "share link expired",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "insufficient stock or cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "cart limit exceeded or price overflow",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/cart/merge": {
+            "post": {
+                "description": "Объединяет гостевую корзину с корзиной пользователя по стратегии sum, max или prefer-user с учётом лимитов и удаляет гостевую корзину",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "guest"
+                ],
+                "summary": "Перенести гостевую корзину в корзину пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Токен гостевой корзины и стратегия",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.MergeCartsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.GetCartResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "guest cart not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/cart/promo": {
+            "post": {
+                "description": "Привязывает промокод к корзине вместо прежнего. Порог min_subtotal проверяется при каждом чтении корзины",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Применить промокод к корзине",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Промокод",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.ApplyPromoRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.GetCartResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия корзины"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "promo code not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Убрать промокод из корзины",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.GetCartResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия корзины"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "promo code not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/cart/quote": {
+            "post": {
+                "description": "Считает сумму товаров со скидками, налог и доставку до адреса. Налог и доставка — оценка, в заказ они не входят",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Оценить итоговую стоимость корзины",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Адрес доставки",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.QuoteCartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CartQuote"
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "cart is empty",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "cart cannot be shipped to the address or price currency differs from cart currency",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "501": {
+                        "description": "cart quotes are not configured",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/cart/saved": {
+            "get": {
+                "description": "Возвращает отложенные товары с текущими названиями и ценами, недоступные товары перечислены отдельно",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "saved"
+                ],
+                "summary": "Получить отложенные товары",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ListSavedResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "product service unavailable",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/cart/saved/{sku_id}/move": {
+            "post": {
+                "description": "Переносит отложенную позицию SKU в корзину по текущей цене с проверкой лимитов и резервом остатков",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "saved"
+                ],
+                "summary": "Вернуть отложенный товар в корзину",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "SKU товара",
+                        "name": "sku_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.GetCartResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия корзины"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "item not in saved list or product not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "insufficient stock or cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "cart limit exceeded, price currency differs from cart currency or price overflow",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "product service unavailable",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/cart/share": {
+            "post": {
+                "description": "Сохраняет снимок корзины с текущими названиями и ценами и возвращает подписанный токен с ограниченным сроком действия. Недоступные товары и промокод в снимок не входят",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "share"
+                ],
+                "summary": "Поделиться корзиной",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ShareCartResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "cart is empty",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "501": {
+                        "description": "cart sharing is not configured",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/cart/{sku_id}": {
+            "put": {
+                "description": "Задаёт абсолютное количество SKU в корзине, 0 удаляет позицию",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Установить количество товара в корзине",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "SKU товара",
+                        "name": "sku_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Количество",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.UpdateItemCountRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "product not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "insufficient stock or cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "cart limit exceeded, price currency differs from cart currency or price overflow",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "product service unavailable",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Добавляет SKU в корзину пользователя после проверки существования во внешнем ProductService",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Добавить товар в корзину",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "SKU товара",
+                        "name": "sku_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Количество",
+                        "name": "payload",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.AddToCartRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "product not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "insufficient stock or cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "422": {
+                        "description": "cart limit exceeded, price currency differs from cart currency or price overflow",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "product service unavailable",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Удалить товар из корзины",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "SKU",
+                        "name": "sku_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "412": {
+                        "description": "cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/cart/{sku_id}/decrement": {
+            "post": {
+                "description": "Убирает одну единицу SKU из корзины, позиция удаляется при достижении нуля",
+                "tags": [
+                    "cart"
+                ],
+                "summary": "Уменьшить количество товара на единицу",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "SKU товара",
+                        "name": "sku_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "item not in cart",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/cart/{sku_id}/save": {
+            "post": {
+                "description": "Переносит позицию SKU из корзины в список отложенных целиком и снимает резерв остатков",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "saved"
+                ],
+                "summary": "Отложить товар",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "SKU товара",
+                        "name": "sku_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Версия корзины из ETag",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ключ идемпотентности",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.GetCartResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Версия корзины"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid input",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "item not in cart",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "412": {
+                        "description": "cart version mismatch",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/orders": {
+            "get": {
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Получить заказы пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ListOrdersResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{user_id}/orders/{order_id}": {
+            "get": {
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Получить заказ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID заказа",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.Order"
+                        }
+                    },
+                    "404": {
+                        "description": "order not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
     },
     "definitions": {
         "domain.AddToCartRequest": {
             "type": "object",
             "required": [
-                "count"
+                "count"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "maximum": 60000
+                }
+            }
+        },
+        "domain.Address": {
+            "type": "object",
+            "required": [
+                "country"
+            ],
+            "properties": {
+                "city": {
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "country": {
+                    "type": "string"
+                },
+                "postal_code": {
+                    "type": "string",
+                    "maxLength": 16
+                },
+                "region": {
+                    "type": "string",
+                    "maxLength": 6
+                }
+            }
+        },
+        "domain.ApplyPromoRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "domain.BulkOp": {
+            "type": "object",
+            "required": [
+                "sku_id"
+            ],
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "maximum": 60000
+                },
+                "op": {
+                    "type": "string",
+                    "enum": [
+                        "add",
+                        "set",
+                        "delete"
+                    ]
+                },
+                "sku_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.BulkOpResult": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "op": {
+                    "type": "string"
+                },
+                "sku_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.BulkUpdateCartRequest": {
+            "type": "object",
+            "required": [
+                "ops"
+            ],
+            "properties": {
+                "all_or_nothing": {
+                    "type": "boolean"
+                },
+                "ops": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/domain.BulkOp"
+                    }
+                }
+            }
+        },
+        "domain.BulkUpdateCartResponse": {
+            "type": "object",
+            "properties": {
+                "cart": {
+                    "$ref": "#/definitions/domain.GetCartResponse"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.BulkOpResult"
+                    }
+                }
+            }
+        },
+        "domain.CartHistoryEvent": {
+            "type": "object",
+            "properties": {
+                "at": {
+                    "type": "string"
+                },
+                "caller": {
+                    "type": "string"
+                },
+                "delta": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "new_count": {
+                    "type": "integer"
+                },
+                "old_count": {
+                    "type": "integer"
+                },
+                "operation": {
+                    "type": "string"
+                },
+                "request_id": {
+                    "type": "string"
+                },
+                "sku_id": {
+                    "type": "integer"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CartHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CartHistoryEvent"
+                    }
+                },
+                "next_page_token": {
+                    "description": "NextPageToken is empty on the last page.",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.CartItem": {
+            "type": "object",
+            "properties": {
+                "added_price": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "current_price": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "sku_id": {
+                    "type": "integer"
+                },
+                "weight_grams": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CartQuote": {
+            "type": "object",
+            "properties": {
+                "discount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "shipping": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "tax": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "total": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "weight_grams": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CheckoutResponse": {
+            "type": "object",
+            "properties": {
+                "order_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.CreateGuestCartResponse": {
+            "type": "object",
+            "properties": {
+                "guest_token": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Discount": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.GetCartResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CartItem"
+                    }
+                },
+                "price": {
+                    "$ref": "#/definitions/domain.PriceBreakdown"
+                },
+                "prices_changed": {
+                    "description": "PricesChanged is set when some item costs other than when it was added.",
+                    "type": "boolean"
+                },
+                "promo_code": {
+                    "description": "PromoCode is the code attached to the cart. It gives no discount while\ndisabled or below its threshold.",
+                    "type": "string"
+                },
+                "total_price": {
+                    "description": "TotalPrice is Price.Total, kept for older clients.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/money.Money"
+                        }
+                    ]
+                },
+                "unavailable_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.UnavailableItem"
+                    }
+                },
+                "version": {
+                    "description": "Version changes with every mutation of the cart, HTTP also sends it as ETag.",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.ImportSharedCartRequest": {
+            "type": "object",
+            "required": [
+                "token"
             ],
             "properties": {
-                "count": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.ImportSharedCartResponse": {
+            "type": "object",
+            "properties": {
+                "cart": {
+                    "$ref": "#/definitions/domain.GetCartResponse"
+                },
+                "skipped_sku_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "domain.ListOrdersResponse": {
+            "type": "object",
+            "properties": {
+                "orders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Order"
+                    }
+                }
+            }
+        },
+        "domain.ListPromosResponse": {
+            "type": "object",
+            "properties": {
+                "promos": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Promo"
+                    }
+                }
+            }
+        },
+        "domain.ListSavedResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SavedItem"
+                    }
+                },
+                "unavailable_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.UnavailableItem"
+                    }
+                }
+            }
+        },
+        "domain.MergeCartsRequest": {
+            "type": "object",
+            "required": [
+                "guest_token"
+            ],
+            "properties": {
+                "guest_token": {
+                    "type": "string"
+                },
+                "strategy": {
+                    "type": "string",
+                    "enum": [
+                        "sum",
+                        "max",
+                        "prefer-user"
+                    ]
+                }
+            }
+        },
+        "domain.Order": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CartItem"
+                    }
+                },
+                "order_id": {
+                    "type": "integer"
+                },
+                "total_price": {
+                    "$ref": "#/definitions/money.Money"
+                }
+            }
+        },
+        "domain.PriceBreakdown": {
+            "type": "object",
+            "properties": {
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Discount"
+                    }
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/money.Money"
+                },
+                "total": {
+                    "$ref": "#/definitions/money.Money"
+                }
+            }
+        },
+        "domain.Promo": {
+            "type": "object",
+            "required": [
+                "code",
+                "kind"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "buy_count": {
+                    "type": "integer"
+                },
+                "code": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "disabled": {
+                    "type": "boolean"
+                },
+                "free_count": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "percent",
+                        "fixed",
+                        "buy_n_get_m"
+                    ]
+                },
+                "min_subtotal": {
+                    "type": "integer"
+                },
+                "percent": {
                     "type": "integer",
-                    "maximum": 60000
+                    "maximum": 100
+                },
+                "sku_id": {
+                    "type": "integer"
                 }
             }
         },
-        "domain.CartItem": {
+        "domain.QuoteCartRequest": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "$ref": "#/definitions/domain.Address"
+                }
+            }
+        },
+        "domain.SavedItem": {
             "type": "object",
             "properties": {
                 "count": {
@@ -177,16 +1932,36 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "price": {
-                    "type": "integer"
+                    "$ref": "#/definitions/money.Money"
+                },
+                "saved_at": {
+                    "type": "string"
                 },
                 "sku_id": {
                     "type": "integer"
                 }
             }
         },
-        "domain.GetCartResponse": {
+        "domain.ShareCartResponse": {
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.SharedCart": {
             "type": "object",
             "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
                 "items": {
                     "type": "array",
                     "items": {
@@ -194,9 +1969,46 @@ const docTemplate = `{
                     }
                 },
                 "total_price": {
+                    "$ref": "#/definitions/money.Money"
+                }
+            }
+        },
+        "domain.UnavailableItem": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "removed": {
+                    "type": "boolean"
+                },
+                "sku_id": {
                     "type": "integer"
                 }
             }
+        },
+        "domain.UpdateItemCountRequest": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "maximum": 60000
+                }
+            }
+        },
+        "money.Money": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "currency": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -1,17 +1,274 @@
 package domain
 
+import (
+	"time"
+
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+// GuestCartIDBase is the first cart ID of guest carts, user IDs are below it.
+const GuestCartIDBase = 1 << 62
+
 type AddToCartRequest struct {
 	Count uint64 `json:"count" validate:"required,gt=0,lte=60000"`
 }
 
+// UpdateItemCountRequest sets the count of a position, zero removes it.
+type UpdateItemCountRequest struct {
+	Count uint64 `json:"count" validate:"lte=60000"`
+}
+
+// CartItem is a position of a cart or an order. In a cart Price is the
+// current price, AddedPrice the one the user saw when adding the sku. Orders
+// leave AddedPrice and CurrentPrice nil.
 type CartItem struct {
-	SkuID uint64 `json:"sku_id"`
-	Name  string `json:"name"`
-	Count uint64 `json:"count"`
-	Price uint64 `json:"price"`
+	SkuID        uint64       `json:"sku_id"`
+	Name         string       `json:"name"`
+	Count        uint64       `json:"count"`
+	Price        money.Money  `json:"price"`
+	AddedPrice   *money.Money `json:"added_price,omitempty"`
+	CurrentPrice *money.Money `json:"current_price,omitempty"`
+	WeightGrams  uint64       `json:"weight_grams,omitempty"`
+}
+
+// Reasons an item of the cart cannot be bought.
+const (
+	// UnavailableDelisted means ProductService no longer knows the sku.
+	UnavailableDelisted = "delisted"
+	// UnavailableCurrency means the product is priced in a currency the cart
+	// cannot convert.
+	UnavailableCurrency = "currency"
+)
+
+// UnavailableItem is a position left out of the items and the total.
+// Removed tells that GetCart has dropped it from the cart.
+type UnavailableItem struct {
+	SkuID   uint64 `json:"sku_id"`
+	Count   uint64 `json:"count"`
+	Reason  string `json:"reason"`
+	Removed bool   `json:"removed,omitempty"`
+}
+
+// Discount is what one promo code takes off the subtotal.
+type Discount struct {
+	Code   string      `json:"code"`
+	Kind   string      `json:"kind"`
+	Amount money.Money `json:"amount"`
+}
+
+// PriceBreakdown is the price of a cart: Total is Subtotal less the discounts.
+type PriceBreakdown struct {
+	Subtotal  money.Money `json:"subtotal"`
+	Discounts []Discount  `json:"discounts"`
+	Total     money.Money `json:"total"`
 }
 
 type GetCartResponse struct {
-	Items      []CartItem `json:"items"`
-	TotalPrice uint64     `json:"total_price"`
+	Items            []CartItem        `json:"items"`
+	UnavailableItems []UnavailableItem `json:"unavailable_items"`
+	// PromoCode is the code attached to the cart. It gives no discount while
+	// disabled or below its threshold.
+	PromoCode string         `json:"promo_code,omitempty"`
+	Price     PriceBreakdown `json:"price"`
+	// TotalPrice is Price.Total, kept for older clients.
+	TotalPrice money.Money `json:"total_price"`
+	// Version changes with every mutation of the cart, HTTP also sends it as ETag.
+	Version uint64 `json:"version"`
+	// PricesChanged is set when some item costs other than when it was added.
+	PricesChanged bool `json:"prices_changed"`
+}
+
+// SavedItem is a position of the saved list with its current price. Moving
+// it back to the cart reserves the stock again.
+type SavedItem struct {
+	SkuID   uint64      `json:"sku_id"`
+	Name    string      `json:"name"`
+	Count   uint64      `json:"count"`
+	Price   money.Money `json:"price"`
+	SavedAt time.Time   `json:"saved_at"`
+}
+
+// ListSavedResponse is the saved list, the latest saved first. Items that
+// cannot be bought are in UnavailableItems as in GetCartResponse.
+type ListSavedResponse struct {
+	Items            []SavedItem       `json:"items"`
+	UnavailableItems []UnavailableItem `json:"unavailable_items"`
+}
+
+type CheckoutResponse struct {
+	OrderID uint64 `json:"order_id"`
+}
+
+type Order struct {
+	OrderID    uint64      `json:"order_id"`
+	Items      []CartItem  `json:"items"`
+	TotalPrice money.Money `json:"total_price"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+type ListOrdersResponse struct {
+	Orders []Order `json:"orders"`
+}
+
+// CartHistoryEvent is the change of one position, Caller and RequestID tell
+// who made it.
+type CartHistoryEvent struct {
+	ID        uint64    `json:"id"`
+	At        time.Time `json:"at"`
+	Operation string    `json:"operation"`
+	SkuID     uint64    `json:"sku_id"`
+	Delta     int64     `json:"delta"`
+	OldCount  uint64    `json:"old_count"`
+	NewCount  uint64    `json:"new_count"`
+	Caller    string    `json:"caller"`
+	RequestID string    `json:"request_id"`
+	Version   uint64    `json:"version"`
+}
+
+type CartHistoryResponse struct {
+	Events []CartHistoryEvent `json:"events"`
+	// NextPageToken is empty on the last page.
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+type CreateGuestCartResponse struct {
+	GuestToken string `json:"guest_token"`
+}
+
+type ApplyPromoRequest struct {
+	Code string `json:"code" validate:"required,max=64"`
+}
+
+// Promo is a promo code with its rule. Kind is "percent" with Percent,
+// "fixed" with Amount or "buy_n_get_m" with SkuID, BuyCount and FreeCount:
+// of every BuyCount+FreeCount units of the sku FreeCount are free. Any kind
+// applies only from a subtotal of MinSubtotal.
+type Promo struct {
+	Code        string    `json:"code" validate:"required,max=64"`
+	Kind        string    `json:"kind" validate:"required,oneof=percent fixed buy_n_get_m"`
+	Percent     uint32    `json:"percent,omitempty" validate:"lte=100"`
+	Amount      uint64    `json:"amount,omitempty"`
+	SkuID       uint64    `json:"sku_id,omitempty"`
+	BuyCount    uint64    `json:"buy_count,omitempty"`
+	FreeCount   uint64    `json:"free_count,omitempty"`
+	MinSubtotal uint64    `json:"min_subtotal,omitempty"`
+	Disabled    bool      `json:"disabled"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ListPromosResponse struct {
+	Promos []Promo `json:"promos"`
+}
+
+// Address is where a cart is shipped. Country is ISO 3166-1 alpha-2 and
+// Region ISO 3166-2, e.g. "RU" and "RU-MOW".
+type Address struct {
+	Country    string `json:"country" validate:"required,len=2,alpha"`
+	Region     string `json:"region,omitempty" validate:"max=6"`
+	City       string `json:"city,omitempty" validate:"max=128"`
+	PostalCode string `json:"postal_code,omitempty" validate:"max=16"`
+}
+
+type QuoteCartRequest struct {
+	Address Address `json:"address"`
+}
+
+// CartQuote estimates what the cart costs shipped to an address: Total is
+// Subtotal less Discount plus Tax and Shipping. Tax and shipping are
+// estimates, the order keeps only the goods.
+type CartQuote struct {
+	Subtotal    money.Money `json:"subtotal"`
+	Discount    money.Money `json:"discount"`
+	Tax         money.Money `json:"tax"`
+	Shipping    money.Money `json:"shipping"`
+	Total       money.Money `json:"total"`
+	WeightGrams uint64      `json:"weight_grams"`
+}
+
+// ShareCartResponse is the token of a cart snapshot, it works until ExpiresAt.
+type ShareCartResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// SharedCart is a cart as it was shared, with the names and prices of then.
+// TotalPrice leaves out the discounts of the sharer.
+type SharedCart struct {
+	Items      []CartItem  `json:"items"`
+	TotalPrice money.Money `json:"total_price"`
+	CreatedAt  time.Time   `json:"created_at"`
+	ExpiresAt  time.Time   `json:"expires_at"`
+}
+
+type ImportSharedCartRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// ImportSharedCartResponse lists the skus of the snapshot that were no longer
+// available and left out, together with the cart after the import.
+type ImportSharedCartResponse struct {
+	SkippedSkuIDs []uint64         `json:"skipped_sku_ids"`
+	Cart          *GetCartResponse `json:"cart"`
+}
+
+// Operations of BulkUpdateCart.
+const (
+	BulkOpAdd    = "add"
+	BulkOpSet    = "set"
+	BulkOpDelete = "delete"
+)
+
+// BulkOp is one change of BulkUpdateCart: add puts Count more units in the
+// cart, set makes it Count units, zero removing the position, and delete
+// removes the position.
+type BulkOp struct {
+	Op    string `json:"op" validate:"oneof=add set delete"`
+	SkuID uint64 `json:"sku_id" validate:"required"`
+	Count uint64 `json:"count" validate:"lte=60000"`
+}
+
+// BulkUpdateCartRequest applies Ops in order. With AllOrNothing a failed op
+// leaves the cart as it was, otherwise only the failed ops are left out.
+type BulkUpdateCartRequest struct {
+	Ops          []BulkOp `json:"ops" validate:"required,min=1,max=100,dive"`
+	AllOrNothing bool     `json:"all_or_nothing"`
+}
+
+// Statuses of a BulkOpResult.
+const (
+	BulkOpApplied = "applied"
+	BulkOpFailed  = "failed"
+	// BulkOpSkipped means the op is valid but was not applied because
+	// another op of an all-or-nothing batch failed.
+	BulkOpSkipped = "skipped"
+)
+
+// BulkOpResult is the outcome of the op at the same index. Count is the
+// count of the position after the op, or where it was left if the op was
+// not applied.
+type BulkOpResult struct {
+	SkuID  uint64 `json:"sku_id"`
+	Op     string `json:"op"`
+	Status string `json:"status"`
+	Count  uint64 `json:"count"`
+	Error  string `json:"error,omitempty"`
+}
+
+type BulkUpdateCartResponse struct {
+	Results []BulkOpResult   `json:"results"`
+	Cart    *GetCartResponse `json:"cart"`
+}
+
+// MergeCartsRequest moves a guest cart into the user's cart. Strategy is
+// "sum", "max" or "prefer-user", empty uses the configured one.
+type MergeCartsRequest struct {
+	GuestToken string `json:"guest_token" validate:"required"`
+	Strategy   string `json:"strategy" validate:"omitempty,oneof=sum max prefer-user"`
+}
+
+// AbandonedCart is what a user is reminded of after leaving the cart alone.
+type AbandonedCart struct {
+	UserID    uint64     `json:"user_id"`
+	Items     []CartItem `json:"items"`
+	UpdatedAt time.Time  `json:"updated_at"`
 }
@@ -0,0 +1,37 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+// Event is a published cart change. Payload depends on Type, see
+// postgres.ItemEvent and postgres.CartEvent.
+type Event struct {
+	ID          uint64          `json:"id"`
+	Type        string          `json:"type"`
+	UserID      uint64          `json:"user_id"`
+	CartVersion uint64          `json:"cart_version"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+func fromOutbox(e postgres.OutboxEvent) Event {
+	return Event{
+		ID:          e.ID,
+		Type:        e.Type,
+		UserID:      e.UserID,
+		CartVersion: e.Version,
+		OccurredAt:  e.CreatedAt,
+		Payload:     e.Payload,
+	}
+}
+
+// EventPublisher delivers cart events downstream. Delivery is at least once,
+// consumers deduplicate by Event.ID.
+type EventPublisher interface {
+	Publish(ctx context.Context, e Event) error
+}
@@ -0,0 +1,26 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// LogPublisher writes every event as a JSON line, to stdout or a file for
+// local testing.
+type LogPublisher struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func NewLogPublisher(w io.Writer) *LogPublisher {
+	return &LogPublisher{enc: json.NewEncoder(w)}
+}
+
+func (p *LogPublisher) Publish(_ context.Context, e Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.enc.Encode(e)
+}
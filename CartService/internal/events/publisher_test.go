@@ -0,0 +1,62 @@
+package events_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/events"
+)
+
+func TestLogPublisher(t *testing.T) {
+	var buf bytes.Buffer
+	pub := events.NewLogPublisher(&buf)
+
+	e := events.Event{ID: 1, Type: "ItemAdded", UserID: 7, CartVersion: 2, Payload: []byte(`{"sku_id":1001}`)}
+	require.NoError(t, pub.Publish(context.Background(), e))
+	require.NoError(t, pub.Publish(context.Background(), e))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var got events.Event
+	require.NoError(t, json.Unmarshal(lines[0], &got))
+	require.Equal(t, e.ID, got.ID)
+	require.JSONEq(t, `{"sku_id":1001}`, string(got.Payload))
+}
+
+func TestWebhookPublisher(t *testing.T) {
+	var got events.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		require.Equal(t, "5", r.Header.Get("X-Event-Id"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	pub := events.NewWebhookPublisher(srv.URL, "secret", time.Second)
+	err := pub.Publish(context.Background(), events.Event{ID: 5, Type: "CartCleared", Payload: []byte(`{"items":[]}`)})
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), got.ID)
+	require.Equal(t, "CartCleared", got.Type)
+}
+
+func TestWebhookPublisher_Non2xx(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	pub := events.NewWebhookPublisher(srv.URL, "", time.Second)
+	err := pub.Publish(context.Background(), events.Event{ID: 1, Payload: []byte(`{}`)})
+
+	require.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package events
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+const DefaultRelayBatch = 100
+
+type OutboxStore interface {
+	RelayOutbox(ctx context.Context, limit int, publish func(postgres.OutboxEvent) error) (int, error)
+}
+
+// Relay moves events from the outbox to an EventPublisher.
+type Relay struct {
+	store    OutboxStore
+	pub      EventPublisher
+	batch    int
+	interval time.Duration
+}
+
+func NewRelay(store OutboxStore, pub EventPublisher, batch int, interval time.Duration) *Relay {
+	if batch <= 0 {
+		batch = DefaultRelayBatch
+	}
+
+	return &Relay{store: store, pub: pub, batch: batch, interval: interval}
+}
+
+// RelayOnce publishes one batch and returns how many events went out.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	return r.store.RelayOutbox(ctx, r.batch, func(e postgres.OutboxEvent) error {
+		return r.pub.Publish(ctx, fromOutbox(e))
+	})
+}
+
+// Run relays until ctx is done. A full batch is followed by the next one
+// right away, otherwise the relay waits for the interval. After a failure
+// the undelivered events are tried again on the next tick.
+func (r *Relay) Run(ctx context.Context) {
+	t := time.NewTicker(r.interval)
+	defer t.Stop()
+
+	for {
+		n, err := r.RelayOnce(ctx)
+		if err != nil {
+			log.Printf("relay outbox: %v", err)
+		}
+		if err == nil && n == r.batch {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
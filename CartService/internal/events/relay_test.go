@@ -0,0 +1,91 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/events"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+// memOutbox behaves like Store.RelayOutbox: published events are removed,
+// the first failure keeps it and everything after it.
+type memOutbox struct {
+	events []postgres.OutboxEvent
+}
+
+func (o *memOutbox) RelayOutbox(_ context.Context, limit int, publish func(postgres.OutboxEvent) error) (int, error) {
+	n := 0
+	for n < limit && n < len(o.events) {
+		if err := publish(o.events[n]); err != nil {
+			o.events = o.events[n:]
+			return n, err
+		}
+		n++
+	}
+	o.events = o.events[n:]
+
+	return n, nil
+}
+
+type recordingPublisher struct {
+	got    []events.Event
+	failID uint64
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, e events.Event) error {
+	if e.ID == p.failID {
+		return errors.New("unavailable")
+	}
+	p.got = append(p.got, e)
+	return nil
+}
+
+func TestRelay_RelayOnce(t *testing.T) {
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	store := &memOutbox{events: []postgres.OutboxEvent{
+		{ID: 1, UserID: 7, Version: 3, Type: postgres.EventItemAdded, Payload: []byte(`{"sku_id":1001,"count":2,"delta":2}`), CreatedAt: at},
+		{ID: 2, UserID: 7, Version: 4, Type: postgres.EventCartCleared, Payload: []byte(`{"items":[]}`), CreatedAt: at},
+		{ID: 3, UserID: 8, Version: 1, Type: postgres.EventItemAdded, Payload: []byte(`{}`), CreatedAt: at},
+	}}
+	pub := &recordingPublisher{}
+
+	n, err := events.NewRelay(store, pub, 2, time.Second).RelayOnce(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Len(t, store.events, 1)
+	require.Equal(t, events.Event{
+		ID:          1,
+		Type:        postgres.EventItemAdded,
+		UserID:      7,
+		CartVersion: 3,
+		OccurredAt:  at,
+		Payload:     []byte(`{"sku_id":1001,"count":2,"delta":2}`),
+	}, pub.got[0])
+	require.Equal(t, uint64(2), pub.got[1].ID)
+}
+
+func TestRelay_FailureKeepsRest(t *testing.T) {
+	store := &memOutbox{events: []postgres.OutboxEvent{{ID: 1}, {ID: 2}, {ID: 3}}}
+	pub := &recordingPublisher{failID: 2}
+	relay := events.NewRelay(store, pub, 10, time.Second)
+
+	n, err := relay.RelayOnce(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 1, n)
+
+	pub.failID = 0
+	n, err = relay.RelayOnce(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	ids := make([]uint64, 0, len(pub.got))
+	for _, e := range pub.got {
+		ids = append(ids, e.ID)
+	}
+	require.Equal(t, []uint64{1, 2, 3}, ids)
+}
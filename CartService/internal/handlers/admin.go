@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/verbovyar/OzonCart/api/CartServiceApiPb"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/internal/validation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// AdminTokenMetadata carries "Bearer <token>" on the admin gRPC calls, like
+// the Authorization header on /admin/... routes.
+const AdminTokenMetadata = "authorization"
+
+// adminMethods are the gRPC calls that need the admin token.
+var adminMethods = map[string]bool{
+	CartServiceApiPb.CartService_CreatePromo_FullMethodName:  true,
+	CartServiceApiPb.CartService_DisablePromo_FullMethodName: true,
+	CartServiceApiPb.CartService_ListPromos_FullMethodName:   true,
+}
+
+// adminAuthorized checks a "Bearer <token>" credential. An empty token
+// turns the admin API off.
+func adminAuthorized(credential, token string) bool {
+	given, ok := strings.CutPrefix(credential, "Bearer ")
+	return ok && token != "" && subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1
+}
+
+// AdminAuthInterceptor rejects the admin calls without the admin token.
+func AdminAuthInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !adminMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		creds := md.Get(AdminTokenMetadata)
+		if len(creds) == 0 || !adminAuthorized(creds[0], token) {
+			return nil, status.Error(codes.Unauthenticated, "admin token required")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+type AdminHttpRouter struct {
+	cs    *service.CartService
+	v     *validation.Validator
+	token string
+}
+
+// NewAdmin serves /admin/... for callers with the admin token.
+func NewAdmin(cs *service.CartService, token string) http.Handler {
+	r := &AdminHttpRouter{
+		cs:    cs,
+		v:     validation.New(),
+		token: token,
+	}
+
+	return http.HandlerFunc(r.root)
+}
+
+func (a *AdminHttpRouter) root(w http.ResponseWriter, req *http.Request) {
+	if !adminAuthorized(req.Header.Get("Authorization"), a.token) {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 2 && parts[1] == "promos":
+		switch req.Method {
+		case http.MethodGet:
+			a.listPromos(w, req)
+		case http.MethodPost:
+			a.createPromo(w, req)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	case len(parts) == 4 && parts[1] == "promos" && parts[3] == "disable":
+		if req.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		a.disablePromo(w, req, parts[2])
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+// createPromo godoc
+// @Summary      Создать промокод
+// @Description  Правила: percent (процент от суммы), fixed (фиксированная скидка), buy_n_get_m (из каждых buy_count+free_count единиц SKU free_count бесплатно). min_subtotal задаёт минимальную сумму корзины для любого правила
+// @Tags         admin
+// @Accept       json
+// @Produce      json
+// @Param        Authorization header string true "Bearer <admin token>"
+// @Param        payload body domain.Promo true "Промокод"
+// @Success      201 {object} domain.Promo
+// @Failure      400 {string} string "invalid promo"
+// @Failure      401 {string} string "unauthorized"
+// @Failure      409 {string} string "promo code already exists"
+// @Router       /admin/promos [post]
+func (a *AdminHttpRouter) createPromo(w http.ResponseWriter, req *http.Request) {
+	var body domain.Promo
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+	if err := a.v.Struct(body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	created, err := a.cs.CreatePromo(req.Context(), body)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// disablePromo godoc
+// @Summary      Отключить промокод
+// @Description  Промокод перестаёт давать скидку, корзины сохраняют его
+// @Tags         admin
+// @Param        Authorization header string true "Bearer <admin token>"
+// @Param        code path string true "Промокод"
+// @Success      204 "No Content"
+// @Failure      401 {string} string "unauthorized"
+// @Failure      404 {string} string "promo code not found"
+// @Router       /admin/promos/{code}/disable [post]
+func (a *AdminHttpRouter) disablePromo(w http.ResponseWriter, req *http.Request, code string) {
+	if err := a.cs.DisablePromo(req.Context(), code); err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listPromos godoc
+// @Summary      Список промокодов
+// @Tags         admin
+// @Produce      json
+// @Param        Authorization header string true "Bearer <admin token>"
+// @Success      200 {object} domain.ListPromosResponse
+// @Failure      401 {string} string "unauthorized"
+// @Router       /admin/promos [get]
+func (a *AdminHttpRouter) listPromos(w http.ResponseWriter, req *http.Request) {
+	resp, err := a.cs.ListPromos(req.Context())
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
@@ -3,96 +3,593 @@ package handlers
 import (
 	"context"
 	"errors"
-	"sync"
+	"time"
 
 	"github.com/verbovyar/OzonCart/api/CartServiceApiPb"
+	"github.com/verbovyar/OzonCart/internal/domain"
 	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/internal/validation"
+	"github.com/verbovyar/OzonCart/pkg/money"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type CartGrpcRouter struct {
 	cs *service.CartService
-
-	wg sync.Mutex
+	v  *validation.Validator
 
 	CartServiceApiPb.UnimplementedCartServiceServer
 }
 
 func NewGrpsRouter(cs *service.CartService) *CartGrpcRouter {
-	return &CartGrpcRouter{cs: cs}
+	return &CartGrpcRouter{cs: cs, v: validation.New()}
 }
 
 func (c *CartGrpcRouter) AddToCart(ctx context.Context, in *CartServiceApiPb.AddToCartRequest) (*CartServiceApiPb.GetCartResponse, error) {
-	c.wg.Lock()
-	defer c.wg.Unlock()
+	// the same rules as the HTTP body
+	if err := c.v.Struct(domain.AddToCartRequest{Count: in.Count}); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	err = c.cs.AddToCart(ctx, owner, in.SkuId, in.Count, in.ExpectedVersion)
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	return &CartServiceApiPb.GetCartResponse{}, nil
+}
+
+func (c *CartGrpcRouter) UpdateItemCount(ctx context.Context, in *CartServiceApiPb.UpdateItemCountRequest) (*CartServiceApiPb.GetCartResponse, error) {
+	if err := c.v.Struct(domain.UpdateItemCountRequest{Count: in.Count}); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
 
-	err := c.cs.AddToCart(ctx, in.UserId, in.SkuId, in.Count)
+	err = c.cs.UpdateItemCount(ctx, owner, in.SkuId, in.Count, in.ExpectedVersion)
 	if err != nil {
-		if errors.Is(err, service.ErrProductNotFound) {
-			return nil, status.Error(codes.NotFound, "product not found")
-		}
-		return nil, status.Error(codes.Internal, "db error")
+		return nil, grpcError(err, "db error")
 	}
 
 	return &CartServiceApiPb.GetCartResponse{}, nil
 }
+
+func (c *CartGrpcRouter) DecrementItem(ctx context.Context, in *CartServiceApiPb.DecrementItemRequest) (*CartServiceApiPb.GetCartResponse, error) {
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	err = c.cs.DecrementItem(ctx, owner, in.SkuId, in.ExpectedVersion)
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	return &CartServiceApiPb.GetCartResponse{}, nil
+}
+
 func (c *CartGrpcRouter) DeleteItem(ctx context.Context, in *CartServiceApiPb.DeleteItemRequest) (*CartServiceApiPb.GetCartResponse, error) {
-	c.wg.Lock()
-	defer c.wg.Unlock()
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
 
-	err := c.cs.DeleteItem(in.UserId, in.SkuId)
+	err = c.cs.DeleteItem(ctx, owner, in.SkuId, in.ExpectedVersion)
 
 	if errors.Is(err, service.ErrProductNotFound) {
 		return nil, status.Error(codes.NotFound, "item or cart not found")
 	}
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, grpcError(err, "internal error")
 	}
 
 	return &CartServiceApiPb.GetCartResponse{}, nil
 }
 
 func (c *CartGrpcRouter) ClearCart(ctx context.Context, in *CartServiceApiPb.ClearCartRequest) (*CartServiceApiPb.GetCartResponse, error) {
-	c.wg.Lock()
-	defer c.wg.Unlock()
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
 
-	err := c.cs.ClearCart(in.UserId)
+	err = c.cs.ClearCart(ctx, owner, in.ExpectedVersion)
 
 	if errors.Is(err, service.ErrProductNotFound) {
 		return nil, status.Error(codes.NotFound, "cart not found")
 	}
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, "internal error")
+		return nil, grpcError(err, "internal error")
 	}
 
 	return &CartServiceApiPb.GetCartResponse{}, nil
 }
 
 func (c *CartGrpcRouter) GetCart(ctx context.Context, in *CartServiceApiPb.GetCartRequest) (*CartServiceApiPb.GetCartResponse, error) {
-	c.wg.Lock()
-	defer c.wg.Unlock()
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	cart, err := c.cs.GetCart(ctx, owner)
+
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	return toPbCart(cart), nil
+}
+
+func (c *CartGrpcRouter) CreateGuestCart(ctx context.Context, _ *CartServiceApiPb.CreateGuestCartRequest) (*CartServiceApiPb.CreateGuestCartResponse, error) {
+	token, err := c.cs.CreateGuestCart(ctx)
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	return &CartServiceApiPb.CreateGuestCartResponse{GuestToken: token}, nil
+}
+
+// MergeCarts answers with the user's cart after the merge.
+func (c *CartGrpcRouter) MergeCarts(ctx context.Context, in *CartServiceApiPb.MergeCartsRequest) (*CartServiceApiPb.GetCartResponse, error) {
+	if err := c.checkUserID(in.UserId); err != nil {
+		return nil, err
+	}
+
+	err := c.cs.MergeCarts(ctx, in.GuestToken, in.UserId, service.MergeStrategy(in.Strategy))
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
 
 	cart, err := c.cs.GetCart(ctx, in.UserId)
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	return toPbCart(cart), nil
+}
 
+// ApplyPromo answers with the cart priced with the code.
+func (c *CartGrpcRouter) ApplyPromo(ctx context.Context, in *CartServiceApiPb.ApplyPromoRequest) (*CartServiceApiPb.GetCartResponse, error) {
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "db error")
+		return nil, grpcError(err, "internal error")
 	}
 
-	items := make([]*CartServiceApiPb.CartItem, 0)
-	for _, temp_item := range cart.Items {
-		items = append(items, &CartServiceApiPb.CartItem{
-			SkuId: temp_item.SkuID,
-			Name:  temp_item.Name,
-			Count: temp_item.Count,
-			Price: temp_item.Price,
+	if err := c.cs.ApplyPromo(ctx, owner, in.Code, in.ExpectedVersion); err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	cart, err := c.cs.GetCart(ctx, owner)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return toPbCart(cart), nil
+}
+
+func (c *CartGrpcRouter) RemovePromo(ctx context.Context, in *CartServiceApiPb.RemovePromoRequest) (*CartServiceApiPb.GetCartResponse, error) {
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	if err := c.cs.RemovePromo(ctx, owner, in.ExpectedVersion); err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	cart, err := c.cs.GetCart(ctx, owner)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return toPbCart(cart), nil
+}
+
+func (c *CartGrpcRouter) QuoteCart(ctx context.Context, in *CartServiceApiPb.QuoteCartRequest) (*CartServiceApiPb.QuoteCartResponse, error) {
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	a := in.GetAddress()
+	quote, err := c.cs.QuoteCart(ctx, owner, domain.Address{
+		Country:    a.GetCountry(),
+		Region:     a.GetRegion(),
+		City:       a.GetCity(),
+		PostalCode: a.GetPostalCode(),
+	})
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return &CartServiceApiPb.QuoteCartResponse{
+		Subtotal:    toPbMoney(quote.Subtotal),
+		Discount:    toPbMoney(quote.Discount),
+		Tax:         toPbMoney(quote.Tax),
+		Shipping:    toPbMoney(quote.Shipping),
+		Total:       toPbMoney(quote.Total),
+		WeightGrams: quote.WeightGrams,
+	}, nil
+}
+
+// MoveToSaved answers with the cart without the moved position.
+func (c *CartGrpcRouter) MoveToSaved(ctx context.Context, in *CartServiceApiPb.MoveToSavedRequest) (*CartServiceApiPb.GetCartResponse, error) {
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	if err := c.cs.MoveToSaved(ctx, owner, in.SkuId, in.ExpectedVersion); err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	cart, err := c.cs.GetCart(ctx, owner)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return toPbCart(cart), nil
+}
+
+// MoveToCart answers with the cart holding the moved position.
+func (c *CartGrpcRouter) MoveToCart(ctx context.Context, in *CartServiceApiPb.MoveToCartRequest) (*CartServiceApiPb.GetCartResponse, error) {
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	if err := c.cs.MoveToCart(ctx, owner, in.SkuId, in.ExpectedVersion); err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	cart, err := c.cs.GetCart(ctx, owner)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return toPbCart(cart), nil
+}
+
+func (c *CartGrpcRouter) ListSaved(ctx context.Context, in *CartServiceApiPb.ListSavedRequest) (*CartServiceApiPb.ListSavedResponse, error) {
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	res, err := c.cs.ListSaved(ctx, owner)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	items := make([]*CartServiceApiPb.SavedItem, 0, len(res.Items))
+	for _, it := range res.Items {
+		items = append(items, &CartServiceApiPb.SavedItem{
+			SkuId:   it.SkuID,
+			Name:    it.Name,
+			Count:   it.Count,
+			Price:   toPbMoney(it.Price),
+			SavedAt: timestamppb.New(it.SavedAt),
 		})
 	}
 
-	return &CartServiceApiPb.GetCartResponse{
-		Items:      items,
-		TotalPrice: cart.TotalPrice,
+	return &CartServiceApiPb.ListSavedResponse{
+		Items:            items,
+		UnavailableItems: toPbUnavailable(res.UnavailableItems),
+	}, nil
+}
+
+func (c *CartGrpcRouter) ShareCart(ctx context.Context, in *CartServiceApiPb.ShareCartRequest) (*CartServiceApiPb.ShareCartResponse, error) {
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	res, err := c.cs.ShareCart(ctx, owner)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return &CartServiceApiPb.ShareCartResponse{Token: res.Token, ExpiresAt: timestamppb.New(res.ExpiresAt)}, nil
+}
+
+func (c *CartGrpcRouter) GetSharedCart(ctx context.Context, in *CartServiceApiPb.GetSharedCartRequest) (*CartServiceApiPb.SharedCart, error) {
+	res, err := c.cs.GetSharedCart(ctx, in.Token)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return &CartServiceApiPb.SharedCart{
+		Items:      toPbItems(res.Items),
+		TotalPrice: toPbMoney(res.TotalPrice),
+		CreatedAt:  timestamppb.New(res.CreatedAt),
+		ExpiresAt:  timestamppb.New(res.ExpiresAt),
 	}, nil
 }
+
+// ImportSharedCart answers with the skipped skus and the user's cart after
+// the import.
+func (c *CartGrpcRouter) ImportSharedCart(ctx context.Context, in *CartServiceApiPb.ImportSharedCartRequest) (*CartServiceApiPb.ImportSharedCartResponse, error) {
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	skipped, err := c.cs.ImportSharedCart(ctx, in.Token, owner, in.ExpectedVersion)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	cart, err := c.cs.GetCart(ctx, owner)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return &CartServiceApiPb.ImportSharedCartResponse{SkippedSkuIds: skipped, Cart: toPbCart(cart)}, nil
+}
+
+// BulkUpdateCart answers with a result per op and the cart after them.
+func (c *CartGrpcRouter) BulkUpdateCart(ctx context.Context, in *CartServiceApiPb.BulkUpdateCartRequest) (*CartServiceApiPb.BulkUpdateCartResponse, error) {
+	owner, err := c.cs.CartOwner(ctx, in.UserId, in.GuestToken)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	ops := make([]domain.BulkOp, 0, len(in.Ops))
+	for _, op := range in.Ops {
+		ops = append(ops, domain.BulkOp{Op: op.Op, SkuID: op.SkuId, Count: op.Count})
+	}
+	results, err := c.cs.BulkUpdateCart(ctx, owner, ops, in.AllOrNothing, in.ExpectedVersion)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	cart, err := c.cs.GetCart(ctx, owner)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	pbResults := make([]*CartServiceApiPb.BulkOpResult, 0, len(results))
+	for _, r := range results {
+		pbResults = append(pbResults, &CartServiceApiPb.BulkOpResult{
+			SkuId:  r.SkuID,
+			Op:     r.Op,
+			Status: r.Status,
+			Count:  r.Count,
+			Error:  r.Error,
+		})
+	}
+
+	return &CartServiceApiPb.BulkUpdateCartResponse{Results: pbResults, Cart: toPbCart(cart)}, nil
+}
+
+func (c *CartGrpcRouter) CreatePromo(ctx context.Context, in *CartServiceApiPb.CreatePromoRequest) (*CartServiceApiPb.Promo, error) {
+	p := in.GetPromo()
+	if p == nil {
+		return nil, status.Error(codes.InvalidArgument, "promo is required")
+	}
+
+	created, err := c.cs.CreatePromo(ctx, domain.Promo{
+		Code:        p.Code,
+		Kind:        p.Kind,
+		Percent:     p.Percent,
+		Amount:      p.Amount,
+		SkuID:       p.SkuId,
+		BuyCount:    p.BuyCount,
+		FreeCount:   p.FreeCount,
+		MinSubtotal: p.MinSubtotal,
+	})
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return toPbPromo(*created), nil
+}
+
+func (c *CartGrpcRouter) DisablePromo(ctx context.Context, in *CartServiceApiPb.DisablePromoRequest) (*CartServiceApiPb.DisablePromoResponse, error) {
+	if err := c.cs.DisablePromo(ctx, in.Code); err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return &CartServiceApiPb.DisablePromoResponse{}, nil
+}
+
+func (c *CartGrpcRouter) ListPromos(ctx context.Context, _ *CartServiceApiPb.ListPromosRequest) (*CartServiceApiPb.ListPromosResponse, error) {
+	res, err := c.cs.ListPromos(ctx)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	promos := make([]*CartServiceApiPb.Promo, 0, len(res.Promos))
+	for _, p := range res.Promos {
+		promos = append(promos, toPbPromo(p))
+	}
+
+	return &CartServiceApiPb.ListPromosResponse{Promos: promos}, nil
+}
+
+func (c *CartGrpcRouter) GetCartHistory(ctx context.Context, in *CartServiceApiPb.GetCartHistoryRequest) (*CartServiceApiPb.GetCartHistoryResponse, error) {
+	if err := c.checkUserID(in.UserId); err != nil {
+		return nil, err
+	}
+
+	var since time.Time
+	if in.Since != nil {
+		since = in.Since.AsTime()
+	}
+
+	res, err := c.cs.GetCartHistory(ctx, in.UserId, since, in.PageToken, int(in.PageSize))
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	events := make([]*CartServiceApiPb.CartHistoryEvent, 0, len(res.Events))
+	for _, e := range res.Events {
+		events = append(events, &CartServiceApiPb.CartHistoryEvent{
+			Id:        e.ID,
+			At:        timestamppb.New(e.At),
+			Operation: e.Operation,
+			SkuId:     e.SkuID,
+			Delta:     e.Delta,
+			OldCount:  e.OldCount,
+			NewCount:  e.NewCount,
+			Caller:    e.Caller,
+			RequestId: e.RequestID,
+			Version:   e.Version,
+		})
+	}
+
+	return &CartServiceApiPb.GetCartHistoryResponse{Events: events, NextPageToken: res.NextPageToken}, nil
+}
+
+func (c *CartGrpcRouter) Checkout(ctx context.Context, in *CartServiceApiPb.CheckoutRequest) (*CartServiceApiPb.CheckoutResponse, error) {
+	if err := c.checkUserID(in.UserId); err != nil {
+		return nil, err
+	}
+
+	orderID, err := c.cs.Checkout(ctx, in.UserId, in.ExpectedVersion)
+	if err != nil {
+		return nil, grpcError(err, "internal error")
+	}
+
+	return &CartServiceApiPb.CheckoutResponse{OrderId: orderID}, nil
+}
+
+func (c *CartGrpcRouter) GetOrder(ctx context.Context, in *CartServiceApiPb.GetOrderRequest) (*CartServiceApiPb.Order, error) {
+	if err := c.checkUserID(in.UserId); err != nil {
+		return nil, err
+	}
+
+	order, err := c.cs.GetOrder(ctx, in.UserId, in.OrderId)
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	return toPbOrder(*order), nil
+}
+
+func (c *CartGrpcRouter) ListOrders(ctx context.Context, in *CartServiceApiPb.ListOrdersRequest) (*CartServiceApiPb.ListOrdersResponse, error) {
+	if err := c.checkUserID(in.UserId); err != nil {
+		return nil, err
+	}
+
+	res, err := c.cs.ListOrders(ctx, in.UserId)
+	if err != nil {
+		return nil, grpcError(err, "db error")
+	}
+
+	orders := make([]*CartServiceApiPb.Order, 0, len(res.Orders))
+	for _, o := range res.Orders {
+		orders = append(orders, toPbOrder(o))
+	}
+
+	return &CartServiceApiPb.ListOrdersResponse{Orders: orders}, nil
+}
+
+// checkUserID rejects the requests only registered users make, their ID must
+// not be a guest cart, as in the HTTP /user/{id} routes.
+func (c *CartGrpcRouter) checkUserID(id uint64) error {
+	if !c.v.ValidateUserID(id) {
+		return status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+
+	return nil
+}
+
+func toPbCart(cart *domain.GetCartResponse) *CartServiceApiPb.GetCartResponse {
+	return &CartServiceApiPb.GetCartResponse{
+		Items:            toPbItems(cart.Items),
+		TotalPrice:       toPbMoney(cart.TotalPrice),
+		Version:          cart.Version,
+		PricesChanged:    cart.PricesChanged,
+		UnavailableItems: toPbUnavailable(cart.UnavailableItems),
+		PromoCode:        cart.PromoCode,
+		Price:            toPbPrice(cart.Price),
+	}
+}
+
+func toPbPrice(p domain.PriceBreakdown) *CartServiceApiPb.PriceBreakdown {
+	discounts := make([]*CartServiceApiPb.Discount, 0, len(p.Discounts))
+	for _, d := range p.Discounts {
+		discounts = append(discounts, &CartServiceApiPb.Discount{Code: d.Code, Kind: d.Kind, Amount: toPbMoney(d.Amount)})
+	}
+
+	return &CartServiceApiPb.PriceBreakdown{Subtotal: toPbMoney(p.Subtotal), Discounts: discounts, Total: toPbMoney(p.Total)}
+}
+
+func toPbMoney(m money.Money) *CartServiceApiPb.Money {
+	return &CartServiceApiPb.Money{Amount: m.Amount, Currency: m.Currency}
+}
+
+// toPbOptionalMoney leaves an unset price unset.
+func toPbOptionalMoney(m *money.Money) *CartServiceApiPb.Money {
+	if m == nil {
+		return nil
+	}
+
+	return toPbMoney(*m)
+}
+
+func toPbPromo(p domain.Promo) *CartServiceApiPb.Promo {
+	return &CartServiceApiPb.Promo{
+		Code:        p.Code,
+		Kind:        p.Kind,
+		Percent:     p.Percent,
+		Amount:      p.Amount,
+		SkuId:       p.SkuID,
+		BuyCount:    p.BuyCount,
+		FreeCount:   p.FreeCount,
+		MinSubtotal: p.MinSubtotal,
+		Disabled:    p.Disabled,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+	}
+}
+
+func toPbUnavailable(in []domain.UnavailableItem) []*CartServiceApiPb.UnavailableItem {
+	items := make([]*CartServiceApiPb.UnavailableItem, 0, len(in))
+	for _, it := range in {
+		items = append(items, &CartServiceApiPb.UnavailableItem{
+			SkuId:   it.SkuID,
+			Count:   it.Count,
+			Reason:  it.Reason,
+			Removed: it.Removed,
+		})
+	}
+
+	return items
+}
+
+func toPbItems(in []domain.CartItem) []*CartServiceApiPb.CartItem {
+	items := make([]*CartServiceApiPb.CartItem, 0, len(in))
+	for _, temp_item := range in {
+		items = append(items, &CartServiceApiPb.CartItem{
+			SkuId:        temp_item.SkuID,
+			Name:         temp_item.Name,
+			Count:        temp_item.Count,
+			Price:        toPbMoney(temp_item.Price),
+			AddedPrice:   toPbOptionalMoney(temp_item.AddedPrice),
+			CurrentPrice: toPbOptionalMoney(temp_item.CurrentPrice),
+			WeightGrams:  temp_item.WeightGrams,
+		})
+	}
+
+	return items
+}
+
+func toPbOrder(o domain.Order) *CartServiceApiPb.Order {
+	return &CartServiceApiPb.Order{
+		OrderId:    o.OrderID,
+		Items:      toPbItems(o.Items),
+		TotalPrice: toPbMoney(o.TotalPrice),
+		CreatedAt:  timestamppb.New(o.CreatedAt),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/verbovyar/OzonCart/internal/domain"
 	"github.com/verbovyar/OzonCart/internal/service"
@@ -16,7 +17,13 @@ const BASE = 10
 const TYPE = 64
 const URL_PARTS_COUNT = 3
 
-var ErrBadId = errors.New("bad id")
+// GuestTokenHeader carries the token of a guest cart on /guest/cart/... routes.
+const GuestTokenHeader = "X-Guest-Token"
+
+var (
+	ErrBadId      = errors.New("bad id")
+	ErrBadVersion = errors.New("bad version")
+)
 
 type CartHttpRouter struct {
 	cs *service.CartService
@@ -34,26 +41,142 @@ func New(cs *service.CartService) http.Handler {
 
 func (c *CartHttpRouter) root(w http.ResponseWriter, req *http.Request) {
 	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
-	if len(parts) < URL_PARTS_COUNT || parts[0] != "user" || parts[2] != "cart" {
+	if len(parts) == 2 && parts[0] == "guest" && parts[1] == "carts" {
+		if req.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		c.createGuestCart(w, req)
+		return
+	}
+	if len(parts) == 2 && parts[0] == "shared" {
+		if req.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		c.getSharedCart(w, req, parts[1])
+		return
+	}
+	if len(parts) >= 2 && parts[0] == "guest" {
+		// /guest/cart/... is /user/{id}/cart/... of the cart in X-Guest-Token
+		parts = append([]string{"guest", ""}, parts[1:]...)
+	}
+	if len(parts) < URL_PARTS_COUNT {
+		http.NotFound(w, req)
+		return
+	}
+
+	var route func(http.ResponseWriter, *http.Request, uint64, []string)
+	var userID uint64
+	switch parts[0] {
+	case "user":
+		switch parts[2] {
+		case "cart":
+			route = c.cartRoot
+		case "orders":
+			route = c.ordersRoot
+		default:
+			http.NotFound(w, req)
+			return
+		}
+
+		id, err := parseID(parts[1])
+		if err != nil || !c.v.ValidateUserID(id) {
+			http.Error(w, "Invalid user_id", http.StatusBadRequest)
+			return
+		}
+		userID = id
+	case "guest":
+		// guests log in to check out, their cart is merged then
+		if parts[2] != "cart" || len(parts) == 4 && (parts[3] == "checkout" || parts[3] == "merge") {
+			http.NotFound(w, req)
+			return
+		}
+		route = c.cartRoot
+
+		token := req.Header.Get(GuestTokenHeader)
+		if token == "" {
+			http.Error(w, "Missing "+GuestTokenHeader, http.StatusBadRequest)
+			return
+		}
+		id, err := c.cs.CartOwner(req.Context(), 0, token)
+		if err != nil {
+			httpError(w, err, "Internal error")
+			return
+		}
+		userID = id
+	default:
 		http.NotFound(w, req)
 		return
 	}
 
-	userID, err := parseID(parts[1])
-	if err != nil || !c.v.ValidateID(userID) {
-		http.Error(w, "Invalid user_id", http.StatusBadRequest)
+	if req.Method == http.MethodGet {
+		route(w, req, userID, parts)
 		return
 	}
+	c.idempotent(w, req, userID, func(w http.ResponseWriter, req *http.Request) {
+		route(w, req, userID, parts)
+	})
+}
 
+func (c *CartHttpRouter) cartRoot(w http.ResponseWriter, req *http.Request, userID uint64, parts []string) {
 	switch req.Method {
 	case http.MethodPost:
+		if len(parts) == 5 && parts[4] == "decrement" {
+			c.decrementItem(w, req, userID, parts[3])
+			return
+		}
+		if len(parts) == 5 && parts[4] == "save" {
+			c.moveToSaved(w, req, userID, parts[3])
+			return
+		}
+		if len(parts) == 6 && parts[3] == "saved" && parts[5] == "move" {
+			c.moveToCart(w, req, userID, parts[4])
+			return
+		}
 		if len(parts) != 4 {
 			http.NotFound(w, req)
 			return
 		}
+		if parts[3] == "checkout" {
+			c.checkout(w, req, userID)
+			return
+		}
+		if parts[3] == "merge" {
+			c.mergeCarts(w, req, userID)
+			return
+		}
+		if parts[3] == "promo" {
+			c.applyPromo(w, req, userID)
+			return
+		}
+		if parts[3] == "quote" {
+			c.quoteCart(w, req, userID)
+			return
+		}
+		if parts[3] == "share" {
+			c.shareCart(w, req, userID)
+			return
+		}
+		if parts[3] == "import" {
+			c.importSharedCart(w, req, userID)
+			return
+		}
+		if parts[3] == "bulk" {
+			c.bulkUpdateCart(w, req, userID)
+			return
+		}
 		c.addToCart(w, req, userID, parts[3])
+	case http.MethodPut:
+		if len(parts) != 4 {
+			http.NotFound(w, req)
+			return
+		}
+		c.updateItemCount(w, req, userID, parts[3])
 	case http.MethodDelete:
-		if len(parts) == 4 {
+		if len(parts) == 4 && parts[3] == "promo" {
+			c.removePromo(w, req, userID)
+		} else if len(parts) == 4 {
 			c.deleteItem(w, req, userID, parts[3])
 		} else if len(parts) == 3 {
 			c.clearCart(w, req, userID)
@@ -62,6 +185,14 @@ func (c *CartHttpRouter) root(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 	case http.MethodGet:
+		if len(parts) == 4 && parts[3] == "history" {
+			c.getCartHistory(w, req, userID)
+			return
+		}
+		if len(parts) == 4 && parts[3] == "saved" {
+			c.listSaved(w, req, userID)
+			return
+		}
 		if len(parts) != 3 {
 			http.NotFound(w, req)
 			return
@@ -72,6 +203,22 @@ func (c *CartHttpRouter) root(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+func (c *CartHttpRouter) ordersRoot(w http.ResponseWriter, req *http.Request, userID uint64, parts []string) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	switch len(parts) {
+	case 3:
+		c.listOrders(w, req, userID)
+	case 4:
+		c.getOrder(w, req, userID, parts[3])
+	default:
+		http.NotFound(w, req)
+	}
+}
+
 func parseID(s string) (uint64, error) {
 	id, err := strconv.ParseInt(s, BASE, TYPE)
 	if err != nil || id <= 0 {
@@ -81,6 +228,27 @@ func parseID(s string) (uint64, error) {
 	return uint64(id), nil
 }
 
+// ifMatch reads the expected cart version from If-Match, no header or "*"
+// means the mutation is applied unconditionally.
+func ifMatch(req *http.Request) (*uint64, error) {
+	v := strings.TrimSpace(req.Header.Get("If-Match"))
+	if v == "" || v == "*" {
+		return nil, nil
+	}
+
+	v = strings.Trim(strings.TrimPrefix(v, "W/"), `"`)
+	version, err := strconv.ParseUint(v, BASE, TYPE)
+	if err != nil {
+		return nil, ErrBadVersion
+	}
+
+	return &version, nil
+}
+
+func etag(version uint64) string {
+	return `"` + strconv.FormatUint(version, BASE) + `"`
+}
+
 // addToCart godoc
 // @Summary      Добавить товар в корзину
 // @Description  Добавляет SKU в корзину пользователя после проверки существования во внешнем ProductService
@@ -89,10 +257,15 @@ func parseID(s string) (uint64, error) {
 // @Param        user_id path int true "ID пользователя"
 // @Param        sku_id  path int true "SKU товара"
 // @Param        payload body domain.AddToCartRequest true "Количество"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
 // @Success      200 "OK"
 // @Failure      400 {string} string "invalid input"
 // @Failure      404 {string} string "product not found"
+// @Failure      412 {string} string "insufficient stock or cart version mismatch"
+// @Failure      422 {string} string "cart limit exceeded, price currency differs from cart currency or price overflow"
 // @Failure      500 {string} string "server error"
+// @Failure      503 {string} string "product service unavailable"
 // @Router       /user/{user_id}/cart/{sku_id} [post]
 func (c *CartHttpRouter) addToCart(w http.ResponseWriter, req *http.Request, userID uint64, skuStr string) {
 	skuID, err := parseID(skuStr)
@@ -111,13 +284,98 @@ func (c *CartHttpRouter) addToCart(w http.ResponseWriter, req *http.Request, use
 		return
 	}
 
-	if err := c.cs.AddToCart(req.Context(), userID, skuID, body.Count); err != nil {
-		switch {
-		case errors.Is(err, service.ErrProductNotFound):
-			http.Error(w, "Product not found", http.StatusNotFound)
-		default:
-			http.Error(w, "Internal error", http.StatusInternalServerError)
-		}
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.cs.AddToCart(req.Context(), userID, skuID, body.Count, expected); err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// updateItemCount godoc
+// @Summary      Установить количество товара в корзине
+// @Description  Задаёт абсолютное количество SKU в корзине, 0 удаляет позицию
+// @Tags         cart
+// @Accept       json
+// @Param        user_id path int true "ID пользователя"
+// @Param        sku_id  path int true "SKU товара"
+// @Param        payload body domain.UpdateItemCountRequest true "Количество"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 "OK"
+// @Failure      400 {string} string "invalid input"
+// @Failure      404 {string} string "product not found"
+// @Failure      412 {string} string "insufficient stock or cart version mismatch"
+// @Failure      422 {string} string "cart limit exceeded, price currency differs from cart currency or price overflow"
+// @Failure      500 {string} string "server error"
+// @Failure      503 {string} string "product service unavailable"
+// @Router       /user/{user_id}/cart/{sku_id} [put]
+func (c *CartHttpRouter) updateItemCount(w http.ResponseWriter, req *http.Request, userID uint64, skuStr string) {
+	skuID, err := parseID(skuStr)
+	if err != nil || !c.v.ValidateID(skuID) {
+		http.Error(w, "Invalid sku_id", http.StatusBadRequest)
+		return
+	}
+
+	var body domain.UpdateItemCountRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+	if err := c.v.Struct(body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.cs.UpdateItemCount(req.Context(), userID, skuID, body.Count, expected); err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// decrementItem godoc
+// @Summary      Уменьшить количество товара на единицу
+// @Description  Убирает одну единицу SKU из корзины, позиция удаляется при достижении нуля
+// @Tags         cart
+// @Param        user_id path int true "ID пользователя"
+// @Param        sku_id  path int true "SKU товара"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 "OK"
+// @Failure      400 {string} string "invalid input"
+// @Failure      404 {string} string "item not in cart"
+// @Failure      500 {string} string "server error"
+// @Failure      412 {string} string "cart version mismatch"
+// @Router       /user/{user_id}/cart/{sku_id}/decrement [post]
+func (c *CartHttpRouter) decrementItem(w http.ResponseWriter, req *http.Request, userID uint64, skuStr string) {
+	skuID, err := parseID(skuStr)
+	if err != nil {
+		http.Error(w, "Invalid sku_id", http.StatusBadRequest)
+		return
+	}
+
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.cs.DecrementItem(req.Context(), userID, skuID, expected); err != nil {
+		httpError(w, err, "Internal error")
 		return
 	}
 
@@ -129,15 +387,27 @@ func (c *CartHttpRouter) addToCart(w http.ResponseWriter, req *http.Request, use
 // @Tags         cart
 // @Param        user_id path int true "ID пользователя"
 // @Param        sku_id  path int true "SKU"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
 // @Success      204 "No Content"
+// @Failure      412 {string} string "cart version mismatch"
 // @Router       /user/{user_id}/cart/{sku_id} [delete]
-func (c *CartHttpRouter) deleteItem(w http.ResponseWriter, _ *http.Request, userID uint64, skuStr string) {
+func (c *CartHttpRouter) deleteItem(w http.ResponseWriter, req *http.Request, userID uint64, skuStr string) {
 	skuID, err := parseID(skuStr)
 	if err != nil {
 		http.Error(w, "Invalid sku_id", http.StatusBadRequest)
 		return
 	}
-	c.cs.DeleteItem(userID, skuID)
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.cs.DeleteItem(req.Context(), userID, skuID, expected); err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -146,10 +416,22 @@ func (c *CartHttpRouter) deleteItem(w http.ResponseWriter, _ *http.Request, user
 // @Summary      Очистить корзину пользователя
 // @Tags         cart
 // @Param        user_id path int true "ID пользователя"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
 // @Success      204 "No Content"
+// @Failure      412 {string} string "cart version mismatch"
 // @Router       /user/{user_id}/cart [delete]
-func (c *CartHttpRouter) clearCart(w http.ResponseWriter, _ *http.Request, userID uint64) {
-	c.cs.ClearCart(userID)
+func (c *CartHttpRouter) clearCart(w http.ResponseWriter, req *http.Request, userID uint64) {
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.cs.ClearCart(req.Context(), userID, expected); err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -159,10 +441,523 @@ func (c *CartHttpRouter) clearCart(w http.ResponseWriter, _ *http.Request, userI
 // @Tags         cart
 // @Param        user_id path int true "ID пользователя"
 // @Success      200 {object} domain.GetCartResponse
+// @Header       200 {string} ETag "Версия корзины"
 // @Failure      404 {string} string "cart is empty"
+// @Failure      503 {string} string "product service unavailable"
 // @Router       /user/{user_id}/cart [get]
 func (c *CartHttpRouter) getCart(w http.ResponseWriter, req *http.Request, userID uint64) {
 	resp, err := c.cs.GetCart(req.Context(), userID)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("ETag", etag(resp.Version))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// checkout godoc
+// @Summary      Оформить заказ из корзины
+// @Description  Фиксирует доступные позиции корзины с ценами в заказ и убирает их из корзины в одной транзакции. Недоступные позиции остаются в корзине
+// @Tags         orders
+// @Param        user_id path int true "ID пользователя"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 {object} domain.CheckoutResponse
+// @Failure      409 {string} string "cart is empty or changed"
+// @Failure      500 {string} string "server error"
+// @Failure      503 {string} string "product service unavailable"
+// @Failure      412 {string} string "cart version mismatch"
+// @Router       /user/{user_id}/cart/checkout [post]
+func (c *CartHttpRouter) checkout(w http.ResponseWriter, req *http.Request, userID uint64) {
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	orderID, err := c.cs.Checkout(req.Context(), userID, expected)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(domain.CheckoutResponse{OrderID: orderID})
+}
+
+// createGuestCart godoc
+// @Summary      Создать гостевую корзину
+// @Description  Создаёт корзину анонимного покупателя. Токен передаётся в заголовке X-Guest-Token на маршрутах /guest/cart/..., которые повторяют /user/{user_id}/cart/... кроме оформления заказа
+// @Tags         guest
+// @Produce      json
+// @Success      200 {object} domain.CreateGuestCartResponse
+// @Failure      500 {string} string "server error"
+// @Router       /guest/carts [post]
+func (c *CartHttpRouter) createGuestCart(w http.ResponseWriter, req *http.Request) {
+	token, err := c.cs.CreateGuestCart(req.Context())
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(domain.CreateGuestCartResponse{GuestToken: token})
+}
+
+// mergeCarts godoc
+// @Summary      Перенести гостевую корзину в корзину пользователя
+// @Description  Объединяет гостевую корзину с корзиной пользователя по стратегии sum, max или prefer-user с учётом лимитов и удаляет гостевую корзину
+// @Tags         guest
+// @Accept       json
+// @Produce      json
+// @Param        user_id path int true "ID пользователя"
+// @Param        payload body domain.MergeCartsRequest true "Токен гостевой корзины и стратегия"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 {object} domain.GetCartResponse
+// @Failure      400 {string} string "invalid input"
+// @Failure      404 {string} string "guest cart not found"
+// @Failure      500 {string} string "server error"
+// @Router       /user/{user_id}/cart/merge [post]
+func (c *CartHttpRouter) mergeCarts(w http.ResponseWriter, req *http.Request, userID uint64) {
+	var body domain.MergeCartsRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+	if err := c.v.Struct(body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err := c.cs.MergeCarts(req.Context(), body.GuestToken, userID, service.MergeStrategy(body.Strategy))
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	resp, err := c.cs.GetCart(req.Context(), userID)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("ETag", etag(resp.Version))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// applyPromo godoc
+// @Summary      Применить промокод к корзине
+// @Description  Привязывает промокод к корзине вместо прежнего. Порог min_subtotal проверяется при каждом чтении корзины
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Param        user_id path int true "ID пользователя"
+// @Param        payload body domain.ApplyPromoRequest true "Промокод"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 {object} domain.GetCartResponse
+// @Header       200 {string} ETag "Версия корзины"
+// @Failure      400 {string} string "invalid input"
+// @Failure      404 {string} string "promo code not found"
+// @Failure      412 {string} string "cart version mismatch"
+// @Router       /user/{user_id}/cart/promo [post]
+func (c *CartHttpRouter) applyPromo(w http.ResponseWriter, req *http.Request, userID uint64) {
+	var body domain.ApplyPromoRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+	if err := c.v.Struct(body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.cs.ApplyPromo(req.Context(), userID, body.Code, expected); err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	c.getCart(w, req, userID)
+}
+
+// removePromo godoc
+// @Summary      Убрать промокод из корзины
+// @Tags         cart
+// @Produce      json
+// @Param        user_id path int true "ID пользователя"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 {object} domain.GetCartResponse
+// @Header       200 {string} ETag "Версия корзины"
+// @Failure      404 {string} string "promo code not found"
+// @Failure      412 {string} string "cart version mismatch"
+// @Router       /user/{user_id}/cart/promo [delete]
+func (c *CartHttpRouter) removePromo(w http.ResponseWriter, req *http.Request, userID uint64) {
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.cs.RemovePromo(req.Context(), userID, expected); err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	c.getCart(w, req, userID)
+}
+
+// quoteCart godoc
+// @Summary      Оценить итоговую стоимость корзины
+// @Description  Считает сумму товаров со скидками, налог и доставку до адреса. Налог и доставка — оценка, в заказ они не входят
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Param        user_id path int true "ID пользователя"
+// @Param        payload body domain.QuoteCartRequest true "Адрес доставки"
+// @Success      200 {object} domain.CartQuote
+// @Failure      400 {string} string "invalid input"
+// @Failure      409 {string} string "cart is empty"
+// @Failure      422 {string} string "cart cannot be shipped to the address or price currency differs from cart currency"
+// @Failure      501 {string} string "cart quotes are not configured"
+// @Router       /user/{user_id}/cart/quote [post]
+func (c *CartHttpRouter) quoteCart(w http.ResponseWriter, req *http.Request, userID uint64) {
+	var body domain.QuoteCartRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+	if err := c.v.Struct(body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := c.cs.QuoteCart(req.Context(), userID, body.Address)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// moveToSaved godoc
+// @Summary      Отложить товар
+// @Description  Переносит позицию SKU из корзины в список отложенных целиком и снимает резерв остатков
+// @Tags         saved
+// @Produce      json
+// @Param        user_id path int true "ID пользователя"
+// @Param        sku_id  path int true "SKU товара"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 {object} domain.GetCartResponse
+// @Header       200 {string} ETag "Версия корзины"
+// @Failure      400 {string} string "invalid input"
+// @Failure      404 {string} string "item not in cart"
+// @Failure      412 {string} string "cart version mismatch"
+// @Router       /user/{user_id}/cart/{sku_id}/save [post]
+func (c *CartHttpRouter) moveToSaved(w http.ResponseWriter, req *http.Request, userID uint64, skuStr string) {
+	skuID, err := parseID(skuStr)
+	if err != nil {
+		http.Error(w, "Invalid sku_id", http.StatusBadRequest)
+		return
+	}
+
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.cs.MoveToSaved(req.Context(), userID, skuID, expected); err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	c.getCart(w, req, userID)
+}
+
+// moveToCart godoc
+// @Summary      Вернуть отложенный товар в корзину
+// @Description  Переносит отложенную позицию SKU в корзину по текущей цене с проверкой лимитов и резервом остатков
+// @Tags         saved
+// @Produce      json
+// @Param        user_id path int true "ID пользователя"
+// @Param        sku_id  path int true "SKU товара"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 {object} domain.GetCartResponse
+// @Header       200 {string} ETag "Версия корзины"
+// @Failure      400 {string} string "invalid input"
+// @Failure      404 {string} string "item not in saved list or product not found"
+// @Failure      412 {string} string "insufficient stock or cart version mismatch"
+// @Failure      422 {string} string "cart limit exceeded, price currency differs from cart currency or price overflow"
+// @Failure      503 {string} string "product service unavailable"
+// @Router       /user/{user_id}/cart/saved/{sku_id}/move [post]
+func (c *CartHttpRouter) moveToCart(w http.ResponseWriter, req *http.Request, userID uint64, skuStr string) {
+	skuID, err := parseID(skuStr)
+	if err != nil {
+		http.Error(w, "Invalid sku_id", http.StatusBadRequest)
+		return
+	}
+
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.cs.MoveToCart(req.Context(), userID, skuID, expected); err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	c.getCart(w, req, userID)
+}
+
+// listSaved godoc
+// @Summary      Получить отложенные товары
+// @Description  Возвращает отложенные товары с текущими названиями и ценами, недоступные товары перечислены отдельно
+// @Tags         saved
+// @Produce      json
+// @Param        user_id path int true "ID пользователя"
+// @Success      200 {object} domain.ListSavedResponse
+// @Failure      500 {string} string "server error"
+// @Failure      503 {string} string "product service unavailable"
+// @Router       /user/{user_id}/cart/saved [get]
+func (c *CartHttpRouter) listSaved(w http.ResponseWriter, req *http.Request, userID uint64) {
+	resp, err := c.cs.ListSaved(req.Context(), userID)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// shareCart godoc
+// @Summary      Поделиться корзиной
+// @Description  Сохраняет снимок корзины с текущими названиями и ценами и возвращает подписанный токен с ограниченным сроком действия. Недоступные товары и промокод в снимок не входят
+// @Tags         share
+// @Produce      json
+// @Param        user_id path int true "ID пользователя"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 {object} domain.ShareCartResponse
+// @Failure      409 {string} string "cart is empty"
+// @Failure      501 {string} string "cart sharing is not configured"
+// @Router       /user/{user_id}/cart/share [post]
+func (c *CartHttpRouter) shareCart(w http.ResponseWriter, req *http.Request, userID uint64) {
+	resp, err := c.cs.ShareCart(req.Context(), userID)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// getSharedCart godoc
+// @Summary      Получить корзину по ссылке
+// @Description  Возвращает снимок корзины в том виде, в каком им поделились
+// @Tags         share
+// @Produce      json
+// @Param        token path string true "Токен из ShareCart"
+// @Success      200 {object} domain.SharedCart
+// @Failure      400 {string} string "invalid share token"
+// @Failure      404 {string} string "shared cart not found"
+// @Failure      410 {string} string "share link expired"
+// @Router       /shared/{token} [get]
+func (c *CartHttpRouter) getSharedCart(w http.ResponseWriter, req *http.Request, token string) {
+	resp, err := c.cs.GetSharedCart(req.Context(), token)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// importSharedCart godoc
+// @Summary      Добавить в корзину товары из чужой корзины
+// @Description  Добавляет товары снимка в корзину пользователя по текущим ценам с проверкой лимитов и резервом остатков: все или ничего. Недоступные товары пропускаются, их sku возвращаются в skipped_sku_ids
+// @Tags         share
+// @Accept       json
+// @Produce      json
+// @Param        user_id path int true "ID пользователя"
+// @Param        payload body domain.ImportSharedCartRequest true "Токен из ShareCart"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 {object} domain.ImportSharedCartResponse
+// @Header       200 {string} ETag "Версия корзины"
+// @Failure      400 {string} string "invalid input"
+// @Failure      404 {string} string "shared cart not found"
+// @Failure      409 {string} string "nothing left to import"
+// @Failure      410 {string} string "share link expired"
+// @Failure      412 {string} string "insufficient stock or cart version mismatch"
+// @Failure      422 {string} string "cart limit exceeded or price overflow"
+// @Router       /user/{user_id}/cart/import [post]
+func (c *CartHttpRouter) importSharedCart(w http.ResponseWriter, req *http.Request, userID uint64) {
+	var body domain.ImportSharedCartRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+	if err := c.v.Struct(body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	skipped, err := c.cs.ImportSharedCart(req.Context(), body.Token, userID, expected)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	cart, err := c.cs.GetCart(req.Context(), userID)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("ETag", etag(cart.Version))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(domain.ImportSharedCartResponse{SkippedSkuIDs: skipped, Cart: cart})
+}
+
+// bulkUpdateCart godoc
+// @Summary      Изменить несколько позиций корзины
+// @Description  Применяет по порядку операции add, set и delete с одной пакетной проверкой товаров в ProductService и в одной транзакции. Возвращает результат каждой операции; с all_or_nothing при ошибке любой операции корзина не меняется, иначе применяются остальные
+// @Tags         cart
+// @Accept       json
+// @Produce      json
+// @Param        user_id path int true "ID пользователя"
+// @Param        payload body domain.BulkUpdateCartRequest true "Операции"
+// @Param        If-Match header string false "Версия корзины из ETag"
+// @Param        Idempotency-Key header string false "Ключ идемпотентности"
+// @Success      200 {object} domain.BulkUpdateCartResponse
+// @Header       200 {string} ETag "Версия корзины"
+// @Failure      400 {string} string "invalid input"
+// @Failure      412 {string} string "cart version mismatch"
+// @Failure      503 {string} string "product service unavailable"
+// @Router       /user/{user_id}/cart/bulk [post]
+func (c *CartHttpRouter) bulkUpdateCart(w http.ResponseWriter, req *http.Request, userID uint64) {
+	var body domain.BulkUpdateCartRequest
+	if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+	if err := c.v.Struct(body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	expected, err := ifMatch(req)
+	if err != nil {
+		http.Error(w, "Invalid If-Match", http.StatusBadRequest)
+		return
+	}
+
+	results, err := c.cs.BulkUpdateCart(req.Context(), userID, body.Ops, body.AllOrNothing, expected)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	cart, err := c.cs.GetCart(req.Context(), userID)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("ETag", etag(cart.Version))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(domain.BulkUpdateCartResponse{Results: results, Cart: cart})
+}
+
+// getCartHistory godoc
+// @Summary      История изменений корзины
+// @Description  Возвращает изменения позиций корзины начиная с since, от старых к новым, с постраничной выдачей по курсору
+// @Tags         cart
+// @Produce      json
+// @Param        user_id    path  int    true  "ID пользователя"
+// @Param        since      query string false "Время в RFC 3339, с которого выдаётся история"
+// @Param        page_token query string false "next_page_token предыдущей страницы"
+// @Param        page_size  query int    false "Размер страницы, по умолчанию 50"
+// @Success      200 {object} domain.CartHistoryResponse
+// @Failure      400 {string} string "invalid input"
+// @Failure      500 {string} string "server error"
+// @Router       /user/{user_id}/cart/history [get]
+func (c *CartHttpRouter) getCartHistory(w http.ResponseWriter, req *http.Request, userID uint64) {
+	q := req.URL.Query()
+
+	var since time.Time
+	if v := q.Get("since"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			http.Error(w, "Invalid since", http.StatusBadRequest)
+			return
+		}
+		since = t
+	}
+
+	var pageSize int
+	if v := q.Get("page_size"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			http.Error(w, "Invalid page_size", http.StatusBadRequest)
+			return
+		}
+		pageSize = n
+	}
+
+	resp, err := c.cs.GetCartHistory(req.Context(), userID, since, q.Get("page_token"), pageSize)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// listOrders godoc
+// @Summary      Получить заказы пользователя
+// @Tags         orders
+// @Param        user_id path int true "ID пользователя"
+// @Success      200 {object} domain.ListOrdersResponse
+// @Router       /user/{user_id}/orders [get]
+func (c *CartHttpRouter) listOrders(w http.ResponseWriter, req *http.Request, userID uint64) {
+	resp, err := c.cs.ListOrders(req.Context(), userID)
 	if err != nil {
 		http.Error(w, "Internal error", http.StatusInternalServerError)
 		return
@@ -172,3 +967,29 @@ func (c *CartHttpRouter) getCart(w http.ResponseWriter, req *http.Request, userI
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(resp)
 }
+
+// getOrder godoc
+// @Summary      Получить заказ
+// @Tags         orders
+// @Param        user_id  path int true "ID пользователя"
+// @Param        order_id path int true "ID заказа"
+// @Success      200 {object} domain.Order
+// @Failure      404 {string} string "order not found"
+// @Router       /user/{user_id}/orders/{order_id} [get]
+func (c *CartHttpRouter) getOrder(w http.ResponseWriter, req *http.Request, userID uint64, orderStr string) {
+	orderID, err := parseID(orderStr)
+	if err != nil {
+		http.Error(w, "Invalid order_id", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := c.cs.GetOrder(req.Context(), userID, orderID)
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(resp)
+}
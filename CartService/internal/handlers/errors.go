@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/verbovyar/OzonCart/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// serviceErrors maps errors returned by CartService to both transports.
+var serviceErrors = []struct {
+	err     error
+	code    codes.Code
+	status  int
+	message string
+}{
+	{service.ErrProductNotFound, codes.NotFound, http.StatusNotFound, "product not found"},
+	{service.ErrInsufficientStock, codes.FailedPrecondition, http.StatusPreconditionFailed, "insufficient stock"},
+	{service.ErrItemNotFound, codes.NotFound, http.StatusNotFound, "item not in cart"},
+	{service.ErrSavedItemNotFound, codes.NotFound, http.StatusNotFound, "item not in saved list"},
+	{service.ErrLimitExceeded, codes.ResourceExhausted, http.StatusUnprocessableEntity, "cart limit exceeded"},
+	{service.ErrVersionMismatch, codes.Aborted, http.StatusPreconditionFailed, "cart version mismatch"},
+	{service.ErrIdempotencyKeyReused, codes.InvalidArgument, http.StatusUnprocessableEntity, "idempotency key reused with another request"},
+	{service.ErrRequestInProgress, codes.Aborted, http.StatusConflict, "request with this idempotency key is in progress"},
+	{service.ErrProductServiceUnavailable, codes.Unavailable, http.StatusServiceUnavailable, "product service unavailable"},
+	{service.ErrEmptyCart, codes.FailedPrecondition, http.StatusConflict, "cart is empty"},
+	{service.ErrCartChanged, codes.Aborted, http.StatusConflict, "cart changed during checkout"},
+	{service.ErrOrderNotFound, codes.NotFound, http.StatusNotFound, "order not found"},
+	{service.ErrInvalidPageToken, codes.InvalidArgument, http.StatusBadRequest, "invalid page token"},
+	{service.ErrGuestCartNotFound, codes.NotFound, http.StatusNotFound, "guest cart not found"},
+	{service.ErrInvalidUserID, codes.InvalidArgument, http.StatusBadRequest, "invalid user id"},
+	{service.ErrInvalidMergeStrategy, codes.InvalidArgument, http.StatusBadRequest, "invalid merge strategy"},
+	{service.ErrPromoNotFound, codes.NotFound, http.StatusNotFound, "promo code not found"},
+	{service.ErrPromoExists, codes.AlreadyExists, http.StatusConflict, "promo code already exists"},
+	{service.ErrInvalidPromo, codes.InvalidArgument, http.StatusBadRequest, "invalid promo"},
+	{service.ErrCurrencyMismatch, codes.FailedPrecondition, http.StatusUnprocessableEntity, "price currency differs from cart currency"},
+	{service.ErrPriceOverflow, codes.OutOfRange, http.StatusUnprocessableEntity, "price overflow"},
+	{service.ErrQuoteUnavailable, codes.Unimplemented, http.StatusNotImplemented, "cart quotes are not configured"},
+	{service.ErrNotDeliverable, codes.FailedPrecondition, http.StatusUnprocessableEntity, "cart cannot be shipped to the address"},
+	{service.ErrInvalidAddress, codes.InvalidArgument, http.StatusBadRequest, "invalid address"},
+	{service.ErrSharingUnavailable, codes.Unimplemented, http.StatusNotImplemented, "cart sharing is not configured"},
+	{service.ErrInvalidShareToken, codes.InvalidArgument, http.StatusBadRequest, "invalid share token"},
+	{service.ErrShareExpired, codes.NotFound, http.StatusGone, "share link expired"},
+	{service.ErrSharedCartNotFound, codes.NotFound, http.StatusNotFound, "shared cart not found"},
+	{service.ErrInvalidBulkOp, codes.InvalidArgument, http.StatusBadRequest, "invalid bulk operation"},
+}
+
+// grpcError converts a service error to a status, unknown errors become
+// Internal with msg.
+func grpcError(err error, msg string) error {
+	for _, e := range serviceErrors {
+		if errors.Is(err, e.err) {
+			return status.Error(e.code, e.message)
+		}
+	}
+
+	return status.Error(codes.Internal, msg)
+}
+
+// httpError writes a service error, unknown errors become 500 with msg.
+func httpError(w http.ResponseWriter, err error, msg string) {
+	for _, e := range serviceErrors {
+		if errors.Is(err, e.err) {
+			http.Error(w, strings.ToUpper(e.message[:1])+e.message[1:], e.status)
+			return
+		}
+	}
+
+	http.Error(w, msg, http.StatusInternalServerError)
+}
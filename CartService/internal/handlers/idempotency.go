@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/verbovyar/OzonCart/api/CartServiceApiPb"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	IdempotencyKeyHeader   = "Idempotency-Key"
+	IdempotencyKeyMetadata = "idempotency-key"
+
+	maxIdempotencyKeyLen = 255
+)
+
+// errNotStored makes CartService.Idempotent drop the key of an unsuccessful response.
+var errNotStored = errors.New("response is not stored")
+
+// fingerprint hashes the parts of a request that must match on a replay.
+func fingerprint(parts ...[]byte) string {
+	h := sha256.New()
+	for _, p := range parts {
+		binary.Write(h, binary.BigEndian, uint64(len(p)))
+		h.Write(p)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recordedResponse is the stored form of a successful HTTP mutation.
+type recordedResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   []byte      `json:"body,omitempty"`
+}
+
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{header: make(http.Header)}
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) response() recordedResponse {
+	return recordedResponse{Status: r.status, Header: r.header, Body: r.body.Bytes()}
+}
+
+func (resp recordedResponse) writeTo(w http.ResponseWriter) {
+	for k, v := range resp.Header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(resp.Status)
+	w.Write(resp.Body)
+}
+
+// idempotent runs handle at most once per Idempotency-Key and replays its
+// response to repeated requests. Only 2xx responses are kept.
+func (c *CartHttpRouter) idempotent(w http.ResponseWriter, req *http.Request, userID uint64, handle http.HandlerFunc) {
+	key := req.Header.Get(IdempotencyKeyHeader)
+	if key == "" {
+		handle(w, req)
+		return
+	}
+	if len(key) > maxIdempotencyKeyLen {
+		http.Error(w, "Invalid Idempotency-Key", http.StatusBadRequest)
+		return
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	fp := fingerprint([]byte(req.Method), []byte(req.URL.Path), []byte(req.Header.Get("If-Match")), body)
+
+	var failed *responseRecorder
+	out, err := c.cs.Idempotent(req.Context(), userID, key, fp, func() ([]byte, error) {
+		rec := newResponseRecorder()
+		handle(rec, req)
+		if rec.status < 200 || rec.status >= 300 {
+			failed = rec
+			return nil, errNotStored
+		}
+		return json.Marshal(rec.response())
+	})
+	if failed != nil {
+		failed.response().writeTo(w)
+		return
+	}
+	if err != nil {
+		httpError(w, err, "Internal error")
+		return
+	}
+
+	var resp recordedResponse
+	if err := json.Unmarshal(out, &resp); err != nil {
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+	resp.writeTo(w)
+}
+
+// idempotentMethods are the gRPC mutations an idempotency-key applies to.
+var idempotentMethods = map[string]bool{
+	CartServiceApiPb.CartService_AddToCart_FullMethodName:        true,
+	CartServiceApiPb.CartService_UpdateItemCount_FullMethodName:  true,
+	CartServiceApiPb.CartService_DecrementItem_FullMethodName:    true,
+	CartServiceApiPb.CartService_DeleteItem_FullMethodName:       true,
+	CartServiceApiPb.CartService_ClearCart_FullMethodName:        true,
+	CartServiceApiPb.CartService_Checkout_FullMethodName:         true,
+	CartServiceApiPb.CartService_ApplyPromo_FullMethodName:       true,
+	CartServiceApiPb.CartService_RemovePromo_FullMethodName:      true,
+	CartServiceApiPb.CartService_MoveToSaved_FullMethodName:      true,
+	CartServiceApiPb.CartService_MoveToCart_FullMethodName:       true,
+	CartServiceApiPb.CartService_ShareCart_FullMethodName:        true,
+	CartServiceApiPb.CartService_ImportSharedCart_FullMethodName: true,
+	CartServiceApiPb.CartService_BulkUpdateCart_FullMethodName:   true,
+}
+
+type userRequest interface {
+	proto.Message
+	GetUserId() uint64
+}
+
+type guestRequest interface {
+	GetGuestToken() string
+}
+
+// IdempotencyInterceptor gives the cart mutations the Idempotency-Key
+// semantics of the HTTP API, with the key in idempotency-key metadata.
+func IdempotencyInterceptor(cs *service.CartService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(IdempotencyKeyMetadata)
+		in, ok := req.(userRequest)
+		if len(keys) == 0 || !ok || !idempotentMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		if len(keys[0]) > maxIdempotencyKeyLen {
+			return nil, status.Error(codes.InvalidArgument, "invalid idempotency key")
+		}
+
+		var guestToken string
+		if g, ok := req.(guestRequest); ok {
+			guestToken = g.GetGuestToken()
+		}
+		owner, err := cs.CartOwner(ctx, in.GetUserId(), guestToken)
+		if err != nil {
+			return nil, grpcError(err, "internal error")
+		}
+
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(in)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+
+		out, err := cs.Idempotent(ctx, owner, keys[0], fingerprint([]byte(info.FullMethod), b), func() ([]byte, error) {
+			resp, err := handler(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			a, err := anypb.New(resp.(proto.Message))
+			if err != nil {
+				return nil, err
+			}
+			return proto.Marshal(a)
+		})
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, grpcError(err, "internal error")
+		}
+
+		var a anypb.Any
+		if err := proto.Unmarshal(out, &a); err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		return a.UnmarshalNew()
+	}
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/verbovyar/OzonCart/internal/audit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestContext puts the caller of the request into its context. A request
+// without X-Request-Id gets a new one, which is sent back in the response.
+func RequestContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c := audit.Caller{
+			Identity:  r.Header.Get(audit.CallerHeader),
+			RequestID: r.Header.Get(audit.RequestIDHeader),
+		}
+		if c.RequestID == "" {
+			c.RequestID = audit.NewRequestID()
+		}
+		w.Header().Set(audit.RequestIDHeader, c.RequestID)
+
+		next.ServeHTTP(w, r.WithContext(audit.WithCaller(r.Context(), c)))
+	})
+}
+
+// UnaryRequestContext is RequestContext for gRPC, the caller comes from
+// x-caller-id and x-request-id metadata.
+func UnaryRequestContext(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	c := audit.Caller{
+		Identity:  first(md.Get(audit.CallerMetadata)),
+		RequestID: first(md.Get(audit.RequestIDMetadata)),
+	}
+	if c.RequestID == "" {
+		c.RequestID = audit.NewRequestID()
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(audit.RequestIDMetadata, c.RequestID))
+
+	return handler(audit.WithCaller(ctx, c), req)
+}
+
+func first(v []string) string {
+	if len(v) == 0 {
+		return ""
+	}
+	return v[0]
+}
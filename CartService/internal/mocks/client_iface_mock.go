@@ -19,12 +19,40 @@ type ClientIfaceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCommitStock          func(ctx context.Context, sku uint64, count uint64) (err error)
+	funcCommitStockOrigin    string
+	inspectFuncCommitStock   func(ctx context.Context, sku uint64, count uint64)
+	afterCommitStockCounter  uint64
+	beforeCommitStockCounter uint64
+	CommitStockMock          mClientIfaceMockCommitStock
+
 	funcGetProduct          func(ctx context.Context, sku uint64) (pp1 *mm_service.Product, err error)
 	funcGetProductOrigin    string
 	inspectFuncGetProduct   func(ctx context.Context, sku uint64)
 	afterGetProductCounter  uint64
 	beforeGetProductCounter uint64
 	GetProductMock          mClientIfaceMockGetProduct
+
+	funcGetProducts          func(ctx context.Context, skus []uint64) (m1 map[uint64]*mm_service.Product, err error)
+	funcGetProductsOrigin    string
+	inspectFuncGetProducts   func(ctx context.Context, skus []uint64)
+	afterGetProductsCounter  uint64
+	beforeGetProductsCounter uint64
+	GetProductsMock          mClientIfaceMockGetProducts
+
+	funcReleaseStock          func(ctx context.Context, sku uint64, count uint64) (err error)
+	funcReleaseStockOrigin    string
+	inspectFuncReleaseStock   func(ctx context.Context, sku uint64, count uint64)
+	afterReleaseStockCounter  uint64
+	beforeReleaseStockCounter uint64
+	ReleaseStockMock          mClientIfaceMockReleaseStock
+
+	funcReserveStock          func(ctx context.Context, sku uint64, count uint64) (err error)
+	funcReserveStockOrigin    string
+	inspectFuncReserveStock   func(ctx context.Context, sku uint64, count uint64)
+	afterReserveStockCounter  uint64
+	beforeReserveStockCounter uint64
+	ReserveStockMock          mClientIfaceMockReserveStock
 }
 
 // NewClientIfaceMock returns a mock for mm_service.ClientIface
@@ -35,14 +63,399 @@ func NewClientIfaceMock(t minimock.Tester) *ClientIfaceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CommitStockMock = mClientIfaceMockCommitStock{mock: m}
+	m.CommitStockMock.callArgs = []*ClientIfaceMockCommitStockParams{}
+
 	m.GetProductMock = mClientIfaceMockGetProduct{mock: m}
 	m.GetProductMock.callArgs = []*ClientIfaceMockGetProductParams{}
 
+	m.GetProductsMock = mClientIfaceMockGetProducts{mock: m}
+	m.GetProductsMock.callArgs = []*ClientIfaceMockGetProductsParams{}
+
+	m.ReleaseStockMock = mClientIfaceMockReleaseStock{mock: m}
+	m.ReleaseStockMock.callArgs = []*ClientIfaceMockReleaseStockParams{}
+
+	m.ReserveStockMock = mClientIfaceMockReserveStock{mock: m}
+	m.ReserveStockMock.callArgs = []*ClientIfaceMockReserveStockParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mClientIfaceMockCommitStock struct {
+	optional           bool
+	mock               *ClientIfaceMock
+	defaultExpectation *ClientIfaceMockCommitStockExpectation
+	expectations       []*ClientIfaceMockCommitStockExpectation
+
+	callArgs []*ClientIfaceMockCommitStockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientIfaceMockCommitStockExpectation specifies expectation struct of the ClientIface.CommitStock
+type ClientIfaceMockCommitStockExpectation struct {
+	mock               *ClientIfaceMock
+	params             *ClientIfaceMockCommitStockParams
+	paramPtrs          *ClientIfaceMockCommitStockParamPtrs
+	expectationOrigins ClientIfaceMockCommitStockExpectationOrigins
+	results            *ClientIfaceMockCommitStockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ClientIfaceMockCommitStockParams contains parameters of the ClientIface.CommitStock
+type ClientIfaceMockCommitStockParams struct {
+	ctx   context.Context
+	sku   uint64
+	count uint64
+}
+
+// ClientIfaceMockCommitStockParamPtrs contains pointers to parameters of the ClientIface.CommitStock
+type ClientIfaceMockCommitStockParamPtrs struct {
+	ctx   *context.Context
+	sku   *uint64
+	count *uint64
+}
+
+// ClientIfaceMockCommitStockResults contains results of the ClientIface.CommitStock
+type ClientIfaceMockCommitStockResults struct {
+	err error
+}
+
+// ClientIfaceMockCommitStockOrigins contains origins of expectations of the ClientIface.CommitStock
+type ClientIfaceMockCommitStockExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSku   string
+	originCount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCommitStock *mClientIfaceMockCommitStock) Optional() *mClientIfaceMockCommitStock {
+	mmCommitStock.optional = true
+	return mmCommitStock
+}
+
+// Expect sets up expected params for ClientIface.CommitStock
+func (mmCommitStock *mClientIfaceMockCommitStock) Expect(ctx context.Context, sku uint64, count uint64) *mClientIfaceMockCommitStock {
+	if mmCommitStock.mock.funcCommitStock != nil {
+		mmCommitStock.mock.t.Fatalf("ClientIfaceMock.CommitStock mock is already set by Set")
+	}
+
+	if mmCommitStock.defaultExpectation == nil {
+		mmCommitStock.defaultExpectation = &ClientIfaceMockCommitStockExpectation{}
+	}
+
+	if mmCommitStock.defaultExpectation.paramPtrs != nil {
+		mmCommitStock.mock.t.Fatalf("ClientIfaceMock.CommitStock mock is already set by ExpectParams functions")
+	}
+
+	mmCommitStock.defaultExpectation.params = &ClientIfaceMockCommitStockParams{ctx, sku, count}
+	mmCommitStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCommitStock.expectations {
+		if minimock.Equal(e.params, mmCommitStock.defaultExpectation.params) {
+			mmCommitStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCommitStock.defaultExpectation.params)
+		}
+	}
+
+	return mmCommitStock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ClientIface.CommitStock
+func (mmCommitStock *mClientIfaceMockCommitStock) ExpectCtxParam1(ctx context.Context) *mClientIfaceMockCommitStock {
+	if mmCommitStock.mock.funcCommitStock != nil {
+		mmCommitStock.mock.t.Fatalf("ClientIfaceMock.CommitStock mock is already set by Set")
+	}
+
+	if mmCommitStock.defaultExpectation == nil {
+		mmCommitStock.defaultExpectation = &ClientIfaceMockCommitStockExpectation{}
+	}
+
+	if mmCommitStock.defaultExpectation.params != nil {
+		mmCommitStock.mock.t.Fatalf("ClientIfaceMock.CommitStock mock is already set by Expect")
+	}
+
+	if mmCommitStock.defaultExpectation.paramPtrs == nil {
+		mmCommitStock.defaultExpectation.paramPtrs = &ClientIfaceMockCommitStockParamPtrs{}
+	}
+	mmCommitStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCommitStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCommitStock
+}
+
+// ExpectSkuParam2 sets up expected param sku for ClientIface.CommitStock
+func (mmCommitStock *mClientIfaceMockCommitStock) ExpectSkuParam2(sku uint64) *mClientIfaceMockCommitStock {
+	if mmCommitStock.mock.funcCommitStock != nil {
+		mmCommitStock.mock.t.Fatalf("ClientIfaceMock.CommitStock mock is already set by Set")
+	}
+
+	if mmCommitStock.defaultExpectation == nil {
+		mmCommitStock.defaultExpectation = &ClientIfaceMockCommitStockExpectation{}
+	}
+
+	if mmCommitStock.defaultExpectation.params != nil {
+		mmCommitStock.mock.t.Fatalf("ClientIfaceMock.CommitStock mock is already set by Expect")
+	}
+
+	if mmCommitStock.defaultExpectation.paramPtrs == nil {
+		mmCommitStock.defaultExpectation.paramPtrs = &ClientIfaceMockCommitStockParamPtrs{}
+	}
+	mmCommitStock.defaultExpectation.paramPtrs.sku = &sku
+	mmCommitStock.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
+
+	return mmCommitStock
+}
+
+// ExpectCountParam3 sets up expected param count for ClientIface.CommitStock
+func (mmCommitStock *mClientIfaceMockCommitStock) ExpectCountParam3(count uint64) *mClientIfaceMockCommitStock {
+	if mmCommitStock.mock.funcCommitStock != nil {
+		mmCommitStock.mock.t.Fatalf("ClientIfaceMock.CommitStock mock is already set by Set")
+	}
+
+	if mmCommitStock.defaultExpectation == nil {
+		mmCommitStock.defaultExpectation = &ClientIfaceMockCommitStockExpectation{}
+	}
+
+	if mmCommitStock.defaultExpectation.params != nil {
+		mmCommitStock.mock.t.Fatalf("ClientIfaceMock.CommitStock mock is already set by Expect")
+	}
+
+	if mmCommitStock.defaultExpectation.paramPtrs == nil {
+		mmCommitStock.defaultExpectation.paramPtrs = &ClientIfaceMockCommitStockParamPtrs{}
+	}
+	mmCommitStock.defaultExpectation.paramPtrs.count = &count
+	mmCommitStock.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmCommitStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the ClientIface.CommitStock
+func (mmCommitStock *mClientIfaceMockCommitStock) Inspect(f func(ctx context.Context, sku uint64, count uint64)) *mClientIfaceMockCommitStock {
+	if mmCommitStock.mock.inspectFuncCommitStock != nil {
+		mmCommitStock.mock.t.Fatalf("Inspect function is already set for ClientIfaceMock.CommitStock")
+	}
+
+	mmCommitStock.mock.inspectFuncCommitStock = f
+
+	return mmCommitStock
+}
+
+// Return sets up results that will be returned by ClientIface.CommitStock
+func (mmCommitStock *mClientIfaceMockCommitStock) Return(err error) *ClientIfaceMock {
+	if mmCommitStock.mock.funcCommitStock != nil {
+		mmCommitStock.mock.t.Fatalf("ClientIfaceMock.CommitStock mock is already set by Set")
+	}
+
+	if mmCommitStock.defaultExpectation == nil {
+		mmCommitStock.defaultExpectation = &ClientIfaceMockCommitStockExpectation{mock: mmCommitStock.mock}
+	}
+	mmCommitStock.defaultExpectation.results = &ClientIfaceMockCommitStockResults{err}
+	mmCommitStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCommitStock.mock
+}
+
+// Set uses given function f to mock the ClientIface.CommitStock method
+func (mmCommitStock *mClientIfaceMockCommitStock) Set(f func(ctx context.Context, sku uint64, count uint64) (err error)) *ClientIfaceMock {
+	if mmCommitStock.defaultExpectation != nil {
+		mmCommitStock.mock.t.Fatalf("Default expectation is already set for the ClientIface.CommitStock method")
+	}
+
+	if len(mmCommitStock.expectations) > 0 {
+		mmCommitStock.mock.t.Fatalf("Some expectations are already set for the ClientIface.CommitStock method")
+	}
+
+	mmCommitStock.mock.funcCommitStock = f
+	mmCommitStock.mock.funcCommitStockOrigin = minimock.CallerInfo(1)
+	return mmCommitStock.mock
+}
+
+// When sets expectation for the ClientIface.CommitStock which will trigger the result defined by the following
+// Then helper
+func (mmCommitStock *mClientIfaceMockCommitStock) When(ctx context.Context, sku uint64, count uint64) *ClientIfaceMockCommitStockExpectation {
+	if mmCommitStock.mock.funcCommitStock != nil {
+		mmCommitStock.mock.t.Fatalf("ClientIfaceMock.CommitStock mock is already set by Set")
+	}
+
+	expectation := &ClientIfaceMockCommitStockExpectation{
+		mock:               mmCommitStock.mock,
+		params:             &ClientIfaceMockCommitStockParams{ctx, sku, count},
+		expectationOrigins: ClientIfaceMockCommitStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCommitStock.expectations = append(mmCommitStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ClientIface.CommitStock return parameters for the expectation previously defined by the When method
+func (e *ClientIfaceMockCommitStockExpectation) Then(err error) *ClientIfaceMock {
+	e.results = &ClientIfaceMockCommitStockResults{err}
+	return e.mock
+}
+
+// Times sets number of times ClientIface.CommitStock should be invoked
+func (mmCommitStock *mClientIfaceMockCommitStock) Times(n uint64) *mClientIfaceMockCommitStock {
+	if n == 0 {
+		mmCommitStock.mock.t.Fatalf("Times of ClientIfaceMock.CommitStock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCommitStock.expectedInvocations, n)
+	mmCommitStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCommitStock
+}
+
+func (mmCommitStock *mClientIfaceMockCommitStock) invocationsDone() bool {
+	if len(mmCommitStock.expectations) == 0 && mmCommitStock.defaultExpectation == nil && mmCommitStock.mock.funcCommitStock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCommitStock.mock.afterCommitStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCommitStock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CommitStock implements mm_service.ClientIface
+func (mmCommitStock *ClientIfaceMock) CommitStock(ctx context.Context, sku uint64, count uint64) (err error) {
+	mm_atomic.AddUint64(&mmCommitStock.beforeCommitStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmCommitStock.afterCommitStockCounter, 1)
+
+	mmCommitStock.t.Helper()
+
+	if mmCommitStock.inspectFuncCommitStock != nil {
+		mmCommitStock.inspectFuncCommitStock(ctx, sku, count)
+	}
+
+	mm_params := ClientIfaceMockCommitStockParams{ctx, sku, count}
+
+	// Record call args
+	mmCommitStock.CommitStockMock.mutex.Lock()
+	mmCommitStock.CommitStockMock.callArgs = append(mmCommitStock.CommitStockMock.callArgs, &mm_params)
+	mmCommitStock.CommitStockMock.mutex.Unlock()
+
+	for _, e := range mmCommitStock.CommitStockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCommitStock.CommitStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCommitStock.CommitStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmCommitStock.CommitStockMock.defaultExpectation.params
+		mm_want_ptrs := mmCommitStock.CommitStockMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientIfaceMockCommitStockParams{ctx, sku, count}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCommitStock.t.Errorf("ClientIfaceMock.CommitStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCommitStock.CommitStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
+				mmCommitStock.t.Errorf("ClientIfaceMock.CommitStock got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCommitStock.CommitStockMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmCommitStock.t.Errorf("ClientIfaceMock.CommitStock got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCommitStock.CommitStockMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCommitStock.t.Errorf("ClientIfaceMock.CommitStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCommitStock.CommitStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCommitStock.CommitStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCommitStock.t.Fatal("No results are set for the ClientIfaceMock.CommitStock")
+		}
+		return (*mm_results).err
+	}
+	if mmCommitStock.funcCommitStock != nil {
+		return mmCommitStock.funcCommitStock(ctx, sku, count)
+	}
+	mmCommitStock.t.Fatalf("Unexpected call to ClientIfaceMock.CommitStock. %v %v %v", ctx, sku, count)
+	return
+}
+
+// CommitStockAfterCounter returns a count of finished ClientIfaceMock.CommitStock invocations
+func (mmCommitStock *ClientIfaceMock) CommitStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCommitStock.afterCommitStockCounter)
+}
+
+// CommitStockBeforeCounter returns a count of ClientIfaceMock.CommitStock invocations
+func (mmCommitStock *ClientIfaceMock) CommitStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCommitStock.beforeCommitStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientIfaceMock.CommitStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCommitStock *mClientIfaceMockCommitStock) Calls() []*ClientIfaceMockCommitStockParams {
+	mmCommitStock.mutex.RLock()
+
+	argCopy := make([]*ClientIfaceMockCommitStockParams, len(mmCommitStock.callArgs))
+	copy(argCopy, mmCommitStock.callArgs)
+
+	mmCommitStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCommitStockDone returns true if the count of the CommitStock invocations corresponds
+// the number of defined expectations
+func (m *ClientIfaceMock) MinimockCommitStockDone() bool {
+	if m.CommitStockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CommitStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CommitStockMock.invocationsDone()
+}
+
+// MinimockCommitStockInspect logs each unmet expectation
+func (m *ClientIfaceMock) MinimockCommitStockInspect() {
+	for _, e := range m.CommitStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientIfaceMock.CommitStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCommitStockCounter := mm_atomic.LoadUint64(&m.afterCommitStockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CommitStockMock.defaultExpectation != nil && afterCommitStockCounter < 1 {
+		if m.CommitStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ClientIfaceMock.CommitStock at\n%s", m.CommitStockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ClientIfaceMock.CommitStock at\n%s with params: %#v", m.CommitStockMock.defaultExpectation.expectationOrigins.origin, *m.CommitStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCommitStock != nil && afterCommitStockCounter < 1 {
+		m.t.Errorf("Expected call to ClientIfaceMock.CommitStock at\n%s", m.funcCommitStockOrigin)
+	}
+
+	if !m.CommitStockMock.invocationsDone() && afterCommitStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientIfaceMock.CommitStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CommitStockMock.expectedInvocations), m.CommitStockMock.expectedInvocationsOrigin, afterCommitStockCounter)
+	}
+}
+
 type mClientIfaceMockGetProduct struct {
 	optional           bool
 	mock               *ClientIfaceMock
@@ -386,11 +799,1108 @@ func (m *ClientIfaceMock) MinimockGetProductInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *ClientIfaceMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockGetProductInspect()
+type mClientIfaceMockGetProducts struct {
+	optional           bool
+	mock               *ClientIfaceMock
+	defaultExpectation *ClientIfaceMockGetProductsExpectation
+	expectations       []*ClientIfaceMockGetProductsExpectation
+
+	callArgs []*ClientIfaceMockGetProductsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientIfaceMockGetProductsExpectation specifies expectation struct of the ClientIface.GetProducts
+type ClientIfaceMockGetProductsExpectation struct {
+	mock               *ClientIfaceMock
+	params             *ClientIfaceMockGetProductsParams
+	paramPtrs          *ClientIfaceMockGetProductsParamPtrs
+	expectationOrigins ClientIfaceMockGetProductsExpectationOrigins
+	results            *ClientIfaceMockGetProductsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ClientIfaceMockGetProductsParams contains parameters of the ClientIface.GetProducts
+type ClientIfaceMockGetProductsParams struct {
+	ctx  context.Context
+	skus []uint64
+}
+
+// ClientIfaceMockGetProductsParamPtrs contains pointers to parameters of the ClientIface.GetProducts
+type ClientIfaceMockGetProductsParamPtrs struct {
+	ctx  *context.Context
+	skus *[]uint64
+}
+
+// ClientIfaceMockGetProductsResults contains results of the ClientIface.GetProducts
+type ClientIfaceMockGetProductsResults struct {
+	m1  map[uint64]*mm_service.Product
+	err error
+}
+
+// ClientIfaceMockGetProductsOrigins contains origins of expectations of the ClientIface.GetProducts
+type ClientIfaceMockGetProductsExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originSkus string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetProducts *mClientIfaceMockGetProducts) Optional() *mClientIfaceMockGetProducts {
+	mmGetProducts.optional = true
+	return mmGetProducts
+}
+
+// Expect sets up expected params for ClientIface.GetProducts
+func (mmGetProducts *mClientIfaceMockGetProducts) Expect(ctx context.Context, skus []uint64) *mClientIfaceMockGetProducts {
+	if mmGetProducts.mock.funcGetProducts != nil {
+		mmGetProducts.mock.t.Fatalf("ClientIfaceMock.GetProducts mock is already set by Set")
+	}
+
+	if mmGetProducts.defaultExpectation == nil {
+		mmGetProducts.defaultExpectation = &ClientIfaceMockGetProductsExpectation{}
+	}
+
+	if mmGetProducts.defaultExpectation.paramPtrs != nil {
+		mmGetProducts.mock.t.Fatalf("ClientIfaceMock.GetProducts mock is already set by ExpectParams functions")
+	}
+
+	mmGetProducts.defaultExpectation.params = &ClientIfaceMockGetProductsParams{ctx, skus}
+	mmGetProducts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetProducts.expectations {
+		if minimock.Equal(e.params, mmGetProducts.defaultExpectation.params) {
+			mmGetProducts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetProducts.defaultExpectation.params)
+		}
+	}
+
+	return mmGetProducts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ClientIface.GetProducts
+func (mmGetProducts *mClientIfaceMockGetProducts) ExpectCtxParam1(ctx context.Context) *mClientIfaceMockGetProducts {
+	if mmGetProducts.mock.funcGetProducts != nil {
+		mmGetProducts.mock.t.Fatalf("ClientIfaceMock.GetProducts mock is already set by Set")
+	}
+
+	if mmGetProducts.defaultExpectation == nil {
+		mmGetProducts.defaultExpectation = &ClientIfaceMockGetProductsExpectation{}
+	}
+
+	if mmGetProducts.defaultExpectation.params != nil {
+		mmGetProducts.mock.t.Fatalf("ClientIfaceMock.GetProducts mock is already set by Expect")
+	}
+
+	if mmGetProducts.defaultExpectation.paramPtrs == nil {
+		mmGetProducts.defaultExpectation.paramPtrs = &ClientIfaceMockGetProductsParamPtrs{}
+	}
+	mmGetProducts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetProducts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetProducts
+}
+
+// ExpectSkusParam2 sets up expected param skus for ClientIface.GetProducts
+func (mmGetProducts *mClientIfaceMockGetProducts) ExpectSkusParam2(skus []uint64) *mClientIfaceMockGetProducts {
+	if mmGetProducts.mock.funcGetProducts != nil {
+		mmGetProducts.mock.t.Fatalf("ClientIfaceMock.GetProducts mock is already set by Set")
+	}
+
+	if mmGetProducts.defaultExpectation == nil {
+		mmGetProducts.defaultExpectation = &ClientIfaceMockGetProductsExpectation{}
+	}
+
+	if mmGetProducts.defaultExpectation.params != nil {
+		mmGetProducts.mock.t.Fatalf("ClientIfaceMock.GetProducts mock is already set by Expect")
+	}
+
+	if mmGetProducts.defaultExpectation.paramPtrs == nil {
+		mmGetProducts.defaultExpectation.paramPtrs = &ClientIfaceMockGetProductsParamPtrs{}
+	}
+	mmGetProducts.defaultExpectation.paramPtrs.skus = &skus
+	mmGetProducts.defaultExpectation.expectationOrigins.originSkus = minimock.CallerInfo(1)
+
+	return mmGetProducts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ClientIface.GetProducts
+func (mmGetProducts *mClientIfaceMockGetProducts) Inspect(f func(ctx context.Context, skus []uint64)) *mClientIfaceMockGetProducts {
+	if mmGetProducts.mock.inspectFuncGetProducts != nil {
+		mmGetProducts.mock.t.Fatalf("Inspect function is already set for ClientIfaceMock.GetProducts")
+	}
+
+	mmGetProducts.mock.inspectFuncGetProducts = f
+
+	return mmGetProducts
+}
+
+// Return sets up results that will be returned by ClientIface.GetProducts
+func (mmGetProducts *mClientIfaceMockGetProducts) Return(m1 map[uint64]*mm_service.Product, err error) *ClientIfaceMock {
+	if mmGetProducts.mock.funcGetProducts != nil {
+		mmGetProducts.mock.t.Fatalf("ClientIfaceMock.GetProducts mock is already set by Set")
+	}
+
+	if mmGetProducts.defaultExpectation == nil {
+		mmGetProducts.defaultExpectation = &ClientIfaceMockGetProductsExpectation{mock: mmGetProducts.mock}
+	}
+	mmGetProducts.defaultExpectation.results = &ClientIfaceMockGetProductsResults{m1, err}
+	mmGetProducts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetProducts.mock
+}
+
+// Set uses given function f to mock the ClientIface.GetProducts method
+func (mmGetProducts *mClientIfaceMockGetProducts) Set(f func(ctx context.Context, skus []uint64) (m1 map[uint64]*mm_service.Product, err error)) *ClientIfaceMock {
+	if mmGetProducts.defaultExpectation != nil {
+		mmGetProducts.mock.t.Fatalf("Default expectation is already set for the ClientIface.GetProducts method")
+	}
+
+	if len(mmGetProducts.expectations) > 0 {
+		mmGetProducts.mock.t.Fatalf("Some expectations are already set for the ClientIface.GetProducts method")
+	}
+
+	mmGetProducts.mock.funcGetProducts = f
+	mmGetProducts.mock.funcGetProductsOrigin = minimock.CallerInfo(1)
+	return mmGetProducts.mock
+}
+
+// When sets expectation for the ClientIface.GetProducts which will trigger the result defined by the following
+// Then helper
+func (mmGetProducts *mClientIfaceMockGetProducts) When(ctx context.Context, skus []uint64) *ClientIfaceMockGetProductsExpectation {
+	if mmGetProducts.mock.funcGetProducts != nil {
+		mmGetProducts.mock.t.Fatalf("ClientIfaceMock.GetProducts mock is already set by Set")
+	}
+
+	expectation := &ClientIfaceMockGetProductsExpectation{
+		mock:               mmGetProducts.mock,
+		params:             &ClientIfaceMockGetProductsParams{ctx, skus},
+		expectationOrigins: ClientIfaceMockGetProductsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetProducts.expectations = append(mmGetProducts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ClientIface.GetProducts return parameters for the expectation previously defined by the When method
+func (e *ClientIfaceMockGetProductsExpectation) Then(m1 map[uint64]*mm_service.Product, err error) *ClientIfaceMock {
+	e.results = &ClientIfaceMockGetProductsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times ClientIface.GetProducts should be invoked
+func (mmGetProducts *mClientIfaceMockGetProducts) Times(n uint64) *mClientIfaceMockGetProducts {
+	if n == 0 {
+		mmGetProducts.mock.t.Fatalf("Times of ClientIfaceMock.GetProducts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetProducts.expectedInvocations, n)
+	mmGetProducts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetProducts
+}
+
+func (mmGetProducts *mClientIfaceMockGetProducts) invocationsDone() bool {
+	if len(mmGetProducts.expectations) == 0 && mmGetProducts.defaultExpectation == nil && mmGetProducts.mock.funcGetProducts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetProducts.mock.afterGetProductsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetProducts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetProducts implements mm_service.ClientIface
+func (mmGetProducts *ClientIfaceMock) GetProducts(ctx context.Context, skus []uint64) (m1 map[uint64]*mm_service.Product, err error) {
+	mm_atomic.AddUint64(&mmGetProducts.beforeGetProductsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetProducts.afterGetProductsCounter, 1)
+
+	mmGetProducts.t.Helper()
+
+	if mmGetProducts.inspectFuncGetProducts != nil {
+		mmGetProducts.inspectFuncGetProducts(ctx, skus)
+	}
+
+	mm_params := ClientIfaceMockGetProductsParams{ctx, skus}
+
+	// Record call args
+	mmGetProducts.GetProductsMock.mutex.Lock()
+	mmGetProducts.GetProductsMock.callArgs = append(mmGetProducts.GetProductsMock.callArgs, &mm_params)
+	mmGetProducts.GetProductsMock.mutex.Unlock()
+
+	for _, e := range mmGetProducts.GetProductsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetProducts.GetProductsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetProducts.GetProductsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetProducts.GetProductsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetProducts.GetProductsMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientIfaceMockGetProductsParams{ctx, skus}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetProducts.t.Errorf("ClientIfaceMock.GetProducts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProducts.GetProductsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skus != nil && !minimock.Equal(*mm_want_ptrs.skus, mm_got.skus) {
+				mmGetProducts.t.Errorf("ClientIfaceMock.GetProducts got unexpected parameter skus, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProducts.GetProductsMock.defaultExpectation.expectationOrigins.originSkus, *mm_want_ptrs.skus, mm_got.skus, minimock.Diff(*mm_want_ptrs.skus, mm_got.skus))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetProducts.t.Errorf("ClientIfaceMock.GetProducts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetProducts.GetProductsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetProducts.GetProductsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetProducts.t.Fatal("No results are set for the ClientIfaceMock.GetProducts")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetProducts.funcGetProducts != nil {
+		return mmGetProducts.funcGetProducts(ctx, skus)
+	}
+	mmGetProducts.t.Fatalf("Unexpected call to ClientIfaceMock.GetProducts. %v %v", ctx, skus)
+	return
+}
+
+// GetProductsAfterCounter returns a count of finished ClientIfaceMock.GetProducts invocations
+func (mmGetProducts *ClientIfaceMock) GetProductsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProducts.afterGetProductsCounter)
+}
+
+// GetProductsBeforeCounter returns a count of ClientIfaceMock.GetProducts invocations
+func (mmGetProducts *ClientIfaceMock) GetProductsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProducts.beforeGetProductsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientIfaceMock.GetProducts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetProducts *mClientIfaceMockGetProducts) Calls() []*ClientIfaceMockGetProductsParams {
+	mmGetProducts.mutex.RLock()
+
+	argCopy := make([]*ClientIfaceMockGetProductsParams, len(mmGetProducts.callArgs))
+	copy(argCopy, mmGetProducts.callArgs)
+
+	mmGetProducts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetProductsDone returns true if the count of the GetProducts invocations corresponds
+// the number of defined expectations
+func (m *ClientIfaceMock) MinimockGetProductsDone() bool {
+	if m.GetProductsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetProductsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetProductsMock.invocationsDone()
+}
+
+// MinimockGetProductsInspect logs each unmet expectation
+func (m *ClientIfaceMock) MinimockGetProductsInspect() {
+	for _, e := range m.GetProductsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientIfaceMock.GetProducts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetProductsCounter := mm_atomic.LoadUint64(&m.afterGetProductsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetProductsMock.defaultExpectation != nil && afterGetProductsCounter < 1 {
+		if m.GetProductsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ClientIfaceMock.GetProducts at\n%s", m.GetProductsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ClientIfaceMock.GetProducts at\n%s with params: %#v", m.GetProductsMock.defaultExpectation.expectationOrigins.origin, *m.GetProductsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetProducts != nil && afterGetProductsCounter < 1 {
+		m.t.Errorf("Expected call to ClientIfaceMock.GetProducts at\n%s", m.funcGetProductsOrigin)
+	}
+
+	if !m.GetProductsMock.invocationsDone() && afterGetProductsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientIfaceMock.GetProducts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetProductsMock.expectedInvocations), m.GetProductsMock.expectedInvocationsOrigin, afterGetProductsCounter)
+	}
+}
+
+type mClientIfaceMockReleaseStock struct {
+	optional           bool
+	mock               *ClientIfaceMock
+	defaultExpectation *ClientIfaceMockReleaseStockExpectation
+	expectations       []*ClientIfaceMockReleaseStockExpectation
+
+	callArgs []*ClientIfaceMockReleaseStockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientIfaceMockReleaseStockExpectation specifies expectation struct of the ClientIface.ReleaseStock
+type ClientIfaceMockReleaseStockExpectation struct {
+	mock               *ClientIfaceMock
+	params             *ClientIfaceMockReleaseStockParams
+	paramPtrs          *ClientIfaceMockReleaseStockParamPtrs
+	expectationOrigins ClientIfaceMockReleaseStockExpectationOrigins
+	results            *ClientIfaceMockReleaseStockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ClientIfaceMockReleaseStockParams contains parameters of the ClientIface.ReleaseStock
+type ClientIfaceMockReleaseStockParams struct {
+	ctx   context.Context
+	sku   uint64
+	count uint64
+}
+
+// ClientIfaceMockReleaseStockParamPtrs contains pointers to parameters of the ClientIface.ReleaseStock
+type ClientIfaceMockReleaseStockParamPtrs struct {
+	ctx   *context.Context
+	sku   *uint64
+	count *uint64
+}
+
+// ClientIfaceMockReleaseStockResults contains results of the ClientIface.ReleaseStock
+type ClientIfaceMockReleaseStockResults struct {
+	err error
+}
+
+// ClientIfaceMockReleaseStockOrigins contains origins of expectations of the ClientIface.ReleaseStock
+type ClientIfaceMockReleaseStockExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSku   string
+	originCount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReleaseStock *mClientIfaceMockReleaseStock) Optional() *mClientIfaceMockReleaseStock {
+	mmReleaseStock.optional = true
+	return mmReleaseStock
+}
+
+// Expect sets up expected params for ClientIface.ReleaseStock
+func (mmReleaseStock *mClientIfaceMockReleaseStock) Expect(ctx context.Context, sku uint64, count uint64) *mClientIfaceMockReleaseStock {
+	if mmReleaseStock.mock.funcReleaseStock != nil {
+		mmReleaseStock.mock.t.Fatalf("ClientIfaceMock.ReleaseStock mock is already set by Set")
+	}
+
+	if mmReleaseStock.defaultExpectation == nil {
+		mmReleaseStock.defaultExpectation = &ClientIfaceMockReleaseStockExpectation{}
+	}
+
+	if mmReleaseStock.defaultExpectation.paramPtrs != nil {
+		mmReleaseStock.mock.t.Fatalf("ClientIfaceMock.ReleaseStock mock is already set by ExpectParams functions")
+	}
+
+	mmReleaseStock.defaultExpectation.params = &ClientIfaceMockReleaseStockParams{ctx, sku, count}
+	mmReleaseStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReleaseStock.expectations {
+		if minimock.Equal(e.params, mmReleaseStock.defaultExpectation.params) {
+			mmReleaseStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReleaseStock.defaultExpectation.params)
+		}
+	}
+
+	return mmReleaseStock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ClientIface.ReleaseStock
+func (mmReleaseStock *mClientIfaceMockReleaseStock) ExpectCtxParam1(ctx context.Context) *mClientIfaceMockReleaseStock {
+	if mmReleaseStock.mock.funcReleaseStock != nil {
+		mmReleaseStock.mock.t.Fatalf("ClientIfaceMock.ReleaseStock mock is already set by Set")
+	}
+
+	if mmReleaseStock.defaultExpectation == nil {
+		mmReleaseStock.defaultExpectation = &ClientIfaceMockReleaseStockExpectation{}
+	}
+
+	if mmReleaseStock.defaultExpectation.params != nil {
+		mmReleaseStock.mock.t.Fatalf("ClientIfaceMock.ReleaseStock mock is already set by Expect")
+	}
+
+	if mmReleaseStock.defaultExpectation.paramPtrs == nil {
+		mmReleaseStock.defaultExpectation.paramPtrs = &ClientIfaceMockReleaseStockParamPtrs{}
+	}
+	mmReleaseStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReleaseStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReleaseStock
+}
+
+// ExpectSkuParam2 sets up expected param sku for ClientIface.ReleaseStock
+func (mmReleaseStock *mClientIfaceMockReleaseStock) ExpectSkuParam2(sku uint64) *mClientIfaceMockReleaseStock {
+	if mmReleaseStock.mock.funcReleaseStock != nil {
+		mmReleaseStock.mock.t.Fatalf("ClientIfaceMock.ReleaseStock mock is already set by Set")
+	}
+
+	if mmReleaseStock.defaultExpectation == nil {
+		mmReleaseStock.defaultExpectation = &ClientIfaceMockReleaseStockExpectation{}
+	}
+
+	if mmReleaseStock.defaultExpectation.params != nil {
+		mmReleaseStock.mock.t.Fatalf("ClientIfaceMock.ReleaseStock mock is already set by Expect")
+	}
+
+	if mmReleaseStock.defaultExpectation.paramPtrs == nil {
+		mmReleaseStock.defaultExpectation.paramPtrs = &ClientIfaceMockReleaseStockParamPtrs{}
+	}
+	mmReleaseStock.defaultExpectation.paramPtrs.sku = &sku
+	mmReleaseStock.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
+
+	return mmReleaseStock
+}
+
+// ExpectCountParam3 sets up expected param count for ClientIface.ReleaseStock
+func (mmReleaseStock *mClientIfaceMockReleaseStock) ExpectCountParam3(count uint64) *mClientIfaceMockReleaseStock {
+	if mmReleaseStock.mock.funcReleaseStock != nil {
+		mmReleaseStock.mock.t.Fatalf("ClientIfaceMock.ReleaseStock mock is already set by Set")
+	}
+
+	if mmReleaseStock.defaultExpectation == nil {
+		mmReleaseStock.defaultExpectation = &ClientIfaceMockReleaseStockExpectation{}
+	}
+
+	if mmReleaseStock.defaultExpectation.params != nil {
+		mmReleaseStock.mock.t.Fatalf("ClientIfaceMock.ReleaseStock mock is already set by Expect")
+	}
+
+	if mmReleaseStock.defaultExpectation.paramPtrs == nil {
+		mmReleaseStock.defaultExpectation.paramPtrs = &ClientIfaceMockReleaseStockParamPtrs{}
+	}
+	mmReleaseStock.defaultExpectation.paramPtrs.count = &count
+	mmReleaseStock.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmReleaseStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the ClientIface.ReleaseStock
+func (mmReleaseStock *mClientIfaceMockReleaseStock) Inspect(f func(ctx context.Context, sku uint64, count uint64)) *mClientIfaceMockReleaseStock {
+	if mmReleaseStock.mock.inspectFuncReleaseStock != nil {
+		mmReleaseStock.mock.t.Fatalf("Inspect function is already set for ClientIfaceMock.ReleaseStock")
+	}
+
+	mmReleaseStock.mock.inspectFuncReleaseStock = f
+
+	return mmReleaseStock
+}
+
+// Return sets up results that will be returned by ClientIface.ReleaseStock
+func (mmReleaseStock *mClientIfaceMockReleaseStock) Return(err error) *ClientIfaceMock {
+	if mmReleaseStock.mock.funcReleaseStock != nil {
+		mmReleaseStock.mock.t.Fatalf("ClientIfaceMock.ReleaseStock mock is already set by Set")
+	}
+
+	if mmReleaseStock.defaultExpectation == nil {
+		mmReleaseStock.defaultExpectation = &ClientIfaceMockReleaseStockExpectation{mock: mmReleaseStock.mock}
+	}
+	mmReleaseStock.defaultExpectation.results = &ClientIfaceMockReleaseStockResults{err}
+	mmReleaseStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReleaseStock.mock
+}
+
+// Set uses given function f to mock the ClientIface.ReleaseStock method
+func (mmReleaseStock *mClientIfaceMockReleaseStock) Set(f func(ctx context.Context, sku uint64, count uint64) (err error)) *ClientIfaceMock {
+	if mmReleaseStock.defaultExpectation != nil {
+		mmReleaseStock.mock.t.Fatalf("Default expectation is already set for the ClientIface.ReleaseStock method")
+	}
+
+	if len(mmReleaseStock.expectations) > 0 {
+		mmReleaseStock.mock.t.Fatalf("Some expectations are already set for the ClientIface.ReleaseStock method")
+	}
+
+	mmReleaseStock.mock.funcReleaseStock = f
+	mmReleaseStock.mock.funcReleaseStockOrigin = minimock.CallerInfo(1)
+	return mmReleaseStock.mock
+}
+
+// When sets expectation for the ClientIface.ReleaseStock which will trigger the result defined by the following
+// Then helper
+func (mmReleaseStock *mClientIfaceMockReleaseStock) When(ctx context.Context, sku uint64, count uint64) *ClientIfaceMockReleaseStockExpectation {
+	if mmReleaseStock.mock.funcReleaseStock != nil {
+		mmReleaseStock.mock.t.Fatalf("ClientIfaceMock.ReleaseStock mock is already set by Set")
+	}
+
+	expectation := &ClientIfaceMockReleaseStockExpectation{
+		mock:               mmReleaseStock.mock,
+		params:             &ClientIfaceMockReleaseStockParams{ctx, sku, count},
+		expectationOrigins: ClientIfaceMockReleaseStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReleaseStock.expectations = append(mmReleaseStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ClientIface.ReleaseStock return parameters for the expectation previously defined by the When method
+func (e *ClientIfaceMockReleaseStockExpectation) Then(err error) *ClientIfaceMock {
+	e.results = &ClientIfaceMockReleaseStockResults{err}
+	return e.mock
+}
+
+// Times sets number of times ClientIface.ReleaseStock should be invoked
+func (mmReleaseStock *mClientIfaceMockReleaseStock) Times(n uint64) *mClientIfaceMockReleaseStock {
+	if n == 0 {
+		mmReleaseStock.mock.t.Fatalf("Times of ClientIfaceMock.ReleaseStock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReleaseStock.expectedInvocations, n)
+	mmReleaseStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReleaseStock
+}
+
+func (mmReleaseStock *mClientIfaceMockReleaseStock) invocationsDone() bool {
+	if len(mmReleaseStock.expectations) == 0 && mmReleaseStock.defaultExpectation == nil && mmReleaseStock.mock.funcReleaseStock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReleaseStock.mock.afterReleaseStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReleaseStock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReleaseStock implements mm_service.ClientIface
+func (mmReleaseStock *ClientIfaceMock) ReleaseStock(ctx context.Context, sku uint64, count uint64) (err error) {
+	mm_atomic.AddUint64(&mmReleaseStock.beforeReleaseStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmReleaseStock.afterReleaseStockCounter, 1)
+
+	mmReleaseStock.t.Helper()
+
+	if mmReleaseStock.inspectFuncReleaseStock != nil {
+		mmReleaseStock.inspectFuncReleaseStock(ctx, sku, count)
+	}
+
+	mm_params := ClientIfaceMockReleaseStockParams{ctx, sku, count}
+
+	// Record call args
+	mmReleaseStock.ReleaseStockMock.mutex.Lock()
+	mmReleaseStock.ReleaseStockMock.callArgs = append(mmReleaseStock.ReleaseStockMock.callArgs, &mm_params)
+	mmReleaseStock.ReleaseStockMock.mutex.Unlock()
+
+	for _, e := range mmReleaseStock.ReleaseStockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReleaseStock.ReleaseStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReleaseStock.ReleaseStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmReleaseStock.ReleaseStockMock.defaultExpectation.params
+		mm_want_ptrs := mmReleaseStock.ReleaseStockMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientIfaceMockReleaseStockParams{ctx, sku, count}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReleaseStock.t.Errorf("ClientIfaceMock.ReleaseStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseStock.ReleaseStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
+				mmReleaseStock.t.Errorf("ClientIfaceMock.ReleaseStock got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseStock.ReleaseStockMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmReleaseStock.t.Errorf("ClientIfaceMock.ReleaseStock got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseStock.ReleaseStockMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReleaseStock.t.Errorf("ClientIfaceMock.ReleaseStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReleaseStock.ReleaseStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReleaseStock.ReleaseStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReleaseStock.t.Fatal("No results are set for the ClientIfaceMock.ReleaseStock")
+		}
+		return (*mm_results).err
+	}
+	if mmReleaseStock.funcReleaseStock != nil {
+		return mmReleaseStock.funcReleaseStock(ctx, sku, count)
+	}
+	mmReleaseStock.t.Fatalf("Unexpected call to ClientIfaceMock.ReleaseStock. %v %v %v", ctx, sku, count)
+	return
+}
+
+// ReleaseStockAfterCounter returns a count of finished ClientIfaceMock.ReleaseStock invocations
+func (mmReleaseStock *ClientIfaceMock) ReleaseStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseStock.afterReleaseStockCounter)
+}
+
+// ReleaseStockBeforeCounter returns a count of ClientIfaceMock.ReleaseStock invocations
+func (mmReleaseStock *ClientIfaceMock) ReleaseStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseStock.beforeReleaseStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientIfaceMock.ReleaseStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReleaseStock *mClientIfaceMockReleaseStock) Calls() []*ClientIfaceMockReleaseStockParams {
+	mmReleaseStock.mutex.RLock()
+
+	argCopy := make([]*ClientIfaceMockReleaseStockParams, len(mmReleaseStock.callArgs))
+	copy(argCopy, mmReleaseStock.callArgs)
+
+	mmReleaseStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseStockDone returns true if the count of the ReleaseStock invocations corresponds
+// the number of defined expectations
+func (m *ClientIfaceMock) MinimockReleaseStockDone() bool {
+	if m.ReleaseStockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReleaseStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReleaseStockMock.invocationsDone()
+}
+
+// MinimockReleaseStockInspect logs each unmet expectation
+func (m *ClientIfaceMock) MinimockReleaseStockInspect() {
+	for _, e := range m.ReleaseStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientIfaceMock.ReleaseStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReleaseStockCounter := mm_atomic.LoadUint64(&m.afterReleaseStockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseStockMock.defaultExpectation != nil && afterReleaseStockCounter < 1 {
+		if m.ReleaseStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ClientIfaceMock.ReleaseStock at\n%s", m.ReleaseStockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ClientIfaceMock.ReleaseStock at\n%s with params: %#v", m.ReleaseStockMock.defaultExpectation.expectationOrigins.origin, *m.ReleaseStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseStock != nil && afterReleaseStockCounter < 1 {
+		m.t.Errorf("Expected call to ClientIfaceMock.ReleaseStock at\n%s", m.funcReleaseStockOrigin)
+	}
+
+	if !m.ReleaseStockMock.invocationsDone() && afterReleaseStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientIfaceMock.ReleaseStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReleaseStockMock.expectedInvocations), m.ReleaseStockMock.expectedInvocationsOrigin, afterReleaseStockCounter)
+	}
+}
+
+type mClientIfaceMockReserveStock struct {
+	optional           bool
+	mock               *ClientIfaceMock
+	defaultExpectation *ClientIfaceMockReserveStockExpectation
+	expectations       []*ClientIfaceMockReserveStockExpectation
+
+	callArgs []*ClientIfaceMockReserveStockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ClientIfaceMockReserveStockExpectation specifies expectation struct of the ClientIface.ReserveStock
+type ClientIfaceMockReserveStockExpectation struct {
+	mock               *ClientIfaceMock
+	params             *ClientIfaceMockReserveStockParams
+	paramPtrs          *ClientIfaceMockReserveStockParamPtrs
+	expectationOrigins ClientIfaceMockReserveStockExpectationOrigins
+	results            *ClientIfaceMockReserveStockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ClientIfaceMockReserveStockParams contains parameters of the ClientIface.ReserveStock
+type ClientIfaceMockReserveStockParams struct {
+	ctx   context.Context
+	sku   uint64
+	count uint64
+}
+
+// ClientIfaceMockReserveStockParamPtrs contains pointers to parameters of the ClientIface.ReserveStock
+type ClientIfaceMockReserveStockParamPtrs struct {
+	ctx   *context.Context
+	sku   *uint64
+	count *uint64
+}
+
+// ClientIfaceMockReserveStockResults contains results of the ClientIface.ReserveStock
+type ClientIfaceMockReserveStockResults struct {
+	err error
+}
+
+// ClientIfaceMockReserveStockOrigins contains origins of expectations of the ClientIface.ReserveStock
+type ClientIfaceMockReserveStockExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSku   string
+	originCount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReserveStock *mClientIfaceMockReserveStock) Optional() *mClientIfaceMockReserveStock {
+	mmReserveStock.optional = true
+	return mmReserveStock
+}
+
+// Expect sets up expected params for ClientIface.ReserveStock
+func (mmReserveStock *mClientIfaceMockReserveStock) Expect(ctx context.Context, sku uint64, count uint64) *mClientIfaceMockReserveStock {
+	if mmReserveStock.mock.funcReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("ClientIfaceMock.ReserveStock mock is already set by Set")
+	}
+
+	if mmReserveStock.defaultExpectation == nil {
+		mmReserveStock.defaultExpectation = &ClientIfaceMockReserveStockExpectation{}
+	}
+
+	if mmReserveStock.defaultExpectation.paramPtrs != nil {
+		mmReserveStock.mock.t.Fatalf("ClientIfaceMock.ReserveStock mock is already set by ExpectParams functions")
+	}
+
+	mmReserveStock.defaultExpectation.params = &ClientIfaceMockReserveStockParams{ctx, sku, count}
+	mmReserveStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReserveStock.expectations {
+		if minimock.Equal(e.params, mmReserveStock.defaultExpectation.params) {
+			mmReserveStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserveStock.defaultExpectation.params)
+		}
+	}
+
+	return mmReserveStock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ClientIface.ReserveStock
+func (mmReserveStock *mClientIfaceMockReserveStock) ExpectCtxParam1(ctx context.Context) *mClientIfaceMockReserveStock {
+	if mmReserveStock.mock.funcReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("ClientIfaceMock.ReserveStock mock is already set by Set")
+	}
+
+	if mmReserveStock.defaultExpectation == nil {
+		mmReserveStock.defaultExpectation = &ClientIfaceMockReserveStockExpectation{}
+	}
+
+	if mmReserveStock.defaultExpectation.params != nil {
+		mmReserveStock.mock.t.Fatalf("ClientIfaceMock.ReserveStock mock is already set by Expect")
+	}
+
+	if mmReserveStock.defaultExpectation.paramPtrs == nil {
+		mmReserveStock.defaultExpectation.paramPtrs = &ClientIfaceMockReserveStockParamPtrs{}
+	}
+	mmReserveStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReserveStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReserveStock
+}
+
+// ExpectSkuParam2 sets up expected param sku for ClientIface.ReserveStock
+func (mmReserveStock *mClientIfaceMockReserveStock) ExpectSkuParam2(sku uint64) *mClientIfaceMockReserveStock {
+	if mmReserveStock.mock.funcReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("ClientIfaceMock.ReserveStock mock is already set by Set")
+	}
+
+	if mmReserveStock.defaultExpectation == nil {
+		mmReserveStock.defaultExpectation = &ClientIfaceMockReserveStockExpectation{}
+	}
+
+	if mmReserveStock.defaultExpectation.params != nil {
+		mmReserveStock.mock.t.Fatalf("ClientIfaceMock.ReserveStock mock is already set by Expect")
+	}
+
+	if mmReserveStock.defaultExpectation.paramPtrs == nil {
+		mmReserveStock.defaultExpectation.paramPtrs = &ClientIfaceMockReserveStockParamPtrs{}
+	}
+	mmReserveStock.defaultExpectation.paramPtrs.sku = &sku
+	mmReserveStock.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
+
+	return mmReserveStock
+}
+
+// ExpectCountParam3 sets up expected param count for ClientIface.ReserveStock
+func (mmReserveStock *mClientIfaceMockReserveStock) ExpectCountParam3(count uint64) *mClientIfaceMockReserveStock {
+	if mmReserveStock.mock.funcReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("ClientIfaceMock.ReserveStock mock is already set by Set")
+	}
+
+	if mmReserveStock.defaultExpectation == nil {
+		mmReserveStock.defaultExpectation = &ClientIfaceMockReserveStockExpectation{}
+	}
+
+	if mmReserveStock.defaultExpectation.params != nil {
+		mmReserveStock.mock.t.Fatalf("ClientIfaceMock.ReserveStock mock is already set by Expect")
+	}
+
+	if mmReserveStock.defaultExpectation.paramPtrs == nil {
+		mmReserveStock.defaultExpectation.paramPtrs = &ClientIfaceMockReserveStockParamPtrs{}
+	}
+	mmReserveStock.defaultExpectation.paramPtrs.count = &count
+	mmReserveStock.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmReserveStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the ClientIface.ReserveStock
+func (mmReserveStock *mClientIfaceMockReserveStock) Inspect(f func(ctx context.Context, sku uint64, count uint64)) *mClientIfaceMockReserveStock {
+	if mmReserveStock.mock.inspectFuncReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("Inspect function is already set for ClientIfaceMock.ReserveStock")
+	}
+
+	mmReserveStock.mock.inspectFuncReserveStock = f
+
+	return mmReserveStock
+}
+
+// Return sets up results that will be returned by ClientIface.ReserveStock
+func (mmReserveStock *mClientIfaceMockReserveStock) Return(err error) *ClientIfaceMock {
+	if mmReserveStock.mock.funcReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("ClientIfaceMock.ReserveStock mock is already set by Set")
+	}
+
+	if mmReserveStock.defaultExpectation == nil {
+		mmReserveStock.defaultExpectation = &ClientIfaceMockReserveStockExpectation{mock: mmReserveStock.mock}
+	}
+	mmReserveStock.defaultExpectation.results = &ClientIfaceMockReserveStockResults{err}
+	mmReserveStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReserveStock.mock
+}
+
+// Set uses given function f to mock the ClientIface.ReserveStock method
+func (mmReserveStock *mClientIfaceMockReserveStock) Set(f func(ctx context.Context, sku uint64, count uint64) (err error)) *ClientIfaceMock {
+	if mmReserveStock.defaultExpectation != nil {
+		mmReserveStock.mock.t.Fatalf("Default expectation is already set for the ClientIface.ReserveStock method")
+	}
+
+	if len(mmReserveStock.expectations) > 0 {
+		mmReserveStock.mock.t.Fatalf("Some expectations are already set for the ClientIface.ReserveStock method")
+	}
+
+	mmReserveStock.mock.funcReserveStock = f
+	mmReserveStock.mock.funcReserveStockOrigin = minimock.CallerInfo(1)
+	return mmReserveStock.mock
+}
+
+// When sets expectation for the ClientIface.ReserveStock which will trigger the result defined by the following
+// Then helper
+func (mmReserveStock *mClientIfaceMockReserveStock) When(ctx context.Context, sku uint64, count uint64) *ClientIfaceMockReserveStockExpectation {
+	if mmReserveStock.mock.funcReserveStock != nil {
+		mmReserveStock.mock.t.Fatalf("ClientIfaceMock.ReserveStock mock is already set by Set")
+	}
+
+	expectation := &ClientIfaceMockReserveStockExpectation{
+		mock:               mmReserveStock.mock,
+		params:             &ClientIfaceMockReserveStockParams{ctx, sku, count},
+		expectationOrigins: ClientIfaceMockReserveStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReserveStock.expectations = append(mmReserveStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ClientIface.ReserveStock return parameters for the expectation previously defined by the When method
+func (e *ClientIfaceMockReserveStockExpectation) Then(err error) *ClientIfaceMock {
+	e.results = &ClientIfaceMockReserveStockResults{err}
+	return e.mock
+}
+
+// Times sets number of times ClientIface.ReserveStock should be invoked
+func (mmReserveStock *mClientIfaceMockReserveStock) Times(n uint64) *mClientIfaceMockReserveStock {
+	if n == 0 {
+		mmReserveStock.mock.t.Fatalf("Times of ClientIfaceMock.ReserveStock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReserveStock.expectedInvocations, n)
+	mmReserveStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReserveStock
+}
+
+func (mmReserveStock *mClientIfaceMockReserveStock) invocationsDone() bool {
+	if len(mmReserveStock.expectations) == 0 && mmReserveStock.defaultExpectation == nil && mmReserveStock.mock.funcReserveStock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReserveStock.mock.afterReserveStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReserveStock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReserveStock implements mm_service.ClientIface
+func (mmReserveStock *ClientIfaceMock) ReserveStock(ctx context.Context, sku uint64, count uint64) (err error) {
+	mm_atomic.AddUint64(&mmReserveStock.beforeReserveStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmReserveStock.afterReserveStockCounter, 1)
+
+	mmReserveStock.t.Helper()
+
+	if mmReserveStock.inspectFuncReserveStock != nil {
+		mmReserveStock.inspectFuncReserveStock(ctx, sku, count)
+	}
+
+	mm_params := ClientIfaceMockReserveStockParams{ctx, sku, count}
+
+	// Record call args
+	mmReserveStock.ReserveStockMock.mutex.Lock()
+	mmReserveStock.ReserveStockMock.callArgs = append(mmReserveStock.ReserveStockMock.callArgs, &mm_params)
+	mmReserveStock.ReserveStockMock.mutex.Unlock()
+
+	for _, e := range mmReserveStock.ReserveStockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReserveStock.ReserveStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReserveStock.ReserveStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmReserveStock.ReserveStockMock.defaultExpectation.params
+		mm_want_ptrs := mmReserveStock.ReserveStockMock.defaultExpectation.paramPtrs
+
+		mm_got := ClientIfaceMockReserveStockParams{ctx, sku, count}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReserveStock.t.Errorf("ClientIfaceMock.ReserveStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveStock.ReserveStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
+				mmReserveStock.t.Errorf("ClientIfaceMock.ReserveStock got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveStock.ReserveStockMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmReserveStock.t.Errorf("ClientIfaceMock.ReserveStock got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveStock.ReserveStockMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReserveStock.t.Errorf("ClientIfaceMock.ReserveStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReserveStock.ReserveStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReserveStock.ReserveStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReserveStock.t.Fatal("No results are set for the ClientIfaceMock.ReserveStock")
+		}
+		return (*mm_results).err
+	}
+	if mmReserveStock.funcReserveStock != nil {
+		return mmReserveStock.funcReserveStock(ctx, sku, count)
+	}
+	mmReserveStock.t.Fatalf("Unexpected call to ClientIfaceMock.ReserveStock. %v %v %v", ctx, sku, count)
+	return
+}
+
+// ReserveStockAfterCounter returns a count of finished ClientIfaceMock.ReserveStock invocations
+func (mmReserveStock *ClientIfaceMock) ReserveStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserveStock.afterReserveStockCounter)
+}
+
+// ReserveStockBeforeCounter returns a count of ClientIfaceMock.ReserveStock invocations
+func (mmReserveStock *ClientIfaceMock) ReserveStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserveStock.beforeReserveStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to ClientIfaceMock.ReserveStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReserveStock *mClientIfaceMockReserveStock) Calls() []*ClientIfaceMockReserveStockParams {
+	mmReserveStock.mutex.RLock()
+
+	argCopy := make([]*ClientIfaceMockReserveStockParams, len(mmReserveStock.callArgs))
+	copy(argCopy, mmReserveStock.callArgs)
+
+	mmReserveStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReserveStockDone returns true if the count of the ReserveStock invocations corresponds
+// the number of defined expectations
+func (m *ClientIfaceMock) MinimockReserveStockDone() bool {
+	if m.ReserveStockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReserveStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReserveStockMock.invocationsDone()
+}
+
+// MinimockReserveStockInspect logs each unmet expectation
+func (m *ClientIfaceMock) MinimockReserveStockInspect() {
+	for _, e := range m.ReserveStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ClientIfaceMock.ReserveStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReserveStockCounter := mm_atomic.LoadUint64(&m.afterReserveStockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReserveStockMock.defaultExpectation != nil && afterReserveStockCounter < 1 {
+		if m.ReserveStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ClientIfaceMock.ReserveStock at\n%s", m.ReserveStockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ClientIfaceMock.ReserveStock at\n%s with params: %#v", m.ReserveStockMock.defaultExpectation.expectationOrigins.origin, *m.ReserveStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReserveStock != nil && afterReserveStockCounter < 1 {
+		m.t.Errorf("Expected call to ClientIfaceMock.ReserveStock at\n%s", m.funcReserveStockOrigin)
+	}
+
+	if !m.ReserveStockMock.invocationsDone() && afterReserveStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to ClientIfaceMock.ReserveStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReserveStockMock.expectedInvocations), m.ReserveStockMock.expectedInvocationsOrigin, afterReserveStockCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ClientIfaceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCommitStockInspect()
+
+			m.MinimockGetProductInspect()
+
+			m.MinimockGetProductsInspect()
+
+			m.MinimockReleaseStockInspect()
+
+			m.MinimockReserveStockInspect()
 		}
 	})
 }
@@ -414,5 +1924,9 @@ func (m *ClientIfaceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ClientIfaceMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockGetProductDone()
+		m.MinimockCommitStockDone() &&
+		m.MinimockGetProductDone() &&
+		m.MinimockGetProductsDone() &&
+		m.MinimockReleaseStockDone() &&
+		m.MinimockReserveStockDone()
 }
@@ -8,10 +8,12 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/pkg/money"
 )
 
 // RepositoryIfaceMock implements mm_interfaces.RepositoryIface
@@ -19,33 +21,257 @@ type RepositoryIfaceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAddItem          func(ctx context.Context, userID uint64, skuID uint64, count uint64) (err error)
+	funcAbandonedCarts          func(ctx context.Context, idle time.Duration, limit int) (aa1 []postgres.AbandonedCart, err error)
+	funcAbandonedCartsOrigin    string
+	inspectFuncAbandonedCarts   func(ctx context.Context, idle time.Duration, limit int)
+	afterAbandonedCartsCounter  uint64
+	beforeAbandonedCartsCounter uint64
+	AbandonedCartsMock          mRepositoryIfaceMockAbandonedCarts
+
+	funcAddItem          func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) (err error)
 	funcAddItemOrigin    string
-	inspectFuncAddItem   func(ctx context.Context, userID uint64, skuID uint64, count uint64)
+	inspectFuncAddItem   func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64)
 	afterAddItemCounter  uint64
 	beforeAddItemCounter uint64
 	AddItemMock          mRepositoryIfaceMockAddItem
 
-	funcClearCart          func(ctx context.Context, userID uint64) (err error)
+	funcApplyPromo          func(ctx context.Context, userID uint64, code string, expected *uint64) (err error)
+	funcApplyPromoOrigin    string
+	inspectFuncApplyPromo   func(ctx context.Context, userID uint64, code string, expected *uint64)
+	afterApplyPromoCounter  uint64
+	beforeApplyPromoCounter uint64
+	ApplyPromoMock          mRepositoryIfaceMockApplyPromo
+
+	funcBulkUpdate          func(ctx context.Context, userID uint64, changes []postgres.CountChange, expected *uint64) (err error)
+	funcBulkUpdateOrigin    string
+	inspectFuncBulkUpdate   func(ctx context.Context, userID uint64, changes []postgres.CountChange, expected *uint64)
+	afterBulkUpdateCounter  uint64
+	beforeBulkUpdateCounter uint64
+	BulkUpdateMock          mRepositoryIfaceMockBulkUpdate
+
+	funcClaimIdempotencyKey          func(ctx context.Context, userID uint64, key string, fingerprint string, lease time.Duration) (ip1 *postgres.IdempotencyRecord, b1 bool, err error)
+	funcClaimIdempotencyKeyOrigin    string
+	inspectFuncClaimIdempotencyKey   func(ctx context.Context, userID uint64, key string, fingerprint string, lease time.Duration)
+	afterClaimIdempotencyKeyCounter  uint64
+	beforeClaimIdempotencyKeyCounter uint64
+	ClaimIdempotencyKeyMock          mRepositoryIfaceMockClaimIdempotencyKey
+
+	funcClearCart          func(ctx context.Context, userID uint64, expected *uint64) (pa1 []postgres.Position, err error)
 	funcClearCartOrigin    string
-	inspectFuncClearCart   func(ctx context.Context, userID uint64)
+	inspectFuncClearCart   func(ctx context.Context, userID uint64, expected *uint64)
 	afterClearCartCounter  uint64
 	beforeClearCartCounter uint64
 	ClearCartMock          mRepositoryIfaceMockClearCart
 
-	funcDeleteItem          func(ctx context.Context, userID uint64, skuID uint64) (err error)
+	funcCreateCartShare          func(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expiresAt time.Time) (u1 uint64, err error)
+	funcCreateCartShareOrigin    string
+	inspectFuncCreateCartShare   func(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expiresAt time.Time)
+	afterCreateCartShareCounter  uint64
+	beforeCreateCartShareCounter uint64
+	CreateCartShareMock          mRepositoryIfaceMockCreateCartShare
+
+	funcCreateGuestCart          func(ctx context.Context, tokenHash string) (u1 uint64, err error)
+	funcCreateGuestCartOrigin    string
+	inspectFuncCreateGuestCart   func(ctx context.Context, tokenHash string)
+	afterCreateGuestCartCounter  uint64
+	beforeCreateGuestCartCounter uint64
+	CreateGuestCartMock          mRepositoryIfaceMockCreateGuestCart
+
+	funcCreateOrder          func(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expected *uint64) (u1 uint64, err error)
+	funcCreateOrderOrigin    string
+	inspectFuncCreateOrder   func(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expected *uint64)
+	afterCreateOrderCounter  uint64
+	beforeCreateOrderCounter uint64
+	CreateOrderMock          mRepositoryIfaceMockCreateOrder
+
+	funcCreatePromo          func(ctx context.Context, p postgres.Promo) (pp1 *postgres.Promo, err error)
+	funcCreatePromoOrigin    string
+	inspectFuncCreatePromo   func(ctx context.Context, p postgres.Promo)
+	afterCreatePromoCounter  uint64
+	beforeCreatePromoCounter uint64
+	CreatePromoMock          mRepositoryIfaceMockCreatePromo
+
+	funcDecrementItem          func(ctx context.Context, userID uint64, skuID uint64, expected *uint64) (u1 uint64, err error)
+	funcDecrementItemOrigin    string
+	inspectFuncDecrementItem   func(ctx context.Context, userID uint64, skuID uint64, expected *uint64)
+	afterDecrementItemCounter  uint64
+	beforeDecrementItemCounter uint64
+	DecrementItemMock          mRepositoryIfaceMockDecrementItem
+
+	funcDeleteExpiredCartShares          func(ctx context.Context) (i1 int64, err error)
+	funcDeleteExpiredCartSharesOrigin    string
+	inspectFuncDeleteExpiredCartShares   func(ctx context.Context)
+	afterDeleteExpiredCartSharesCounter  uint64
+	beforeDeleteExpiredCartSharesCounter uint64
+	DeleteExpiredCartSharesMock          mRepositoryIfaceMockDeleteExpiredCartShares
+
+	funcDeleteExpiredIdempotencyKeys          func(ctx context.Context) (i1 int64, err error)
+	funcDeleteExpiredIdempotencyKeysOrigin    string
+	inspectFuncDeleteExpiredIdempotencyKeys   func(ctx context.Context)
+	afterDeleteExpiredIdempotencyKeysCounter  uint64
+	beforeDeleteExpiredIdempotencyKeysCounter uint64
+	DeleteExpiredIdempotencyKeysMock          mRepositoryIfaceMockDeleteExpiredIdempotencyKeys
+
+	funcDeleteItem          func(ctx context.Context, userID uint64, skuID uint64, expected *uint64) (u1 uint64, err error)
 	funcDeleteItemOrigin    string
-	inspectFuncDeleteItem   func(ctx context.Context, userID uint64, skuID uint64)
+	inspectFuncDeleteItem   func(ctx context.Context, userID uint64, skuID uint64, expected *uint64)
 	afterDeleteItemCounter  uint64
 	beforeDeleteItemCounter uint64
 	DeleteItemMock          mRepositoryIfaceMockDeleteItem
 
-	funcGetCart          func(ctx context.Context, userID uint64) (pa1 []postgres.Position, err error)
+	funcDisablePromo          func(ctx context.Context, code string) (err error)
+	funcDisablePromoOrigin    string
+	inspectFuncDisablePromo   func(ctx context.Context, code string)
+	afterDisablePromoCounter  uint64
+	beforeDisablePromoCounter uint64
+	DisablePromoMock          mRepositoryIfaceMockDisablePromo
+
+	funcExpireCarts          func(ctx context.Context, idle time.Duration, limit int) (ea1 []postgres.ExpiredCart, err error)
+	funcExpireCartsOrigin    string
+	inspectFuncExpireCarts   func(ctx context.Context, idle time.Duration, limit int)
+	afterExpireCartsCounter  uint64
+	beforeExpireCartsCounter uint64
+	ExpireCartsMock          mRepositoryIfaceMockExpireCarts
+
+	funcGetCart          func(ctx context.Context, userID uint64) (pa1 []postgres.Position, u1 uint64, err error)
 	funcGetCartOrigin    string
 	inspectFuncGetCart   func(ctx context.Context, userID uint64)
 	afterGetCartCounter  uint64
 	beforeGetCartCounter uint64
 	GetCartMock          mRepositoryIfaceMockGetCart
+
+	funcGetCartHistory          func(ctx context.Context, userID uint64, since time.Time, after uint64, limit int) (ha1 []postgres.HistoryEntry, err error)
+	funcGetCartHistoryOrigin    string
+	inspectFuncGetCartHistory   func(ctx context.Context, userID uint64, since time.Time, after uint64, limit int)
+	afterGetCartHistoryCounter  uint64
+	beforeGetCartHistoryCounter uint64
+	GetCartHistoryMock          mRepositoryIfaceMockGetCartHistory
+
+	funcGetCartPromo          func(ctx context.Context, userID uint64) (pp1 *postgres.Promo, err error)
+	funcGetCartPromoOrigin    string
+	inspectFuncGetCartPromo   func(ctx context.Context, userID uint64)
+	afterGetCartPromoCounter  uint64
+	beforeGetCartPromoCounter uint64
+	GetCartPromoMock          mRepositoryIfaceMockGetCartPromo
+
+	funcGetCartShare          func(ctx context.Context, id uint64) (cp1 *postgres.CartShare, err error)
+	funcGetCartShareOrigin    string
+	inspectFuncGetCartShare   func(ctx context.Context, id uint64)
+	afterGetCartShareCounter  uint64
+	beforeGetCartShareCounter uint64
+	GetCartShareMock          mRepositoryIfaceMockGetCartShare
+
+	funcGetOrder          func(ctx context.Context, userID uint64, orderID uint64) (op1 *postgres.Order, err error)
+	funcGetOrderOrigin    string
+	inspectFuncGetOrder   func(ctx context.Context, userID uint64, orderID uint64)
+	afterGetOrderCounter  uint64
+	beforeGetOrderCounter uint64
+	GetOrderMock          mRepositoryIfaceMockGetOrder
+
+	funcGuestCartID          func(ctx context.Context, tokenHash string) (u1 uint64, err error)
+	funcGuestCartIDOrigin    string
+	inspectFuncGuestCartID   func(ctx context.Context, tokenHash string)
+	afterGuestCartIDCounter  uint64
+	beforeGuestCartIDCounter uint64
+	GuestCartIDMock          mRepositoryIfaceMockGuestCartID
+
+	funcImportItems          func(ctx context.Context, userID uint64, shareID uint64, items []postgres.Position, expected *uint64) (err error)
+	funcImportItemsOrigin    string
+	inspectFuncImportItems   func(ctx context.Context, userID uint64, shareID uint64, items []postgres.Position, expected *uint64)
+	afterImportItemsCounter  uint64
+	beforeImportItemsCounter uint64
+	ImportItemsMock          mRepositoryIfaceMockImportItems
+
+	funcListOrders          func(ctx context.Context, userID uint64) (oa1 []postgres.Order, err error)
+	funcListOrdersOrigin    string
+	inspectFuncListOrders   func(ctx context.Context, userID uint64)
+	afterListOrdersCounter  uint64
+	beforeListOrdersCounter uint64
+	ListOrdersMock          mRepositoryIfaceMockListOrders
+
+	funcListPromos          func(ctx context.Context) (pa1 []postgres.Promo, err error)
+	funcListPromosOrigin    string
+	inspectFuncListPromos   func(ctx context.Context)
+	afterListPromosCounter  uint64
+	beforeListPromosCounter uint64
+	ListPromosMock          mRepositoryIfaceMockListPromos
+
+	funcListSaved          func(ctx context.Context, userID uint64) (sa1 []postgres.SavedItem, err error)
+	funcListSavedOrigin    string
+	inspectFuncListSaved   func(ctx context.Context, userID uint64)
+	afterListSavedCounter  uint64
+	beforeListSavedCounter uint64
+	ListSavedMock          mRepositoryIfaceMockListSaved
+
+	funcMarkAbandonedNotified          func(ctx context.Context, userID uint64, updatedAt time.Time) (err error)
+	funcMarkAbandonedNotifiedOrigin    string
+	inspectFuncMarkAbandonedNotified   func(ctx context.Context, userID uint64, updatedAt time.Time)
+	afterMarkAbandonedNotifiedCounter  uint64
+	beforeMarkAbandonedNotifiedCounter uint64
+	MarkAbandonedNotifiedMock          mRepositoryIfaceMockMarkAbandonedNotified
+
+	funcMergeCarts          func(ctx context.Context, guestID uint64, userID uint64, plan func(user, guest []postgres.Position) (map[uint64]uint64, error)) (pa1 []postgres.Position, err error)
+	funcMergeCartsOrigin    string
+	inspectFuncMergeCarts   func(ctx context.Context, guestID uint64, userID uint64, plan func(user, guest []postgres.Position) (map[uint64]uint64, error))
+	afterMergeCartsCounter  uint64
+	beforeMergeCartsCounter uint64
+	MergeCartsMock          mRepositoryIfaceMockMergeCarts
+
+	funcMoveToCart          func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) (err error)
+	funcMoveToCartOrigin    string
+	inspectFuncMoveToCart   func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64)
+	afterMoveToCartCounter  uint64
+	beforeMoveToCartCounter uint64
+	MoveToCartMock          mRepositoryIfaceMockMoveToCart
+
+	funcMoveToSaved          func(ctx context.Context, userID uint64, skuID uint64, expected *uint64) (u1 uint64, err error)
+	funcMoveToSavedOrigin    string
+	inspectFuncMoveToSaved   func(ctx context.Context, userID uint64, skuID uint64, expected *uint64)
+	afterMoveToSavedCounter  uint64
+	beforeMoveToSavedCounter uint64
+	MoveToSavedMock          mRepositoryIfaceMockMoveToSaved
+
+	funcReleaseIdempotencyKey          func(ctx context.Context, userID uint64, key string) (err error)
+	funcReleaseIdempotencyKeyOrigin    string
+	inspectFuncReleaseIdempotencyKey   func(ctx context.Context, userID uint64, key string)
+	afterReleaseIdempotencyKeyCounter  uint64
+	beforeReleaseIdempotencyKeyCounter uint64
+	ReleaseIdempotencyKeyMock          mRepositoryIfaceMockReleaseIdempotencyKey
+
+	funcRemoveDelisted          func(ctx context.Context, userID uint64, skus []uint64) (pa1 []postgres.Position, u1 uint64, err error)
+	funcRemoveDelistedOrigin    string
+	inspectFuncRemoveDelisted   func(ctx context.Context, userID uint64, skus []uint64)
+	afterRemoveDelistedCounter  uint64
+	beforeRemoveDelistedCounter uint64
+	RemoveDelistedMock          mRepositoryIfaceMockRemoveDelisted
+
+	funcRemovePromo          func(ctx context.Context, userID uint64, expected *uint64) (err error)
+	funcRemovePromoOrigin    string
+	inspectFuncRemovePromo   func(ctx context.Context, userID uint64, expected *uint64)
+	afterRemovePromoCounter  uint64
+	beforeRemovePromoCounter uint64
+	RemovePromoMock          mRepositoryIfaceMockRemovePromo
+
+	funcSaveIdempotentResponse          func(ctx context.Context, userID uint64, key string, response []byte, ttl time.Duration) (err error)
+	funcSaveIdempotentResponseOrigin    string
+	inspectFuncSaveIdempotentResponse   func(ctx context.Context, userID uint64, key string, response []byte, ttl time.Duration)
+	afterSaveIdempotentResponseCounter  uint64
+	beforeSaveIdempotentResponseCounter uint64
+	SaveIdempotentResponseMock          mRepositoryIfaceMockSaveIdempotentResponse
+
+	funcUpdateItemCount          func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) (u1 uint64, err error)
+	funcUpdateItemCountOrigin    string
+	inspectFuncUpdateItemCount   func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64)
+	afterUpdateItemCountCounter  uint64
+	beforeUpdateItemCountCounter uint64
+	UpdateItemCountMock          mRepositoryIfaceMockUpdateItemCount
+
+	funcWithSweepLock          func(ctx context.Context, fn func() error) (b1 bool, err error)
+	funcWithSweepLockOrigin    string
+	inspectFuncWithSweepLock   func(ctx context.Context, fn func() error)
+	afterWithSweepLockCounter  uint64
+	beforeWithSweepLockCounter uint64
+	WithSweepLockMock          mRepositoryIfaceMockWithSweepLock
 }
 
 // NewRepositoryIfaceMock returns a mock for mm_interfaces.RepositoryIface
@@ -56,75 +282,169 @@ func NewRepositoryIfaceMock(t minimock.Tester) *RepositoryIfaceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AbandonedCartsMock = mRepositoryIfaceMockAbandonedCarts{mock: m}
+	m.AbandonedCartsMock.callArgs = []*RepositoryIfaceMockAbandonedCartsParams{}
+
 	m.AddItemMock = mRepositoryIfaceMockAddItem{mock: m}
 	m.AddItemMock.callArgs = []*RepositoryIfaceMockAddItemParams{}
 
+	m.ApplyPromoMock = mRepositoryIfaceMockApplyPromo{mock: m}
+	m.ApplyPromoMock.callArgs = []*RepositoryIfaceMockApplyPromoParams{}
+
+	m.BulkUpdateMock = mRepositoryIfaceMockBulkUpdate{mock: m}
+	m.BulkUpdateMock.callArgs = []*RepositoryIfaceMockBulkUpdateParams{}
+
+	m.ClaimIdempotencyKeyMock = mRepositoryIfaceMockClaimIdempotencyKey{mock: m}
+	m.ClaimIdempotencyKeyMock.callArgs = []*RepositoryIfaceMockClaimIdempotencyKeyParams{}
+
 	m.ClearCartMock = mRepositoryIfaceMockClearCart{mock: m}
 	m.ClearCartMock.callArgs = []*RepositoryIfaceMockClearCartParams{}
 
+	m.CreateCartShareMock = mRepositoryIfaceMockCreateCartShare{mock: m}
+	m.CreateCartShareMock.callArgs = []*RepositoryIfaceMockCreateCartShareParams{}
+
+	m.CreateGuestCartMock = mRepositoryIfaceMockCreateGuestCart{mock: m}
+	m.CreateGuestCartMock.callArgs = []*RepositoryIfaceMockCreateGuestCartParams{}
+
+	m.CreateOrderMock = mRepositoryIfaceMockCreateOrder{mock: m}
+	m.CreateOrderMock.callArgs = []*RepositoryIfaceMockCreateOrderParams{}
+
+	m.CreatePromoMock = mRepositoryIfaceMockCreatePromo{mock: m}
+	m.CreatePromoMock.callArgs = []*RepositoryIfaceMockCreatePromoParams{}
+
+	m.DecrementItemMock = mRepositoryIfaceMockDecrementItem{mock: m}
+	m.DecrementItemMock.callArgs = []*RepositoryIfaceMockDecrementItemParams{}
+
+	m.DeleteExpiredCartSharesMock = mRepositoryIfaceMockDeleteExpiredCartShares{mock: m}
+	m.DeleteExpiredCartSharesMock.callArgs = []*RepositoryIfaceMockDeleteExpiredCartSharesParams{}
+
+	m.DeleteExpiredIdempotencyKeysMock = mRepositoryIfaceMockDeleteExpiredIdempotencyKeys{mock: m}
+	m.DeleteExpiredIdempotencyKeysMock.callArgs = []*RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams{}
+
 	m.DeleteItemMock = mRepositoryIfaceMockDeleteItem{mock: m}
 	m.DeleteItemMock.callArgs = []*RepositoryIfaceMockDeleteItemParams{}
 
+	m.DisablePromoMock = mRepositoryIfaceMockDisablePromo{mock: m}
+	m.DisablePromoMock.callArgs = []*RepositoryIfaceMockDisablePromoParams{}
+
+	m.ExpireCartsMock = mRepositoryIfaceMockExpireCarts{mock: m}
+	m.ExpireCartsMock.callArgs = []*RepositoryIfaceMockExpireCartsParams{}
+
 	m.GetCartMock = mRepositoryIfaceMockGetCart{mock: m}
 	m.GetCartMock.callArgs = []*RepositoryIfaceMockGetCartParams{}
 
+	m.GetCartHistoryMock = mRepositoryIfaceMockGetCartHistory{mock: m}
+	m.GetCartHistoryMock.callArgs = []*RepositoryIfaceMockGetCartHistoryParams{}
+
+	m.GetCartPromoMock = mRepositoryIfaceMockGetCartPromo{mock: m}
+	m.GetCartPromoMock.callArgs = []*RepositoryIfaceMockGetCartPromoParams{}
+
+	m.GetCartShareMock = mRepositoryIfaceMockGetCartShare{mock: m}
+	m.GetCartShareMock.callArgs = []*RepositoryIfaceMockGetCartShareParams{}
+
+	m.GetOrderMock = mRepositoryIfaceMockGetOrder{mock: m}
+	m.GetOrderMock.callArgs = []*RepositoryIfaceMockGetOrderParams{}
+
+	m.GuestCartIDMock = mRepositoryIfaceMockGuestCartID{mock: m}
+	m.GuestCartIDMock.callArgs = []*RepositoryIfaceMockGuestCartIDParams{}
+
+	m.ImportItemsMock = mRepositoryIfaceMockImportItems{mock: m}
+	m.ImportItemsMock.callArgs = []*RepositoryIfaceMockImportItemsParams{}
+
+	m.ListOrdersMock = mRepositoryIfaceMockListOrders{mock: m}
+	m.ListOrdersMock.callArgs = []*RepositoryIfaceMockListOrdersParams{}
+
+	m.ListPromosMock = mRepositoryIfaceMockListPromos{mock: m}
+	m.ListPromosMock.callArgs = []*RepositoryIfaceMockListPromosParams{}
+
+	m.ListSavedMock = mRepositoryIfaceMockListSaved{mock: m}
+	m.ListSavedMock.callArgs = []*RepositoryIfaceMockListSavedParams{}
+
+	m.MarkAbandonedNotifiedMock = mRepositoryIfaceMockMarkAbandonedNotified{mock: m}
+	m.MarkAbandonedNotifiedMock.callArgs = []*RepositoryIfaceMockMarkAbandonedNotifiedParams{}
+
+	m.MergeCartsMock = mRepositoryIfaceMockMergeCarts{mock: m}
+	m.MergeCartsMock.callArgs = []*RepositoryIfaceMockMergeCartsParams{}
+
+	m.MoveToCartMock = mRepositoryIfaceMockMoveToCart{mock: m}
+	m.MoveToCartMock.callArgs = []*RepositoryIfaceMockMoveToCartParams{}
+
+	m.MoveToSavedMock = mRepositoryIfaceMockMoveToSaved{mock: m}
+	m.MoveToSavedMock.callArgs = []*RepositoryIfaceMockMoveToSavedParams{}
+
+	m.ReleaseIdempotencyKeyMock = mRepositoryIfaceMockReleaseIdempotencyKey{mock: m}
+	m.ReleaseIdempotencyKeyMock.callArgs = []*RepositoryIfaceMockReleaseIdempotencyKeyParams{}
+
+	m.RemoveDelistedMock = mRepositoryIfaceMockRemoveDelisted{mock: m}
+	m.RemoveDelistedMock.callArgs = []*RepositoryIfaceMockRemoveDelistedParams{}
+
+	m.RemovePromoMock = mRepositoryIfaceMockRemovePromo{mock: m}
+	m.RemovePromoMock.callArgs = []*RepositoryIfaceMockRemovePromoParams{}
+
+	m.SaveIdempotentResponseMock = mRepositoryIfaceMockSaveIdempotentResponse{mock: m}
+	m.SaveIdempotentResponseMock.callArgs = []*RepositoryIfaceMockSaveIdempotentResponseParams{}
+
+	m.UpdateItemCountMock = mRepositoryIfaceMockUpdateItemCount{mock: m}
+	m.UpdateItemCountMock.callArgs = []*RepositoryIfaceMockUpdateItemCountParams{}
+
+	m.WithSweepLockMock = mRepositoryIfaceMockWithSweepLock{mock: m}
+	m.WithSweepLockMock.callArgs = []*RepositoryIfaceMockWithSweepLockParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mRepositoryIfaceMockAddItem struct {
+type mRepositoryIfaceMockAbandonedCarts struct {
 	optional           bool
 	mock               *RepositoryIfaceMock
-	defaultExpectation *RepositoryIfaceMockAddItemExpectation
-	expectations       []*RepositoryIfaceMockAddItemExpectation
+	defaultExpectation *RepositoryIfaceMockAbandonedCartsExpectation
+	expectations       []*RepositoryIfaceMockAbandonedCartsExpectation
 
-	callArgs []*RepositoryIfaceMockAddItemParams
+	callArgs []*RepositoryIfaceMockAbandonedCartsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryIfaceMockAddItemExpectation specifies expectation struct of the RepositoryIface.AddItem
-type RepositoryIfaceMockAddItemExpectation struct {
+// RepositoryIfaceMockAbandonedCartsExpectation specifies expectation struct of the RepositoryIface.AbandonedCarts
+type RepositoryIfaceMockAbandonedCartsExpectation struct {
 	mock               *RepositoryIfaceMock
-	params             *RepositoryIfaceMockAddItemParams
-	paramPtrs          *RepositoryIfaceMockAddItemParamPtrs
-	expectationOrigins RepositoryIfaceMockAddItemExpectationOrigins
-	results            *RepositoryIfaceMockAddItemResults
+	params             *RepositoryIfaceMockAbandonedCartsParams
+	paramPtrs          *RepositoryIfaceMockAbandonedCartsParamPtrs
+	expectationOrigins RepositoryIfaceMockAbandonedCartsExpectationOrigins
+	results            *RepositoryIfaceMockAbandonedCartsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryIfaceMockAddItemParams contains parameters of the RepositoryIface.AddItem
-type RepositoryIfaceMockAddItemParams struct {
-	ctx    context.Context
-	userID uint64
-	skuID  uint64
-	count  uint64
+// RepositoryIfaceMockAbandonedCartsParams contains parameters of the RepositoryIface.AbandonedCarts
+type RepositoryIfaceMockAbandonedCartsParams struct {
+	ctx   context.Context
+	idle  time.Duration
+	limit int
 }
 
-// RepositoryIfaceMockAddItemParamPtrs contains pointers to parameters of the RepositoryIface.AddItem
-type RepositoryIfaceMockAddItemParamPtrs struct {
-	ctx    *context.Context
-	userID *uint64
-	skuID  *uint64
-	count  *uint64
+// RepositoryIfaceMockAbandonedCartsParamPtrs contains pointers to parameters of the RepositoryIface.AbandonedCarts
+type RepositoryIfaceMockAbandonedCartsParamPtrs struct {
+	ctx   *context.Context
+	idle  *time.Duration
+	limit *int
 }
 
-// RepositoryIfaceMockAddItemResults contains results of the RepositoryIface.AddItem
-type RepositoryIfaceMockAddItemResults struct {
+// RepositoryIfaceMockAbandonedCartsResults contains results of the RepositoryIface.AbandonedCarts
+type RepositoryIfaceMockAbandonedCartsResults struct {
+	aa1 []postgres.AbandonedCart
 	err error
 }
 
-// RepositoryIfaceMockAddItemOrigins contains origins of expectations of the RepositoryIface.AddItem
-type RepositoryIfaceMockAddItemExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originSkuID  string
-	originCount  string
+// RepositoryIfaceMockAbandonedCartsOrigins contains origins of expectations of the RepositoryIface.AbandonedCarts
+type RepositoryIfaceMockAbandonedCartsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originIdle  string
+	originLimit string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -132,397 +452,12557 @@ type RepositoryIfaceMockAddItemExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmAddItem *mRepositoryIfaceMockAddItem) Optional() *mRepositoryIfaceMockAddItem {
-	mmAddItem.optional = true
-	return mmAddItem
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) Optional() *mRepositoryIfaceMockAbandonedCarts {
+	mmAbandonedCarts.optional = true
+	return mmAbandonedCarts
 }
 
-// Expect sets up expected params for RepositoryIface.AddItem
-func (mmAddItem *mRepositoryIfaceMockAddItem) Expect(ctx context.Context, userID uint64, skuID uint64, count uint64) *mRepositoryIfaceMockAddItem {
-	if mmAddItem.mock.funcAddItem != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+// Expect sets up expected params for RepositoryIface.AbandonedCarts
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) Expect(ctx context.Context, idle time.Duration, limit int) *mRepositoryIfaceMockAbandonedCarts {
+	if mmAbandonedCarts.mock.funcAbandonedCarts != nil {
+		mmAbandonedCarts.mock.t.Fatalf("RepositoryIfaceMock.AbandonedCarts mock is already set by Set")
 	}
 
-	if mmAddItem.defaultExpectation == nil {
-		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	if mmAbandonedCarts.defaultExpectation == nil {
+		mmAbandonedCarts.defaultExpectation = &RepositoryIfaceMockAbandonedCartsExpectation{}
 	}
 
-	if mmAddItem.defaultExpectation.paramPtrs != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by ExpectParams functions")
+	if mmAbandonedCarts.defaultExpectation.paramPtrs != nil {
+		mmAbandonedCarts.mock.t.Fatalf("RepositoryIfaceMock.AbandonedCarts mock is already set by ExpectParams functions")
 	}
 
-	mmAddItem.defaultExpectation.params = &RepositoryIfaceMockAddItemParams{ctx, userID, skuID, count}
-	mmAddItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmAddItem.expectations {
-		if minimock.Equal(e.params, mmAddItem.defaultExpectation.params) {
-			mmAddItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddItem.defaultExpectation.params)
+	mmAbandonedCarts.defaultExpectation.params = &RepositoryIfaceMockAbandonedCartsParams{ctx, idle, limit}
+	mmAbandonedCarts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAbandonedCarts.expectations {
+		if minimock.Equal(e.params, mmAbandonedCarts.defaultExpectation.params) {
+			mmAbandonedCarts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAbandonedCarts.defaultExpectation.params)
 		}
 	}
 
-	return mmAddItem
-}
-
-// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.AddItem
-func (mmAddItem *mRepositoryIfaceMockAddItem) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockAddItem {
-	if mmAddItem.mock.funcAddItem != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
-	}
-
-	if mmAddItem.defaultExpectation == nil {
-		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
-	}
-
-	if mmAddItem.defaultExpectation.params != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Expect")
-	}
-
-	if mmAddItem.defaultExpectation.paramPtrs == nil {
-		mmAddItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockAddItemParamPtrs{}
-	}
-	mmAddItem.defaultExpectation.paramPtrs.ctx = &ctx
-	mmAddItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmAddItem
+	return mmAbandonedCarts
 }
 
-// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.AddItem
-func (mmAddItem *mRepositoryIfaceMockAddItem) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockAddItem {
-	if mmAddItem.mock.funcAddItem != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.AbandonedCarts
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockAbandonedCarts {
+	if mmAbandonedCarts.mock.funcAbandonedCarts != nil {
+		mmAbandonedCarts.mock.t.Fatalf("RepositoryIfaceMock.AbandonedCarts mock is already set by Set")
 	}
 
-	if mmAddItem.defaultExpectation == nil {
-		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	if mmAbandonedCarts.defaultExpectation == nil {
+		mmAbandonedCarts.defaultExpectation = &RepositoryIfaceMockAbandonedCartsExpectation{}
 	}
 
-	if mmAddItem.defaultExpectation.params != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Expect")
+	if mmAbandonedCarts.defaultExpectation.params != nil {
+		mmAbandonedCarts.mock.t.Fatalf("RepositoryIfaceMock.AbandonedCarts mock is already set by Expect")
 	}
 
-	if mmAddItem.defaultExpectation.paramPtrs == nil {
-		mmAddItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockAddItemParamPtrs{}
+	if mmAbandonedCarts.defaultExpectation.paramPtrs == nil {
+		mmAbandonedCarts.defaultExpectation.paramPtrs = &RepositoryIfaceMockAbandonedCartsParamPtrs{}
 	}
-	mmAddItem.defaultExpectation.paramPtrs.userID = &userID
-	mmAddItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmAbandonedCarts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAbandonedCarts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmAddItem
+	return mmAbandonedCarts
 }
 
-// ExpectSkuIDParam3 sets up expected param skuID for RepositoryIface.AddItem
-func (mmAddItem *mRepositoryIfaceMockAddItem) ExpectSkuIDParam3(skuID uint64) *mRepositoryIfaceMockAddItem {
-	if mmAddItem.mock.funcAddItem != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+// ExpectIdleParam2 sets up expected param idle for RepositoryIface.AbandonedCarts
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) ExpectIdleParam2(idle time.Duration) *mRepositoryIfaceMockAbandonedCarts {
+	if mmAbandonedCarts.mock.funcAbandonedCarts != nil {
+		mmAbandonedCarts.mock.t.Fatalf("RepositoryIfaceMock.AbandonedCarts mock is already set by Set")
 	}
 
-	if mmAddItem.defaultExpectation == nil {
-		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	if mmAbandonedCarts.defaultExpectation == nil {
+		mmAbandonedCarts.defaultExpectation = &RepositoryIfaceMockAbandonedCartsExpectation{}
 	}
 
-	if mmAddItem.defaultExpectation.params != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Expect")
+	if mmAbandonedCarts.defaultExpectation.params != nil {
+		mmAbandonedCarts.mock.t.Fatalf("RepositoryIfaceMock.AbandonedCarts mock is already set by Expect")
 	}
 
-	if mmAddItem.defaultExpectation.paramPtrs == nil {
-		mmAddItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockAddItemParamPtrs{}
+	if mmAbandonedCarts.defaultExpectation.paramPtrs == nil {
+		mmAbandonedCarts.defaultExpectation.paramPtrs = &RepositoryIfaceMockAbandonedCartsParamPtrs{}
 	}
-	mmAddItem.defaultExpectation.paramPtrs.skuID = &skuID
-	mmAddItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmAbandonedCarts.defaultExpectation.paramPtrs.idle = &idle
+	mmAbandonedCarts.defaultExpectation.expectationOrigins.originIdle = minimock.CallerInfo(1)
 
-	return mmAddItem
+	return mmAbandonedCarts
 }
 
-// ExpectCountParam4 sets up expected param count for RepositoryIface.AddItem
-func (mmAddItem *mRepositoryIfaceMockAddItem) ExpectCountParam4(count uint64) *mRepositoryIfaceMockAddItem {
-	if mmAddItem.mock.funcAddItem != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+// ExpectLimitParam3 sets up expected param limit for RepositoryIface.AbandonedCarts
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) ExpectLimitParam3(limit int) *mRepositoryIfaceMockAbandonedCarts {
+	if mmAbandonedCarts.mock.funcAbandonedCarts != nil {
+		mmAbandonedCarts.mock.t.Fatalf("RepositoryIfaceMock.AbandonedCarts mock is already set by Set")
 	}
 
-	if mmAddItem.defaultExpectation == nil {
-		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	if mmAbandonedCarts.defaultExpectation == nil {
+		mmAbandonedCarts.defaultExpectation = &RepositoryIfaceMockAbandonedCartsExpectation{}
 	}
 
-	if mmAddItem.defaultExpectation.params != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Expect")
+	if mmAbandonedCarts.defaultExpectation.params != nil {
+		mmAbandonedCarts.mock.t.Fatalf("RepositoryIfaceMock.AbandonedCarts mock is already set by Expect")
 	}
 
-	if mmAddItem.defaultExpectation.paramPtrs == nil {
-		mmAddItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockAddItemParamPtrs{}
+	if mmAbandonedCarts.defaultExpectation.paramPtrs == nil {
+		mmAbandonedCarts.defaultExpectation.paramPtrs = &RepositoryIfaceMockAbandonedCartsParamPtrs{}
 	}
-	mmAddItem.defaultExpectation.paramPtrs.count = &count
-	mmAddItem.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+	mmAbandonedCarts.defaultExpectation.paramPtrs.limit = &limit
+	mmAbandonedCarts.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
 
-	return mmAddItem
+	return mmAbandonedCarts
 }
 
-// Inspect accepts an inspector function that has same arguments as the RepositoryIface.AddItem
-func (mmAddItem *mRepositoryIfaceMockAddItem) Inspect(f func(ctx context.Context, userID uint64, skuID uint64, count uint64)) *mRepositoryIfaceMockAddItem {
-	if mmAddItem.mock.inspectFuncAddItem != nil {
-		mmAddItem.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.AddItem")
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.AbandonedCarts
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) Inspect(f func(ctx context.Context, idle time.Duration, limit int)) *mRepositoryIfaceMockAbandonedCarts {
+	if mmAbandonedCarts.mock.inspectFuncAbandonedCarts != nil {
+		mmAbandonedCarts.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.AbandonedCarts")
 	}
 
-	mmAddItem.mock.inspectFuncAddItem = f
+	mmAbandonedCarts.mock.inspectFuncAbandonedCarts = f
 
-	return mmAddItem
+	return mmAbandonedCarts
 }
 
-// Return sets up results that will be returned by RepositoryIface.AddItem
-func (mmAddItem *mRepositoryIfaceMockAddItem) Return(err error) *RepositoryIfaceMock {
-	if mmAddItem.mock.funcAddItem != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+// Return sets up results that will be returned by RepositoryIface.AbandonedCarts
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) Return(aa1 []postgres.AbandonedCart, err error) *RepositoryIfaceMock {
+	if mmAbandonedCarts.mock.funcAbandonedCarts != nil {
+		mmAbandonedCarts.mock.t.Fatalf("RepositoryIfaceMock.AbandonedCarts mock is already set by Set")
 	}
 
-	if mmAddItem.defaultExpectation == nil {
-		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{mock: mmAddItem.mock}
+	if mmAbandonedCarts.defaultExpectation == nil {
+		mmAbandonedCarts.defaultExpectation = &RepositoryIfaceMockAbandonedCartsExpectation{mock: mmAbandonedCarts.mock}
 	}
-	mmAddItem.defaultExpectation.results = &RepositoryIfaceMockAddItemResults{err}
-	mmAddItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmAddItem.mock
+	mmAbandonedCarts.defaultExpectation.results = &RepositoryIfaceMockAbandonedCartsResults{aa1, err}
+	mmAbandonedCarts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAbandonedCarts.mock
 }
 
-// Set uses given function f to mock the RepositoryIface.AddItem method
-func (mmAddItem *mRepositoryIfaceMockAddItem) Set(f func(ctx context.Context, userID uint64, skuID uint64, count uint64) (err error)) *RepositoryIfaceMock {
-	if mmAddItem.defaultExpectation != nil {
-		mmAddItem.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.AddItem method")
+// Set uses given function f to mock the RepositoryIface.AbandonedCarts method
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) Set(f func(ctx context.Context, idle time.Duration, limit int) (aa1 []postgres.AbandonedCart, err error)) *RepositoryIfaceMock {
+	if mmAbandonedCarts.defaultExpectation != nil {
+		mmAbandonedCarts.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.AbandonedCarts method")
 	}
 
-	if len(mmAddItem.expectations) > 0 {
-		mmAddItem.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.AddItem method")
+	if len(mmAbandonedCarts.expectations) > 0 {
+		mmAbandonedCarts.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.AbandonedCarts method")
 	}
 
-	mmAddItem.mock.funcAddItem = f
-	mmAddItem.mock.funcAddItemOrigin = minimock.CallerInfo(1)
-	return mmAddItem.mock
+	mmAbandonedCarts.mock.funcAbandonedCarts = f
+	mmAbandonedCarts.mock.funcAbandonedCartsOrigin = minimock.CallerInfo(1)
+	return mmAbandonedCarts.mock
 }
 
-// When sets expectation for the RepositoryIface.AddItem which will trigger the result defined by the following
+// When sets expectation for the RepositoryIface.AbandonedCarts which will trigger the result defined by the following
 // Then helper
-func (mmAddItem *mRepositoryIfaceMockAddItem) When(ctx context.Context, userID uint64, skuID uint64, count uint64) *RepositoryIfaceMockAddItemExpectation {
-	if mmAddItem.mock.funcAddItem != nil {
-		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) When(ctx context.Context, idle time.Duration, limit int) *RepositoryIfaceMockAbandonedCartsExpectation {
+	if mmAbandonedCarts.mock.funcAbandonedCarts != nil {
+		mmAbandonedCarts.mock.t.Fatalf("RepositoryIfaceMock.AbandonedCarts mock is already set by Set")
 	}
 
-	expectation := &RepositoryIfaceMockAddItemExpectation{
-		mock:               mmAddItem.mock,
-		params:             &RepositoryIfaceMockAddItemParams{ctx, userID, skuID, count},
-		expectationOrigins: RepositoryIfaceMockAddItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryIfaceMockAbandonedCartsExpectation{
+		mock:               mmAbandonedCarts.mock,
+		params:             &RepositoryIfaceMockAbandonedCartsParams{ctx, idle, limit},
+		expectationOrigins: RepositoryIfaceMockAbandonedCartsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmAddItem.expectations = append(mmAddItem.expectations, expectation)
+	mmAbandonedCarts.expectations = append(mmAbandonedCarts.expectations, expectation)
 	return expectation
 }
 
-// Then sets up RepositoryIface.AddItem return parameters for the expectation previously defined by the When method
-func (e *RepositoryIfaceMockAddItemExpectation) Then(err error) *RepositoryIfaceMock {
-	e.results = &RepositoryIfaceMockAddItemResults{err}
+// Then sets up RepositoryIface.AbandonedCarts return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockAbandonedCartsExpectation) Then(aa1 []postgres.AbandonedCart, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockAbandonedCartsResults{aa1, err}
 	return e.mock
 }
 
-// Times sets number of times RepositoryIface.AddItem should be invoked
-func (mmAddItem *mRepositoryIfaceMockAddItem) Times(n uint64) *mRepositoryIfaceMockAddItem {
+// Times sets number of times RepositoryIface.AbandonedCarts should be invoked
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) Times(n uint64) *mRepositoryIfaceMockAbandonedCarts {
 	if n == 0 {
-		mmAddItem.mock.t.Fatalf("Times of RepositoryIfaceMock.AddItem mock can not be zero")
+		mmAbandonedCarts.mock.t.Fatalf("Times of RepositoryIfaceMock.AbandonedCarts mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmAddItem.expectedInvocations, n)
-	mmAddItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmAddItem
+	mm_atomic.StoreUint64(&mmAbandonedCarts.expectedInvocations, n)
+	mmAbandonedCarts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAbandonedCarts
 }
 
-func (mmAddItem *mRepositoryIfaceMockAddItem) invocationsDone() bool {
-	if len(mmAddItem.expectations) == 0 && mmAddItem.defaultExpectation == nil && mmAddItem.mock.funcAddItem == nil {
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) invocationsDone() bool {
+	if len(mmAbandonedCarts.expectations) == 0 && mmAbandonedCarts.defaultExpectation == nil && mmAbandonedCarts.mock.funcAbandonedCarts == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmAddItem.mock.afterAddItemCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmAddItem.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAbandonedCarts.mock.afterAbandonedCartsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAbandonedCarts.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// AddItem implements mm_interfaces.RepositoryIface
-func (mmAddItem *RepositoryIfaceMock) AddItem(ctx context.Context, userID uint64, skuID uint64, count uint64) (err error) {
-	mm_atomic.AddUint64(&mmAddItem.beforeAddItemCounter, 1)
-	defer mm_atomic.AddUint64(&mmAddItem.afterAddItemCounter, 1)
+// AbandonedCarts implements mm_interfaces.RepositoryIface
+func (mmAbandonedCarts *RepositoryIfaceMock) AbandonedCarts(ctx context.Context, idle time.Duration, limit int) (aa1 []postgres.AbandonedCart, err error) {
+	mm_atomic.AddUint64(&mmAbandonedCarts.beforeAbandonedCartsCounter, 1)
+	defer mm_atomic.AddUint64(&mmAbandonedCarts.afterAbandonedCartsCounter, 1)
 
-	mmAddItem.t.Helper()
+	mmAbandonedCarts.t.Helper()
 
-	if mmAddItem.inspectFuncAddItem != nil {
-		mmAddItem.inspectFuncAddItem(ctx, userID, skuID, count)
+	if mmAbandonedCarts.inspectFuncAbandonedCarts != nil {
+		mmAbandonedCarts.inspectFuncAbandonedCarts(ctx, idle, limit)
 	}
 
-	mm_params := RepositoryIfaceMockAddItemParams{ctx, userID, skuID, count}
+	mm_params := RepositoryIfaceMockAbandonedCartsParams{ctx, idle, limit}
 
 	// Record call args
-	mmAddItem.AddItemMock.mutex.Lock()
-	mmAddItem.AddItemMock.callArgs = append(mmAddItem.AddItemMock.callArgs, &mm_params)
-	mmAddItem.AddItemMock.mutex.Unlock()
+	mmAbandonedCarts.AbandonedCartsMock.mutex.Lock()
+	mmAbandonedCarts.AbandonedCartsMock.callArgs = append(mmAbandonedCarts.AbandonedCartsMock.callArgs, &mm_params)
+	mmAbandonedCarts.AbandonedCartsMock.mutex.Unlock()
 
-	for _, e := range mmAddItem.AddItemMock.expectations {
+	for _, e := range mmAbandonedCarts.AbandonedCartsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.aa1, e.results.err
 		}
 	}
 
-	if mmAddItem.AddItemMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmAddItem.AddItemMock.defaultExpectation.Counter, 1)
-		mm_want := mmAddItem.AddItemMock.defaultExpectation.params
-		mm_want_ptrs := mmAddItem.AddItemMock.defaultExpectation.paramPtrs
+	if mmAbandonedCarts.AbandonedCartsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAbandonedCarts.AbandonedCartsMock.defaultExpectation.Counter, 1)
+		mm_want := mmAbandonedCarts.AbandonedCartsMock.defaultExpectation.params
+		mm_want_ptrs := mmAbandonedCarts.AbandonedCartsMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryIfaceMockAddItemParams{ctx, userID, skuID, count}
+		mm_got := RepositoryIfaceMockAbandonedCartsParams{ctx, idle, limit}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmAbandonedCarts.t.Errorf("RepositoryIfaceMock.AbandonedCarts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAbandonedCarts.AbandonedCartsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			if mm_want_ptrs.idle != nil && !minimock.Equal(*mm_want_ptrs.idle, mm_got.idle) {
+				mmAbandonedCarts.t.Errorf("RepositoryIfaceMock.AbandonedCarts got unexpected parameter idle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAbandonedCarts.AbandonedCartsMock.defaultExpectation.expectationOrigins.originIdle, *mm_want_ptrs.idle, mm_got.idle, minimock.Diff(*mm_want_ptrs.idle, mm_got.idle))
 			}
 
-			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
-				mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmAbandonedCarts.t.Errorf("RepositoryIfaceMock.AbandonedCarts got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAbandonedCarts.AbandonedCartsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAbandonedCarts.t.Errorf("RepositoryIfaceMock.AbandonedCarts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAbandonedCarts.AbandonedCartsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmAddItem.AddItemMock.defaultExpectation.results
+		mm_results := mmAbandonedCarts.AbandonedCartsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmAddItem.t.Fatal("No results are set for the RepositoryIfaceMock.AddItem")
+			mmAbandonedCarts.t.Fatal("No results are set for the RepositoryIfaceMock.AbandonedCarts")
 		}
-		return (*mm_results).err
+		return (*mm_results).aa1, (*mm_results).err
 	}
-	if mmAddItem.funcAddItem != nil {
-		return mmAddItem.funcAddItem(ctx, userID, skuID, count)
+	if mmAbandonedCarts.funcAbandonedCarts != nil {
+		return mmAbandonedCarts.funcAbandonedCarts(ctx, idle, limit)
 	}
-	mmAddItem.t.Fatalf("Unexpected call to RepositoryIfaceMock.AddItem. %v %v %v %v", ctx, userID, skuID, count)
+	mmAbandonedCarts.t.Fatalf("Unexpected call to RepositoryIfaceMock.AbandonedCarts. %v %v %v", ctx, idle, limit)
 	return
 }
 
-// AddItemAfterCounter returns a count of finished RepositoryIfaceMock.AddItem invocations
-func (mmAddItem *RepositoryIfaceMock) AddItemAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmAddItem.afterAddItemCounter)
+// AbandonedCartsAfterCounter returns a count of finished RepositoryIfaceMock.AbandonedCarts invocations
+func (mmAbandonedCarts *RepositoryIfaceMock) AbandonedCartsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAbandonedCarts.afterAbandonedCartsCounter)
 }
 
-// AddItemBeforeCounter returns a count of RepositoryIfaceMock.AddItem invocations
-func (mmAddItem *RepositoryIfaceMock) AddItemBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmAddItem.beforeAddItemCounter)
+// AbandonedCartsBeforeCounter returns a count of RepositoryIfaceMock.AbandonedCarts invocations
+func (mmAbandonedCarts *RepositoryIfaceMock) AbandonedCartsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAbandonedCarts.beforeAbandonedCartsCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryIfaceMock.AddItem.
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.AbandonedCarts.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmAddItem *mRepositoryIfaceMockAddItem) Calls() []*RepositoryIfaceMockAddItemParams {
-	mmAddItem.mutex.RLock()
+func (mmAbandonedCarts *mRepositoryIfaceMockAbandonedCarts) Calls() []*RepositoryIfaceMockAbandonedCartsParams {
+	mmAbandonedCarts.mutex.RLock()
 
-	argCopy := make([]*RepositoryIfaceMockAddItemParams, len(mmAddItem.callArgs))
-	copy(argCopy, mmAddItem.callArgs)
+	argCopy := make([]*RepositoryIfaceMockAbandonedCartsParams, len(mmAbandonedCarts.callArgs))
+	copy(argCopy, mmAbandonedCarts.callArgs)
 
-	mmAddItem.mutex.RUnlock()
+	mmAbandonedCarts.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockAddItemDone returns true if the count of the AddItem invocations corresponds
+// MinimockAbandonedCartsDone returns true if the count of the AbandonedCarts invocations corresponds
 // the number of defined expectations
-func (m *RepositoryIfaceMock) MinimockAddItemDone() bool {
-	if m.AddItemMock.optional {
+func (m *RepositoryIfaceMock) MinimockAbandonedCartsDone() bool {
+	if m.AbandonedCartsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.AddItemMock.expectations {
+	for _, e := range m.AbandonedCartsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.AddItemMock.invocationsDone()
+	return m.AbandonedCartsMock.invocationsDone()
 }
 
-// MinimockAddItemInspect logs each unmet expectation
-func (m *RepositoryIfaceMock) MinimockAddItemInspect() {
-	for _, e := range m.AddItemMock.expectations {
+// MinimockAbandonedCartsInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockAbandonedCartsInspect() {
+	for _, e := range m.AbandonedCartsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.AddItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryIfaceMock.AbandonedCarts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterAddItemCounter := mm_atomic.LoadUint64(&m.afterAddItemCounter)
+	afterAbandonedCartsCounter := mm_atomic.LoadUint64(&m.afterAbandonedCartsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.AddItemMock.defaultExpectation != nil && afterAddItemCounter < 1 {
-		if m.AddItemMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.AddItem at\n%s", m.AddItemMock.defaultExpectation.returnOrigin)
+	if m.AbandonedCartsMock.defaultExpectation != nil && afterAbandonedCartsCounter < 1 {
+		if m.AbandonedCartsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.AbandonedCarts at\n%s", m.AbandonedCartsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.AddItem at\n%s with params: %#v", m.AddItemMock.defaultExpectation.expectationOrigins.origin, *m.AddItemMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryIfaceMock.AbandonedCarts at\n%s with params: %#v", m.AbandonedCartsMock.defaultExpectation.expectationOrigins.origin, *m.AbandonedCartsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcAddItem != nil && afterAddItemCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryIfaceMock.AddItem at\n%s", m.funcAddItemOrigin)
+	if m.funcAbandonedCarts != nil && afterAbandonedCartsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.AbandonedCarts at\n%s", m.funcAbandonedCartsOrigin)
 	}
 
-	if !m.AddItemMock.invocationsDone() && afterAddItemCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.AddItem at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.AddItemMock.expectedInvocations), m.AddItemMock.expectedInvocationsOrigin, afterAddItemCounter)
+	if !m.AbandonedCartsMock.invocationsDone() && afterAbandonedCartsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.AbandonedCarts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AbandonedCartsMock.expectedInvocations), m.AbandonedCartsMock.expectedInvocationsOrigin, afterAbandonedCartsCounter)
 	}
 }
 
-type mRepositoryIfaceMockClearCart struct {
+type mRepositoryIfaceMockAddItem struct {
 	optional           bool
 	mock               *RepositoryIfaceMock
-	defaultExpectation *RepositoryIfaceMockClearCartExpectation
-	expectations       []*RepositoryIfaceMockClearCartExpectation
+	defaultExpectation *RepositoryIfaceMockAddItemExpectation
+	expectations       []*RepositoryIfaceMockAddItemExpectation
 
-	callArgs []*RepositoryIfaceMockClearCartParams
+	callArgs []*RepositoryIfaceMockAddItemParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryIfaceMockClearCartExpectation specifies expectation struct of the RepositoryIface.ClearCart
-type RepositoryIfaceMockClearCartExpectation struct {
+// RepositoryIfaceMockAddItemExpectation specifies expectation struct of the RepositoryIface.AddItem
+type RepositoryIfaceMockAddItemExpectation struct {
 	mock               *RepositoryIfaceMock
-	params             *RepositoryIfaceMockClearCartParams
-	paramPtrs          *RepositoryIfaceMockClearCartParamPtrs
+	params             *RepositoryIfaceMockAddItemParams
+	paramPtrs          *RepositoryIfaceMockAddItemParamPtrs
+	expectationOrigins RepositoryIfaceMockAddItemExpectationOrigins
+	results            *RepositoryIfaceMockAddItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockAddItemParams contains parameters of the RepositoryIface.AddItem
+type RepositoryIfaceMockAddItemParams struct {
+	ctx      context.Context
+	userID   uint64
+	skuID    uint64
+	count    uint64
+	price    uint64
+	expected *uint64
+}
+
+// RepositoryIfaceMockAddItemParamPtrs contains pointers to parameters of the RepositoryIface.AddItem
+type RepositoryIfaceMockAddItemParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	skuID    *uint64
+	count    *uint64
+	price    *uint64
+	expected **uint64
+}
+
+// RepositoryIfaceMockAddItemResults contains results of the RepositoryIface.AddItem
+type RepositoryIfaceMockAddItemResults struct {
+	err error
+}
+
+// RepositoryIfaceMockAddItemOrigins contains origins of expectations of the RepositoryIface.AddItem
+type RepositoryIfaceMockAddItemExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originCount    string
+	originPrice    string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddItem *mRepositoryIfaceMockAddItem) Optional() *mRepositoryIfaceMockAddItem {
+	mmAddItem.optional = true
+	return mmAddItem
+}
+
+// Expect sets up expected params for RepositoryIface.AddItem
+func (mmAddItem *mRepositoryIfaceMockAddItem) Expect(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) *mRepositoryIfaceMockAddItem {
+	if mmAddItem.mock.funcAddItem != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+	}
+
+	if mmAddItem.defaultExpectation == nil {
+		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	}
+
+	if mmAddItem.defaultExpectation.paramPtrs != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by ExpectParams functions")
+	}
+
+	mmAddItem.defaultExpectation.params = &RepositoryIfaceMockAddItemParams{ctx, userID, skuID, count, price, expected}
+	mmAddItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddItem.expectations {
+		if minimock.Equal(e.params, mmAddItem.defaultExpectation.params) {
+			mmAddItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddItem.defaultExpectation.params)
+		}
+	}
+
+	return mmAddItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.AddItem
+func (mmAddItem *mRepositoryIfaceMockAddItem) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockAddItem {
+	if mmAddItem.mock.funcAddItem != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+	}
+
+	if mmAddItem.defaultExpectation == nil {
+		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	}
+
+	if mmAddItem.defaultExpectation.params != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Expect")
+	}
+
+	if mmAddItem.defaultExpectation.paramPtrs == nil {
+		mmAddItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockAddItemParamPtrs{}
+	}
+	mmAddItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.AddItem
+func (mmAddItem *mRepositoryIfaceMockAddItem) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockAddItem {
+	if mmAddItem.mock.funcAddItem != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+	}
+
+	if mmAddItem.defaultExpectation == nil {
+		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	}
+
+	if mmAddItem.defaultExpectation.params != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Expect")
+	}
+
+	if mmAddItem.defaultExpectation.paramPtrs == nil {
+		mmAddItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockAddItemParamPtrs{}
+	}
+	mmAddItem.defaultExpectation.paramPtrs.userID = &userID
+	mmAddItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmAddItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for RepositoryIface.AddItem
+func (mmAddItem *mRepositoryIfaceMockAddItem) ExpectSkuIDParam3(skuID uint64) *mRepositoryIfaceMockAddItem {
+	if mmAddItem.mock.funcAddItem != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+	}
+
+	if mmAddItem.defaultExpectation == nil {
+		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	}
+
+	if mmAddItem.defaultExpectation.params != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Expect")
+	}
+
+	if mmAddItem.defaultExpectation.paramPtrs == nil {
+		mmAddItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockAddItemParamPtrs{}
+	}
+	mmAddItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmAddItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmAddItem
+}
+
+// ExpectCountParam4 sets up expected param count for RepositoryIface.AddItem
+func (mmAddItem *mRepositoryIfaceMockAddItem) ExpectCountParam4(count uint64) *mRepositoryIfaceMockAddItem {
+	if mmAddItem.mock.funcAddItem != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+	}
+
+	if mmAddItem.defaultExpectation == nil {
+		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	}
+
+	if mmAddItem.defaultExpectation.params != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Expect")
+	}
+
+	if mmAddItem.defaultExpectation.paramPtrs == nil {
+		mmAddItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockAddItemParamPtrs{}
+	}
+	mmAddItem.defaultExpectation.paramPtrs.count = &count
+	mmAddItem.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmAddItem
+}
+
+// ExpectPriceParam5 sets up expected param price for RepositoryIface.AddItem
+func (mmAddItem *mRepositoryIfaceMockAddItem) ExpectPriceParam5(price uint64) *mRepositoryIfaceMockAddItem {
+	if mmAddItem.mock.funcAddItem != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+	}
+
+	if mmAddItem.defaultExpectation == nil {
+		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	}
+
+	if mmAddItem.defaultExpectation.params != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Expect")
+	}
+
+	if mmAddItem.defaultExpectation.paramPtrs == nil {
+		mmAddItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockAddItemParamPtrs{}
+	}
+	mmAddItem.defaultExpectation.paramPtrs.price = &price
+	mmAddItem.defaultExpectation.expectationOrigins.originPrice = minimock.CallerInfo(1)
+
+	return mmAddItem
+}
+
+// ExpectExpectedParam6 sets up expected param expected for RepositoryIface.AddItem
+func (mmAddItem *mRepositoryIfaceMockAddItem) ExpectExpectedParam6(expected *uint64) *mRepositoryIfaceMockAddItem {
+	if mmAddItem.mock.funcAddItem != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+	}
+
+	if mmAddItem.defaultExpectation == nil {
+		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{}
+	}
+
+	if mmAddItem.defaultExpectation.params != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Expect")
+	}
+
+	if mmAddItem.defaultExpectation.paramPtrs == nil {
+		mmAddItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockAddItemParamPtrs{}
+	}
+	mmAddItem.defaultExpectation.paramPtrs.expected = &expected
+	mmAddItem.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmAddItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.AddItem
+func (mmAddItem *mRepositoryIfaceMockAddItem) Inspect(f func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64)) *mRepositoryIfaceMockAddItem {
+	if mmAddItem.mock.inspectFuncAddItem != nil {
+		mmAddItem.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.AddItem")
+	}
+
+	mmAddItem.mock.inspectFuncAddItem = f
+
+	return mmAddItem
+}
+
+// Return sets up results that will be returned by RepositoryIface.AddItem
+func (mmAddItem *mRepositoryIfaceMockAddItem) Return(err error) *RepositoryIfaceMock {
+	if mmAddItem.mock.funcAddItem != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+	}
+
+	if mmAddItem.defaultExpectation == nil {
+		mmAddItem.defaultExpectation = &RepositoryIfaceMockAddItemExpectation{mock: mmAddItem.mock}
+	}
+	mmAddItem.defaultExpectation.results = &RepositoryIfaceMockAddItemResults{err}
+	mmAddItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddItem.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.AddItem method
+func (mmAddItem *mRepositoryIfaceMockAddItem) Set(f func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) (err error)) *RepositoryIfaceMock {
+	if mmAddItem.defaultExpectation != nil {
+		mmAddItem.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.AddItem method")
+	}
+
+	if len(mmAddItem.expectations) > 0 {
+		mmAddItem.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.AddItem method")
+	}
+
+	mmAddItem.mock.funcAddItem = f
+	mmAddItem.mock.funcAddItemOrigin = minimock.CallerInfo(1)
+	return mmAddItem.mock
+}
+
+// When sets expectation for the RepositoryIface.AddItem which will trigger the result defined by the following
+// Then helper
+func (mmAddItem *mRepositoryIfaceMockAddItem) When(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) *RepositoryIfaceMockAddItemExpectation {
+	if mmAddItem.mock.funcAddItem != nil {
+		mmAddItem.mock.t.Fatalf("RepositoryIfaceMock.AddItem mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockAddItemExpectation{
+		mock:               mmAddItem.mock,
+		params:             &RepositoryIfaceMockAddItemParams{ctx, userID, skuID, count, price, expected},
+		expectationOrigins: RepositoryIfaceMockAddItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddItem.expectations = append(mmAddItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.AddItem return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockAddItemExpectation) Then(err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockAddItemResults{err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.AddItem should be invoked
+func (mmAddItem *mRepositoryIfaceMockAddItem) Times(n uint64) *mRepositoryIfaceMockAddItem {
+	if n == 0 {
+		mmAddItem.mock.t.Fatalf("Times of RepositoryIfaceMock.AddItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddItem.expectedInvocations, n)
+	mmAddItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddItem
+}
+
+func (mmAddItem *mRepositoryIfaceMockAddItem) invocationsDone() bool {
+	if len(mmAddItem.expectations) == 0 && mmAddItem.defaultExpectation == nil && mmAddItem.mock.funcAddItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddItem.mock.afterAddItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddItem implements mm_interfaces.RepositoryIface
+func (mmAddItem *RepositoryIfaceMock) AddItem(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) (err error) {
+	mm_atomic.AddUint64(&mmAddItem.beforeAddItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddItem.afterAddItemCounter, 1)
+
+	mmAddItem.t.Helper()
+
+	if mmAddItem.inspectFuncAddItem != nil {
+		mmAddItem.inspectFuncAddItem(ctx, userID, skuID, count, price, expected)
+	}
+
+	mm_params := RepositoryIfaceMockAddItemParams{ctx, userID, skuID, count, price, expected}
+
+	// Record call args
+	mmAddItem.AddItemMock.mutex.Lock()
+	mmAddItem.AddItemMock.callArgs = append(mmAddItem.AddItemMock.callArgs, &mm_params)
+	mmAddItem.AddItemMock.mutex.Unlock()
+
+	for _, e := range mmAddItem.AddItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddItem.AddItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddItem.AddItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddItem.AddItemMock.defaultExpectation.params
+		mm_want_ptrs := mmAddItem.AddItemMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockAddItemParams{ctx, userID, skuID, count, price, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+			if mm_want_ptrs.price != nil && !minimock.Equal(*mm_want_ptrs.price, mm_got.price) {
+				mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameter price, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.originPrice, *mm_want_ptrs.price, mm_got.price, minimock.Diff(*mm_want_ptrs.price, mm_got.price))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddItem.t.Errorf("RepositoryIfaceMock.AddItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddItem.AddItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddItem.AddItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddItem.t.Fatal("No results are set for the RepositoryIfaceMock.AddItem")
+		}
+		return (*mm_results).err
+	}
+	if mmAddItem.funcAddItem != nil {
+		return mmAddItem.funcAddItem(ctx, userID, skuID, count, price, expected)
+	}
+	mmAddItem.t.Fatalf("Unexpected call to RepositoryIfaceMock.AddItem. %v %v %v %v %v %v", ctx, userID, skuID, count, price, expected)
+	return
+}
+
+// AddItemAfterCounter returns a count of finished RepositoryIfaceMock.AddItem invocations
+func (mmAddItem *RepositoryIfaceMock) AddItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddItem.afterAddItemCounter)
+}
+
+// AddItemBeforeCounter returns a count of RepositoryIfaceMock.AddItem invocations
+func (mmAddItem *RepositoryIfaceMock) AddItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddItem.beforeAddItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.AddItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddItem *mRepositoryIfaceMockAddItem) Calls() []*RepositoryIfaceMockAddItemParams {
+	mmAddItem.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockAddItemParams, len(mmAddItem.callArgs))
+	copy(argCopy, mmAddItem.callArgs)
+
+	mmAddItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddItemDone returns true if the count of the AddItem invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockAddItemDone() bool {
+	if m.AddItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddItemMock.invocationsDone()
+}
+
+// MinimockAddItemInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockAddItemInspect() {
+	for _, e := range m.AddItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.AddItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddItemCounter := mm_atomic.LoadUint64(&m.afterAddItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddItemMock.defaultExpectation != nil && afterAddItemCounter < 1 {
+		if m.AddItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.AddItem at\n%s", m.AddItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.AddItem at\n%s with params: %#v", m.AddItemMock.defaultExpectation.expectationOrigins.origin, *m.AddItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddItem != nil && afterAddItemCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.AddItem at\n%s", m.funcAddItemOrigin)
+	}
+
+	if !m.AddItemMock.invocationsDone() && afterAddItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.AddItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddItemMock.expectedInvocations), m.AddItemMock.expectedInvocationsOrigin, afterAddItemCounter)
+	}
+}
+
+type mRepositoryIfaceMockApplyPromo struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockApplyPromoExpectation
+	expectations       []*RepositoryIfaceMockApplyPromoExpectation
+
+	callArgs []*RepositoryIfaceMockApplyPromoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockApplyPromoExpectation specifies expectation struct of the RepositoryIface.ApplyPromo
+type RepositoryIfaceMockApplyPromoExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockApplyPromoParams
+	paramPtrs          *RepositoryIfaceMockApplyPromoParamPtrs
+	expectationOrigins RepositoryIfaceMockApplyPromoExpectationOrigins
+	results            *RepositoryIfaceMockApplyPromoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockApplyPromoParams contains parameters of the RepositoryIface.ApplyPromo
+type RepositoryIfaceMockApplyPromoParams struct {
+	ctx      context.Context
+	userID   uint64
+	code     string
+	expected *uint64
+}
+
+// RepositoryIfaceMockApplyPromoParamPtrs contains pointers to parameters of the RepositoryIface.ApplyPromo
+type RepositoryIfaceMockApplyPromoParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	code     *string
+	expected **uint64
+}
+
+// RepositoryIfaceMockApplyPromoResults contains results of the RepositoryIface.ApplyPromo
+type RepositoryIfaceMockApplyPromoResults struct {
+	err error
+}
+
+// RepositoryIfaceMockApplyPromoOrigins contains origins of expectations of the RepositoryIface.ApplyPromo
+type RepositoryIfaceMockApplyPromoExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originCode     string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) Optional() *mRepositoryIfaceMockApplyPromo {
+	mmApplyPromo.optional = true
+	return mmApplyPromo
+}
+
+// Expect sets up expected params for RepositoryIface.ApplyPromo
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) Expect(ctx context.Context, userID uint64, code string, expected *uint64) *mRepositoryIfaceMockApplyPromo {
+	if mmApplyPromo.mock.funcApplyPromo != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Set")
+	}
+
+	if mmApplyPromo.defaultExpectation == nil {
+		mmApplyPromo.defaultExpectation = &RepositoryIfaceMockApplyPromoExpectation{}
+	}
+
+	if mmApplyPromo.defaultExpectation.paramPtrs != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by ExpectParams functions")
+	}
+
+	mmApplyPromo.defaultExpectation.params = &RepositoryIfaceMockApplyPromoParams{ctx, userID, code, expected}
+	mmApplyPromo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyPromo.expectations {
+		if minimock.Equal(e.params, mmApplyPromo.defaultExpectation.params) {
+			mmApplyPromo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyPromo.defaultExpectation.params)
+		}
+	}
+
+	return mmApplyPromo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.ApplyPromo
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockApplyPromo {
+	if mmApplyPromo.mock.funcApplyPromo != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Set")
+	}
+
+	if mmApplyPromo.defaultExpectation == nil {
+		mmApplyPromo.defaultExpectation = &RepositoryIfaceMockApplyPromoExpectation{}
+	}
+
+	if mmApplyPromo.defaultExpectation.params != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Expect")
+	}
+
+	if mmApplyPromo.defaultExpectation.paramPtrs == nil {
+		mmApplyPromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockApplyPromoParamPtrs{}
+	}
+	mmApplyPromo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyPromo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApplyPromo
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.ApplyPromo
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockApplyPromo {
+	if mmApplyPromo.mock.funcApplyPromo != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Set")
+	}
+
+	if mmApplyPromo.defaultExpectation == nil {
+		mmApplyPromo.defaultExpectation = &RepositoryIfaceMockApplyPromoExpectation{}
+	}
+
+	if mmApplyPromo.defaultExpectation.params != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Expect")
+	}
+
+	if mmApplyPromo.defaultExpectation.paramPtrs == nil {
+		mmApplyPromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockApplyPromoParamPtrs{}
+	}
+	mmApplyPromo.defaultExpectation.paramPtrs.userID = &userID
+	mmApplyPromo.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmApplyPromo
+}
+
+// ExpectCodeParam3 sets up expected param code for RepositoryIface.ApplyPromo
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) ExpectCodeParam3(code string) *mRepositoryIfaceMockApplyPromo {
+	if mmApplyPromo.mock.funcApplyPromo != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Set")
+	}
+
+	if mmApplyPromo.defaultExpectation == nil {
+		mmApplyPromo.defaultExpectation = &RepositoryIfaceMockApplyPromoExpectation{}
+	}
+
+	if mmApplyPromo.defaultExpectation.params != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Expect")
+	}
+
+	if mmApplyPromo.defaultExpectation.paramPtrs == nil {
+		mmApplyPromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockApplyPromoParamPtrs{}
+	}
+	mmApplyPromo.defaultExpectation.paramPtrs.code = &code
+	mmApplyPromo.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmApplyPromo
+}
+
+// ExpectExpectedParam4 sets up expected param expected for RepositoryIface.ApplyPromo
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) ExpectExpectedParam4(expected *uint64) *mRepositoryIfaceMockApplyPromo {
+	if mmApplyPromo.mock.funcApplyPromo != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Set")
+	}
+
+	if mmApplyPromo.defaultExpectation == nil {
+		mmApplyPromo.defaultExpectation = &RepositoryIfaceMockApplyPromoExpectation{}
+	}
+
+	if mmApplyPromo.defaultExpectation.params != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Expect")
+	}
+
+	if mmApplyPromo.defaultExpectation.paramPtrs == nil {
+		mmApplyPromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockApplyPromoParamPtrs{}
+	}
+	mmApplyPromo.defaultExpectation.paramPtrs.expected = &expected
+	mmApplyPromo.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmApplyPromo
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.ApplyPromo
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) Inspect(f func(ctx context.Context, userID uint64, code string, expected *uint64)) *mRepositoryIfaceMockApplyPromo {
+	if mmApplyPromo.mock.inspectFuncApplyPromo != nil {
+		mmApplyPromo.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.ApplyPromo")
+	}
+
+	mmApplyPromo.mock.inspectFuncApplyPromo = f
+
+	return mmApplyPromo
+}
+
+// Return sets up results that will be returned by RepositoryIface.ApplyPromo
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) Return(err error) *RepositoryIfaceMock {
+	if mmApplyPromo.mock.funcApplyPromo != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Set")
+	}
+
+	if mmApplyPromo.defaultExpectation == nil {
+		mmApplyPromo.defaultExpectation = &RepositoryIfaceMockApplyPromoExpectation{mock: mmApplyPromo.mock}
+	}
+	mmApplyPromo.defaultExpectation.results = &RepositoryIfaceMockApplyPromoResults{err}
+	mmApplyPromo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyPromo.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.ApplyPromo method
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) Set(f func(ctx context.Context, userID uint64, code string, expected *uint64) (err error)) *RepositoryIfaceMock {
+	if mmApplyPromo.defaultExpectation != nil {
+		mmApplyPromo.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.ApplyPromo method")
+	}
+
+	if len(mmApplyPromo.expectations) > 0 {
+		mmApplyPromo.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.ApplyPromo method")
+	}
+
+	mmApplyPromo.mock.funcApplyPromo = f
+	mmApplyPromo.mock.funcApplyPromoOrigin = minimock.CallerInfo(1)
+	return mmApplyPromo.mock
+}
+
+// When sets expectation for the RepositoryIface.ApplyPromo which will trigger the result defined by the following
+// Then helper
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) When(ctx context.Context, userID uint64, code string, expected *uint64) *RepositoryIfaceMockApplyPromoExpectation {
+	if mmApplyPromo.mock.funcApplyPromo != nil {
+		mmApplyPromo.mock.t.Fatalf("RepositoryIfaceMock.ApplyPromo mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockApplyPromoExpectation{
+		mock:               mmApplyPromo.mock,
+		params:             &RepositoryIfaceMockApplyPromoParams{ctx, userID, code, expected},
+		expectationOrigins: RepositoryIfaceMockApplyPromoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApplyPromo.expectations = append(mmApplyPromo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.ApplyPromo return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockApplyPromoExpectation) Then(err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockApplyPromoResults{err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.ApplyPromo should be invoked
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) Times(n uint64) *mRepositoryIfaceMockApplyPromo {
+	if n == 0 {
+		mmApplyPromo.mock.t.Fatalf("Times of RepositoryIfaceMock.ApplyPromo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApplyPromo.expectedInvocations, n)
+	mmApplyPromo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyPromo
+}
+
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) invocationsDone() bool {
+	if len(mmApplyPromo.expectations) == 0 && mmApplyPromo.defaultExpectation == nil && mmApplyPromo.mock.funcApplyPromo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyPromo.mock.afterApplyPromoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyPromo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApplyPromo implements mm_interfaces.RepositoryIface
+func (mmApplyPromo *RepositoryIfaceMock) ApplyPromo(ctx context.Context, userID uint64, code string, expected *uint64) (err error) {
+	mm_atomic.AddUint64(&mmApplyPromo.beforeApplyPromoCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyPromo.afterApplyPromoCounter, 1)
+
+	mmApplyPromo.t.Helper()
+
+	if mmApplyPromo.inspectFuncApplyPromo != nil {
+		mmApplyPromo.inspectFuncApplyPromo(ctx, userID, code, expected)
+	}
+
+	mm_params := RepositoryIfaceMockApplyPromoParams{ctx, userID, code, expected}
+
+	// Record call args
+	mmApplyPromo.ApplyPromoMock.mutex.Lock()
+	mmApplyPromo.ApplyPromoMock.callArgs = append(mmApplyPromo.ApplyPromoMock.callArgs, &mm_params)
+	mmApplyPromo.ApplyPromoMock.mutex.Unlock()
+
+	for _, e := range mmApplyPromo.ApplyPromoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmApplyPromo.ApplyPromoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyPromo.ApplyPromoMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyPromo.ApplyPromoMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyPromo.ApplyPromoMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockApplyPromoParams{ctx, userID, code, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApplyPromo.t.Errorf("RepositoryIfaceMock.ApplyPromo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPromo.ApplyPromoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmApplyPromo.t.Errorf("RepositoryIfaceMock.ApplyPromo got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPromo.ApplyPromoMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmApplyPromo.t.Errorf("RepositoryIfaceMock.ApplyPromo got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPromo.ApplyPromoMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmApplyPromo.t.Errorf("RepositoryIfaceMock.ApplyPromo got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPromo.ApplyPromoMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApplyPromo.t.Errorf("RepositoryIfaceMock.ApplyPromo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyPromo.ApplyPromoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApplyPromo.ApplyPromoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApplyPromo.t.Fatal("No results are set for the RepositoryIfaceMock.ApplyPromo")
+		}
+		return (*mm_results).err
+	}
+	if mmApplyPromo.funcApplyPromo != nil {
+		return mmApplyPromo.funcApplyPromo(ctx, userID, code, expected)
+	}
+	mmApplyPromo.t.Fatalf("Unexpected call to RepositoryIfaceMock.ApplyPromo. %v %v %v %v", ctx, userID, code, expected)
+	return
+}
+
+// ApplyPromoAfterCounter returns a count of finished RepositoryIfaceMock.ApplyPromo invocations
+func (mmApplyPromo *RepositoryIfaceMock) ApplyPromoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPromo.afterApplyPromoCounter)
+}
+
+// ApplyPromoBeforeCounter returns a count of RepositoryIfaceMock.ApplyPromo invocations
+func (mmApplyPromo *RepositoryIfaceMock) ApplyPromoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPromo.beforeApplyPromoCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.ApplyPromo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApplyPromo *mRepositoryIfaceMockApplyPromo) Calls() []*RepositoryIfaceMockApplyPromoParams {
+	mmApplyPromo.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockApplyPromoParams, len(mmApplyPromo.callArgs))
+	copy(argCopy, mmApplyPromo.callArgs)
+
+	mmApplyPromo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApplyPromoDone returns true if the count of the ApplyPromo invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockApplyPromoDone() bool {
+	if m.ApplyPromoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApplyPromoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApplyPromoMock.invocationsDone()
+}
+
+// MinimockApplyPromoInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockApplyPromoInspect() {
+	for _, e := range m.ApplyPromoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ApplyPromo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApplyPromoCounter := mm_atomic.LoadUint64(&m.afterApplyPromoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApplyPromoMock.defaultExpectation != nil && afterApplyPromoCounter < 1 {
+		if m.ApplyPromoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ApplyPromo at\n%s", m.ApplyPromoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ApplyPromo at\n%s with params: %#v", m.ApplyPromoMock.defaultExpectation.expectationOrigins.origin, *m.ApplyPromoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApplyPromo != nil && afterApplyPromoCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.ApplyPromo at\n%s", m.funcApplyPromoOrigin)
+	}
+
+	if !m.ApplyPromoMock.invocationsDone() && afterApplyPromoCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.ApplyPromo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyPromoMock.expectedInvocations), m.ApplyPromoMock.expectedInvocationsOrigin, afterApplyPromoCounter)
+	}
+}
+
+type mRepositoryIfaceMockBulkUpdate struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockBulkUpdateExpectation
+	expectations       []*RepositoryIfaceMockBulkUpdateExpectation
+
+	callArgs []*RepositoryIfaceMockBulkUpdateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockBulkUpdateExpectation specifies expectation struct of the RepositoryIface.BulkUpdate
+type RepositoryIfaceMockBulkUpdateExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockBulkUpdateParams
+	paramPtrs          *RepositoryIfaceMockBulkUpdateParamPtrs
+	expectationOrigins RepositoryIfaceMockBulkUpdateExpectationOrigins
+	results            *RepositoryIfaceMockBulkUpdateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockBulkUpdateParams contains parameters of the RepositoryIface.BulkUpdate
+type RepositoryIfaceMockBulkUpdateParams struct {
+	ctx      context.Context
+	userID   uint64
+	changes  []postgres.CountChange
+	expected *uint64
+}
+
+// RepositoryIfaceMockBulkUpdateParamPtrs contains pointers to parameters of the RepositoryIface.BulkUpdate
+type RepositoryIfaceMockBulkUpdateParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	changes  *[]postgres.CountChange
+	expected **uint64
+}
+
+// RepositoryIfaceMockBulkUpdateResults contains results of the RepositoryIface.BulkUpdate
+type RepositoryIfaceMockBulkUpdateResults struct {
+	err error
+}
+
+// RepositoryIfaceMockBulkUpdateOrigins contains origins of expectations of the RepositoryIface.BulkUpdate
+type RepositoryIfaceMockBulkUpdateExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originChanges  string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) Optional() *mRepositoryIfaceMockBulkUpdate {
+	mmBulkUpdate.optional = true
+	return mmBulkUpdate
+}
+
+// Expect sets up expected params for RepositoryIface.BulkUpdate
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) Expect(ctx context.Context, userID uint64, changes []postgres.CountChange, expected *uint64) *mRepositoryIfaceMockBulkUpdate {
+	if mmBulkUpdate.mock.funcBulkUpdate != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Set")
+	}
+
+	if mmBulkUpdate.defaultExpectation == nil {
+		mmBulkUpdate.defaultExpectation = &RepositoryIfaceMockBulkUpdateExpectation{}
+	}
+
+	if mmBulkUpdate.defaultExpectation.paramPtrs != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by ExpectParams functions")
+	}
+
+	mmBulkUpdate.defaultExpectation.params = &RepositoryIfaceMockBulkUpdateParams{ctx, userID, changes, expected}
+	mmBulkUpdate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBulkUpdate.expectations {
+		if minimock.Equal(e.params, mmBulkUpdate.defaultExpectation.params) {
+			mmBulkUpdate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBulkUpdate.defaultExpectation.params)
+		}
+	}
+
+	return mmBulkUpdate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.BulkUpdate
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockBulkUpdate {
+	if mmBulkUpdate.mock.funcBulkUpdate != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Set")
+	}
+
+	if mmBulkUpdate.defaultExpectation == nil {
+		mmBulkUpdate.defaultExpectation = &RepositoryIfaceMockBulkUpdateExpectation{}
+	}
+
+	if mmBulkUpdate.defaultExpectation.params != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Expect")
+	}
+
+	if mmBulkUpdate.defaultExpectation.paramPtrs == nil {
+		mmBulkUpdate.defaultExpectation.paramPtrs = &RepositoryIfaceMockBulkUpdateParamPtrs{}
+	}
+	mmBulkUpdate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBulkUpdate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmBulkUpdate
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.BulkUpdate
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockBulkUpdate {
+	if mmBulkUpdate.mock.funcBulkUpdate != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Set")
+	}
+
+	if mmBulkUpdate.defaultExpectation == nil {
+		mmBulkUpdate.defaultExpectation = &RepositoryIfaceMockBulkUpdateExpectation{}
+	}
+
+	if mmBulkUpdate.defaultExpectation.params != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Expect")
+	}
+
+	if mmBulkUpdate.defaultExpectation.paramPtrs == nil {
+		mmBulkUpdate.defaultExpectation.paramPtrs = &RepositoryIfaceMockBulkUpdateParamPtrs{}
+	}
+	mmBulkUpdate.defaultExpectation.paramPtrs.userID = &userID
+	mmBulkUpdate.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmBulkUpdate
+}
+
+// ExpectChangesParam3 sets up expected param changes for RepositoryIface.BulkUpdate
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) ExpectChangesParam3(changes []postgres.CountChange) *mRepositoryIfaceMockBulkUpdate {
+	if mmBulkUpdate.mock.funcBulkUpdate != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Set")
+	}
+
+	if mmBulkUpdate.defaultExpectation == nil {
+		mmBulkUpdate.defaultExpectation = &RepositoryIfaceMockBulkUpdateExpectation{}
+	}
+
+	if mmBulkUpdate.defaultExpectation.params != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Expect")
+	}
+
+	if mmBulkUpdate.defaultExpectation.paramPtrs == nil {
+		mmBulkUpdate.defaultExpectation.paramPtrs = &RepositoryIfaceMockBulkUpdateParamPtrs{}
+	}
+	mmBulkUpdate.defaultExpectation.paramPtrs.changes = &changes
+	mmBulkUpdate.defaultExpectation.expectationOrigins.originChanges = minimock.CallerInfo(1)
+
+	return mmBulkUpdate
+}
+
+// ExpectExpectedParam4 sets up expected param expected for RepositoryIface.BulkUpdate
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) ExpectExpectedParam4(expected *uint64) *mRepositoryIfaceMockBulkUpdate {
+	if mmBulkUpdate.mock.funcBulkUpdate != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Set")
+	}
+
+	if mmBulkUpdate.defaultExpectation == nil {
+		mmBulkUpdate.defaultExpectation = &RepositoryIfaceMockBulkUpdateExpectation{}
+	}
+
+	if mmBulkUpdate.defaultExpectation.params != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Expect")
+	}
+
+	if mmBulkUpdate.defaultExpectation.paramPtrs == nil {
+		mmBulkUpdate.defaultExpectation.paramPtrs = &RepositoryIfaceMockBulkUpdateParamPtrs{}
+	}
+	mmBulkUpdate.defaultExpectation.paramPtrs.expected = &expected
+	mmBulkUpdate.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmBulkUpdate
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.BulkUpdate
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) Inspect(f func(ctx context.Context, userID uint64, changes []postgres.CountChange, expected *uint64)) *mRepositoryIfaceMockBulkUpdate {
+	if mmBulkUpdate.mock.inspectFuncBulkUpdate != nil {
+		mmBulkUpdate.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.BulkUpdate")
+	}
+
+	mmBulkUpdate.mock.inspectFuncBulkUpdate = f
+
+	return mmBulkUpdate
+}
+
+// Return sets up results that will be returned by RepositoryIface.BulkUpdate
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) Return(err error) *RepositoryIfaceMock {
+	if mmBulkUpdate.mock.funcBulkUpdate != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Set")
+	}
+
+	if mmBulkUpdate.defaultExpectation == nil {
+		mmBulkUpdate.defaultExpectation = &RepositoryIfaceMockBulkUpdateExpectation{mock: mmBulkUpdate.mock}
+	}
+	mmBulkUpdate.defaultExpectation.results = &RepositoryIfaceMockBulkUpdateResults{err}
+	mmBulkUpdate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBulkUpdate.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.BulkUpdate method
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) Set(f func(ctx context.Context, userID uint64, changes []postgres.CountChange, expected *uint64) (err error)) *RepositoryIfaceMock {
+	if mmBulkUpdate.defaultExpectation != nil {
+		mmBulkUpdate.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.BulkUpdate method")
+	}
+
+	if len(mmBulkUpdate.expectations) > 0 {
+		mmBulkUpdate.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.BulkUpdate method")
+	}
+
+	mmBulkUpdate.mock.funcBulkUpdate = f
+	mmBulkUpdate.mock.funcBulkUpdateOrigin = minimock.CallerInfo(1)
+	return mmBulkUpdate.mock
+}
+
+// When sets expectation for the RepositoryIface.BulkUpdate which will trigger the result defined by the following
+// Then helper
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) When(ctx context.Context, userID uint64, changes []postgres.CountChange, expected *uint64) *RepositoryIfaceMockBulkUpdateExpectation {
+	if mmBulkUpdate.mock.funcBulkUpdate != nil {
+		mmBulkUpdate.mock.t.Fatalf("RepositoryIfaceMock.BulkUpdate mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockBulkUpdateExpectation{
+		mock:               mmBulkUpdate.mock,
+		params:             &RepositoryIfaceMockBulkUpdateParams{ctx, userID, changes, expected},
+		expectationOrigins: RepositoryIfaceMockBulkUpdateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmBulkUpdate.expectations = append(mmBulkUpdate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.BulkUpdate return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockBulkUpdateExpectation) Then(err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockBulkUpdateResults{err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.BulkUpdate should be invoked
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) Times(n uint64) *mRepositoryIfaceMockBulkUpdate {
+	if n == 0 {
+		mmBulkUpdate.mock.t.Fatalf("Times of RepositoryIfaceMock.BulkUpdate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmBulkUpdate.expectedInvocations, n)
+	mmBulkUpdate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBulkUpdate
+}
+
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) invocationsDone() bool {
+	if len(mmBulkUpdate.expectations) == 0 && mmBulkUpdate.defaultExpectation == nil && mmBulkUpdate.mock.funcBulkUpdate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmBulkUpdate.mock.afterBulkUpdateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBulkUpdate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// BulkUpdate implements mm_interfaces.RepositoryIface
+func (mmBulkUpdate *RepositoryIfaceMock) BulkUpdate(ctx context.Context, userID uint64, changes []postgres.CountChange, expected *uint64) (err error) {
+	mm_atomic.AddUint64(&mmBulkUpdate.beforeBulkUpdateCounter, 1)
+	defer mm_atomic.AddUint64(&mmBulkUpdate.afterBulkUpdateCounter, 1)
+
+	mmBulkUpdate.t.Helper()
+
+	if mmBulkUpdate.inspectFuncBulkUpdate != nil {
+		mmBulkUpdate.inspectFuncBulkUpdate(ctx, userID, changes, expected)
+	}
+
+	mm_params := RepositoryIfaceMockBulkUpdateParams{ctx, userID, changes, expected}
+
+	// Record call args
+	mmBulkUpdate.BulkUpdateMock.mutex.Lock()
+	mmBulkUpdate.BulkUpdateMock.callArgs = append(mmBulkUpdate.BulkUpdateMock.callArgs, &mm_params)
+	mmBulkUpdate.BulkUpdateMock.mutex.Unlock()
+
+	for _, e := range mmBulkUpdate.BulkUpdateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmBulkUpdate.BulkUpdateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBulkUpdate.BulkUpdateMock.defaultExpectation.Counter, 1)
+		mm_want := mmBulkUpdate.BulkUpdateMock.defaultExpectation.params
+		mm_want_ptrs := mmBulkUpdate.BulkUpdateMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockBulkUpdateParams{ctx, userID, changes, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBulkUpdate.t.Errorf("RepositoryIfaceMock.BulkUpdate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBulkUpdate.BulkUpdateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmBulkUpdate.t.Errorf("RepositoryIfaceMock.BulkUpdate got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBulkUpdate.BulkUpdateMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.changes != nil && !minimock.Equal(*mm_want_ptrs.changes, mm_got.changes) {
+				mmBulkUpdate.t.Errorf("RepositoryIfaceMock.BulkUpdate got unexpected parameter changes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBulkUpdate.BulkUpdateMock.defaultExpectation.expectationOrigins.originChanges, *mm_want_ptrs.changes, mm_got.changes, minimock.Diff(*mm_want_ptrs.changes, mm_got.changes))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmBulkUpdate.t.Errorf("RepositoryIfaceMock.BulkUpdate got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBulkUpdate.BulkUpdateMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBulkUpdate.t.Errorf("RepositoryIfaceMock.BulkUpdate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBulkUpdate.BulkUpdateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBulkUpdate.BulkUpdateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBulkUpdate.t.Fatal("No results are set for the RepositoryIfaceMock.BulkUpdate")
+		}
+		return (*mm_results).err
+	}
+	if mmBulkUpdate.funcBulkUpdate != nil {
+		return mmBulkUpdate.funcBulkUpdate(ctx, userID, changes, expected)
+	}
+	mmBulkUpdate.t.Fatalf("Unexpected call to RepositoryIfaceMock.BulkUpdate. %v %v %v %v", ctx, userID, changes, expected)
+	return
+}
+
+// BulkUpdateAfterCounter returns a count of finished RepositoryIfaceMock.BulkUpdate invocations
+func (mmBulkUpdate *RepositoryIfaceMock) BulkUpdateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBulkUpdate.afterBulkUpdateCounter)
+}
+
+// BulkUpdateBeforeCounter returns a count of RepositoryIfaceMock.BulkUpdate invocations
+func (mmBulkUpdate *RepositoryIfaceMock) BulkUpdateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBulkUpdate.beforeBulkUpdateCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.BulkUpdate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBulkUpdate *mRepositoryIfaceMockBulkUpdate) Calls() []*RepositoryIfaceMockBulkUpdateParams {
+	mmBulkUpdate.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockBulkUpdateParams, len(mmBulkUpdate.callArgs))
+	copy(argCopy, mmBulkUpdate.callArgs)
+
+	mmBulkUpdate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBulkUpdateDone returns true if the count of the BulkUpdate invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockBulkUpdateDone() bool {
+	if m.BulkUpdateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BulkUpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BulkUpdateMock.invocationsDone()
+}
+
+// MinimockBulkUpdateInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockBulkUpdateInspect() {
+	for _, e := range m.BulkUpdateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.BulkUpdate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBulkUpdateCounter := mm_atomic.LoadUint64(&m.afterBulkUpdateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BulkUpdateMock.defaultExpectation != nil && afterBulkUpdateCounter < 1 {
+		if m.BulkUpdateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.BulkUpdate at\n%s", m.BulkUpdateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.BulkUpdate at\n%s with params: %#v", m.BulkUpdateMock.defaultExpectation.expectationOrigins.origin, *m.BulkUpdateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBulkUpdate != nil && afterBulkUpdateCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.BulkUpdate at\n%s", m.funcBulkUpdateOrigin)
+	}
+
+	if !m.BulkUpdateMock.invocationsDone() && afterBulkUpdateCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.BulkUpdate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BulkUpdateMock.expectedInvocations), m.BulkUpdateMock.expectedInvocationsOrigin, afterBulkUpdateCounter)
+	}
+}
+
+type mRepositoryIfaceMockClaimIdempotencyKey struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockClaimIdempotencyKeyExpectation
+	expectations       []*RepositoryIfaceMockClaimIdempotencyKeyExpectation
+
+	callArgs []*RepositoryIfaceMockClaimIdempotencyKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockClaimIdempotencyKeyExpectation specifies expectation struct of the RepositoryIface.ClaimIdempotencyKey
+type RepositoryIfaceMockClaimIdempotencyKeyExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockClaimIdempotencyKeyParams
+	paramPtrs          *RepositoryIfaceMockClaimIdempotencyKeyParamPtrs
+	expectationOrigins RepositoryIfaceMockClaimIdempotencyKeyExpectationOrigins
+	results            *RepositoryIfaceMockClaimIdempotencyKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockClaimIdempotencyKeyParams contains parameters of the RepositoryIface.ClaimIdempotencyKey
+type RepositoryIfaceMockClaimIdempotencyKeyParams struct {
+	ctx         context.Context
+	userID      uint64
+	key         string
+	fingerprint string
+	lease       time.Duration
+}
+
+// RepositoryIfaceMockClaimIdempotencyKeyParamPtrs contains pointers to parameters of the RepositoryIface.ClaimIdempotencyKey
+type RepositoryIfaceMockClaimIdempotencyKeyParamPtrs struct {
+	ctx         *context.Context
+	userID      *uint64
+	key         *string
+	fingerprint *string
+	lease       *time.Duration
+}
+
+// RepositoryIfaceMockClaimIdempotencyKeyResults contains results of the RepositoryIface.ClaimIdempotencyKey
+type RepositoryIfaceMockClaimIdempotencyKeyResults struct {
+	ip1 *postgres.IdempotencyRecord
+	b1  bool
+	err error
+}
+
+// RepositoryIfaceMockClaimIdempotencyKeyOrigins contains origins of expectations of the RepositoryIface.ClaimIdempotencyKey
+type RepositoryIfaceMockClaimIdempotencyKeyExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originUserID      string
+	originKey         string
+	originFingerprint string
+	originLease       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) Optional() *mRepositoryIfaceMockClaimIdempotencyKey {
+	mmClaimIdempotencyKey.optional = true
+	return mmClaimIdempotencyKey
+}
+
+// Expect sets up expected params for RepositoryIface.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) Expect(ctx context.Context, userID uint64, key string, fingerprint string, lease time.Duration) *mRepositoryIfaceMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Set")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &RepositoryIfaceMockClaimIdempotencyKeyExpectation{}
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.paramPtrs != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by ExpectParams functions")
+	}
+
+	mmClaimIdempotencyKey.defaultExpectation.params = &RepositoryIfaceMockClaimIdempotencyKeyParams{ctx, userID, key, fingerprint, lease}
+	mmClaimIdempotencyKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaimIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmClaimIdempotencyKey.defaultExpectation.params) {
+			mmClaimIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimIdempotencyKey.defaultExpectation.params)
+		}
+	}
+
+	return mmClaimIdempotencyKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Set")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &RepositoryIfaceMockClaimIdempotencyKeyExpectation{}
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.params != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmClaimIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryIfaceMockClaimIdempotencyKeyParamPtrs{}
+	}
+	mmClaimIdempotencyKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaimIdempotencyKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaimIdempotencyKey
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Set")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &RepositoryIfaceMockClaimIdempotencyKeyExpectation{}
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.params != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmClaimIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryIfaceMockClaimIdempotencyKeyParamPtrs{}
+	}
+	mmClaimIdempotencyKey.defaultExpectation.paramPtrs.userID = &userID
+	mmClaimIdempotencyKey.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmClaimIdempotencyKey
+}
+
+// ExpectKeyParam3 sets up expected param key for RepositoryIface.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) ExpectKeyParam3(key string) *mRepositoryIfaceMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Set")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &RepositoryIfaceMockClaimIdempotencyKeyExpectation{}
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.params != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmClaimIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryIfaceMockClaimIdempotencyKeyParamPtrs{}
+	}
+	mmClaimIdempotencyKey.defaultExpectation.paramPtrs.key = &key
+	mmClaimIdempotencyKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmClaimIdempotencyKey
+}
+
+// ExpectFingerprintParam4 sets up expected param fingerprint for RepositoryIface.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) ExpectFingerprintParam4(fingerprint string) *mRepositoryIfaceMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Set")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &RepositoryIfaceMockClaimIdempotencyKeyExpectation{}
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.params != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmClaimIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryIfaceMockClaimIdempotencyKeyParamPtrs{}
+	}
+	mmClaimIdempotencyKey.defaultExpectation.paramPtrs.fingerprint = &fingerprint
+	mmClaimIdempotencyKey.defaultExpectation.expectationOrigins.originFingerprint = minimock.CallerInfo(1)
+
+	return mmClaimIdempotencyKey
+}
+
+// ExpectLeaseParam5 sets up expected param lease for RepositoryIface.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) ExpectLeaseParam5(lease time.Duration) *mRepositoryIfaceMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Set")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &RepositoryIfaceMockClaimIdempotencyKeyExpectation{}
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.params != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmClaimIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryIfaceMockClaimIdempotencyKeyParamPtrs{}
+	}
+	mmClaimIdempotencyKey.defaultExpectation.paramPtrs.lease = &lease
+	mmClaimIdempotencyKey.defaultExpectation.expectationOrigins.originLease = minimock.CallerInfo(1)
+
+	return mmClaimIdempotencyKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) Inspect(f func(ctx context.Context, userID uint64, key string, fingerprint string, lease time.Duration)) *mRepositoryIfaceMockClaimIdempotencyKey {
+	if mmClaimIdempotencyKey.mock.inspectFuncClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.ClaimIdempotencyKey")
+	}
+
+	mmClaimIdempotencyKey.mock.inspectFuncClaimIdempotencyKey = f
+
+	return mmClaimIdempotencyKey
+}
+
+// Return sets up results that will be returned by RepositoryIface.ClaimIdempotencyKey
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) Return(ip1 *postgres.IdempotencyRecord, b1 bool, err error) *RepositoryIfaceMock {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Set")
+	}
+
+	if mmClaimIdempotencyKey.defaultExpectation == nil {
+		mmClaimIdempotencyKey.defaultExpectation = &RepositoryIfaceMockClaimIdempotencyKeyExpectation{mock: mmClaimIdempotencyKey.mock}
+	}
+	mmClaimIdempotencyKey.defaultExpectation.results = &RepositoryIfaceMockClaimIdempotencyKeyResults{ip1, b1, err}
+	mmClaimIdempotencyKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaimIdempotencyKey.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.ClaimIdempotencyKey method
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) Set(f func(ctx context.Context, userID uint64, key string, fingerprint string, lease time.Duration) (ip1 *postgres.IdempotencyRecord, b1 bool, err error)) *RepositoryIfaceMock {
+	if mmClaimIdempotencyKey.defaultExpectation != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.ClaimIdempotencyKey method")
+	}
+
+	if len(mmClaimIdempotencyKey.expectations) > 0 {
+		mmClaimIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.ClaimIdempotencyKey method")
+	}
+
+	mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey = f
+	mmClaimIdempotencyKey.mock.funcClaimIdempotencyKeyOrigin = minimock.CallerInfo(1)
+	return mmClaimIdempotencyKey.mock
+}
+
+// When sets expectation for the RepositoryIface.ClaimIdempotencyKey which will trigger the result defined by the following
+// Then helper
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) When(ctx context.Context, userID uint64, key string, fingerprint string, lease time.Duration) *RepositoryIfaceMockClaimIdempotencyKeyExpectation {
+	if mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ClaimIdempotencyKey mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockClaimIdempotencyKeyExpectation{
+		mock:               mmClaimIdempotencyKey.mock,
+		params:             &RepositoryIfaceMockClaimIdempotencyKeyParams{ctx, userID, key, fingerprint, lease},
+		expectationOrigins: RepositoryIfaceMockClaimIdempotencyKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaimIdempotencyKey.expectations = append(mmClaimIdempotencyKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.ClaimIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockClaimIdempotencyKeyExpectation) Then(ip1 *postgres.IdempotencyRecord, b1 bool, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockClaimIdempotencyKeyResults{ip1, b1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.ClaimIdempotencyKey should be invoked
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) Times(n uint64) *mRepositoryIfaceMockClaimIdempotencyKey {
+	if n == 0 {
+		mmClaimIdempotencyKey.mock.t.Fatalf("Times of RepositoryIfaceMock.ClaimIdempotencyKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimIdempotencyKey.expectedInvocations, n)
+	mmClaimIdempotencyKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaimIdempotencyKey
+}
+
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) invocationsDone() bool {
+	if len(mmClaimIdempotencyKey.expectations) == 0 && mmClaimIdempotencyKey.defaultExpectation == nil && mmClaimIdempotencyKey.mock.funcClaimIdempotencyKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimIdempotencyKey.mock.afterClaimIdempotencyKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimIdempotencyKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimIdempotencyKey implements mm_interfaces.RepositoryIface
+func (mmClaimIdempotencyKey *RepositoryIfaceMock) ClaimIdempotencyKey(ctx context.Context, userID uint64, key string, fingerprint string, lease time.Duration) (ip1 *postgres.IdempotencyRecord, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmClaimIdempotencyKey.beforeClaimIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimIdempotencyKey.afterClaimIdempotencyKeyCounter, 1)
+
+	mmClaimIdempotencyKey.t.Helper()
+
+	if mmClaimIdempotencyKey.inspectFuncClaimIdempotencyKey != nil {
+		mmClaimIdempotencyKey.inspectFuncClaimIdempotencyKey(ctx, userID, key, fingerprint, lease)
+	}
+
+	mm_params := RepositoryIfaceMockClaimIdempotencyKeyParams{ctx, userID, key, fingerprint, lease}
+
+	// Record call args
+	mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.mutex.Lock()
+	mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.callArgs = append(mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.callArgs, &mm_params)
+	mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.mutex.Unlock()
+
+	for _, e := range mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ip1, e.results.b1, e.results.err
+		}
+	}
+
+	if mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockClaimIdempotencyKeyParams{ctx, userID, key, fingerprint, lease}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimIdempotencyKey.t.Errorf("RepositoryIfaceMock.ClaimIdempotencyKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmClaimIdempotencyKey.t.Errorf("RepositoryIfaceMock.ClaimIdempotencyKey got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmClaimIdempotencyKey.t.Errorf("RepositoryIfaceMock.ClaimIdempotencyKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.fingerprint != nil && !minimock.Equal(*mm_want_ptrs.fingerprint, mm_got.fingerprint) {
+				mmClaimIdempotencyKey.t.Errorf("RepositoryIfaceMock.ClaimIdempotencyKey got unexpected parameter fingerprint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.originFingerprint, *mm_want_ptrs.fingerprint, mm_got.fingerprint, minimock.Diff(*mm_want_ptrs.fingerprint, mm_got.fingerprint))
+			}
+
+			if mm_want_ptrs.lease != nil && !minimock.Equal(*mm_want_ptrs.lease, mm_got.lease) {
+				mmClaimIdempotencyKey.t.Errorf("RepositoryIfaceMock.ClaimIdempotencyKey got unexpected parameter lease, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.originLease, *mm_want_ptrs.lease, mm_got.lease, minimock.Diff(*mm_want_ptrs.lease, mm_got.lease))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimIdempotencyKey.t.Errorf("RepositoryIfaceMock.ClaimIdempotencyKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimIdempotencyKey.ClaimIdempotencyKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimIdempotencyKey.t.Fatal("No results are set for the RepositoryIfaceMock.ClaimIdempotencyKey")
+		}
+		return (*mm_results).ip1, (*mm_results).b1, (*mm_results).err
+	}
+	if mmClaimIdempotencyKey.funcClaimIdempotencyKey != nil {
+		return mmClaimIdempotencyKey.funcClaimIdempotencyKey(ctx, userID, key, fingerprint, lease)
+	}
+	mmClaimIdempotencyKey.t.Fatalf("Unexpected call to RepositoryIfaceMock.ClaimIdempotencyKey. %v %v %v %v %v", ctx, userID, key, fingerprint, lease)
+	return
+}
+
+// ClaimIdempotencyKeyAfterCounter returns a count of finished RepositoryIfaceMock.ClaimIdempotencyKey invocations
+func (mmClaimIdempotencyKey *RepositoryIfaceMock) ClaimIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimIdempotencyKey.afterClaimIdempotencyKeyCounter)
+}
+
+// ClaimIdempotencyKeyBeforeCounter returns a count of RepositoryIfaceMock.ClaimIdempotencyKey invocations
+func (mmClaimIdempotencyKey *RepositoryIfaceMock) ClaimIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimIdempotencyKey.beforeClaimIdempotencyKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.ClaimIdempotencyKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimIdempotencyKey *mRepositoryIfaceMockClaimIdempotencyKey) Calls() []*RepositoryIfaceMockClaimIdempotencyKeyParams {
+	mmClaimIdempotencyKey.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockClaimIdempotencyKeyParams, len(mmClaimIdempotencyKey.callArgs))
+	copy(argCopy, mmClaimIdempotencyKey.callArgs)
+
+	mmClaimIdempotencyKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimIdempotencyKeyDone returns true if the count of the ClaimIdempotencyKey invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockClaimIdempotencyKeyDone() bool {
+	if m.ClaimIdempotencyKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimIdempotencyKeyMock.invocationsDone()
+}
+
+// MinimockClaimIdempotencyKeyInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockClaimIdempotencyKeyInspect() {
+	for _, e := range m.ClaimIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ClaimIdempotencyKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimIdempotencyKeyCounter := mm_atomic.LoadUint64(&m.afterClaimIdempotencyKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimIdempotencyKeyMock.defaultExpectation != nil && afterClaimIdempotencyKeyCounter < 1 {
+		if m.ClaimIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ClaimIdempotencyKey at\n%s", m.ClaimIdempotencyKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ClaimIdempotencyKey at\n%s with params: %#v", m.ClaimIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *m.ClaimIdempotencyKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimIdempotencyKey != nil && afterClaimIdempotencyKeyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.ClaimIdempotencyKey at\n%s", m.funcClaimIdempotencyKeyOrigin)
+	}
+
+	if !m.ClaimIdempotencyKeyMock.invocationsDone() && afterClaimIdempotencyKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.ClaimIdempotencyKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimIdempotencyKeyMock.expectedInvocations), m.ClaimIdempotencyKeyMock.expectedInvocationsOrigin, afterClaimIdempotencyKeyCounter)
+	}
+}
+
+type mRepositoryIfaceMockClearCart struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockClearCartExpectation
+	expectations       []*RepositoryIfaceMockClearCartExpectation
+
+	callArgs []*RepositoryIfaceMockClearCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockClearCartExpectation specifies expectation struct of the RepositoryIface.ClearCart
+type RepositoryIfaceMockClearCartExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockClearCartParams
+	paramPtrs          *RepositoryIfaceMockClearCartParamPtrs
 	expectationOrigins RepositoryIfaceMockClearCartExpectationOrigins
 	results            *RepositoryIfaceMockClearCartResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryIfaceMockClearCartParams contains parameters of the RepositoryIface.ClearCart
-type RepositoryIfaceMockClearCartParams struct {
-	ctx    context.Context
-	userID uint64
+// RepositoryIfaceMockClearCartParams contains parameters of the RepositoryIface.ClearCart
+type RepositoryIfaceMockClearCartParams struct {
+	ctx      context.Context
+	userID   uint64
+	expected *uint64
+}
+
+// RepositoryIfaceMockClearCartParamPtrs contains pointers to parameters of the RepositoryIface.ClearCart
+type RepositoryIfaceMockClearCartParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	expected **uint64
+}
+
+// RepositoryIfaceMockClearCartResults contains results of the RepositoryIface.ClearCart
+type RepositoryIfaceMockClearCartResults struct {
+	pa1 []postgres.Position
+	err error
+}
+
+// RepositoryIfaceMockClearCartOrigins contains origins of expectations of the RepositoryIface.ClearCart
+type RepositoryIfaceMockClearCartExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClearCart *mRepositoryIfaceMockClearCart) Optional() *mRepositoryIfaceMockClearCart {
+	mmClearCart.optional = true
+	return mmClearCart
+}
+
+// Expect sets up expected params for RepositoryIface.ClearCart
+func (mmClearCart *mRepositoryIfaceMockClearCart) Expect(ctx context.Context, userID uint64, expected *uint64) *mRepositoryIfaceMockClearCart {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+	}
+
+	if mmClearCart.defaultExpectation == nil {
+		mmClearCart.defaultExpectation = &RepositoryIfaceMockClearCartExpectation{}
+	}
+
+	if mmClearCart.defaultExpectation.paramPtrs != nil {
+		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by ExpectParams functions")
+	}
+
+	mmClearCart.defaultExpectation.params = &RepositoryIfaceMockClearCartParams{ctx, userID, expected}
+	mmClearCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClearCart.expectations {
+		if minimock.Equal(e.params, mmClearCart.defaultExpectation.params) {
+			mmClearCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClearCart.defaultExpectation.params)
+		}
+	}
+
+	return mmClearCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.ClearCart
+func (mmClearCart *mRepositoryIfaceMockClearCart) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockClearCart {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+	}
+
+	if mmClearCart.defaultExpectation == nil {
+		mmClearCart.defaultExpectation = &RepositoryIfaceMockClearCartExpectation{}
+	}
+
+	if mmClearCart.defaultExpectation.params != nil {
+		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Expect")
+	}
+
+	if mmClearCart.defaultExpectation.paramPtrs == nil {
+		mmClearCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockClearCartParamPtrs{}
+	}
+	mmClearCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClearCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClearCart
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.ClearCart
+func (mmClearCart *mRepositoryIfaceMockClearCart) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockClearCart {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+	}
+
+	if mmClearCart.defaultExpectation == nil {
+		mmClearCart.defaultExpectation = &RepositoryIfaceMockClearCartExpectation{}
+	}
+
+	if mmClearCart.defaultExpectation.params != nil {
+		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Expect")
+	}
+
+	if mmClearCart.defaultExpectation.paramPtrs == nil {
+		mmClearCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockClearCartParamPtrs{}
+	}
+	mmClearCart.defaultExpectation.paramPtrs.userID = &userID
+	mmClearCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmClearCart
+}
+
+// ExpectExpectedParam3 sets up expected param expected for RepositoryIface.ClearCart
+func (mmClearCart *mRepositoryIfaceMockClearCart) ExpectExpectedParam3(expected *uint64) *mRepositoryIfaceMockClearCart {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+	}
+
+	if mmClearCart.defaultExpectation == nil {
+		mmClearCart.defaultExpectation = &RepositoryIfaceMockClearCartExpectation{}
+	}
+
+	if mmClearCart.defaultExpectation.params != nil {
+		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Expect")
+	}
+
+	if mmClearCart.defaultExpectation.paramPtrs == nil {
+		mmClearCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockClearCartParamPtrs{}
+	}
+	mmClearCart.defaultExpectation.paramPtrs.expected = &expected
+	mmClearCart.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmClearCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.ClearCart
+func (mmClearCart *mRepositoryIfaceMockClearCart) Inspect(f func(ctx context.Context, userID uint64, expected *uint64)) *mRepositoryIfaceMockClearCart {
+	if mmClearCart.mock.inspectFuncClearCart != nil {
+		mmClearCart.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.ClearCart")
+	}
+
+	mmClearCart.mock.inspectFuncClearCart = f
+
+	return mmClearCart
+}
+
+// Return sets up results that will be returned by RepositoryIface.ClearCart
+func (mmClearCart *mRepositoryIfaceMockClearCart) Return(pa1 []postgres.Position, err error) *RepositoryIfaceMock {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+	}
+
+	if mmClearCart.defaultExpectation == nil {
+		mmClearCart.defaultExpectation = &RepositoryIfaceMockClearCartExpectation{mock: mmClearCart.mock}
+	}
+	mmClearCart.defaultExpectation.results = &RepositoryIfaceMockClearCartResults{pa1, err}
+	mmClearCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClearCart.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.ClearCart method
+func (mmClearCart *mRepositoryIfaceMockClearCart) Set(f func(ctx context.Context, userID uint64, expected *uint64) (pa1 []postgres.Position, err error)) *RepositoryIfaceMock {
+	if mmClearCart.defaultExpectation != nil {
+		mmClearCart.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.ClearCart method")
+	}
+
+	if len(mmClearCart.expectations) > 0 {
+		mmClearCart.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.ClearCart method")
+	}
+
+	mmClearCart.mock.funcClearCart = f
+	mmClearCart.mock.funcClearCartOrigin = minimock.CallerInfo(1)
+	return mmClearCart.mock
+}
+
+// When sets expectation for the RepositoryIface.ClearCart which will trigger the result defined by the following
+// Then helper
+func (mmClearCart *mRepositoryIfaceMockClearCart) When(ctx context.Context, userID uint64, expected *uint64) *RepositoryIfaceMockClearCartExpectation {
+	if mmClearCart.mock.funcClearCart != nil {
+		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockClearCartExpectation{
+		mock:               mmClearCart.mock,
+		params:             &RepositoryIfaceMockClearCartParams{ctx, userID, expected},
+		expectationOrigins: RepositoryIfaceMockClearCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClearCart.expectations = append(mmClearCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.ClearCart return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockClearCartExpectation) Then(pa1 []postgres.Position, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockClearCartResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.ClearCart should be invoked
+func (mmClearCart *mRepositoryIfaceMockClearCart) Times(n uint64) *mRepositoryIfaceMockClearCart {
+	if n == 0 {
+		mmClearCart.mock.t.Fatalf("Times of RepositoryIfaceMock.ClearCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClearCart.expectedInvocations, n)
+	mmClearCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClearCart
+}
+
+func (mmClearCart *mRepositoryIfaceMockClearCart) invocationsDone() bool {
+	if len(mmClearCart.expectations) == 0 && mmClearCart.defaultExpectation == nil && mmClearCart.mock.funcClearCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClearCart.mock.afterClearCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClearCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClearCart implements mm_interfaces.RepositoryIface
+func (mmClearCart *RepositoryIfaceMock) ClearCart(ctx context.Context, userID uint64, expected *uint64) (pa1 []postgres.Position, err error) {
+	mm_atomic.AddUint64(&mmClearCart.beforeClearCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmClearCart.afterClearCartCounter, 1)
+
+	mmClearCart.t.Helper()
+
+	if mmClearCart.inspectFuncClearCart != nil {
+		mmClearCart.inspectFuncClearCart(ctx, userID, expected)
+	}
+
+	mm_params := RepositoryIfaceMockClearCartParams{ctx, userID, expected}
+
+	// Record call args
+	mmClearCart.ClearCartMock.mutex.Lock()
+	mmClearCart.ClearCartMock.callArgs = append(mmClearCart.ClearCartMock.callArgs, &mm_params)
+	mmClearCart.ClearCartMock.mutex.Unlock()
+
+	for _, e := range mmClearCart.ClearCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmClearCart.ClearCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClearCart.ClearCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmClearCart.ClearCartMock.defaultExpectation.params
+		mm_want_ptrs := mmClearCart.ClearCartMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockClearCartParams{ctx, userID, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClearCart.t.Errorf("RepositoryIfaceMock.ClearCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmClearCart.t.Errorf("RepositoryIfaceMock.ClearCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmClearCart.t.Errorf("RepositoryIfaceMock.ClearCart got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClearCart.t.Errorf("RepositoryIfaceMock.ClearCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClearCart.ClearCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClearCart.t.Fatal("No results are set for the RepositoryIfaceMock.ClearCart")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmClearCart.funcClearCart != nil {
+		return mmClearCart.funcClearCart(ctx, userID, expected)
+	}
+	mmClearCart.t.Fatalf("Unexpected call to RepositoryIfaceMock.ClearCart. %v %v %v", ctx, userID, expected)
+	return
+}
+
+// ClearCartAfterCounter returns a count of finished RepositoryIfaceMock.ClearCart invocations
+func (mmClearCart *RepositoryIfaceMock) ClearCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClearCart.afterClearCartCounter)
+}
+
+// ClearCartBeforeCounter returns a count of RepositoryIfaceMock.ClearCart invocations
+func (mmClearCart *RepositoryIfaceMock) ClearCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClearCart.beforeClearCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.ClearCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClearCart *mRepositoryIfaceMockClearCart) Calls() []*RepositoryIfaceMockClearCartParams {
+	mmClearCart.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockClearCartParams, len(mmClearCart.callArgs))
+	copy(argCopy, mmClearCart.callArgs)
+
+	mmClearCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClearCartDone returns true if the count of the ClearCart invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockClearCartDone() bool {
+	if m.ClearCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClearCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClearCartMock.invocationsDone()
+}
+
+// MinimockClearCartInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockClearCartInspect() {
+	for _, e := range m.ClearCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ClearCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClearCartCounter := mm_atomic.LoadUint64(&m.afterClearCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClearCartMock.defaultExpectation != nil && afterClearCartCounter < 1 {
+		if m.ClearCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ClearCart at\n%s", m.ClearCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ClearCart at\n%s with params: %#v", m.ClearCartMock.defaultExpectation.expectationOrigins.origin, *m.ClearCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClearCart != nil && afterClearCartCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.ClearCart at\n%s", m.funcClearCartOrigin)
+	}
+
+	if !m.ClearCartMock.invocationsDone() && afterClearCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.ClearCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClearCartMock.expectedInvocations), m.ClearCartMock.expectedInvocationsOrigin, afterClearCartCounter)
+	}
+}
+
+type mRepositoryIfaceMockCreateCartShare struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockCreateCartShareExpectation
+	expectations       []*RepositoryIfaceMockCreateCartShareExpectation
+
+	callArgs []*RepositoryIfaceMockCreateCartShareParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockCreateCartShareExpectation specifies expectation struct of the RepositoryIface.CreateCartShare
+type RepositoryIfaceMockCreateCartShareExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockCreateCartShareParams
+	paramPtrs          *RepositoryIfaceMockCreateCartShareParamPtrs
+	expectationOrigins RepositoryIfaceMockCreateCartShareExpectationOrigins
+	results            *RepositoryIfaceMockCreateCartShareResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockCreateCartShareParams contains parameters of the RepositoryIface.CreateCartShare
+type RepositoryIfaceMockCreateCartShareParams struct {
+	ctx       context.Context
+	userID    uint64
+	items     []postgres.OrderItem
+	total     money.Money
+	expiresAt time.Time
+}
+
+// RepositoryIfaceMockCreateCartShareParamPtrs contains pointers to parameters of the RepositoryIface.CreateCartShare
+type RepositoryIfaceMockCreateCartShareParamPtrs struct {
+	ctx       *context.Context
+	userID    *uint64
+	items     *[]postgres.OrderItem
+	total     *money.Money
+	expiresAt *time.Time
+}
+
+// RepositoryIfaceMockCreateCartShareResults contains results of the RepositoryIface.CreateCartShare
+type RepositoryIfaceMockCreateCartShareResults struct {
+	u1  uint64
+	err error
+}
+
+// RepositoryIfaceMockCreateCartShareOrigins contains origins of expectations of the RepositoryIface.CreateCartShare
+type RepositoryIfaceMockCreateCartShareExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originItems     string
+	originTotal     string
+	originExpiresAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) Optional() *mRepositoryIfaceMockCreateCartShare {
+	mmCreateCartShare.optional = true
+	return mmCreateCartShare
+}
+
+// Expect sets up expected params for RepositoryIface.CreateCartShare
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) Expect(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expiresAt time.Time) *mRepositoryIfaceMockCreateCartShare {
+	if mmCreateCartShare.mock.funcCreateCartShare != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Set")
+	}
+
+	if mmCreateCartShare.defaultExpectation == nil {
+		mmCreateCartShare.defaultExpectation = &RepositoryIfaceMockCreateCartShareExpectation{}
+	}
+
+	if mmCreateCartShare.defaultExpectation.paramPtrs != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by ExpectParams functions")
+	}
+
+	mmCreateCartShare.defaultExpectation.params = &RepositoryIfaceMockCreateCartShareParams{ctx, userID, items, total, expiresAt}
+	mmCreateCartShare.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateCartShare.expectations {
+		if minimock.Equal(e.params, mmCreateCartShare.defaultExpectation.params) {
+			mmCreateCartShare.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateCartShare.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateCartShare
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.CreateCartShare
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockCreateCartShare {
+	if mmCreateCartShare.mock.funcCreateCartShare != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Set")
+	}
+
+	if mmCreateCartShare.defaultExpectation == nil {
+		mmCreateCartShare.defaultExpectation = &RepositoryIfaceMockCreateCartShareExpectation{}
+	}
+
+	if mmCreateCartShare.defaultExpectation.params != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Expect")
+	}
+
+	if mmCreateCartShare.defaultExpectation.paramPtrs == nil {
+		mmCreateCartShare.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateCartShareParamPtrs{}
+	}
+	mmCreateCartShare.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateCartShare.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateCartShare
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.CreateCartShare
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockCreateCartShare {
+	if mmCreateCartShare.mock.funcCreateCartShare != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Set")
+	}
+
+	if mmCreateCartShare.defaultExpectation == nil {
+		mmCreateCartShare.defaultExpectation = &RepositoryIfaceMockCreateCartShareExpectation{}
+	}
+
+	if mmCreateCartShare.defaultExpectation.params != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Expect")
+	}
+
+	if mmCreateCartShare.defaultExpectation.paramPtrs == nil {
+		mmCreateCartShare.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateCartShareParamPtrs{}
+	}
+	mmCreateCartShare.defaultExpectation.paramPtrs.userID = &userID
+	mmCreateCartShare.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCreateCartShare
+}
+
+// ExpectItemsParam3 sets up expected param items for RepositoryIface.CreateCartShare
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) ExpectItemsParam3(items []postgres.OrderItem) *mRepositoryIfaceMockCreateCartShare {
+	if mmCreateCartShare.mock.funcCreateCartShare != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Set")
+	}
+
+	if mmCreateCartShare.defaultExpectation == nil {
+		mmCreateCartShare.defaultExpectation = &RepositoryIfaceMockCreateCartShareExpectation{}
+	}
+
+	if mmCreateCartShare.defaultExpectation.params != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Expect")
+	}
+
+	if mmCreateCartShare.defaultExpectation.paramPtrs == nil {
+		mmCreateCartShare.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateCartShareParamPtrs{}
+	}
+	mmCreateCartShare.defaultExpectation.paramPtrs.items = &items
+	mmCreateCartShare.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmCreateCartShare
+}
+
+// ExpectTotalParam4 sets up expected param total for RepositoryIface.CreateCartShare
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) ExpectTotalParam4(total money.Money) *mRepositoryIfaceMockCreateCartShare {
+	if mmCreateCartShare.mock.funcCreateCartShare != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Set")
+	}
+
+	if mmCreateCartShare.defaultExpectation == nil {
+		mmCreateCartShare.defaultExpectation = &RepositoryIfaceMockCreateCartShareExpectation{}
+	}
+
+	if mmCreateCartShare.defaultExpectation.params != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Expect")
+	}
+
+	if mmCreateCartShare.defaultExpectation.paramPtrs == nil {
+		mmCreateCartShare.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateCartShareParamPtrs{}
+	}
+	mmCreateCartShare.defaultExpectation.paramPtrs.total = &total
+	mmCreateCartShare.defaultExpectation.expectationOrigins.originTotal = minimock.CallerInfo(1)
+
+	return mmCreateCartShare
+}
+
+// ExpectExpiresAtParam5 sets up expected param expiresAt for RepositoryIface.CreateCartShare
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) ExpectExpiresAtParam5(expiresAt time.Time) *mRepositoryIfaceMockCreateCartShare {
+	if mmCreateCartShare.mock.funcCreateCartShare != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Set")
+	}
+
+	if mmCreateCartShare.defaultExpectation == nil {
+		mmCreateCartShare.defaultExpectation = &RepositoryIfaceMockCreateCartShareExpectation{}
+	}
+
+	if mmCreateCartShare.defaultExpectation.params != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Expect")
+	}
+
+	if mmCreateCartShare.defaultExpectation.paramPtrs == nil {
+		mmCreateCartShare.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateCartShareParamPtrs{}
+	}
+	mmCreateCartShare.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+	mmCreateCartShare.defaultExpectation.expectationOrigins.originExpiresAt = minimock.CallerInfo(1)
+
+	return mmCreateCartShare
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.CreateCartShare
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) Inspect(f func(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expiresAt time.Time)) *mRepositoryIfaceMockCreateCartShare {
+	if mmCreateCartShare.mock.inspectFuncCreateCartShare != nil {
+		mmCreateCartShare.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.CreateCartShare")
+	}
+
+	mmCreateCartShare.mock.inspectFuncCreateCartShare = f
+
+	return mmCreateCartShare
+}
+
+// Return sets up results that will be returned by RepositoryIface.CreateCartShare
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) Return(u1 uint64, err error) *RepositoryIfaceMock {
+	if mmCreateCartShare.mock.funcCreateCartShare != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Set")
+	}
+
+	if mmCreateCartShare.defaultExpectation == nil {
+		mmCreateCartShare.defaultExpectation = &RepositoryIfaceMockCreateCartShareExpectation{mock: mmCreateCartShare.mock}
+	}
+	mmCreateCartShare.defaultExpectation.results = &RepositoryIfaceMockCreateCartShareResults{u1, err}
+	mmCreateCartShare.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateCartShare.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.CreateCartShare method
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) Set(f func(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expiresAt time.Time) (u1 uint64, err error)) *RepositoryIfaceMock {
+	if mmCreateCartShare.defaultExpectation != nil {
+		mmCreateCartShare.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.CreateCartShare method")
+	}
+
+	if len(mmCreateCartShare.expectations) > 0 {
+		mmCreateCartShare.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.CreateCartShare method")
+	}
+
+	mmCreateCartShare.mock.funcCreateCartShare = f
+	mmCreateCartShare.mock.funcCreateCartShareOrigin = minimock.CallerInfo(1)
+	return mmCreateCartShare.mock
+}
+
+// When sets expectation for the RepositoryIface.CreateCartShare which will trigger the result defined by the following
+// Then helper
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) When(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expiresAt time.Time) *RepositoryIfaceMockCreateCartShareExpectation {
+	if mmCreateCartShare.mock.funcCreateCartShare != nil {
+		mmCreateCartShare.mock.t.Fatalf("RepositoryIfaceMock.CreateCartShare mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockCreateCartShareExpectation{
+		mock:               mmCreateCartShare.mock,
+		params:             &RepositoryIfaceMockCreateCartShareParams{ctx, userID, items, total, expiresAt},
+		expectationOrigins: RepositoryIfaceMockCreateCartShareExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateCartShare.expectations = append(mmCreateCartShare.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.CreateCartShare return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockCreateCartShareExpectation) Then(u1 uint64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockCreateCartShareResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.CreateCartShare should be invoked
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) Times(n uint64) *mRepositoryIfaceMockCreateCartShare {
+	if n == 0 {
+		mmCreateCartShare.mock.t.Fatalf("Times of RepositoryIfaceMock.CreateCartShare mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateCartShare.expectedInvocations, n)
+	mmCreateCartShare.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateCartShare
+}
+
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) invocationsDone() bool {
+	if len(mmCreateCartShare.expectations) == 0 && mmCreateCartShare.defaultExpectation == nil && mmCreateCartShare.mock.funcCreateCartShare == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateCartShare.mock.afterCreateCartShareCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateCartShare.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateCartShare implements mm_interfaces.RepositoryIface
+func (mmCreateCartShare *RepositoryIfaceMock) CreateCartShare(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expiresAt time.Time) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmCreateCartShare.beforeCreateCartShareCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateCartShare.afterCreateCartShareCounter, 1)
+
+	mmCreateCartShare.t.Helper()
+
+	if mmCreateCartShare.inspectFuncCreateCartShare != nil {
+		mmCreateCartShare.inspectFuncCreateCartShare(ctx, userID, items, total, expiresAt)
+	}
+
+	mm_params := RepositoryIfaceMockCreateCartShareParams{ctx, userID, items, total, expiresAt}
+
+	// Record call args
+	mmCreateCartShare.CreateCartShareMock.mutex.Lock()
+	mmCreateCartShare.CreateCartShareMock.callArgs = append(mmCreateCartShare.CreateCartShareMock.callArgs, &mm_params)
+	mmCreateCartShare.CreateCartShareMock.mutex.Unlock()
+
+	for _, e := range mmCreateCartShare.CreateCartShareMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmCreateCartShare.CreateCartShareMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateCartShare.CreateCartShareMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateCartShare.CreateCartShareMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateCartShare.CreateCartShareMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockCreateCartShareParams{ctx, userID, items, total, expiresAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateCartShare.t.Errorf("RepositoryIfaceMock.CreateCartShare got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCartShare.CreateCartShareMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCreateCartShare.t.Errorf("RepositoryIfaceMock.CreateCartShare got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCartShare.CreateCartShareMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmCreateCartShare.t.Errorf("RepositoryIfaceMock.CreateCartShare got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCartShare.CreateCartShareMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+			if mm_want_ptrs.total != nil && !minimock.Equal(*mm_want_ptrs.total, mm_got.total) {
+				mmCreateCartShare.t.Errorf("RepositoryIfaceMock.CreateCartShare got unexpected parameter total, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCartShare.CreateCartShareMock.defaultExpectation.expectationOrigins.originTotal, *mm_want_ptrs.total, mm_got.total, minimock.Diff(*mm_want_ptrs.total, mm_got.total))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmCreateCartShare.t.Errorf("RepositoryIfaceMock.CreateCartShare got unexpected parameter expiresAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCartShare.CreateCartShareMock.defaultExpectation.expectationOrigins.originExpiresAt, *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateCartShare.t.Errorf("RepositoryIfaceMock.CreateCartShare got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateCartShare.CreateCartShareMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateCartShare.CreateCartShareMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateCartShare.t.Fatal("No results are set for the RepositoryIfaceMock.CreateCartShare")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmCreateCartShare.funcCreateCartShare != nil {
+		return mmCreateCartShare.funcCreateCartShare(ctx, userID, items, total, expiresAt)
+	}
+	mmCreateCartShare.t.Fatalf("Unexpected call to RepositoryIfaceMock.CreateCartShare. %v %v %v %v %v", ctx, userID, items, total, expiresAt)
+	return
+}
+
+// CreateCartShareAfterCounter returns a count of finished RepositoryIfaceMock.CreateCartShare invocations
+func (mmCreateCartShare *RepositoryIfaceMock) CreateCartShareAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateCartShare.afterCreateCartShareCounter)
+}
+
+// CreateCartShareBeforeCounter returns a count of RepositoryIfaceMock.CreateCartShare invocations
+func (mmCreateCartShare *RepositoryIfaceMock) CreateCartShareBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateCartShare.beforeCreateCartShareCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.CreateCartShare.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateCartShare *mRepositoryIfaceMockCreateCartShare) Calls() []*RepositoryIfaceMockCreateCartShareParams {
+	mmCreateCartShare.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockCreateCartShareParams, len(mmCreateCartShare.callArgs))
+	copy(argCopy, mmCreateCartShare.callArgs)
+
+	mmCreateCartShare.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateCartShareDone returns true if the count of the CreateCartShare invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockCreateCartShareDone() bool {
+	if m.CreateCartShareMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateCartShareMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateCartShareMock.invocationsDone()
+}
+
+// MinimockCreateCartShareInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockCreateCartShareInspect() {
+	for _, e := range m.CreateCartShareMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreateCartShare at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCartShareCounter := mm_atomic.LoadUint64(&m.afterCreateCartShareCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateCartShareMock.defaultExpectation != nil && afterCreateCartShareCounter < 1 {
+		if m.CreateCartShareMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreateCartShare at\n%s", m.CreateCartShareMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreateCartShare at\n%s with params: %#v", m.CreateCartShareMock.defaultExpectation.expectationOrigins.origin, *m.CreateCartShareMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateCartShare != nil && afterCreateCartShareCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.CreateCartShare at\n%s", m.funcCreateCartShareOrigin)
+	}
+
+	if !m.CreateCartShareMock.invocationsDone() && afterCreateCartShareCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.CreateCartShare at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateCartShareMock.expectedInvocations), m.CreateCartShareMock.expectedInvocationsOrigin, afterCreateCartShareCounter)
+	}
+}
+
+type mRepositoryIfaceMockCreateGuestCart struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockCreateGuestCartExpectation
+	expectations       []*RepositoryIfaceMockCreateGuestCartExpectation
+
+	callArgs []*RepositoryIfaceMockCreateGuestCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockCreateGuestCartExpectation specifies expectation struct of the RepositoryIface.CreateGuestCart
+type RepositoryIfaceMockCreateGuestCartExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockCreateGuestCartParams
+	paramPtrs          *RepositoryIfaceMockCreateGuestCartParamPtrs
+	expectationOrigins RepositoryIfaceMockCreateGuestCartExpectationOrigins
+	results            *RepositoryIfaceMockCreateGuestCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockCreateGuestCartParams contains parameters of the RepositoryIface.CreateGuestCart
+type RepositoryIfaceMockCreateGuestCartParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// RepositoryIfaceMockCreateGuestCartParamPtrs contains pointers to parameters of the RepositoryIface.CreateGuestCart
+type RepositoryIfaceMockCreateGuestCartParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// RepositoryIfaceMockCreateGuestCartResults contains results of the RepositoryIface.CreateGuestCart
+type RepositoryIfaceMockCreateGuestCartResults struct {
+	u1  uint64
+	err error
+}
+
+// RepositoryIfaceMockCreateGuestCartOrigins contains origins of expectations of the RepositoryIface.CreateGuestCart
+type RepositoryIfaceMockCreateGuestCartExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) Optional() *mRepositoryIfaceMockCreateGuestCart {
+	mmCreateGuestCart.optional = true
+	return mmCreateGuestCart
+}
+
+// Expect sets up expected params for RepositoryIface.CreateGuestCart
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) Expect(ctx context.Context, tokenHash string) *mRepositoryIfaceMockCreateGuestCart {
+	if mmCreateGuestCart.mock.funcCreateGuestCart != nil {
+		mmCreateGuestCart.mock.t.Fatalf("RepositoryIfaceMock.CreateGuestCart mock is already set by Set")
+	}
+
+	if mmCreateGuestCart.defaultExpectation == nil {
+		mmCreateGuestCart.defaultExpectation = &RepositoryIfaceMockCreateGuestCartExpectation{}
+	}
+
+	if mmCreateGuestCart.defaultExpectation.paramPtrs != nil {
+		mmCreateGuestCart.mock.t.Fatalf("RepositoryIfaceMock.CreateGuestCart mock is already set by ExpectParams functions")
+	}
+
+	mmCreateGuestCart.defaultExpectation.params = &RepositoryIfaceMockCreateGuestCartParams{ctx, tokenHash}
+	mmCreateGuestCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateGuestCart.expectations {
+		if minimock.Equal(e.params, mmCreateGuestCart.defaultExpectation.params) {
+			mmCreateGuestCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateGuestCart.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateGuestCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.CreateGuestCart
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockCreateGuestCart {
+	if mmCreateGuestCart.mock.funcCreateGuestCart != nil {
+		mmCreateGuestCart.mock.t.Fatalf("RepositoryIfaceMock.CreateGuestCart mock is already set by Set")
+	}
+
+	if mmCreateGuestCart.defaultExpectation == nil {
+		mmCreateGuestCart.defaultExpectation = &RepositoryIfaceMockCreateGuestCartExpectation{}
+	}
+
+	if mmCreateGuestCart.defaultExpectation.params != nil {
+		mmCreateGuestCart.mock.t.Fatalf("RepositoryIfaceMock.CreateGuestCart mock is already set by Expect")
+	}
+
+	if mmCreateGuestCart.defaultExpectation.paramPtrs == nil {
+		mmCreateGuestCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateGuestCartParamPtrs{}
+	}
+	mmCreateGuestCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateGuestCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateGuestCart
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for RepositoryIface.CreateGuestCart
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) ExpectTokenHashParam2(tokenHash string) *mRepositoryIfaceMockCreateGuestCart {
+	if mmCreateGuestCart.mock.funcCreateGuestCart != nil {
+		mmCreateGuestCart.mock.t.Fatalf("RepositoryIfaceMock.CreateGuestCart mock is already set by Set")
+	}
+
+	if mmCreateGuestCart.defaultExpectation == nil {
+		mmCreateGuestCart.defaultExpectation = &RepositoryIfaceMockCreateGuestCartExpectation{}
+	}
+
+	if mmCreateGuestCart.defaultExpectation.params != nil {
+		mmCreateGuestCart.mock.t.Fatalf("RepositoryIfaceMock.CreateGuestCart mock is already set by Expect")
+	}
+
+	if mmCreateGuestCart.defaultExpectation.paramPtrs == nil {
+		mmCreateGuestCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateGuestCartParamPtrs{}
+	}
+	mmCreateGuestCart.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmCreateGuestCart.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmCreateGuestCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.CreateGuestCart
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) Inspect(f func(ctx context.Context, tokenHash string)) *mRepositoryIfaceMockCreateGuestCart {
+	if mmCreateGuestCart.mock.inspectFuncCreateGuestCart != nil {
+		mmCreateGuestCart.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.CreateGuestCart")
+	}
+
+	mmCreateGuestCart.mock.inspectFuncCreateGuestCart = f
+
+	return mmCreateGuestCart
+}
+
+// Return sets up results that will be returned by RepositoryIface.CreateGuestCart
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) Return(u1 uint64, err error) *RepositoryIfaceMock {
+	if mmCreateGuestCart.mock.funcCreateGuestCart != nil {
+		mmCreateGuestCart.mock.t.Fatalf("RepositoryIfaceMock.CreateGuestCart mock is already set by Set")
+	}
+
+	if mmCreateGuestCart.defaultExpectation == nil {
+		mmCreateGuestCart.defaultExpectation = &RepositoryIfaceMockCreateGuestCartExpectation{mock: mmCreateGuestCart.mock}
+	}
+	mmCreateGuestCart.defaultExpectation.results = &RepositoryIfaceMockCreateGuestCartResults{u1, err}
+	mmCreateGuestCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateGuestCart.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.CreateGuestCart method
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) Set(f func(ctx context.Context, tokenHash string) (u1 uint64, err error)) *RepositoryIfaceMock {
+	if mmCreateGuestCart.defaultExpectation != nil {
+		mmCreateGuestCart.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.CreateGuestCart method")
+	}
+
+	if len(mmCreateGuestCart.expectations) > 0 {
+		mmCreateGuestCart.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.CreateGuestCart method")
+	}
+
+	mmCreateGuestCart.mock.funcCreateGuestCart = f
+	mmCreateGuestCart.mock.funcCreateGuestCartOrigin = minimock.CallerInfo(1)
+	return mmCreateGuestCart.mock
+}
+
+// When sets expectation for the RepositoryIface.CreateGuestCart which will trigger the result defined by the following
+// Then helper
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) When(ctx context.Context, tokenHash string) *RepositoryIfaceMockCreateGuestCartExpectation {
+	if mmCreateGuestCart.mock.funcCreateGuestCart != nil {
+		mmCreateGuestCart.mock.t.Fatalf("RepositoryIfaceMock.CreateGuestCart mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockCreateGuestCartExpectation{
+		mock:               mmCreateGuestCart.mock,
+		params:             &RepositoryIfaceMockCreateGuestCartParams{ctx, tokenHash},
+		expectationOrigins: RepositoryIfaceMockCreateGuestCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateGuestCart.expectations = append(mmCreateGuestCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.CreateGuestCart return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockCreateGuestCartExpectation) Then(u1 uint64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockCreateGuestCartResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.CreateGuestCart should be invoked
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) Times(n uint64) *mRepositoryIfaceMockCreateGuestCart {
+	if n == 0 {
+		mmCreateGuestCart.mock.t.Fatalf("Times of RepositoryIfaceMock.CreateGuestCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateGuestCart.expectedInvocations, n)
+	mmCreateGuestCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateGuestCart
+}
+
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) invocationsDone() bool {
+	if len(mmCreateGuestCart.expectations) == 0 && mmCreateGuestCart.defaultExpectation == nil && mmCreateGuestCart.mock.funcCreateGuestCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateGuestCart.mock.afterCreateGuestCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateGuestCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateGuestCart implements mm_interfaces.RepositoryIface
+func (mmCreateGuestCart *RepositoryIfaceMock) CreateGuestCart(ctx context.Context, tokenHash string) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmCreateGuestCart.beforeCreateGuestCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateGuestCart.afterCreateGuestCartCounter, 1)
+
+	mmCreateGuestCart.t.Helper()
+
+	if mmCreateGuestCart.inspectFuncCreateGuestCart != nil {
+		mmCreateGuestCart.inspectFuncCreateGuestCart(ctx, tokenHash)
+	}
+
+	mm_params := RepositoryIfaceMockCreateGuestCartParams{ctx, tokenHash}
+
+	// Record call args
+	mmCreateGuestCart.CreateGuestCartMock.mutex.Lock()
+	mmCreateGuestCart.CreateGuestCartMock.callArgs = append(mmCreateGuestCart.CreateGuestCartMock.callArgs, &mm_params)
+	mmCreateGuestCart.CreateGuestCartMock.mutex.Unlock()
+
+	for _, e := range mmCreateGuestCart.CreateGuestCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmCreateGuestCart.CreateGuestCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateGuestCart.CreateGuestCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateGuestCart.CreateGuestCartMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateGuestCart.CreateGuestCartMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockCreateGuestCartParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateGuestCart.t.Errorf("RepositoryIfaceMock.CreateGuestCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateGuestCart.CreateGuestCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmCreateGuestCart.t.Errorf("RepositoryIfaceMock.CreateGuestCart got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateGuestCart.CreateGuestCartMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateGuestCart.t.Errorf("RepositoryIfaceMock.CreateGuestCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateGuestCart.CreateGuestCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateGuestCart.CreateGuestCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateGuestCart.t.Fatal("No results are set for the RepositoryIfaceMock.CreateGuestCart")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmCreateGuestCart.funcCreateGuestCart != nil {
+		return mmCreateGuestCart.funcCreateGuestCart(ctx, tokenHash)
+	}
+	mmCreateGuestCart.t.Fatalf("Unexpected call to RepositoryIfaceMock.CreateGuestCart. %v %v", ctx, tokenHash)
+	return
+}
+
+// CreateGuestCartAfterCounter returns a count of finished RepositoryIfaceMock.CreateGuestCart invocations
+func (mmCreateGuestCart *RepositoryIfaceMock) CreateGuestCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateGuestCart.afterCreateGuestCartCounter)
+}
+
+// CreateGuestCartBeforeCounter returns a count of RepositoryIfaceMock.CreateGuestCart invocations
+func (mmCreateGuestCart *RepositoryIfaceMock) CreateGuestCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateGuestCart.beforeCreateGuestCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.CreateGuestCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateGuestCart *mRepositoryIfaceMockCreateGuestCart) Calls() []*RepositoryIfaceMockCreateGuestCartParams {
+	mmCreateGuestCart.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockCreateGuestCartParams, len(mmCreateGuestCart.callArgs))
+	copy(argCopy, mmCreateGuestCart.callArgs)
+
+	mmCreateGuestCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateGuestCartDone returns true if the count of the CreateGuestCart invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockCreateGuestCartDone() bool {
+	if m.CreateGuestCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateGuestCartMock.invocationsDone()
+}
+
+// MinimockCreateGuestCartInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockCreateGuestCartInspect() {
+	for _, e := range m.CreateGuestCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreateGuestCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateGuestCartCounter := mm_atomic.LoadUint64(&m.afterCreateGuestCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateGuestCartMock.defaultExpectation != nil && afterCreateGuestCartCounter < 1 {
+		if m.CreateGuestCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreateGuestCart at\n%s", m.CreateGuestCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreateGuestCart at\n%s with params: %#v", m.CreateGuestCartMock.defaultExpectation.expectationOrigins.origin, *m.CreateGuestCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateGuestCart != nil && afterCreateGuestCartCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.CreateGuestCart at\n%s", m.funcCreateGuestCartOrigin)
+	}
+
+	if !m.CreateGuestCartMock.invocationsDone() && afterCreateGuestCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.CreateGuestCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateGuestCartMock.expectedInvocations), m.CreateGuestCartMock.expectedInvocationsOrigin, afterCreateGuestCartCounter)
+	}
+}
+
+type mRepositoryIfaceMockCreateOrder struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockCreateOrderExpectation
+	expectations       []*RepositoryIfaceMockCreateOrderExpectation
+
+	callArgs []*RepositoryIfaceMockCreateOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockCreateOrderExpectation specifies expectation struct of the RepositoryIface.CreateOrder
+type RepositoryIfaceMockCreateOrderExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockCreateOrderParams
+	paramPtrs          *RepositoryIfaceMockCreateOrderParamPtrs
+	expectationOrigins RepositoryIfaceMockCreateOrderExpectationOrigins
+	results            *RepositoryIfaceMockCreateOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockCreateOrderParams contains parameters of the RepositoryIface.CreateOrder
+type RepositoryIfaceMockCreateOrderParams struct {
+	ctx      context.Context
+	userID   uint64
+	items    []postgres.OrderItem
+	total    money.Money
+	expected *uint64
+}
+
+// RepositoryIfaceMockCreateOrderParamPtrs contains pointers to parameters of the RepositoryIface.CreateOrder
+type RepositoryIfaceMockCreateOrderParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	items    *[]postgres.OrderItem
+	total    *money.Money
+	expected **uint64
+}
+
+// RepositoryIfaceMockCreateOrderResults contains results of the RepositoryIface.CreateOrder
+type RepositoryIfaceMockCreateOrderResults struct {
+	u1  uint64
+	err error
+}
+
+// RepositoryIfaceMockCreateOrderOrigins contains origins of expectations of the RepositoryIface.CreateOrder
+type RepositoryIfaceMockCreateOrderExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originItems    string
+	originTotal    string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) Optional() *mRepositoryIfaceMockCreateOrder {
+	mmCreateOrder.optional = true
+	return mmCreateOrder
+}
+
+// Expect sets up expected params for RepositoryIface.CreateOrder
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) Expect(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expected *uint64) *mRepositoryIfaceMockCreateOrder {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Set")
+	}
+
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &RepositoryIfaceMockCreateOrderExpectation{}
+	}
+
+	if mmCreateOrder.defaultExpectation.paramPtrs != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by ExpectParams functions")
+	}
+
+	mmCreateOrder.defaultExpectation.params = &RepositoryIfaceMockCreateOrderParams{ctx, userID, items, total, expected}
+	mmCreateOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateOrder.expectations {
+		if minimock.Equal(e.params, mmCreateOrder.defaultExpectation.params) {
+			mmCreateOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.CreateOrder
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockCreateOrder {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Set")
+	}
+
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &RepositoryIfaceMockCreateOrderExpectation{}
+	}
+
+	if mmCreateOrder.defaultExpectation.params != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Expect")
+	}
+
+	if mmCreateOrder.defaultExpectation.paramPtrs == nil {
+		mmCreateOrder.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateOrderParamPtrs{}
+	}
+	mmCreateOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateOrder
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.CreateOrder
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockCreateOrder {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Set")
+	}
+
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &RepositoryIfaceMockCreateOrderExpectation{}
+	}
+
+	if mmCreateOrder.defaultExpectation.params != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Expect")
+	}
+
+	if mmCreateOrder.defaultExpectation.paramPtrs == nil {
+		mmCreateOrder.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateOrderParamPtrs{}
+	}
+	mmCreateOrder.defaultExpectation.paramPtrs.userID = &userID
+	mmCreateOrder.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCreateOrder
+}
+
+// ExpectItemsParam3 sets up expected param items for RepositoryIface.CreateOrder
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) ExpectItemsParam3(items []postgres.OrderItem) *mRepositoryIfaceMockCreateOrder {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Set")
+	}
+
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &RepositoryIfaceMockCreateOrderExpectation{}
+	}
+
+	if mmCreateOrder.defaultExpectation.params != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Expect")
+	}
+
+	if mmCreateOrder.defaultExpectation.paramPtrs == nil {
+		mmCreateOrder.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateOrderParamPtrs{}
+	}
+	mmCreateOrder.defaultExpectation.paramPtrs.items = &items
+	mmCreateOrder.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmCreateOrder
+}
+
+// ExpectTotalParam4 sets up expected param total for RepositoryIface.CreateOrder
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) ExpectTotalParam4(total money.Money) *mRepositoryIfaceMockCreateOrder {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Set")
+	}
+
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &RepositoryIfaceMockCreateOrderExpectation{}
+	}
+
+	if mmCreateOrder.defaultExpectation.params != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Expect")
+	}
+
+	if mmCreateOrder.defaultExpectation.paramPtrs == nil {
+		mmCreateOrder.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateOrderParamPtrs{}
+	}
+	mmCreateOrder.defaultExpectation.paramPtrs.total = &total
+	mmCreateOrder.defaultExpectation.expectationOrigins.originTotal = minimock.CallerInfo(1)
+
+	return mmCreateOrder
+}
+
+// ExpectExpectedParam5 sets up expected param expected for RepositoryIface.CreateOrder
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) ExpectExpectedParam5(expected *uint64) *mRepositoryIfaceMockCreateOrder {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Set")
+	}
+
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &RepositoryIfaceMockCreateOrderExpectation{}
+	}
+
+	if mmCreateOrder.defaultExpectation.params != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Expect")
+	}
+
+	if mmCreateOrder.defaultExpectation.paramPtrs == nil {
+		mmCreateOrder.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreateOrderParamPtrs{}
+	}
+	mmCreateOrder.defaultExpectation.paramPtrs.expected = &expected
+	mmCreateOrder.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmCreateOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.CreateOrder
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) Inspect(f func(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expected *uint64)) *mRepositoryIfaceMockCreateOrder {
+	if mmCreateOrder.mock.inspectFuncCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.CreateOrder")
+	}
+
+	mmCreateOrder.mock.inspectFuncCreateOrder = f
+
+	return mmCreateOrder
+}
+
+// Return sets up results that will be returned by RepositoryIface.CreateOrder
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) Return(u1 uint64, err error) *RepositoryIfaceMock {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Set")
+	}
+
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &RepositoryIfaceMockCreateOrderExpectation{mock: mmCreateOrder.mock}
+	}
+	mmCreateOrder.defaultExpectation.results = &RepositoryIfaceMockCreateOrderResults{u1, err}
+	mmCreateOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateOrder.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.CreateOrder method
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) Set(f func(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expected *uint64) (u1 uint64, err error)) *RepositoryIfaceMock {
+	if mmCreateOrder.defaultExpectation != nil {
+		mmCreateOrder.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.CreateOrder method")
+	}
+
+	if len(mmCreateOrder.expectations) > 0 {
+		mmCreateOrder.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.CreateOrder method")
+	}
+
+	mmCreateOrder.mock.funcCreateOrder = f
+	mmCreateOrder.mock.funcCreateOrderOrigin = minimock.CallerInfo(1)
+	return mmCreateOrder.mock
+}
+
+// When sets expectation for the RepositoryIface.CreateOrder which will trigger the result defined by the following
+// Then helper
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) When(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expected *uint64) *RepositoryIfaceMockCreateOrderExpectation {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("RepositoryIfaceMock.CreateOrder mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockCreateOrderExpectation{
+		mock:               mmCreateOrder.mock,
+		params:             &RepositoryIfaceMockCreateOrderParams{ctx, userID, items, total, expected},
+		expectationOrigins: RepositoryIfaceMockCreateOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateOrder.expectations = append(mmCreateOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.CreateOrder return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockCreateOrderExpectation) Then(u1 uint64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockCreateOrderResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.CreateOrder should be invoked
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) Times(n uint64) *mRepositoryIfaceMockCreateOrder {
+	if n == 0 {
+		mmCreateOrder.mock.t.Fatalf("Times of RepositoryIfaceMock.CreateOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateOrder.expectedInvocations, n)
+	mmCreateOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateOrder
+}
+
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) invocationsDone() bool {
+	if len(mmCreateOrder.expectations) == 0 && mmCreateOrder.defaultExpectation == nil && mmCreateOrder.mock.funcCreateOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOrder.mock.afterCreateOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateOrder implements mm_interfaces.RepositoryIface
+func (mmCreateOrder *RepositoryIfaceMock) CreateOrder(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expected *uint64) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmCreateOrder.beforeCreateOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOrder.afterCreateOrderCounter, 1)
+
+	mmCreateOrder.t.Helper()
+
+	if mmCreateOrder.inspectFuncCreateOrder != nil {
+		mmCreateOrder.inspectFuncCreateOrder(ctx, userID, items, total, expected)
+	}
+
+	mm_params := RepositoryIfaceMockCreateOrderParams{ctx, userID, items, total, expected}
+
+	// Record call args
+	mmCreateOrder.CreateOrderMock.mutex.Lock()
+	mmCreateOrder.CreateOrderMock.callArgs = append(mmCreateOrder.CreateOrderMock.callArgs, &mm_params)
+	mmCreateOrder.CreateOrderMock.mutex.Unlock()
+
+	for _, e := range mmCreateOrder.CreateOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmCreateOrder.CreateOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOrder.CreateOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOrder.CreateOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOrder.CreateOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockCreateOrderParams{ctx, userID, items, total, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateOrder.t.Errorf("RepositoryIfaceMock.CreateOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrder.CreateOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCreateOrder.t.Errorf("RepositoryIfaceMock.CreateOrder got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrder.CreateOrderMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmCreateOrder.t.Errorf("RepositoryIfaceMock.CreateOrder got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrder.CreateOrderMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+			if mm_want_ptrs.total != nil && !minimock.Equal(*mm_want_ptrs.total, mm_got.total) {
+				mmCreateOrder.t.Errorf("RepositoryIfaceMock.CreateOrder got unexpected parameter total, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrder.CreateOrderMock.defaultExpectation.expectationOrigins.originTotal, *mm_want_ptrs.total, mm_got.total, minimock.Diff(*mm_want_ptrs.total, mm_got.total))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmCreateOrder.t.Errorf("RepositoryIfaceMock.CreateOrder got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrder.CreateOrderMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOrder.t.Errorf("RepositoryIfaceMock.CreateOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateOrder.CreateOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOrder.CreateOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOrder.t.Fatal("No results are set for the RepositoryIfaceMock.CreateOrder")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmCreateOrder.funcCreateOrder != nil {
+		return mmCreateOrder.funcCreateOrder(ctx, userID, items, total, expected)
+	}
+	mmCreateOrder.t.Fatalf("Unexpected call to RepositoryIfaceMock.CreateOrder. %v %v %v %v %v", ctx, userID, items, total, expected)
+	return
+}
+
+// CreateOrderAfterCounter returns a count of finished RepositoryIfaceMock.CreateOrder invocations
+func (mmCreateOrder *RepositoryIfaceMock) CreateOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrder.afterCreateOrderCounter)
+}
+
+// CreateOrderBeforeCounter returns a count of RepositoryIfaceMock.CreateOrder invocations
+func (mmCreateOrder *RepositoryIfaceMock) CreateOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrder.beforeCreateOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.CreateOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOrder *mRepositoryIfaceMockCreateOrder) Calls() []*RepositoryIfaceMockCreateOrderParams {
+	mmCreateOrder.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockCreateOrderParams, len(mmCreateOrder.callArgs))
+	copy(argCopy, mmCreateOrder.callArgs)
+
+	mmCreateOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOrderDone returns true if the count of the CreateOrder invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockCreateOrderDone() bool {
+	if m.CreateOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateOrderMock.invocationsDone()
+}
+
+// MinimockCreateOrderInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockCreateOrderInspect() {
+	for _, e := range m.CreateOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreateOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateOrderCounter := mm_atomic.LoadUint64(&m.afterCreateOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOrderMock.defaultExpectation != nil && afterCreateOrderCounter < 1 {
+		if m.CreateOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreateOrder at\n%s", m.CreateOrderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreateOrder at\n%s with params: %#v", m.CreateOrderMock.defaultExpectation.expectationOrigins.origin, *m.CreateOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOrder != nil && afterCreateOrderCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.CreateOrder at\n%s", m.funcCreateOrderOrigin)
+	}
+
+	if !m.CreateOrderMock.invocationsDone() && afterCreateOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.CreateOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOrderMock.expectedInvocations), m.CreateOrderMock.expectedInvocationsOrigin, afterCreateOrderCounter)
+	}
+}
+
+type mRepositoryIfaceMockCreatePromo struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockCreatePromoExpectation
+	expectations       []*RepositoryIfaceMockCreatePromoExpectation
+
+	callArgs []*RepositoryIfaceMockCreatePromoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockCreatePromoExpectation specifies expectation struct of the RepositoryIface.CreatePromo
+type RepositoryIfaceMockCreatePromoExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockCreatePromoParams
+	paramPtrs          *RepositoryIfaceMockCreatePromoParamPtrs
+	expectationOrigins RepositoryIfaceMockCreatePromoExpectationOrigins
+	results            *RepositoryIfaceMockCreatePromoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockCreatePromoParams contains parameters of the RepositoryIface.CreatePromo
+type RepositoryIfaceMockCreatePromoParams struct {
+	ctx context.Context
+	p   postgres.Promo
+}
+
+// RepositoryIfaceMockCreatePromoParamPtrs contains pointers to parameters of the RepositoryIface.CreatePromo
+type RepositoryIfaceMockCreatePromoParamPtrs struct {
+	ctx *context.Context
+	p   *postgres.Promo
+}
+
+// RepositoryIfaceMockCreatePromoResults contains results of the RepositoryIface.CreatePromo
+type RepositoryIfaceMockCreatePromoResults struct {
+	pp1 *postgres.Promo
+	err error
+}
+
+// RepositoryIfaceMockCreatePromoOrigins contains origins of expectations of the RepositoryIface.CreatePromo
+type RepositoryIfaceMockCreatePromoExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originP   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) Optional() *mRepositoryIfaceMockCreatePromo {
+	mmCreatePromo.optional = true
+	return mmCreatePromo
+}
+
+// Expect sets up expected params for RepositoryIface.CreatePromo
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) Expect(ctx context.Context, p postgres.Promo) *mRepositoryIfaceMockCreatePromo {
+	if mmCreatePromo.mock.funcCreatePromo != nil {
+		mmCreatePromo.mock.t.Fatalf("RepositoryIfaceMock.CreatePromo mock is already set by Set")
+	}
+
+	if mmCreatePromo.defaultExpectation == nil {
+		mmCreatePromo.defaultExpectation = &RepositoryIfaceMockCreatePromoExpectation{}
+	}
+
+	if mmCreatePromo.defaultExpectation.paramPtrs != nil {
+		mmCreatePromo.mock.t.Fatalf("RepositoryIfaceMock.CreatePromo mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePromo.defaultExpectation.params = &RepositoryIfaceMockCreatePromoParams{ctx, p}
+	mmCreatePromo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePromo.expectations {
+		if minimock.Equal(e.params, mmCreatePromo.defaultExpectation.params) {
+			mmCreatePromo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePromo.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePromo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.CreatePromo
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockCreatePromo {
+	if mmCreatePromo.mock.funcCreatePromo != nil {
+		mmCreatePromo.mock.t.Fatalf("RepositoryIfaceMock.CreatePromo mock is already set by Set")
+	}
+
+	if mmCreatePromo.defaultExpectation == nil {
+		mmCreatePromo.defaultExpectation = &RepositoryIfaceMockCreatePromoExpectation{}
+	}
+
+	if mmCreatePromo.defaultExpectation.params != nil {
+		mmCreatePromo.mock.t.Fatalf("RepositoryIfaceMock.CreatePromo mock is already set by Expect")
+	}
+
+	if mmCreatePromo.defaultExpectation.paramPtrs == nil {
+		mmCreatePromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreatePromoParamPtrs{}
+	}
+	mmCreatePromo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePromo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreatePromo
+}
+
+// ExpectPParam2 sets up expected param p for RepositoryIface.CreatePromo
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) ExpectPParam2(p postgres.Promo) *mRepositoryIfaceMockCreatePromo {
+	if mmCreatePromo.mock.funcCreatePromo != nil {
+		mmCreatePromo.mock.t.Fatalf("RepositoryIfaceMock.CreatePromo mock is already set by Set")
+	}
+
+	if mmCreatePromo.defaultExpectation == nil {
+		mmCreatePromo.defaultExpectation = &RepositoryIfaceMockCreatePromoExpectation{}
+	}
+
+	if mmCreatePromo.defaultExpectation.params != nil {
+		mmCreatePromo.mock.t.Fatalf("RepositoryIfaceMock.CreatePromo mock is already set by Expect")
+	}
+
+	if mmCreatePromo.defaultExpectation.paramPtrs == nil {
+		mmCreatePromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockCreatePromoParamPtrs{}
+	}
+	mmCreatePromo.defaultExpectation.paramPtrs.p = &p
+	mmCreatePromo.defaultExpectation.expectationOrigins.originP = minimock.CallerInfo(1)
+
+	return mmCreatePromo
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.CreatePromo
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) Inspect(f func(ctx context.Context, p postgres.Promo)) *mRepositoryIfaceMockCreatePromo {
+	if mmCreatePromo.mock.inspectFuncCreatePromo != nil {
+		mmCreatePromo.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.CreatePromo")
+	}
+
+	mmCreatePromo.mock.inspectFuncCreatePromo = f
+
+	return mmCreatePromo
+}
+
+// Return sets up results that will be returned by RepositoryIface.CreatePromo
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) Return(pp1 *postgres.Promo, err error) *RepositoryIfaceMock {
+	if mmCreatePromo.mock.funcCreatePromo != nil {
+		mmCreatePromo.mock.t.Fatalf("RepositoryIfaceMock.CreatePromo mock is already set by Set")
+	}
+
+	if mmCreatePromo.defaultExpectation == nil {
+		mmCreatePromo.defaultExpectation = &RepositoryIfaceMockCreatePromoExpectation{mock: mmCreatePromo.mock}
+	}
+	mmCreatePromo.defaultExpectation.results = &RepositoryIfaceMockCreatePromoResults{pp1, err}
+	mmCreatePromo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePromo.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.CreatePromo method
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) Set(f func(ctx context.Context, p postgres.Promo) (pp1 *postgres.Promo, err error)) *RepositoryIfaceMock {
+	if mmCreatePromo.defaultExpectation != nil {
+		mmCreatePromo.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.CreatePromo method")
+	}
+
+	if len(mmCreatePromo.expectations) > 0 {
+		mmCreatePromo.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.CreatePromo method")
+	}
+
+	mmCreatePromo.mock.funcCreatePromo = f
+	mmCreatePromo.mock.funcCreatePromoOrigin = minimock.CallerInfo(1)
+	return mmCreatePromo.mock
+}
+
+// When sets expectation for the RepositoryIface.CreatePromo which will trigger the result defined by the following
+// Then helper
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) When(ctx context.Context, p postgres.Promo) *RepositoryIfaceMockCreatePromoExpectation {
+	if mmCreatePromo.mock.funcCreatePromo != nil {
+		mmCreatePromo.mock.t.Fatalf("RepositoryIfaceMock.CreatePromo mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockCreatePromoExpectation{
+		mock:               mmCreatePromo.mock,
+		params:             &RepositoryIfaceMockCreatePromoParams{ctx, p},
+		expectationOrigins: RepositoryIfaceMockCreatePromoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePromo.expectations = append(mmCreatePromo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.CreatePromo return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockCreatePromoExpectation) Then(pp1 *postgres.Promo, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockCreatePromoResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.CreatePromo should be invoked
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) Times(n uint64) *mRepositoryIfaceMockCreatePromo {
+	if n == 0 {
+		mmCreatePromo.mock.t.Fatalf("Times of RepositoryIfaceMock.CreatePromo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePromo.expectedInvocations, n)
+	mmCreatePromo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePromo
+}
+
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) invocationsDone() bool {
+	if len(mmCreatePromo.expectations) == 0 && mmCreatePromo.defaultExpectation == nil && mmCreatePromo.mock.funcCreatePromo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePromo.mock.afterCreatePromoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePromo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePromo implements mm_interfaces.RepositoryIface
+func (mmCreatePromo *RepositoryIfaceMock) CreatePromo(ctx context.Context, p postgres.Promo) (pp1 *postgres.Promo, err error) {
+	mm_atomic.AddUint64(&mmCreatePromo.beforeCreatePromoCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePromo.afterCreatePromoCounter, 1)
+
+	mmCreatePromo.t.Helper()
+
+	if mmCreatePromo.inspectFuncCreatePromo != nil {
+		mmCreatePromo.inspectFuncCreatePromo(ctx, p)
+	}
+
+	mm_params := RepositoryIfaceMockCreatePromoParams{ctx, p}
+
+	// Record call args
+	mmCreatePromo.CreatePromoMock.mutex.Lock()
+	mmCreatePromo.CreatePromoMock.callArgs = append(mmCreatePromo.CreatePromoMock.callArgs, &mm_params)
+	mmCreatePromo.CreatePromoMock.mutex.Unlock()
+
+	for _, e := range mmCreatePromo.CreatePromoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmCreatePromo.CreatePromoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePromo.CreatePromoMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePromo.CreatePromoMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePromo.CreatePromoMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockCreatePromoParams{ctx, p}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePromo.t.Errorf("RepositoryIfaceMock.CreatePromo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePromo.CreatePromoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.p != nil && !minimock.Equal(*mm_want_ptrs.p, mm_got.p) {
+				mmCreatePromo.t.Errorf("RepositoryIfaceMock.CreatePromo got unexpected parameter p, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePromo.CreatePromoMock.defaultExpectation.expectationOrigins.originP, *mm_want_ptrs.p, mm_got.p, minimock.Diff(*mm_want_ptrs.p, mm_got.p))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePromo.t.Errorf("RepositoryIfaceMock.CreatePromo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePromo.CreatePromoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePromo.CreatePromoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePromo.t.Fatal("No results are set for the RepositoryIfaceMock.CreatePromo")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmCreatePromo.funcCreatePromo != nil {
+		return mmCreatePromo.funcCreatePromo(ctx, p)
+	}
+	mmCreatePromo.t.Fatalf("Unexpected call to RepositoryIfaceMock.CreatePromo. %v %v", ctx, p)
+	return
+}
+
+// CreatePromoAfterCounter returns a count of finished RepositoryIfaceMock.CreatePromo invocations
+func (mmCreatePromo *RepositoryIfaceMock) CreatePromoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePromo.afterCreatePromoCounter)
+}
+
+// CreatePromoBeforeCounter returns a count of RepositoryIfaceMock.CreatePromo invocations
+func (mmCreatePromo *RepositoryIfaceMock) CreatePromoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePromo.beforeCreatePromoCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.CreatePromo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePromo *mRepositoryIfaceMockCreatePromo) Calls() []*RepositoryIfaceMockCreatePromoParams {
+	mmCreatePromo.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockCreatePromoParams, len(mmCreatePromo.callArgs))
+	copy(argCopy, mmCreatePromo.callArgs)
+
+	mmCreatePromo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePromoDone returns true if the count of the CreatePromo invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockCreatePromoDone() bool {
+	if m.CreatePromoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePromoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePromoMock.invocationsDone()
+}
+
+// MinimockCreatePromoInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockCreatePromoInspect() {
+	for _, e := range m.CreatePromoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreatePromo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePromoCounter := mm_atomic.LoadUint64(&m.afterCreatePromoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePromoMock.defaultExpectation != nil && afterCreatePromoCounter < 1 {
+		if m.CreatePromoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreatePromo at\n%s", m.CreatePromoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.CreatePromo at\n%s with params: %#v", m.CreatePromoMock.defaultExpectation.expectationOrigins.origin, *m.CreatePromoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePromo != nil && afterCreatePromoCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.CreatePromo at\n%s", m.funcCreatePromoOrigin)
+	}
+
+	if !m.CreatePromoMock.invocationsDone() && afterCreatePromoCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.CreatePromo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePromoMock.expectedInvocations), m.CreatePromoMock.expectedInvocationsOrigin, afterCreatePromoCounter)
+	}
+}
+
+type mRepositoryIfaceMockDecrementItem struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockDecrementItemExpectation
+	expectations       []*RepositoryIfaceMockDecrementItemExpectation
+
+	callArgs []*RepositoryIfaceMockDecrementItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockDecrementItemExpectation specifies expectation struct of the RepositoryIface.DecrementItem
+type RepositoryIfaceMockDecrementItemExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockDecrementItemParams
+	paramPtrs          *RepositoryIfaceMockDecrementItemParamPtrs
+	expectationOrigins RepositoryIfaceMockDecrementItemExpectationOrigins
+	results            *RepositoryIfaceMockDecrementItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockDecrementItemParams contains parameters of the RepositoryIface.DecrementItem
+type RepositoryIfaceMockDecrementItemParams struct {
+	ctx      context.Context
+	userID   uint64
+	skuID    uint64
+	expected *uint64
+}
+
+// RepositoryIfaceMockDecrementItemParamPtrs contains pointers to parameters of the RepositoryIface.DecrementItem
+type RepositoryIfaceMockDecrementItemParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	skuID    *uint64
+	expected **uint64
+}
+
+// RepositoryIfaceMockDecrementItemResults contains results of the RepositoryIface.DecrementItem
+type RepositoryIfaceMockDecrementItemResults struct {
+	u1  uint64
+	err error
+}
+
+// RepositoryIfaceMockDecrementItemOrigins contains origins of expectations of the RepositoryIface.DecrementItem
+type RepositoryIfaceMockDecrementItemExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) Optional() *mRepositoryIfaceMockDecrementItem {
+	mmDecrementItem.optional = true
+	return mmDecrementItem
+}
+
+// Expect sets up expected params for RepositoryIface.DecrementItem
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) Expect(ctx context.Context, userID uint64, skuID uint64, expected *uint64) *mRepositoryIfaceMockDecrementItem {
+	if mmDecrementItem.mock.funcDecrementItem != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Set")
+	}
+
+	if mmDecrementItem.defaultExpectation == nil {
+		mmDecrementItem.defaultExpectation = &RepositoryIfaceMockDecrementItemExpectation{}
+	}
+
+	if mmDecrementItem.defaultExpectation.paramPtrs != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by ExpectParams functions")
+	}
+
+	mmDecrementItem.defaultExpectation.params = &RepositoryIfaceMockDecrementItemParams{ctx, userID, skuID, expected}
+	mmDecrementItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDecrementItem.expectations {
+		if minimock.Equal(e.params, mmDecrementItem.defaultExpectation.params) {
+			mmDecrementItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDecrementItem.defaultExpectation.params)
+		}
+	}
+
+	return mmDecrementItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.DecrementItem
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockDecrementItem {
+	if mmDecrementItem.mock.funcDecrementItem != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Set")
+	}
+
+	if mmDecrementItem.defaultExpectation == nil {
+		mmDecrementItem.defaultExpectation = &RepositoryIfaceMockDecrementItemExpectation{}
+	}
+
+	if mmDecrementItem.defaultExpectation.params != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Expect")
+	}
+
+	if mmDecrementItem.defaultExpectation.paramPtrs == nil {
+		mmDecrementItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDecrementItemParamPtrs{}
+	}
+	mmDecrementItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDecrementItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDecrementItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.DecrementItem
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockDecrementItem {
+	if mmDecrementItem.mock.funcDecrementItem != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Set")
+	}
+
+	if mmDecrementItem.defaultExpectation == nil {
+		mmDecrementItem.defaultExpectation = &RepositoryIfaceMockDecrementItemExpectation{}
+	}
+
+	if mmDecrementItem.defaultExpectation.params != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Expect")
+	}
+
+	if mmDecrementItem.defaultExpectation.paramPtrs == nil {
+		mmDecrementItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDecrementItemParamPtrs{}
+	}
+	mmDecrementItem.defaultExpectation.paramPtrs.userID = &userID
+	mmDecrementItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDecrementItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for RepositoryIface.DecrementItem
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) ExpectSkuIDParam3(skuID uint64) *mRepositoryIfaceMockDecrementItem {
+	if mmDecrementItem.mock.funcDecrementItem != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Set")
+	}
+
+	if mmDecrementItem.defaultExpectation == nil {
+		mmDecrementItem.defaultExpectation = &RepositoryIfaceMockDecrementItemExpectation{}
+	}
+
+	if mmDecrementItem.defaultExpectation.params != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Expect")
+	}
+
+	if mmDecrementItem.defaultExpectation.paramPtrs == nil {
+		mmDecrementItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDecrementItemParamPtrs{}
+	}
+	mmDecrementItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmDecrementItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmDecrementItem
+}
+
+// ExpectExpectedParam4 sets up expected param expected for RepositoryIface.DecrementItem
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) ExpectExpectedParam4(expected *uint64) *mRepositoryIfaceMockDecrementItem {
+	if mmDecrementItem.mock.funcDecrementItem != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Set")
+	}
+
+	if mmDecrementItem.defaultExpectation == nil {
+		mmDecrementItem.defaultExpectation = &RepositoryIfaceMockDecrementItemExpectation{}
+	}
+
+	if mmDecrementItem.defaultExpectation.params != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Expect")
+	}
+
+	if mmDecrementItem.defaultExpectation.paramPtrs == nil {
+		mmDecrementItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDecrementItemParamPtrs{}
+	}
+	mmDecrementItem.defaultExpectation.paramPtrs.expected = &expected
+	mmDecrementItem.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmDecrementItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.DecrementItem
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) Inspect(f func(ctx context.Context, userID uint64, skuID uint64, expected *uint64)) *mRepositoryIfaceMockDecrementItem {
+	if mmDecrementItem.mock.inspectFuncDecrementItem != nil {
+		mmDecrementItem.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.DecrementItem")
+	}
+
+	mmDecrementItem.mock.inspectFuncDecrementItem = f
+
+	return mmDecrementItem
+}
+
+// Return sets up results that will be returned by RepositoryIface.DecrementItem
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) Return(u1 uint64, err error) *RepositoryIfaceMock {
+	if mmDecrementItem.mock.funcDecrementItem != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Set")
+	}
+
+	if mmDecrementItem.defaultExpectation == nil {
+		mmDecrementItem.defaultExpectation = &RepositoryIfaceMockDecrementItemExpectation{mock: mmDecrementItem.mock}
+	}
+	mmDecrementItem.defaultExpectation.results = &RepositoryIfaceMockDecrementItemResults{u1, err}
+	mmDecrementItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDecrementItem.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.DecrementItem method
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) Set(f func(ctx context.Context, userID uint64, skuID uint64, expected *uint64) (u1 uint64, err error)) *RepositoryIfaceMock {
+	if mmDecrementItem.defaultExpectation != nil {
+		mmDecrementItem.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.DecrementItem method")
+	}
+
+	if len(mmDecrementItem.expectations) > 0 {
+		mmDecrementItem.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.DecrementItem method")
+	}
+
+	mmDecrementItem.mock.funcDecrementItem = f
+	mmDecrementItem.mock.funcDecrementItemOrigin = minimock.CallerInfo(1)
+	return mmDecrementItem.mock
+}
+
+// When sets expectation for the RepositoryIface.DecrementItem which will trigger the result defined by the following
+// Then helper
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) When(ctx context.Context, userID uint64, skuID uint64, expected *uint64) *RepositoryIfaceMockDecrementItemExpectation {
+	if mmDecrementItem.mock.funcDecrementItem != nil {
+		mmDecrementItem.mock.t.Fatalf("RepositoryIfaceMock.DecrementItem mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockDecrementItemExpectation{
+		mock:               mmDecrementItem.mock,
+		params:             &RepositoryIfaceMockDecrementItemParams{ctx, userID, skuID, expected},
+		expectationOrigins: RepositoryIfaceMockDecrementItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDecrementItem.expectations = append(mmDecrementItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.DecrementItem return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockDecrementItemExpectation) Then(u1 uint64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockDecrementItemResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.DecrementItem should be invoked
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) Times(n uint64) *mRepositoryIfaceMockDecrementItem {
+	if n == 0 {
+		mmDecrementItem.mock.t.Fatalf("Times of RepositoryIfaceMock.DecrementItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDecrementItem.expectedInvocations, n)
+	mmDecrementItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDecrementItem
+}
+
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) invocationsDone() bool {
+	if len(mmDecrementItem.expectations) == 0 && mmDecrementItem.defaultExpectation == nil && mmDecrementItem.mock.funcDecrementItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDecrementItem.mock.afterDecrementItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDecrementItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DecrementItem implements mm_interfaces.RepositoryIface
+func (mmDecrementItem *RepositoryIfaceMock) DecrementItem(ctx context.Context, userID uint64, skuID uint64, expected *uint64) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmDecrementItem.beforeDecrementItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDecrementItem.afterDecrementItemCounter, 1)
+
+	mmDecrementItem.t.Helper()
+
+	if mmDecrementItem.inspectFuncDecrementItem != nil {
+		mmDecrementItem.inspectFuncDecrementItem(ctx, userID, skuID, expected)
+	}
+
+	mm_params := RepositoryIfaceMockDecrementItemParams{ctx, userID, skuID, expected}
+
+	// Record call args
+	mmDecrementItem.DecrementItemMock.mutex.Lock()
+	mmDecrementItem.DecrementItemMock.callArgs = append(mmDecrementItem.DecrementItemMock.callArgs, &mm_params)
+	mmDecrementItem.DecrementItemMock.mutex.Unlock()
+
+	for _, e := range mmDecrementItem.DecrementItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmDecrementItem.DecrementItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDecrementItem.DecrementItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDecrementItem.DecrementItemMock.defaultExpectation.params
+		mm_want_ptrs := mmDecrementItem.DecrementItemMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockDecrementItemParams{ctx, userID, skuID, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDecrementItem.t.Errorf("RepositoryIfaceMock.DecrementItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDecrementItem.DecrementItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDecrementItem.t.Errorf("RepositoryIfaceMock.DecrementItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDecrementItem.DecrementItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmDecrementItem.t.Errorf("RepositoryIfaceMock.DecrementItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDecrementItem.DecrementItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmDecrementItem.t.Errorf("RepositoryIfaceMock.DecrementItem got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDecrementItem.DecrementItemMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDecrementItem.t.Errorf("RepositoryIfaceMock.DecrementItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDecrementItem.DecrementItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDecrementItem.DecrementItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDecrementItem.t.Fatal("No results are set for the RepositoryIfaceMock.DecrementItem")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmDecrementItem.funcDecrementItem != nil {
+		return mmDecrementItem.funcDecrementItem(ctx, userID, skuID, expected)
+	}
+	mmDecrementItem.t.Fatalf("Unexpected call to RepositoryIfaceMock.DecrementItem. %v %v %v %v", ctx, userID, skuID, expected)
+	return
+}
+
+// DecrementItemAfterCounter returns a count of finished RepositoryIfaceMock.DecrementItem invocations
+func (mmDecrementItem *RepositoryIfaceMock) DecrementItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDecrementItem.afterDecrementItemCounter)
+}
+
+// DecrementItemBeforeCounter returns a count of RepositoryIfaceMock.DecrementItem invocations
+func (mmDecrementItem *RepositoryIfaceMock) DecrementItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDecrementItem.beforeDecrementItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.DecrementItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDecrementItem *mRepositoryIfaceMockDecrementItem) Calls() []*RepositoryIfaceMockDecrementItemParams {
+	mmDecrementItem.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockDecrementItemParams, len(mmDecrementItem.callArgs))
+	copy(argCopy, mmDecrementItem.callArgs)
+
+	mmDecrementItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDecrementItemDone returns true if the count of the DecrementItem invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockDecrementItemDone() bool {
+	if m.DecrementItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DecrementItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DecrementItemMock.invocationsDone()
+}
+
+// MinimockDecrementItemInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockDecrementItemInspect() {
+	for _, e := range m.DecrementItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DecrementItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDecrementItemCounter := mm_atomic.LoadUint64(&m.afterDecrementItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DecrementItemMock.defaultExpectation != nil && afterDecrementItemCounter < 1 {
+		if m.DecrementItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DecrementItem at\n%s", m.DecrementItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DecrementItem at\n%s with params: %#v", m.DecrementItemMock.defaultExpectation.expectationOrigins.origin, *m.DecrementItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDecrementItem != nil && afterDecrementItemCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.DecrementItem at\n%s", m.funcDecrementItemOrigin)
+	}
+
+	if !m.DecrementItemMock.invocationsDone() && afterDecrementItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.DecrementItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DecrementItemMock.expectedInvocations), m.DecrementItemMock.expectedInvocationsOrigin, afterDecrementItemCounter)
+	}
+}
+
+type mRepositoryIfaceMockDeleteExpiredCartShares struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockDeleteExpiredCartSharesExpectation
+	expectations       []*RepositoryIfaceMockDeleteExpiredCartSharesExpectation
+
+	callArgs []*RepositoryIfaceMockDeleteExpiredCartSharesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockDeleteExpiredCartSharesExpectation specifies expectation struct of the RepositoryIface.DeleteExpiredCartShares
+type RepositoryIfaceMockDeleteExpiredCartSharesExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockDeleteExpiredCartSharesParams
+	paramPtrs          *RepositoryIfaceMockDeleteExpiredCartSharesParamPtrs
+	expectationOrigins RepositoryIfaceMockDeleteExpiredCartSharesExpectationOrigins
+	results            *RepositoryIfaceMockDeleteExpiredCartSharesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockDeleteExpiredCartSharesParams contains parameters of the RepositoryIface.DeleteExpiredCartShares
+type RepositoryIfaceMockDeleteExpiredCartSharesParams struct {
+	ctx context.Context
+}
+
+// RepositoryIfaceMockDeleteExpiredCartSharesParamPtrs contains pointers to parameters of the RepositoryIface.DeleteExpiredCartShares
+type RepositoryIfaceMockDeleteExpiredCartSharesParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryIfaceMockDeleteExpiredCartSharesResults contains results of the RepositoryIface.DeleteExpiredCartShares
+type RepositoryIfaceMockDeleteExpiredCartSharesResults struct {
+	i1  int64
+	err error
+}
+
+// RepositoryIfaceMockDeleteExpiredCartSharesOrigins contains origins of expectations of the RepositoryIface.DeleteExpiredCartShares
+type RepositoryIfaceMockDeleteExpiredCartSharesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpiredCartShares *mRepositoryIfaceMockDeleteExpiredCartShares) Optional() *mRepositoryIfaceMockDeleteExpiredCartShares {
+	mmDeleteExpiredCartShares.optional = true
+	return mmDeleteExpiredCartShares
+}
+
+// Expect sets up expected params for RepositoryIface.DeleteExpiredCartShares
+func (mmDeleteExpiredCartShares *mRepositoryIfaceMockDeleteExpiredCartShares) Expect(ctx context.Context) *mRepositoryIfaceMockDeleteExpiredCartShares {
+	if mmDeleteExpiredCartShares.mock.funcDeleteExpiredCartShares != nil {
+		mmDeleteExpiredCartShares.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredCartShares mock is already set by Set")
+	}
+
+	if mmDeleteExpiredCartShares.defaultExpectation == nil {
+		mmDeleteExpiredCartShares.defaultExpectation = &RepositoryIfaceMockDeleteExpiredCartSharesExpectation{}
+	}
+
+	if mmDeleteExpiredCartShares.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpiredCartShares.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredCartShares mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpiredCartShares.defaultExpectation.params = &RepositoryIfaceMockDeleteExpiredCartSharesParams{ctx}
+	mmDeleteExpiredCartShares.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteExpiredCartShares.expectations {
+		if minimock.Equal(e.params, mmDeleteExpiredCartShares.defaultExpectation.params) {
+			mmDeleteExpiredCartShares.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpiredCartShares.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpiredCartShares
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.DeleteExpiredCartShares
+func (mmDeleteExpiredCartShares *mRepositoryIfaceMockDeleteExpiredCartShares) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockDeleteExpiredCartShares {
+	if mmDeleteExpiredCartShares.mock.funcDeleteExpiredCartShares != nil {
+		mmDeleteExpiredCartShares.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredCartShares mock is already set by Set")
+	}
+
+	if mmDeleteExpiredCartShares.defaultExpectation == nil {
+		mmDeleteExpiredCartShares.defaultExpectation = &RepositoryIfaceMockDeleteExpiredCartSharesExpectation{}
+	}
+
+	if mmDeleteExpiredCartShares.defaultExpectation.params != nil {
+		mmDeleteExpiredCartShares.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredCartShares mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredCartShares.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredCartShares.defaultExpectation.paramPtrs = &RepositoryIfaceMockDeleteExpiredCartSharesParamPtrs{}
+	}
+	mmDeleteExpiredCartShares.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteExpiredCartShares.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredCartShares
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.DeleteExpiredCartShares
+func (mmDeleteExpiredCartShares *mRepositoryIfaceMockDeleteExpiredCartShares) Inspect(f func(ctx context.Context)) *mRepositoryIfaceMockDeleteExpiredCartShares {
+	if mmDeleteExpiredCartShares.mock.inspectFuncDeleteExpiredCartShares != nil {
+		mmDeleteExpiredCartShares.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.DeleteExpiredCartShares")
+	}
+
+	mmDeleteExpiredCartShares.mock.inspectFuncDeleteExpiredCartShares = f
+
+	return mmDeleteExpiredCartShares
+}
+
+// Return sets up results that will be returned by RepositoryIface.DeleteExpiredCartShares
+func (mmDeleteExpiredCartShares *mRepositoryIfaceMockDeleteExpiredCartShares) Return(i1 int64, err error) *RepositoryIfaceMock {
+	if mmDeleteExpiredCartShares.mock.funcDeleteExpiredCartShares != nil {
+		mmDeleteExpiredCartShares.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredCartShares mock is already set by Set")
+	}
+
+	if mmDeleteExpiredCartShares.defaultExpectation == nil {
+		mmDeleteExpiredCartShares.defaultExpectation = &RepositoryIfaceMockDeleteExpiredCartSharesExpectation{mock: mmDeleteExpiredCartShares.mock}
+	}
+	mmDeleteExpiredCartShares.defaultExpectation.results = &RepositoryIfaceMockDeleteExpiredCartSharesResults{i1, err}
+	mmDeleteExpiredCartShares.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredCartShares.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.DeleteExpiredCartShares method
+func (mmDeleteExpiredCartShares *mRepositoryIfaceMockDeleteExpiredCartShares) Set(f func(ctx context.Context) (i1 int64, err error)) *RepositoryIfaceMock {
+	if mmDeleteExpiredCartShares.defaultExpectation != nil {
+		mmDeleteExpiredCartShares.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.DeleteExpiredCartShares method")
+	}
+
+	if len(mmDeleteExpiredCartShares.expectations) > 0 {
+		mmDeleteExpiredCartShares.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.DeleteExpiredCartShares method")
+	}
+
+	mmDeleteExpiredCartShares.mock.funcDeleteExpiredCartShares = f
+	mmDeleteExpiredCartShares.mock.funcDeleteExpiredCartSharesOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredCartShares.mock
+}
+
+// When sets expectation for the RepositoryIface.DeleteExpiredCartShares which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpiredCartShares *mRepositoryIfaceMockDeleteExpiredCartShares) When(ctx context.Context) *RepositoryIfaceMockDeleteExpiredCartSharesExpectation {
+	if mmDeleteExpiredCartShares.mock.funcDeleteExpiredCartShares != nil {
+		mmDeleteExpiredCartShares.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredCartShares mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockDeleteExpiredCartSharesExpectation{
+		mock:               mmDeleteExpiredCartShares.mock,
+		params:             &RepositoryIfaceMockDeleteExpiredCartSharesParams{ctx},
+		expectationOrigins: RepositoryIfaceMockDeleteExpiredCartSharesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteExpiredCartShares.expectations = append(mmDeleteExpiredCartShares.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.DeleteExpiredCartShares return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockDeleteExpiredCartSharesExpectation) Then(i1 int64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockDeleteExpiredCartSharesResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.DeleteExpiredCartShares should be invoked
+func (mmDeleteExpiredCartShares *mRepositoryIfaceMockDeleteExpiredCartShares) Times(n uint64) *mRepositoryIfaceMockDeleteExpiredCartShares {
+	if n == 0 {
+		mmDeleteExpiredCartShares.mock.t.Fatalf("Times of RepositoryIfaceMock.DeleteExpiredCartShares mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpiredCartShares.expectedInvocations, n)
+	mmDeleteExpiredCartShares.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredCartShares
+}
+
+func (mmDeleteExpiredCartShares *mRepositoryIfaceMockDeleteExpiredCartShares) invocationsDone() bool {
+	if len(mmDeleteExpiredCartShares.expectations) == 0 && mmDeleteExpiredCartShares.defaultExpectation == nil && mmDeleteExpiredCartShares.mock.funcDeleteExpiredCartShares == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredCartShares.mock.afterDeleteExpiredCartSharesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredCartShares.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpiredCartShares implements mm_interfaces.RepositoryIface
+func (mmDeleteExpiredCartShares *RepositoryIfaceMock) DeleteExpiredCartShares(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteExpiredCartShares.beforeDeleteExpiredCartSharesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpiredCartShares.afterDeleteExpiredCartSharesCounter, 1)
+
+	mmDeleteExpiredCartShares.t.Helper()
+
+	if mmDeleteExpiredCartShares.inspectFuncDeleteExpiredCartShares != nil {
+		mmDeleteExpiredCartShares.inspectFuncDeleteExpiredCartShares(ctx)
+	}
+
+	mm_params := RepositoryIfaceMockDeleteExpiredCartSharesParams{ctx}
+
+	// Record call args
+	mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.mutex.Lock()
+	mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.callArgs = append(mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.callArgs, &mm_params)
+	mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockDeleteExpiredCartSharesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpiredCartShares.t.Errorf("RepositoryIfaceMock.DeleteExpiredCartShares got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpiredCartShares.t.Errorf("RepositoryIfaceMock.DeleteExpiredCartShares got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpiredCartShares.DeleteExpiredCartSharesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpiredCartShares.t.Fatal("No results are set for the RepositoryIfaceMock.DeleteExpiredCartShares")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteExpiredCartShares.funcDeleteExpiredCartShares != nil {
+		return mmDeleteExpiredCartShares.funcDeleteExpiredCartShares(ctx)
+	}
+	mmDeleteExpiredCartShares.t.Fatalf("Unexpected call to RepositoryIfaceMock.DeleteExpiredCartShares. %v", ctx)
+	return
+}
+
+// DeleteExpiredCartSharesAfterCounter returns a count of finished RepositoryIfaceMock.DeleteExpiredCartShares invocations
+func (mmDeleteExpiredCartShares *RepositoryIfaceMock) DeleteExpiredCartSharesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredCartShares.afterDeleteExpiredCartSharesCounter)
+}
+
+// DeleteExpiredCartSharesBeforeCounter returns a count of RepositoryIfaceMock.DeleteExpiredCartShares invocations
+func (mmDeleteExpiredCartShares *RepositoryIfaceMock) DeleteExpiredCartSharesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredCartShares.beforeDeleteExpiredCartSharesCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.DeleteExpiredCartShares.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpiredCartShares *mRepositoryIfaceMockDeleteExpiredCartShares) Calls() []*RepositoryIfaceMockDeleteExpiredCartSharesParams {
+	mmDeleteExpiredCartShares.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockDeleteExpiredCartSharesParams, len(mmDeleteExpiredCartShares.callArgs))
+	copy(argCopy, mmDeleteExpiredCartShares.callArgs)
+
+	mmDeleteExpiredCartShares.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredCartSharesDone returns true if the count of the DeleteExpiredCartShares invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockDeleteExpiredCartSharesDone() bool {
+	if m.DeleteExpiredCartSharesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredCartSharesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredCartSharesMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredCartSharesInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockDeleteExpiredCartSharesInspect() {
+	for _, e := range m.DeleteExpiredCartSharesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteExpiredCartShares at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteExpiredCartSharesCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredCartSharesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredCartSharesMock.defaultExpectation != nil && afterDeleteExpiredCartSharesCounter < 1 {
+		if m.DeleteExpiredCartSharesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteExpiredCartShares at\n%s", m.DeleteExpiredCartSharesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteExpiredCartShares at\n%s with params: %#v", m.DeleteExpiredCartSharesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteExpiredCartSharesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredCartShares != nil && afterDeleteExpiredCartSharesCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteExpiredCartShares at\n%s", m.funcDeleteExpiredCartSharesOrigin)
+	}
+
+	if !m.DeleteExpiredCartSharesMock.invocationsDone() && afterDeleteExpiredCartSharesCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.DeleteExpiredCartShares at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredCartSharesMock.expectedInvocations), m.DeleteExpiredCartSharesMock.expectedInvocationsOrigin, afterDeleteExpiredCartSharesCounter)
+	}
+}
+
+type mRepositoryIfaceMockDeleteExpiredIdempotencyKeys struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectation
+	expectations       []*RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectation
+
+	callArgs []*RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectation specifies expectation struct of the RepositoryIface.DeleteExpiredIdempotencyKeys
+type RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams
+	paramPtrs          *RepositoryIfaceMockDeleteExpiredIdempotencyKeysParamPtrs
+	expectationOrigins RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectationOrigins
+	results            *RepositoryIfaceMockDeleteExpiredIdempotencyKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams contains parameters of the RepositoryIface.DeleteExpiredIdempotencyKeys
+type RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams struct {
+	ctx context.Context
+}
+
+// RepositoryIfaceMockDeleteExpiredIdempotencyKeysParamPtrs contains pointers to parameters of the RepositoryIface.DeleteExpiredIdempotencyKeys
+type RepositoryIfaceMockDeleteExpiredIdempotencyKeysParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryIfaceMockDeleteExpiredIdempotencyKeysResults contains results of the RepositoryIface.DeleteExpiredIdempotencyKeys
+type RepositoryIfaceMockDeleteExpiredIdempotencyKeysResults struct {
+	i1  int64
+	err error
+}
+
+// RepositoryIfaceMockDeleteExpiredIdempotencyKeysOrigins contains origins of expectations of the RepositoryIface.DeleteExpiredIdempotencyKeys
+type RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpiredIdempotencyKeys *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys) Optional() *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys {
+	mmDeleteExpiredIdempotencyKeys.optional = true
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+// Expect sets up expected params for RepositoryIface.DeleteExpiredIdempotencyKeys
+func (mmDeleteExpiredIdempotencyKeys *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys) Expect(ctx context.Context) *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys {
+	if mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation == nil {
+		mmDeleteExpiredIdempotencyKeys.defaultExpectation = &RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectation{}
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredIdempotencyKeys mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.params = &RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams{ctx}
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteExpiredIdempotencyKeys.expectations {
+		if minimock.Equal(e.params, mmDeleteExpiredIdempotencyKeys.defaultExpectation.params) {
+			mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpiredIdempotencyKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.DeleteExpiredIdempotencyKeys
+func (mmDeleteExpiredIdempotencyKeys *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys {
+	if mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation == nil {
+		mmDeleteExpiredIdempotencyKeys.defaultExpectation = &RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectation{}
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation.params != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredIdempotencyKeys mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs = &RepositoryIfaceMockDeleteExpiredIdempotencyKeysParamPtrs{}
+	}
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.DeleteExpiredIdempotencyKeys
+func (mmDeleteExpiredIdempotencyKeys *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys) Inspect(f func(ctx context.Context)) *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys {
+	if mmDeleteExpiredIdempotencyKeys.mock.inspectFuncDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.DeleteExpiredIdempotencyKeys")
+	}
+
+	mmDeleteExpiredIdempotencyKeys.mock.inspectFuncDeleteExpiredIdempotencyKeys = f
+
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+// Return sets up results that will be returned by RepositoryIface.DeleteExpiredIdempotencyKeys
+func (mmDeleteExpiredIdempotencyKeys *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys) Return(i1 int64, err error) *RepositoryIfaceMock {
+	if mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation == nil {
+		mmDeleteExpiredIdempotencyKeys.defaultExpectation = &RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectation{mock: mmDeleteExpiredIdempotencyKeys.mock}
+	}
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.results = &RepositoryIfaceMockDeleteExpiredIdempotencyKeysResults{i1, err}
+	mmDeleteExpiredIdempotencyKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredIdempotencyKeys.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.DeleteExpiredIdempotencyKeys method
+func (mmDeleteExpiredIdempotencyKeys *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys) Set(f func(ctx context.Context) (i1 int64, err error)) *RepositoryIfaceMock {
+	if mmDeleteExpiredIdempotencyKeys.defaultExpectation != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.DeleteExpiredIdempotencyKeys method")
+	}
+
+	if len(mmDeleteExpiredIdempotencyKeys.expectations) > 0 {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.DeleteExpiredIdempotencyKeys method")
+	}
+
+	mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys = f
+	mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeysOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredIdempotencyKeys.mock
+}
+
+// When sets expectation for the RepositoryIface.DeleteExpiredIdempotencyKeys which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpiredIdempotencyKeys *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys) When(ctx context.Context) *RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectation {
+	if mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("RepositoryIfaceMock.DeleteExpiredIdempotencyKeys mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectation{
+		mock:               mmDeleteExpiredIdempotencyKeys.mock,
+		params:             &RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams{ctx},
+		expectationOrigins: RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteExpiredIdempotencyKeys.expectations = append(mmDeleteExpiredIdempotencyKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.DeleteExpiredIdempotencyKeys return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockDeleteExpiredIdempotencyKeysExpectation) Then(i1 int64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockDeleteExpiredIdempotencyKeysResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.DeleteExpiredIdempotencyKeys should be invoked
+func (mmDeleteExpiredIdempotencyKeys *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys) Times(n uint64) *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys {
+	if n == 0 {
+		mmDeleteExpiredIdempotencyKeys.mock.t.Fatalf("Times of RepositoryIfaceMock.DeleteExpiredIdempotencyKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpiredIdempotencyKeys.expectedInvocations, n)
+	mmDeleteExpiredIdempotencyKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredIdempotencyKeys
+}
+
+func (mmDeleteExpiredIdempotencyKeys *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys) invocationsDone() bool {
+	if len(mmDeleteExpiredIdempotencyKeys.expectations) == 0 && mmDeleteExpiredIdempotencyKeys.defaultExpectation == nil && mmDeleteExpiredIdempotencyKeys.mock.funcDeleteExpiredIdempotencyKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredIdempotencyKeys.mock.afterDeleteExpiredIdempotencyKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredIdempotencyKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpiredIdempotencyKeys implements mm_interfaces.RepositoryIface
+func (mmDeleteExpiredIdempotencyKeys *RepositoryIfaceMock) DeleteExpiredIdempotencyKeys(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteExpiredIdempotencyKeys.beforeDeleteExpiredIdempotencyKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpiredIdempotencyKeys.afterDeleteExpiredIdempotencyKeysCounter, 1)
+
+	mmDeleteExpiredIdempotencyKeys.t.Helper()
+
+	if mmDeleteExpiredIdempotencyKeys.inspectFuncDeleteExpiredIdempotencyKeys != nil {
+		mmDeleteExpiredIdempotencyKeys.inspectFuncDeleteExpiredIdempotencyKeys(ctx)
+	}
+
+	mm_params := RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams{ctx}
+
+	// Record call args
+	mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.mutex.Lock()
+	mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.callArgs = append(mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.callArgs, &mm_params)
+	mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpiredIdempotencyKeys.t.Errorf("RepositoryIfaceMock.DeleteExpiredIdempotencyKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpiredIdempotencyKeys.t.Errorf("RepositoryIfaceMock.DeleteExpiredIdempotencyKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpiredIdempotencyKeys.DeleteExpiredIdempotencyKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpiredIdempotencyKeys.t.Fatal("No results are set for the RepositoryIfaceMock.DeleteExpiredIdempotencyKeys")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteExpiredIdempotencyKeys.funcDeleteExpiredIdempotencyKeys != nil {
+		return mmDeleteExpiredIdempotencyKeys.funcDeleteExpiredIdempotencyKeys(ctx)
+	}
+	mmDeleteExpiredIdempotencyKeys.t.Fatalf("Unexpected call to RepositoryIfaceMock.DeleteExpiredIdempotencyKeys. %v", ctx)
+	return
+}
+
+// DeleteExpiredIdempotencyKeysAfterCounter returns a count of finished RepositoryIfaceMock.DeleteExpiredIdempotencyKeys invocations
+func (mmDeleteExpiredIdempotencyKeys *RepositoryIfaceMock) DeleteExpiredIdempotencyKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredIdempotencyKeys.afterDeleteExpiredIdempotencyKeysCounter)
+}
+
+// DeleteExpiredIdempotencyKeysBeforeCounter returns a count of RepositoryIfaceMock.DeleteExpiredIdempotencyKeys invocations
+func (mmDeleteExpiredIdempotencyKeys *RepositoryIfaceMock) DeleteExpiredIdempotencyKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredIdempotencyKeys.beforeDeleteExpiredIdempotencyKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.DeleteExpiredIdempotencyKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpiredIdempotencyKeys *mRepositoryIfaceMockDeleteExpiredIdempotencyKeys) Calls() []*RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams {
+	mmDeleteExpiredIdempotencyKeys.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockDeleteExpiredIdempotencyKeysParams, len(mmDeleteExpiredIdempotencyKeys.callArgs))
+	copy(argCopy, mmDeleteExpiredIdempotencyKeys.callArgs)
+
+	mmDeleteExpiredIdempotencyKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredIdempotencyKeysDone returns true if the count of the DeleteExpiredIdempotencyKeys invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockDeleteExpiredIdempotencyKeysDone() bool {
+	if m.DeleteExpiredIdempotencyKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredIdempotencyKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredIdempotencyKeysMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredIdempotencyKeysInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockDeleteExpiredIdempotencyKeysInspect() {
+	for _, e := range m.DeleteExpiredIdempotencyKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteExpiredIdempotencyKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteExpiredIdempotencyKeysCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredIdempotencyKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredIdempotencyKeysMock.defaultExpectation != nil && afterDeleteExpiredIdempotencyKeysCounter < 1 {
+		if m.DeleteExpiredIdempotencyKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteExpiredIdempotencyKeys at\n%s", m.DeleteExpiredIdempotencyKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteExpiredIdempotencyKeys at\n%s with params: %#v", m.DeleteExpiredIdempotencyKeysMock.defaultExpectation.expectationOrigins.origin, *m.DeleteExpiredIdempotencyKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredIdempotencyKeys != nil && afterDeleteExpiredIdempotencyKeysCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteExpiredIdempotencyKeys at\n%s", m.funcDeleteExpiredIdempotencyKeysOrigin)
+	}
+
+	if !m.DeleteExpiredIdempotencyKeysMock.invocationsDone() && afterDeleteExpiredIdempotencyKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.DeleteExpiredIdempotencyKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredIdempotencyKeysMock.expectedInvocations), m.DeleteExpiredIdempotencyKeysMock.expectedInvocationsOrigin, afterDeleteExpiredIdempotencyKeysCounter)
+	}
+}
+
+type mRepositoryIfaceMockDeleteItem struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockDeleteItemExpectation
+	expectations       []*RepositoryIfaceMockDeleteItemExpectation
+
+	callArgs []*RepositoryIfaceMockDeleteItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockDeleteItemExpectation specifies expectation struct of the RepositoryIface.DeleteItem
+type RepositoryIfaceMockDeleteItemExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockDeleteItemParams
+	paramPtrs          *RepositoryIfaceMockDeleteItemParamPtrs
+	expectationOrigins RepositoryIfaceMockDeleteItemExpectationOrigins
+	results            *RepositoryIfaceMockDeleteItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockDeleteItemParams contains parameters of the RepositoryIface.DeleteItem
+type RepositoryIfaceMockDeleteItemParams struct {
+	ctx      context.Context
+	userID   uint64
+	skuID    uint64
+	expected *uint64
+}
+
+// RepositoryIfaceMockDeleteItemParamPtrs contains pointers to parameters of the RepositoryIface.DeleteItem
+type RepositoryIfaceMockDeleteItemParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	skuID    *uint64
+	expected **uint64
+}
+
+// RepositoryIfaceMockDeleteItemResults contains results of the RepositoryIface.DeleteItem
+type RepositoryIfaceMockDeleteItemResults struct {
+	u1  uint64
+	err error
+}
+
+// RepositoryIfaceMockDeleteItemOrigins contains origins of expectations of the RepositoryIface.DeleteItem
+type RepositoryIfaceMockDeleteItemExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Optional() *mRepositoryIfaceMockDeleteItem {
+	mmDeleteItem.optional = true
+	return mmDeleteItem
+}
+
+// Expect sets up expected params for RepositoryIface.DeleteItem
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Expect(ctx context.Context, userID uint64, skuID uint64, expected *uint64) *mRepositoryIfaceMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteItem.defaultExpectation.params = &RepositoryIfaceMockDeleteItemParams{ctx, userID, skuID, expected}
+	mmDeleteItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteItem.expectations {
+		if minimock.Equal(e.params, mmDeleteItem.defaultExpectation.params) {
+			mmDeleteItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteItem.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.DeleteItem
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.params != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Expect")
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDeleteItemParamPtrs{}
+	}
+	mmDeleteItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.DeleteItem
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.params != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Expect")
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDeleteItemParamPtrs{}
+	}
+	mmDeleteItem.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for RepositoryIface.DeleteItem
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) ExpectSkuIDParam3(skuID uint64) *mRepositoryIfaceMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.params != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Expect")
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDeleteItemParamPtrs{}
+	}
+	mmDeleteItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmDeleteItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmDeleteItem
+}
+
+// ExpectExpectedParam4 sets up expected param expected for RepositoryIface.DeleteItem
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) ExpectExpectedParam4(expected *uint64) *mRepositoryIfaceMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.params != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Expect")
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDeleteItemParamPtrs{}
+	}
+	mmDeleteItem.defaultExpectation.paramPtrs.expected = &expected
+	mmDeleteItem.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmDeleteItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.DeleteItem
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Inspect(f func(ctx context.Context, userID uint64, skuID uint64, expected *uint64)) *mRepositoryIfaceMockDeleteItem {
+	if mmDeleteItem.mock.inspectFuncDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.DeleteItem")
+	}
+
+	mmDeleteItem.mock.inspectFuncDeleteItem = f
+
+	return mmDeleteItem
+}
+
+// Return sets up results that will be returned by RepositoryIface.DeleteItem
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Return(u1 uint64, err error) *RepositoryIfaceMock {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{mock: mmDeleteItem.mock}
+	}
+	mmDeleteItem.defaultExpectation.results = &RepositoryIfaceMockDeleteItemResults{u1, err}
+	mmDeleteItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteItem.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.DeleteItem method
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Set(f func(ctx context.Context, userID uint64, skuID uint64, expected *uint64) (u1 uint64, err error)) *RepositoryIfaceMock {
+	if mmDeleteItem.defaultExpectation != nil {
+		mmDeleteItem.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.DeleteItem method")
+	}
+
+	if len(mmDeleteItem.expectations) > 0 {
+		mmDeleteItem.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.DeleteItem method")
+	}
+
+	mmDeleteItem.mock.funcDeleteItem = f
+	mmDeleteItem.mock.funcDeleteItemOrigin = minimock.CallerInfo(1)
+	return mmDeleteItem.mock
+}
+
+// When sets expectation for the RepositoryIface.DeleteItem which will trigger the result defined by the following
+// Then helper
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) When(ctx context.Context, userID uint64, skuID uint64, expected *uint64) *RepositoryIfaceMockDeleteItemExpectation {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockDeleteItemExpectation{
+		mock:               mmDeleteItem.mock,
+		params:             &RepositoryIfaceMockDeleteItemParams{ctx, userID, skuID, expected},
+		expectationOrigins: RepositoryIfaceMockDeleteItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteItem.expectations = append(mmDeleteItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.DeleteItem return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockDeleteItemExpectation) Then(u1 uint64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockDeleteItemResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.DeleteItem should be invoked
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Times(n uint64) *mRepositoryIfaceMockDeleteItem {
+	if n == 0 {
+		mmDeleteItem.mock.t.Fatalf("Times of RepositoryIfaceMock.DeleteItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteItem.expectedInvocations, n)
+	mmDeleteItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteItem
+}
+
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) invocationsDone() bool {
+	if len(mmDeleteItem.expectations) == 0 && mmDeleteItem.defaultExpectation == nil && mmDeleteItem.mock.funcDeleteItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteItem.mock.afterDeleteItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteItem implements mm_interfaces.RepositoryIface
+func (mmDeleteItem *RepositoryIfaceMock) DeleteItem(ctx context.Context, userID uint64, skuID uint64, expected *uint64) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmDeleteItem.beforeDeleteItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteItem.afterDeleteItemCounter, 1)
+
+	mmDeleteItem.t.Helper()
+
+	if mmDeleteItem.inspectFuncDeleteItem != nil {
+		mmDeleteItem.inspectFuncDeleteItem(ctx, userID, skuID, expected)
+	}
+
+	mm_params := RepositoryIfaceMockDeleteItemParams{ctx, userID, skuID, expected}
+
+	// Record call args
+	mmDeleteItem.DeleteItemMock.mutex.Lock()
+	mmDeleteItem.DeleteItemMock.callArgs = append(mmDeleteItem.DeleteItemMock.callArgs, &mm_params)
+	mmDeleteItem.DeleteItemMock.mutex.Unlock()
+
+	for _, e := range mmDeleteItem.DeleteItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmDeleteItem.DeleteItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteItem.DeleteItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteItem.DeleteItemMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteItem.DeleteItemMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockDeleteItemParams{ctx, userID, skuID, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteItem.t.Errorf("RepositoryIfaceMock.DeleteItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteItem.t.Errorf("RepositoryIfaceMock.DeleteItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmDeleteItem.t.Errorf("RepositoryIfaceMock.DeleteItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmDeleteItem.t.Errorf("RepositoryIfaceMock.DeleteItem got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteItem.t.Errorf("RepositoryIfaceMock.DeleteItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteItem.DeleteItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteItem.t.Fatal("No results are set for the RepositoryIfaceMock.DeleteItem")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmDeleteItem.funcDeleteItem != nil {
+		return mmDeleteItem.funcDeleteItem(ctx, userID, skuID, expected)
+	}
+	mmDeleteItem.t.Fatalf("Unexpected call to RepositoryIfaceMock.DeleteItem. %v %v %v %v", ctx, userID, skuID, expected)
+	return
+}
+
+// DeleteItemAfterCounter returns a count of finished RepositoryIfaceMock.DeleteItem invocations
+func (mmDeleteItem *RepositoryIfaceMock) DeleteItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteItem.afterDeleteItemCounter)
+}
+
+// DeleteItemBeforeCounter returns a count of RepositoryIfaceMock.DeleteItem invocations
+func (mmDeleteItem *RepositoryIfaceMock) DeleteItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteItem.beforeDeleteItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.DeleteItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Calls() []*RepositoryIfaceMockDeleteItemParams {
+	mmDeleteItem.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockDeleteItemParams, len(mmDeleteItem.callArgs))
+	copy(argCopy, mmDeleteItem.callArgs)
+
+	mmDeleteItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteItemDone returns true if the count of the DeleteItem invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockDeleteItemDone() bool {
+	if m.DeleteItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteItemMock.invocationsDone()
+}
+
+// MinimockDeleteItemInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockDeleteItemInspect() {
+	for _, e := range m.DeleteItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteItemCounter := mm_atomic.LoadUint64(&m.afterDeleteItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteItemMock.defaultExpectation != nil && afterDeleteItemCounter < 1 {
+		if m.DeleteItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteItem at\n%s", m.DeleteItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteItem at\n%s with params: %#v", m.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteItem != nil && afterDeleteItemCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteItem at\n%s", m.funcDeleteItemOrigin)
+	}
+
+	if !m.DeleteItemMock.invocationsDone() && afterDeleteItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.DeleteItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteItemMock.expectedInvocations), m.DeleteItemMock.expectedInvocationsOrigin, afterDeleteItemCounter)
+	}
+}
+
+type mRepositoryIfaceMockDisablePromo struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockDisablePromoExpectation
+	expectations       []*RepositoryIfaceMockDisablePromoExpectation
+
+	callArgs []*RepositoryIfaceMockDisablePromoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockDisablePromoExpectation specifies expectation struct of the RepositoryIface.DisablePromo
+type RepositoryIfaceMockDisablePromoExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockDisablePromoParams
+	paramPtrs          *RepositoryIfaceMockDisablePromoParamPtrs
+	expectationOrigins RepositoryIfaceMockDisablePromoExpectationOrigins
+	results            *RepositoryIfaceMockDisablePromoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockDisablePromoParams contains parameters of the RepositoryIface.DisablePromo
+type RepositoryIfaceMockDisablePromoParams struct {
+	ctx  context.Context
+	code string
+}
+
+// RepositoryIfaceMockDisablePromoParamPtrs contains pointers to parameters of the RepositoryIface.DisablePromo
+type RepositoryIfaceMockDisablePromoParamPtrs struct {
+	ctx  *context.Context
+	code *string
+}
+
+// RepositoryIfaceMockDisablePromoResults contains results of the RepositoryIface.DisablePromo
+type RepositoryIfaceMockDisablePromoResults struct {
+	err error
+}
+
+// RepositoryIfaceMockDisablePromoOrigins contains origins of expectations of the RepositoryIface.DisablePromo
+type RepositoryIfaceMockDisablePromoExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originCode string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) Optional() *mRepositoryIfaceMockDisablePromo {
+	mmDisablePromo.optional = true
+	return mmDisablePromo
+}
+
+// Expect sets up expected params for RepositoryIface.DisablePromo
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) Expect(ctx context.Context, code string) *mRepositoryIfaceMockDisablePromo {
+	if mmDisablePromo.mock.funcDisablePromo != nil {
+		mmDisablePromo.mock.t.Fatalf("RepositoryIfaceMock.DisablePromo mock is already set by Set")
+	}
+
+	if mmDisablePromo.defaultExpectation == nil {
+		mmDisablePromo.defaultExpectation = &RepositoryIfaceMockDisablePromoExpectation{}
+	}
+
+	if mmDisablePromo.defaultExpectation.paramPtrs != nil {
+		mmDisablePromo.mock.t.Fatalf("RepositoryIfaceMock.DisablePromo mock is already set by ExpectParams functions")
+	}
+
+	mmDisablePromo.defaultExpectation.params = &RepositoryIfaceMockDisablePromoParams{ctx, code}
+	mmDisablePromo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDisablePromo.expectations {
+		if minimock.Equal(e.params, mmDisablePromo.defaultExpectation.params) {
+			mmDisablePromo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDisablePromo.defaultExpectation.params)
+		}
+	}
+
+	return mmDisablePromo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.DisablePromo
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockDisablePromo {
+	if mmDisablePromo.mock.funcDisablePromo != nil {
+		mmDisablePromo.mock.t.Fatalf("RepositoryIfaceMock.DisablePromo mock is already set by Set")
+	}
+
+	if mmDisablePromo.defaultExpectation == nil {
+		mmDisablePromo.defaultExpectation = &RepositoryIfaceMockDisablePromoExpectation{}
+	}
+
+	if mmDisablePromo.defaultExpectation.params != nil {
+		mmDisablePromo.mock.t.Fatalf("RepositoryIfaceMock.DisablePromo mock is already set by Expect")
+	}
+
+	if mmDisablePromo.defaultExpectation.paramPtrs == nil {
+		mmDisablePromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockDisablePromoParamPtrs{}
+	}
+	mmDisablePromo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDisablePromo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDisablePromo
+}
+
+// ExpectCodeParam2 sets up expected param code for RepositoryIface.DisablePromo
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) ExpectCodeParam2(code string) *mRepositoryIfaceMockDisablePromo {
+	if mmDisablePromo.mock.funcDisablePromo != nil {
+		mmDisablePromo.mock.t.Fatalf("RepositoryIfaceMock.DisablePromo mock is already set by Set")
+	}
+
+	if mmDisablePromo.defaultExpectation == nil {
+		mmDisablePromo.defaultExpectation = &RepositoryIfaceMockDisablePromoExpectation{}
+	}
+
+	if mmDisablePromo.defaultExpectation.params != nil {
+		mmDisablePromo.mock.t.Fatalf("RepositoryIfaceMock.DisablePromo mock is already set by Expect")
+	}
+
+	if mmDisablePromo.defaultExpectation.paramPtrs == nil {
+		mmDisablePromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockDisablePromoParamPtrs{}
+	}
+	mmDisablePromo.defaultExpectation.paramPtrs.code = &code
+	mmDisablePromo.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmDisablePromo
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.DisablePromo
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) Inspect(f func(ctx context.Context, code string)) *mRepositoryIfaceMockDisablePromo {
+	if mmDisablePromo.mock.inspectFuncDisablePromo != nil {
+		mmDisablePromo.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.DisablePromo")
+	}
+
+	mmDisablePromo.mock.inspectFuncDisablePromo = f
+
+	return mmDisablePromo
+}
+
+// Return sets up results that will be returned by RepositoryIface.DisablePromo
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) Return(err error) *RepositoryIfaceMock {
+	if mmDisablePromo.mock.funcDisablePromo != nil {
+		mmDisablePromo.mock.t.Fatalf("RepositoryIfaceMock.DisablePromo mock is already set by Set")
+	}
+
+	if mmDisablePromo.defaultExpectation == nil {
+		mmDisablePromo.defaultExpectation = &RepositoryIfaceMockDisablePromoExpectation{mock: mmDisablePromo.mock}
+	}
+	mmDisablePromo.defaultExpectation.results = &RepositoryIfaceMockDisablePromoResults{err}
+	mmDisablePromo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDisablePromo.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.DisablePromo method
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) Set(f func(ctx context.Context, code string) (err error)) *RepositoryIfaceMock {
+	if mmDisablePromo.defaultExpectation != nil {
+		mmDisablePromo.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.DisablePromo method")
+	}
+
+	if len(mmDisablePromo.expectations) > 0 {
+		mmDisablePromo.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.DisablePromo method")
+	}
+
+	mmDisablePromo.mock.funcDisablePromo = f
+	mmDisablePromo.mock.funcDisablePromoOrigin = minimock.CallerInfo(1)
+	return mmDisablePromo.mock
+}
+
+// When sets expectation for the RepositoryIface.DisablePromo which will trigger the result defined by the following
+// Then helper
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) When(ctx context.Context, code string) *RepositoryIfaceMockDisablePromoExpectation {
+	if mmDisablePromo.mock.funcDisablePromo != nil {
+		mmDisablePromo.mock.t.Fatalf("RepositoryIfaceMock.DisablePromo mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockDisablePromoExpectation{
+		mock:               mmDisablePromo.mock,
+		params:             &RepositoryIfaceMockDisablePromoParams{ctx, code},
+		expectationOrigins: RepositoryIfaceMockDisablePromoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDisablePromo.expectations = append(mmDisablePromo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.DisablePromo return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockDisablePromoExpectation) Then(err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockDisablePromoResults{err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.DisablePromo should be invoked
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) Times(n uint64) *mRepositoryIfaceMockDisablePromo {
+	if n == 0 {
+		mmDisablePromo.mock.t.Fatalf("Times of RepositoryIfaceMock.DisablePromo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDisablePromo.expectedInvocations, n)
+	mmDisablePromo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDisablePromo
+}
+
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) invocationsDone() bool {
+	if len(mmDisablePromo.expectations) == 0 && mmDisablePromo.defaultExpectation == nil && mmDisablePromo.mock.funcDisablePromo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDisablePromo.mock.afterDisablePromoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDisablePromo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DisablePromo implements mm_interfaces.RepositoryIface
+func (mmDisablePromo *RepositoryIfaceMock) DisablePromo(ctx context.Context, code string) (err error) {
+	mm_atomic.AddUint64(&mmDisablePromo.beforeDisablePromoCounter, 1)
+	defer mm_atomic.AddUint64(&mmDisablePromo.afterDisablePromoCounter, 1)
+
+	mmDisablePromo.t.Helper()
+
+	if mmDisablePromo.inspectFuncDisablePromo != nil {
+		mmDisablePromo.inspectFuncDisablePromo(ctx, code)
+	}
+
+	mm_params := RepositoryIfaceMockDisablePromoParams{ctx, code}
+
+	// Record call args
+	mmDisablePromo.DisablePromoMock.mutex.Lock()
+	mmDisablePromo.DisablePromoMock.callArgs = append(mmDisablePromo.DisablePromoMock.callArgs, &mm_params)
+	mmDisablePromo.DisablePromoMock.mutex.Unlock()
+
+	for _, e := range mmDisablePromo.DisablePromoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDisablePromo.DisablePromoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDisablePromo.DisablePromoMock.defaultExpectation.Counter, 1)
+		mm_want := mmDisablePromo.DisablePromoMock.defaultExpectation.params
+		mm_want_ptrs := mmDisablePromo.DisablePromoMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockDisablePromoParams{ctx, code}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDisablePromo.t.Errorf("RepositoryIfaceMock.DisablePromo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisablePromo.DisablePromoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmDisablePromo.t.Errorf("RepositoryIfaceMock.DisablePromo got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDisablePromo.DisablePromoMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDisablePromo.t.Errorf("RepositoryIfaceMock.DisablePromo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDisablePromo.DisablePromoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDisablePromo.DisablePromoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDisablePromo.t.Fatal("No results are set for the RepositoryIfaceMock.DisablePromo")
+		}
+		return (*mm_results).err
+	}
+	if mmDisablePromo.funcDisablePromo != nil {
+		return mmDisablePromo.funcDisablePromo(ctx, code)
+	}
+	mmDisablePromo.t.Fatalf("Unexpected call to RepositoryIfaceMock.DisablePromo. %v %v", ctx, code)
+	return
+}
+
+// DisablePromoAfterCounter returns a count of finished RepositoryIfaceMock.DisablePromo invocations
+func (mmDisablePromo *RepositoryIfaceMock) DisablePromoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDisablePromo.afterDisablePromoCounter)
+}
+
+// DisablePromoBeforeCounter returns a count of RepositoryIfaceMock.DisablePromo invocations
+func (mmDisablePromo *RepositoryIfaceMock) DisablePromoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDisablePromo.beforeDisablePromoCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.DisablePromo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDisablePromo *mRepositoryIfaceMockDisablePromo) Calls() []*RepositoryIfaceMockDisablePromoParams {
+	mmDisablePromo.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockDisablePromoParams, len(mmDisablePromo.callArgs))
+	copy(argCopy, mmDisablePromo.callArgs)
+
+	mmDisablePromo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDisablePromoDone returns true if the count of the DisablePromo invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockDisablePromoDone() bool {
+	if m.DisablePromoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DisablePromoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DisablePromoMock.invocationsDone()
+}
+
+// MinimockDisablePromoInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockDisablePromoInspect() {
+	for _, e := range m.DisablePromoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DisablePromo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDisablePromoCounter := mm_atomic.LoadUint64(&m.afterDisablePromoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DisablePromoMock.defaultExpectation != nil && afterDisablePromoCounter < 1 {
+		if m.DisablePromoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DisablePromo at\n%s", m.DisablePromoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.DisablePromo at\n%s with params: %#v", m.DisablePromoMock.defaultExpectation.expectationOrigins.origin, *m.DisablePromoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDisablePromo != nil && afterDisablePromoCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.DisablePromo at\n%s", m.funcDisablePromoOrigin)
+	}
+
+	if !m.DisablePromoMock.invocationsDone() && afterDisablePromoCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.DisablePromo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DisablePromoMock.expectedInvocations), m.DisablePromoMock.expectedInvocationsOrigin, afterDisablePromoCounter)
+	}
+}
+
+type mRepositoryIfaceMockExpireCarts struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockExpireCartsExpectation
+	expectations       []*RepositoryIfaceMockExpireCartsExpectation
+
+	callArgs []*RepositoryIfaceMockExpireCartsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockExpireCartsExpectation specifies expectation struct of the RepositoryIface.ExpireCarts
+type RepositoryIfaceMockExpireCartsExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockExpireCartsParams
+	paramPtrs          *RepositoryIfaceMockExpireCartsParamPtrs
+	expectationOrigins RepositoryIfaceMockExpireCartsExpectationOrigins
+	results            *RepositoryIfaceMockExpireCartsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockExpireCartsParams contains parameters of the RepositoryIface.ExpireCarts
+type RepositoryIfaceMockExpireCartsParams struct {
+	ctx   context.Context
+	idle  time.Duration
+	limit int
+}
+
+// RepositoryIfaceMockExpireCartsParamPtrs contains pointers to parameters of the RepositoryIface.ExpireCarts
+type RepositoryIfaceMockExpireCartsParamPtrs struct {
+	ctx   *context.Context
+	idle  *time.Duration
+	limit *int
+}
+
+// RepositoryIfaceMockExpireCartsResults contains results of the RepositoryIface.ExpireCarts
+type RepositoryIfaceMockExpireCartsResults struct {
+	ea1 []postgres.ExpiredCart
+	err error
+}
+
+// RepositoryIfaceMockExpireCartsOrigins contains origins of expectations of the RepositoryIface.ExpireCarts
+type RepositoryIfaceMockExpireCartsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originIdle  string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) Optional() *mRepositoryIfaceMockExpireCarts {
+	mmExpireCarts.optional = true
+	return mmExpireCarts
+}
+
+// Expect sets up expected params for RepositoryIface.ExpireCarts
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) Expect(ctx context.Context, idle time.Duration, limit int) *mRepositoryIfaceMockExpireCarts {
+	if mmExpireCarts.mock.funcExpireCarts != nil {
+		mmExpireCarts.mock.t.Fatalf("RepositoryIfaceMock.ExpireCarts mock is already set by Set")
+	}
+
+	if mmExpireCarts.defaultExpectation == nil {
+		mmExpireCarts.defaultExpectation = &RepositoryIfaceMockExpireCartsExpectation{}
+	}
+
+	if mmExpireCarts.defaultExpectation.paramPtrs != nil {
+		mmExpireCarts.mock.t.Fatalf("RepositoryIfaceMock.ExpireCarts mock is already set by ExpectParams functions")
+	}
+
+	mmExpireCarts.defaultExpectation.params = &RepositoryIfaceMockExpireCartsParams{ctx, idle, limit}
+	mmExpireCarts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExpireCarts.expectations {
+		if minimock.Equal(e.params, mmExpireCarts.defaultExpectation.params) {
+			mmExpireCarts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpireCarts.defaultExpectation.params)
+		}
+	}
+
+	return mmExpireCarts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.ExpireCarts
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockExpireCarts {
+	if mmExpireCarts.mock.funcExpireCarts != nil {
+		mmExpireCarts.mock.t.Fatalf("RepositoryIfaceMock.ExpireCarts mock is already set by Set")
+	}
+
+	if mmExpireCarts.defaultExpectation == nil {
+		mmExpireCarts.defaultExpectation = &RepositoryIfaceMockExpireCartsExpectation{}
+	}
+
+	if mmExpireCarts.defaultExpectation.params != nil {
+		mmExpireCarts.mock.t.Fatalf("RepositoryIfaceMock.ExpireCarts mock is already set by Expect")
+	}
+
+	if mmExpireCarts.defaultExpectation.paramPtrs == nil {
+		mmExpireCarts.defaultExpectation.paramPtrs = &RepositoryIfaceMockExpireCartsParamPtrs{}
+	}
+	mmExpireCarts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExpireCarts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExpireCarts
+}
+
+// ExpectIdleParam2 sets up expected param idle for RepositoryIface.ExpireCarts
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) ExpectIdleParam2(idle time.Duration) *mRepositoryIfaceMockExpireCarts {
+	if mmExpireCarts.mock.funcExpireCarts != nil {
+		mmExpireCarts.mock.t.Fatalf("RepositoryIfaceMock.ExpireCarts mock is already set by Set")
+	}
+
+	if mmExpireCarts.defaultExpectation == nil {
+		mmExpireCarts.defaultExpectation = &RepositoryIfaceMockExpireCartsExpectation{}
+	}
+
+	if mmExpireCarts.defaultExpectation.params != nil {
+		mmExpireCarts.mock.t.Fatalf("RepositoryIfaceMock.ExpireCarts mock is already set by Expect")
+	}
+
+	if mmExpireCarts.defaultExpectation.paramPtrs == nil {
+		mmExpireCarts.defaultExpectation.paramPtrs = &RepositoryIfaceMockExpireCartsParamPtrs{}
+	}
+	mmExpireCarts.defaultExpectation.paramPtrs.idle = &idle
+	mmExpireCarts.defaultExpectation.expectationOrigins.originIdle = minimock.CallerInfo(1)
+
+	return mmExpireCarts
+}
+
+// ExpectLimitParam3 sets up expected param limit for RepositoryIface.ExpireCarts
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) ExpectLimitParam3(limit int) *mRepositoryIfaceMockExpireCarts {
+	if mmExpireCarts.mock.funcExpireCarts != nil {
+		mmExpireCarts.mock.t.Fatalf("RepositoryIfaceMock.ExpireCarts mock is already set by Set")
+	}
+
+	if mmExpireCarts.defaultExpectation == nil {
+		mmExpireCarts.defaultExpectation = &RepositoryIfaceMockExpireCartsExpectation{}
+	}
+
+	if mmExpireCarts.defaultExpectation.params != nil {
+		mmExpireCarts.mock.t.Fatalf("RepositoryIfaceMock.ExpireCarts mock is already set by Expect")
+	}
+
+	if mmExpireCarts.defaultExpectation.paramPtrs == nil {
+		mmExpireCarts.defaultExpectation.paramPtrs = &RepositoryIfaceMockExpireCartsParamPtrs{}
+	}
+	mmExpireCarts.defaultExpectation.paramPtrs.limit = &limit
+	mmExpireCarts.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmExpireCarts
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.ExpireCarts
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) Inspect(f func(ctx context.Context, idle time.Duration, limit int)) *mRepositoryIfaceMockExpireCarts {
+	if mmExpireCarts.mock.inspectFuncExpireCarts != nil {
+		mmExpireCarts.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.ExpireCarts")
+	}
+
+	mmExpireCarts.mock.inspectFuncExpireCarts = f
+
+	return mmExpireCarts
+}
+
+// Return sets up results that will be returned by RepositoryIface.ExpireCarts
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) Return(ea1 []postgres.ExpiredCart, err error) *RepositoryIfaceMock {
+	if mmExpireCarts.mock.funcExpireCarts != nil {
+		mmExpireCarts.mock.t.Fatalf("RepositoryIfaceMock.ExpireCarts mock is already set by Set")
+	}
+
+	if mmExpireCarts.defaultExpectation == nil {
+		mmExpireCarts.defaultExpectation = &RepositoryIfaceMockExpireCartsExpectation{mock: mmExpireCarts.mock}
+	}
+	mmExpireCarts.defaultExpectation.results = &RepositoryIfaceMockExpireCartsResults{ea1, err}
+	mmExpireCarts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExpireCarts.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.ExpireCarts method
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) Set(f func(ctx context.Context, idle time.Duration, limit int) (ea1 []postgres.ExpiredCart, err error)) *RepositoryIfaceMock {
+	if mmExpireCarts.defaultExpectation != nil {
+		mmExpireCarts.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.ExpireCarts method")
+	}
+
+	if len(mmExpireCarts.expectations) > 0 {
+		mmExpireCarts.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.ExpireCarts method")
+	}
+
+	mmExpireCarts.mock.funcExpireCarts = f
+	mmExpireCarts.mock.funcExpireCartsOrigin = minimock.CallerInfo(1)
+	return mmExpireCarts.mock
+}
+
+// When sets expectation for the RepositoryIface.ExpireCarts which will trigger the result defined by the following
+// Then helper
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) When(ctx context.Context, idle time.Duration, limit int) *RepositoryIfaceMockExpireCartsExpectation {
+	if mmExpireCarts.mock.funcExpireCarts != nil {
+		mmExpireCarts.mock.t.Fatalf("RepositoryIfaceMock.ExpireCarts mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockExpireCartsExpectation{
+		mock:               mmExpireCarts.mock,
+		params:             &RepositoryIfaceMockExpireCartsParams{ctx, idle, limit},
+		expectationOrigins: RepositoryIfaceMockExpireCartsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExpireCarts.expectations = append(mmExpireCarts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.ExpireCarts return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockExpireCartsExpectation) Then(ea1 []postgres.ExpiredCart, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockExpireCartsResults{ea1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.ExpireCarts should be invoked
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) Times(n uint64) *mRepositoryIfaceMockExpireCarts {
+	if n == 0 {
+		mmExpireCarts.mock.t.Fatalf("Times of RepositoryIfaceMock.ExpireCarts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExpireCarts.expectedInvocations, n)
+	mmExpireCarts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExpireCarts
+}
+
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) invocationsDone() bool {
+	if len(mmExpireCarts.expectations) == 0 && mmExpireCarts.defaultExpectation == nil && mmExpireCarts.mock.funcExpireCarts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExpireCarts.mock.afterExpireCartsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExpireCarts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExpireCarts implements mm_interfaces.RepositoryIface
+func (mmExpireCarts *RepositoryIfaceMock) ExpireCarts(ctx context.Context, idle time.Duration, limit int) (ea1 []postgres.ExpiredCart, err error) {
+	mm_atomic.AddUint64(&mmExpireCarts.beforeExpireCartsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpireCarts.afterExpireCartsCounter, 1)
+
+	mmExpireCarts.t.Helper()
+
+	if mmExpireCarts.inspectFuncExpireCarts != nil {
+		mmExpireCarts.inspectFuncExpireCarts(ctx, idle, limit)
+	}
+
+	mm_params := RepositoryIfaceMockExpireCartsParams{ctx, idle, limit}
+
+	// Record call args
+	mmExpireCarts.ExpireCartsMock.mutex.Lock()
+	mmExpireCarts.ExpireCartsMock.callArgs = append(mmExpireCarts.ExpireCartsMock.callArgs, &mm_params)
+	mmExpireCarts.ExpireCartsMock.mutex.Unlock()
+
+	for _, e := range mmExpireCarts.ExpireCartsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ea1, e.results.err
+		}
+	}
+
+	if mmExpireCarts.ExpireCartsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpireCarts.ExpireCartsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpireCarts.ExpireCartsMock.defaultExpectation.params
+		mm_want_ptrs := mmExpireCarts.ExpireCartsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockExpireCartsParams{ctx, idle, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpireCarts.t.Errorf("RepositoryIfaceMock.ExpireCarts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireCarts.ExpireCartsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.idle != nil && !minimock.Equal(*mm_want_ptrs.idle, mm_got.idle) {
+				mmExpireCarts.t.Errorf("RepositoryIfaceMock.ExpireCarts got unexpected parameter idle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireCarts.ExpireCartsMock.defaultExpectation.expectationOrigins.originIdle, *mm_want_ptrs.idle, mm_got.idle, minimock.Diff(*mm_want_ptrs.idle, mm_got.idle))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmExpireCarts.t.Errorf("RepositoryIfaceMock.ExpireCarts got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireCarts.ExpireCartsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpireCarts.t.Errorf("RepositoryIfaceMock.ExpireCarts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExpireCarts.ExpireCartsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpireCarts.ExpireCartsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpireCarts.t.Fatal("No results are set for the RepositoryIfaceMock.ExpireCarts")
+		}
+		return (*mm_results).ea1, (*mm_results).err
+	}
+	if mmExpireCarts.funcExpireCarts != nil {
+		return mmExpireCarts.funcExpireCarts(ctx, idle, limit)
+	}
+	mmExpireCarts.t.Fatalf("Unexpected call to RepositoryIfaceMock.ExpireCarts. %v %v %v", ctx, idle, limit)
+	return
+}
+
+// ExpireCartsAfterCounter returns a count of finished RepositoryIfaceMock.ExpireCarts invocations
+func (mmExpireCarts *RepositoryIfaceMock) ExpireCartsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireCarts.afterExpireCartsCounter)
+}
+
+// ExpireCartsBeforeCounter returns a count of RepositoryIfaceMock.ExpireCarts invocations
+func (mmExpireCarts *RepositoryIfaceMock) ExpireCartsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireCarts.beforeExpireCartsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.ExpireCarts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpireCarts *mRepositoryIfaceMockExpireCarts) Calls() []*RepositoryIfaceMockExpireCartsParams {
+	mmExpireCarts.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockExpireCartsParams, len(mmExpireCarts.callArgs))
+	copy(argCopy, mmExpireCarts.callArgs)
+
+	mmExpireCarts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireCartsDone returns true if the count of the ExpireCarts invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockExpireCartsDone() bool {
+	if m.ExpireCartsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExpireCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExpireCartsMock.invocationsDone()
+}
+
+// MinimockExpireCartsInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockExpireCartsInspect() {
+	for _, e := range m.ExpireCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ExpireCarts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExpireCartsCounter := mm_atomic.LoadUint64(&m.afterExpireCartsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireCartsMock.defaultExpectation != nil && afterExpireCartsCounter < 1 {
+		if m.ExpireCartsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ExpireCarts at\n%s", m.ExpireCartsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ExpireCarts at\n%s with params: %#v", m.ExpireCartsMock.defaultExpectation.expectationOrigins.origin, *m.ExpireCartsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireCarts != nil && afterExpireCartsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.ExpireCarts at\n%s", m.funcExpireCartsOrigin)
+	}
+
+	if !m.ExpireCartsMock.invocationsDone() && afterExpireCartsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.ExpireCarts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExpireCartsMock.expectedInvocations), m.ExpireCartsMock.expectedInvocationsOrigin, afterExpireCartsCounter)
+	}
+}
+
+type mRepositoryIfaceMockGetCart struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockGetCartExpectation
+	expectations       []*RepositoryIfaceMockGetCartExpectation
+
+	callArgs []*RepositoryIfaceMockGetCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockGetCartExpectation specifies expectation struct of the RepositoryIface.GetCart
+type RepositoryIfaceMockGetCartExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockGetCartParams
+	paramPtrs          *RepositoryIfaceMockGetCartParamPtrs
+	expectationOrigins RepositoryIfaceMockGetCartExpectationOrigins
+	results            *RepositoryIfaceMockGetCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockGetCartParams contains parameters of the RepositoryIface.GetCart
+type RepositoryIfaceMockGetCartParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// RepositoryIfaceMockGetCartParamPtrs contains pointers to parameters of the RepositoryIface.GetCart
+type RepositoryIfaceMockGetCartParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// RepositoryIfaceMockGetCartResults contains results of the RepositoryIface.GetCart
+type RepositoryIfaceMockGetCartResults struct {
+	pa1 []postgres.Position
+	u1  uint64
+	err error
+}
+
+// RepositoryIfaceMockGetCartOrigins contains origins of expectations of the RepositoryIface.GetCart
+type RepositoryIfaceMockGetCartExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCart *mRepositoryIfaceMockGetCart) Optional() *mRepositoryIfaceMockGetCart {
+	mmGetCart.optional = true
+	return mmGetCart
+}
+
+// Expect sets up expected params for RepositoryIface.GetCart
+func (mmGetCart *mRepositoryIfaceMockGetCart) Expect(ctx context.Context, userID uint64) *mRepositoryIfaceMockGetCart {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Set")
+	}
+
+	if mmGetCart.defaultExpectation == nil {
+		mmGetCart.defaultExpectation = &RepositoryIfaceMockGetCartExpectation{}
+	}
+
+	if mmGetCart.defaultExpectation.paramPtrs != nil {
+		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by ExpectParams functions")
+	}
+
+	mmGetCart.defaultExpectation.params = &RepositoryIfaceMockGetCartParams{ctx, userID}
+	mmGetCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCart.expectations {
+		if minimock.Equal(e.params, mmGetCart.defaultExpectation.params) {
+			mmGetCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCart.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.GetCart
+func (mmGetCart *mRepositoryIfaceMockGetCart) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockGetCart {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Set")
+	}
+
+	if mmGetCart.defaultExpectation == nil {
+		mmGetCart.defaultExpectation = &RepositoryIfaceMockGetCartExpectation{}
+	}
+
+	if mmGetCart.defaultExpectation.params != nil {
+		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Expect")
+	}
+
+	if mmGetCart.defaultExpectation.paramPtrs == nil {
+		mmGetCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartParamPtrs{}
+	}
+	mmGetCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCart
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.GetCart
+func (mmGetCart *mRepositoryIfaceMockGetCart) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockGetCart {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Set")
+	}
+
+	if mmGetCart.defaultExpectation == nil {
+		mmGetCart.defaultExpectation = &RepositoryIfaceMockGetCartExpectation{}
+	}
+
+	if mmGetCart.defaultExpectation.params != nil {
+		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Expect")
+	}
+
+	if mmGetCart.defaultExpectation.paramPtrs == nil {
+		mmGetCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartParamPtrs{}
+	}
+	mmGetCart.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.GetCart
+func (mmGetCart *mRepositoryIfaceMockGetCart) Inspect(f func(ctx context.Context, userID uint64)) *mRepositoryIfaceMockGetCart {
+	if mmGetCart.mock.inspectFuncGetCart != nil {
+		mmGetCart.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.GetCart")
+	}
+
+	mmGetCart.mock.inspectFuncGetCart = f
+
+	return mmGetCart
+}
+
+// Return sets up results that will be returned by RepositoryIface.GetCart
+func (mmGetCart *mRepositoryIfaceMockGetCart) Return(pa1 []postgres.Position, u1 uint64, err error) *RepositoryIfaceMock {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Set")
+	}
+
+	if mmGetCart.defaultExpectation == nil {
+		mmGetCart.defaultExpectation = &RepositoryIfaceMockGetCartExpectation{mock: mmGetCart.mock}
+	}
+	mmGetCart.defaultExpectation.results = &RepositoryIfaceMockGetCartResults{pa1, u1, err}
+	mmGetCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCart.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.GetCart method
+func (mmGetCart *mRepositoryIfaceMockGetCart) Set(f func(ctx context.Context, userID uint64) (pa1 []postgres.Position, u1 uint64, err error)) *RepositoryIfaceMock {
+	if mmGetCart.defaultExpectation != nil {
+		mmGetCart.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.GetCart method")
+	}
+
+	if len(mmGetCart.expectations) > 0 {
+		mmGetCart.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.GetCart method")
+	}
+
+	mmGetCart.mock.funcGetCart = f
+	mmGetCart.mock.funcGetCartOrigin = minimock.CallerInfo(1)
+	return mmGetCart.mock
+}
+
+// When sets expectation for the RepositoryIface.GetCart which will trigger the result defined by the following
+// Then helper
+func (mmGetCart *mRepositoryIfaceMockGetCart) When(ctx context.Context, userID uint64) *RepositoryIfaceMockGetCartExpectation {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockGetCartExpectation{
+		mock:               mmGetCart.mock,
+		params:             &RepositoryIfaceMockGetCartParams{ctx, userID},
+		expectationOrigins: RepositoryIfaceMockGetCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCart.expectations = append(mmGetCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.GetCart return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockGetCartExpectation) Then(pa1 []postgres.Position, u1 uint64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockGetCartResults{pa1, u1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.GetCart should be invoked
+func (mmGetCart *mRepositoryIfaceMockGetCart) Times(n uint64) *mRepositoryIfaceMockGetCart {
+	if n == 0 {
+		mmGetCart.mock.t.Fatalf("Times of RepositoryIfaceMock.GetCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCart.expectedInvocations, n)
+	mmGetCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCart
+}
+
+func (mmGetCart *mRepositoryIfaceMockGetCart) invocationsDone() bool {
+	if len(mmGetCart.expectations) == 0 && mmGetCart.defaultExpectation == nil && mmGetCart.mock.funcGetCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCart.mock.afterGetCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCart implements mm_interfaces.RepositoryIface
+func (mmGetCart *RepositoryIfaceMock) GetCart(ctx context.Context, userID uint64) (pa1 []postgres.Position, u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmGetCart.beforeGetCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCart.afterGetCartCounter, 1)
+
+	mmGetCart.t.Helper()
+
+	if mmGetCart.inspectFuncGetCart != nil {
+		mmGetCart.inspectFuncGetCart(ctx, userID)
+	}
+
+	mm_params := RepositoryIfaceMockGetCartParams{ctx, userID}
+
+	// Record call args
+	mmGetCart.GetCartMock.mutex.Lock()
+	mmGetCart.GetCartMock.callArgs = append(mmGetCart.GetCartMock.callArgs, &mm_params)
+	mmGetCart.GetCartMock.mutex.Unlock()
+
+	for _, e := range mmGetCart.GetCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetCart.GetCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCart.GetCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCart.GetCartMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCart.GetCartMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockGetCartParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCart.t.Errorf("RepositoryIfaceMock.GetCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCart.t.Errorf("RepositoryIfaceMock.GetCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCart.t.Errorf("RepositoryIfaceMock.GetCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCart.GetCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCart.t.Fatal("No results are set for the RepositoryIfaceMock.GetCart")
+		}
+		return (*mm_results).pa1, (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetCart.funcGetCart != nil {
+		return mmGetCart.funcGetCart(ctx, userID)
+	}
+	mmGetCart.t.Fatalf("Unexpected call to RepositoryIfaceMock.GetCart. %v %v", ctx, userID)
+	return
+}
+
+// GetCartAfterCounter returns a count of finished RepositoryIfaceMock.GetCart invocations
+func (mmGetCart *RepositoryIfaceMock) GetCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCart.afterGetCartCounter)
+}
+
+// GetCartBeforeCounter returns a count of RepositoryIfaceMock.GetCart invocations
+func (mmGetCart *RepositoryIfaceMock) GetCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCart.beforeGetCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.GetCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCart *mRepositoryIfaceMockGetCart) Calls() []*RepositoryIfaceMockGetCartParams {
+	mmGetCart.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockGetCartParams, len(mmGetCart.callArgs))
+	copy(argCopy, mmGetCart.callArgs)
+
+	mmGetCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCartDone returns true if the count of the GetCart invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockGetCartDone() bool {
+	if m.GetCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCartMock.invocationsDone()
+}
+
+// MinimockGetCartInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockGetCartInspect() {
+	for _, e := range m.GetCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCartCounter := mm_atomic.LoadUint64(&m.afterGetCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCartMock.defaultExpectation != nil && afterGetCartCounter < 1 {
+		if m.GetCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCart at\n%s", m.GetCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCart at\n%s with params: %#v", m.GetCartMock.defaultExpectation.expectationOrigins.origin, *m.GetCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCart != nil && afterGetCartCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.GetCart at\n%s", m.funcGetCartOrigin)
+	}
+
+	if !m.GetCartMock.invocationsDone() && afterGetCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.GetCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCartMock.expectedInvocations), m.GetCartMock.expectedInvocationsOrigin, afterGetCartCounter)
+	}
+}
+
+type mRepositoryIfaceMockGetCartHistory struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockGetCartHistoryExpectation
+	expectations       []*RepositoryIfaceMockGetCartHistoryExpectation
+
+	callArgs []*RepositoryIfaceMockGetCartHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockGetCartHistoryExpectation specifies expectation struct of the RepositoryIface.GetCartHistory
+type RepositoryIfaceMockGetCartHistoryExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockGetCartHistoryParams
+	paramPtrs          *RepositoryIfaceMockGetCartHistoryParamPtrs
+	expectationOrigins RepositoryIfaceMockGetCartHistoryExpectationOrigins
+	results            *RepositoryIfaceMockGetCartHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockGetCartHistoryParams contains parameters of the RepositoryIface.GetCartHistory
+type RepositoryIfaceMockGetCartHistoryParams struct {
+	ctx    context.Context
+	userID uint64
+	since  time.Time
+	after  uint64
+	limit  int
+}
+
+// RepositoryIfaceMockGetCartHistoryParamPtrs contains pointers to parameters of the RepositoryIface.GetCartHistory
+type RepositoryIfaceMockGetCartHistoryParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	since  *time.Time
+	after  *uint64
+	limit  *int
+}
+
+// RepositoryIfaceMockGetCartHistoryResults contains results of the RepositoryIface.GetCartHistory
+type RepositoryIfaceMockGetCartHistoryResults struct {
+	ha1 []postgres.HistoryEntry
+	err error
+}
+
+// RepositoryIfaceMockGetCartHistoryOrigins contains origins of expectations of the RepositoryIface.GetCartHistory
+type RepositoryIfaceMockGetCartHistoryExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSince  string
+	originAfter  string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) Optional() *mRepositoryIfaceMockGetCartHistory {
+	mmGetCartHistory.optional = true
+	return mmGetCartHistory
+}
+
+// Expect sets up expected params for RepositoryIface.GetCartHistory
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) Expect(ctx context.Context, userID uint64, since time.Time, after uint64, limit int) *mRepositoryIfaceMockGetCartHistory {
+	if mmGetCartHistory.mock.funcGetCartHistory != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Set")
+	}
+
+	if mmGetCartHistory.defaultExpectation == nil {
+		mmGetCartHistory.defaultExpectation = &RepositoryIfaceMockGetCartHistoryExpectation{}
+	}
+
+	if mmGetCartHistory.defaultExpectation.paramPtrs != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetCartHistory.defaultExpectation.params = &RepositoryIfaceMockGetCartHistoryParams{ctx, userID, since, after, limit}
+	mmGetCartHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCartHistory.expectations {
+		if minimock.Equal(e.params, mmGetCartHistory.defaultExpectation.params) {
+			mmGetCartHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCartHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCartHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.GetCartHistory
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockGetCartHistory {
+	if mmGetCartHistory.mock.funcGetCartHistory != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Set")
+	}
+
+	if mmGetCartHistory.defaultExpectation == nil {
+		mmGetCartHistory.defaultExpectation = &RepositoryIfaceMockGetCartHistoryExpectation{}
+	}
+
+	if mmGetCartHistory.defaultExpectation.params != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Expect")
+	}
+
+	if mmGetCartHistory.defaultExpectation.paramPtrs == nil {
+		mmGetCartHistory.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartHistoryParamPtrs{}
+	}
+	mmGetCartHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCartHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCartHistory
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.GetCartHistory
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockGetCartHistory {
+	if mmGetCartHistory.mock.funcGetCartHistory != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Set")
+	}
+
+	if mmGetCartHistory.defaultExpectation == nil {
+		mmGetCartHistory.defaultExpectation = &RepositoryIfaceMockGetCartHistoryExpectation{}
+	}
+
+	if mmGetCartHistory.defaultExpectation.params != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Expect")
+	}
+
+	if mmGetCartHistory.defaultExpectation.paramPtrs == nil {
+		mmGetCartHistory.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartHistoryParamPtrs{}
+	}
+	mmGetCartHistory.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCartHistory.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCartHistory
+}
+
+// ExpectSinceParam3 sets up expected param since for RepositoryIface.GetCartHistory
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) ExpectSinceParam3(since time.Time) *mRepositoryIfaceMockGetCartHistory {
+	if mmGetCartHistory.mock.funcGetCartHistory != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Set")
+	}
+
+	if mmGetCartHistory.defaultExpectation == nil {
+		mmGetCartHistory.defaultExpectation = &RepositoryIfaceMockGetCartHistoryExpectation{}
+	}
+
+	if mmGetCartHistory.defaultExpectation.params != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Expect")
+	}
+
+	if mmGetCartHistory.defaultExpectation.paramPtrs == nil {
+		mmGetCartHistory.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartHistoryParamPtrs{}
+	}
+	mmGetCartHistory.defaultExpectation.paramPtrs.since = &since
+	mmGetCartHistory.defaultExpectation.expectationOrigins.originSince = minimock.CallerInfo(1)
+
+	return mmGetCartHistory
+}
+
+// ExpectAfterParam4 sets up expected param after for RepositoryIface.GetCartHistory
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) ExpectAfterParam4(after uint64) *mRepositoryIfaceMockGetCartHistory {
+	if mmGetCartHistory.mock.funcGetCartHistory != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Set")
+	}
+
+	if mmGetCartHistory.defaultExpectation == nil {
+		mmGetCartHistory.defaultExpectation = &RepositoryIfaceMockGetCartHistoryExpectation{}
+	}
+
+	if mmGetCartHistory.defaultExpectation.params != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Expect")
+	}
+
+	if mmGetCartHistory.defaultExpectation.paramPtrs == nil {
+		mmGetCartHistory.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartHistoryParamPtrs{}
+	}
+	mmGetCartHistory.defaultExpectation.paramPtrs.after = &after
+	mmGetCartHistory.defaultExpectation.expectationOrigins.originAfter = minimock.CallerInfo(1)
+
+	return mmGetCartHistory
+}
+
+// ExpectLimitParam5 sets up expected param limit for RepositoryIface.GetCartHistory
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) ExpectLimitParam5(limit int) *mRepositoryIfaceMockGetCartHistory {
+	if mmGetCartHistory.mock.funcGetCartHistory != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Set")
+	}
+
+	if mmGetCartHistory.defaultExpectation == nil {
+		mmGetCartHistory.defaultExpectation = &RepositoryIfaceMockGetCartHistoryExpectation{}
+	}
+
+	if mmGetCartHistory.defaultExpectation.params != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Expect")
+	}
+
+	if mmGetCartHistory.defaultExpectation.paramPtrs == nil {
+		mmGetCartHistory.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartHistoryParamPtrs{}
+	}
+	mmGetCartHistory.defaultExpectation.paramPtrs.limit = &limit
+	mmGetCartHistory.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetCartHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.GetCartHistory
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) Inspect(f func(ctx context.Context, userID uint64, since time.Time, after uint64, limit int)) *mRepositoryIfaceMockGetCartHistory {
+	if mmGetCartHistory.mock.inspectFuncGetCartHistory != nil {
+		mmGetCartHistory.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.GetCartHistory")
+	}
+
+	mmGetCartHistory.mock.inspectFuncGetCartHistory = f
+
+	return mmGetCartHistory
+}
+
+// Return sets up results that will be returned by RepositoryIface.GetCartHistory
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) Return(ha1 []postgres.HistoryEntry, err error) *RepositoryIfaceMock {
+	if mmGetCartHistory.mock.funcGetCartHistory != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Set")
+	}
+
+	if mmGetCartHistory.defaultExpectation == nil {
+		mmGetCartHistory.defaultExpectation = &RepositoryIfaceMockGetCartHistoryExpectation{mock: mmGetCartHistory.mock}
+	}
+	mmGetCartHistory.defaultExpectation.results = &RepositoryIfaceMockGetCartHistoryResults{ha1, err}
+	mmGetCartHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCartHistory.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.GetCartHistory method
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) Set(f func(ctx context.Context, userID uint64, since time.Time, after uint64, limit int) (ha1 []postgres.HistoryEntry, err error)) *RepositoryIfaceMock {
+	if mmGetCartHistory.defaultExpectation != nil {
+		mmGetCartHistory.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.GetCartHistory method")
+	}
+
+	if len(mmGetCartHistory.expectations) > 0 {
+		mmGetCartHistory.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.GetCartHistory method")
+	}
+
+	mmGetCartHistory.mock.funcGetCartHistory = f
+	mmGetCartHistory.mock.funcGetCartHistoryOrigin = minimock.CallerInfo(1)
+	return mmGetCartHistory.mock
+}
+
+// When sets expectation for the RepositoryIface.GetCartHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) When(ctx context.Context, userID uint64, since time.Time, after uint64, limit int) *RepositoryIfaceMockGetCartHistoryExpectation {
+	if mmGetCartHistory.mock.funcGetCartHistory != nil {
+		mmGetCartHistory.mock.t.Fatalf("RepositoryIfaceMock.GetCartHistory mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockGetCartHistoryExpectation{
+		mock:               mmGetCartHistory.mock,
+		params:             &RepositoryIfaceMockGetCartHistoryParams{ctx, userID, since, after, limit},
+		expectationOrigins: RepositoryIfaceMockGetCartHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCartHistory.expectations = append(mmGetCartHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.GetCartHistory return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockGetCartHistoryExpectation) Then(ha1 []postgres.HistoryEntry, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockGetCartHistoryResults{ha1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.GetCartHistory should be invoked
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) Times(n uint64) *mRepositoryIfaceMockGetCartHistory {
+	if n == 0 {
+		mmGetCartHistory.mock.t.Fatalf("Times of RepositoryIfaceMock.GetCartHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCartHistory.expectedInvocations, n)
+	mmGetCartHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCartHistory
+}
+
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) invocationsDone() bool {
+	if len(mmGetCartHistory.expectations) == 0 && mmGetCartHistory.defaultExpectation == nil && mmGetCartHistory.mock.funcGetCartHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCartHistory.mock.afterGetCartHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCartHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCartHistory implements mm_interfaces.RepositoryIface
+func (mmGetCartHistory *RepositoryIfaceMock) GetCartHistory(ctx context.Context, userID uint64, since time.Time, after uint64, limit int) (ha1 []postgres.HistoryEntry, err error) {
+	mm_atomic.AddUint64(&mmGetCartHistory.beforeGetCartHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCartHistory.afterGetCartHistoryCounter, 1)
+
+	mmGetCartHistory.t.Helper()
+
+	if mmGetCartHistory.inspectFuncGetCartHistory != nil {
+		mmGetCartHistory.inspectFuncGetCartHistory(ctx, userID, since, after, limit)
+	}
+
+	mm_params := RepositoryIfaceMockGetCartHistoryParams{ctx, userID, since, after, limit}
+
+	// Record call args
+	mmGetCartHistory.GetCartHistoryMock.mutex.Lock()
+	mmGetCartHistory.GetCartHistoryMock.callArgs = append(mmGetCartHistory.GetCartHistoryMock.callArgs, &mm_params)
+	mmGetCartHistory.GetCartHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetCartHistory.GetCartHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ha1, e.results.err
+		}
+	}
+
+	if mmGetCartHistory.GetCartHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCartHistory.GetCartHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCartHistory.GetCartHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCartHistory.GetCartHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockGetCartHistoryParams{ctx, userID, since, after, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCartHistory.t.Errorf("RepositoryIfaceMock.GetCartHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartHistory.GetCartHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCartHistory.t.Errorf("RepositoryIfaceMock.GetCartHistory got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartHistory.GetCartHistoryMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.since != nil && !minimock.Equal(*mm_want_ptrs.since, mm_got.since) {
+				mmGetCartHistory.t.Errorf("RepositoryIfaceMock.GetCartHistory got unexpected parameter since, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartHistory.GetCartHistoryMock.defaultExpectation.expectationOrigins.originSince, *mm_want_ptrs.since, mm_got.since, minimock.Diff(*mm_want_ptrs.since, mm_got.since))
+			}
+
+			if mm_want_ptrs.after != nil && !minimock.Equal(*mm_want_ptrs.after, mm_got.after) {
+				mmGetCartHistory.t.Errorf("RepositoryIfaceMock.GetCartHistory got unexpected parameter after, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartHistory.GetCartHistoryMock.defaultExpectation.expectationOrigins.originAfter, *mm_want_ptrs.after, mm_got.after, minimock.Diff(*mm_want_ptrs.after, mm_got.after))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetCartHistory.t.Errorf("RepositoryIfaceMock.GetCartHistory got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartHistory.GetCartHistoryMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCartHistory.t.Errorf("RepositoryIfaceMock.GetCartHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCartHistory.GetCartHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCartHistory.GetCartHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCartHistory.t.Fatal("No results are set for the RepositoryIfaceMock.GetCartHistory")
+		}
+		return (*mm_results).ha1, (*mm_results).err
+	}
+	if mmGetCartHistory.funcGetCartHistory != nil {
+		return mmGetCartHistory.funcGetCartHistory(ctx, userID, since, after, limit)
+	}
+	mmGetCartHistory.t.Fatalf("Unexpected call to RepositoryIfaceMock.GetCartHistory. %v %v %v %v %v", ctx, userID, since, after, limit)
+	return
+}
+
+// GetCartHistoryAfterCounter returns a count of finished RepositoryIfaceMock.GetCartHistory invocations
+func (mmGetCartHistory *RepositoryIfaceMock) GetCartHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartHistory.afterGetCartHistoryCounter)
+}
+
+// GetCartHistoryBeforeCounter returns a count of RepositoryIfaceMock.GetCartHistory invocations
+func (mmGetCartHistory *RepositoryIfaceMock) GetCartHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartHistory.beforeGetCartHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.GetCartHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCartHistory *mRepositoryIfaceMockGetCartHistory) Calls() []*RepositoryIfaceMockGetCartHistoryParams {
+	mmGetCartHistory.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockGetCartHistoryParams, len(mmGetCartHistory.callArgs))
+	copy(argCopy, mmGetCartHistory.callArgs)
+
+	mmGetCartHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCartHistoryDone returns true if the count of the GetCartHistory invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockGetCartHistoryDone() bool {
+	if m.GetCartHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCartHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCartHistoryMock.invocationsDone()
+}
+
+// MinimockGetCartHistoryInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockGetCartHistoryInspect() {
+	for _, e := range m.GetCartHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCartHistoryCounter := mm_atomic.LoadUint64(&m.afterGetCartHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCartHistoryMock.defaultExpectation != nil && afterGetCartHistoryCounter < 1 {
+		if m.GetCartHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartHistory at\n%s", m.GetCartHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartHistory at\n%s with params: %#v", m.GetCartHistoryMock.defaultExpectation.expectationOrigins.origin, *m.GetCartHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCartHistory != nil && afterGetCartHistoryCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartHistory at\n%s", m.funcGetCartHistoryOrigin)
+	}
+
+	if !m.GetCartHistoryMock.invocationsDone() && afterGetCartHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.GetCartHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCartHistoryMock.expectedInvocations), m.GetCartHistoryMock.expectedInvocationsOrigin, afterGetCartHistoryCounter)
+	}
+}
+
+type mRepositoryIfaceMockGetCartPromo struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockGetCartPromoExpectation
+	expectations       []*RepositoryIfaceMockGetCartPromoExpectation
+
+	callArgs []*RepositoryIfaceMockGetCartPromoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockGetCartPromoExpectation specifies expectation struct of the RepositoryIface.GetCartPromo
+type RepositoryIfaceMockGetCartPromoExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockGetCartPromoParams
+	paramPtrs          *RepositoryIfaceMockGetCartPromoParamPtrs
+	expectationOrigins RepositoryIfaceMockGetCartPromoExpectationOrigins
+	results            *RepositoryIfaceMockGetCartPromoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockGetCartPromoParams contains parameters of the RepositoryIface.GetCartPromo
+type RepositoryIfaceMockGetCartPromoParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// RepositoryIfaceMockGetCartPromoParamPtrs contains pointers to parameters of the RepositoryIface.GetCartPromo
+type RepositoryIfaceMockGetCartPromoParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// RepositoryIfaceMockGetCartPromoResults contains results of the RepositoryIface.GetCartPromo
+type RepositoryIfaceMockGetCartPromoResults struct {
+	pp1 *postgres.Promo
+	err error
+}
+
+// RepositoryIfaceMockGetCartPromoOrigins contains origins of expectations of the RepositoryIface.GetCartPromo
+type RepositoryIfaceMockGetCartPromoExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) Optional() *mRepositoryIfaceMockGetCartPromo {
+	mmGetCartPromo.optional = true
+	return mmGetCartPromo
+}
+
+// Expect sets up expected params for RepositoryIface.GetCartPromo
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) Expect(ctx context.Context, userID uint64) *mRepositoryIfaceMockGetCartPromo {
+	if mmGetCartPromo.mock.funcGetCartPromo != nil {
+		mmGetCartPromo.mock.t.Fatalf("RepositoryIfaceMock.GetCartPromo mock is already set by Set")
+	}
+
+	if mmGetCartPromo.defaultExpectation == nil {
+		mmGetCartPromo.defaultExpectation = &RepositoryIfaceMockGetCartPromoExpectation{}
+	}
+
+	if mmGetCartPromo.defaultExpectation.paramPtrs != nil {
+		mmGetCartPromo.mock.t.Fatalf("RepositoryIfaceMock.GetCartPromo mock is already set by ExpectParams functions")
+	}
+
+	mmGetCartPromo.defaultExpectation.params = &RepositoryIfaceMockGetCartPromoParams{ctx, userID}
+	mmGetCartPromo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCartPromo.expectations {
+		if minimock.Equal(e.params, mmGetCartPromo.defaultExpectation.params) {
+			mmGetCartPromo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCartPromo.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCartPromo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.GetCartPromo
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockGetCartPromo {
+	if mmGetCartPromo.mock.funcGetCartPromo != nil {
+		mmGetCartPromo.mock.t.Fatalf("RepositoryIfaceMock.GetCartPromo mock is already set by Set")
+	}
+
+	if mmGetCartPromo.defaultExpectation == nil {
+		mmGetCartPromo.defaultExpectation = &RepositoryIfaceMockGetCartPromoExpectation{}
+	}
+
+	if mmGetCartPromo.defaultExpectation.params != nil {
+		mmGetCartPromo.mock.t.Fatalf("RepositoryIfaceMock.GetCartPromo mock is already set by Expect")
+	}
+
+	if mmGetCartPromo.defaultExpectation.paramPtrs == nil {
+		mmGetCartPromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartPromoParamPtrs{}
+	}
+	mmGetCartPromo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCartPromo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCartPromo
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.GetCartPromo
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockGetCartPromo {
+	if mmGetCartPromo.mock.funcGetCartPromo != nil {
+		mmGetCartPromo.mock.t.Fatalf("RepositoryIfaceMock.GetCartPromo mock is already set by Set")
+	}
+
+	if mmGetCartPromo.defaultExpectation == nil {
+		mmGetCartPromo.defaultExpectation = &RepositoryIfaceMockGetCartPromoExpectation{}
+	}
+
+	if mmGetCartPromo.defaultExpectation.params != nil {
+		mmGetCartPromo.mock.t.Fatalf("RepositoryIfaceMock.GetCartPromo mock is already set by Expect")
+	}
+
+	if mmGetCartPromo.defaultExpectation.paramPtrs == nil {
+		mmGetCartPromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartPromoParamPtrs{}
+	}
+	mmGetCartPromo.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCartPromo.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCartPromo
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.GetCartPromo
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) Inspect(f func(ctx context.Context, userID uint64)) *mRepositoryIfaceMockGetCartPromo {
+	if mmGetCartPromo.mock.inspectFuncGetCartPromo != nil {
+		mmGetCartPromo.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.GetCartPromo")
+	}
+
+	mmGetCartPromo.mock.inspectFuncGetCartPromo = f
+
+	return mmGetCartPromo
+}
+
+// Return sets up results that will be returned by RepositoryIface.GetCartPromo
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) Return(pp1 *postgres.Promo, err error) *RepositoryIfaceMock {
+	if mmGetCartPromo.mock.funcGetCartPromo != nil {
+		mmGetCartPromo.mock.t.Fatalf("RepositoryIfaceMock.GetCartPromo mock is already set by Set")
+	}
+
+	if mmGetCartPromo.defaultExpectation == nil {
+		mmGetCartPromo.defaultExpectation = &RepositoryIfaceMockGetCartPromoExpectation{mock: mmGetCartPromo.mock}
+	}
+	mmGetCartPromo.defaultExpectation.results = &RepositoryIfaceMockGetCartPromoResults{pp1, err}
+	mmGetCartPromo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCartPromo.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.GetCartPromo method
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) Set(f func(ctx context.Context, userID uint64) (pp1 *postgres.Promo, err error)) *RepositoryIfaceMock {
+	if mmGetCartPromo.defaultExpectation != nil {
+		mmGetCartPromo.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.GetCartPromo method")
+	}
+
+	if len(mmGetCartPromo.expectations) > 0 {
+		mmGetCartPromo.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.GetCartPromo method")
+	}
+
+	mmGetCartPromo.mock.funcGetCartPromo = f
+	mmGetCartPromo.mock.funcGetCartPromoOrigin = minimock.CallerInfo(1)
+	return mmGetCartPromo.mock
+}
+
+// When sets expectation for the RepositoryIface.GetCartPromo which will trigger the result defined by the following
+// Then helper
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) When(ctx context.Context, userID uint64) *RepositoryIfaceMockGetCartPromoExpectation {
+	if mmGetCartPromo.mock.funcGetCartPromo != nil {
+		mmGetCartPromo.mock.t.Fatalf("RepositoryIfaceMock.GetCartPromo mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockGetCartPromoExpectation{
+		mock:               mmGetCartPromo.mock,
+		params:             &RepositoryIfaceMockGetCartPromoParams{ctx, userID},
+		expectationOrigins: RepositoryIfaceMockGetCartPromoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCartPromo.expectations = append(mmGetCartPromo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.GetCartPromo return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockGetCartPromoExpectation) Then(pp1 *postgres.Promo, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockGetCartPromoResults{pp1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.GetCartPromo should be invoked
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) Times(n uint64) *mRepositoryIfaceMockGetCartPromo {
+	if n == 0 {
+		mmGetCartPromo.mock.t.Fatalf("Times of RepositoryIfaceMock.GetCartPromo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCartPromo.expectedInvocations, n)
+	mmGetCartPromo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCartPromo
+}
+
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) invocationsDone() bool {
+	if len(mmGetCartPromo.expectations) == 0 && mmGetCartPromo.defaultExpectation == nil && mmGetCartPromo.mock.funcGetCartPromo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCartPromo.mock.afterGetCartPromoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCartPromo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCartPromo implements mm_interfaces.RepositoryIface
+func (mmGetCartPromo *RepositoryIfaceMock) GetCartPromo(ctx context.Context, userID uint64) (pp1 *postgres.Promo, err error) {
+	mm_atomic.AddUint64(&mmGetCartPromo.beforeGetCartPromoCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCartPromo.afterGetCartPromoCounter, 1)
+
+	mmGetCartPromo.t.Helper()
+
+	if mmGetCartPromo.inspectFuncGetCartPromo != nil {
+		mmGetCartPromo.inspectFuncGetCartPromo(ctx, userID)
+	}
+
+	mm_params := RepositoryIfaceMockGetCartPromoParams{ctx, userID}
+
+	// Record call args
+	mmGetCartPromo.GetCartPromoMock.mutex.Lock()
+	mmGetCartPromo.GetCartPromoMock.callArgs = append(mmGetCartPromo.GetCartPromoMock.callArgs, &mm_params)
+	mmGetCartPromo.GetCartPromoMock.mutex.Unlock()
+
+	for _, e := range mmGetCartPromo.GetCartPromoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pp1, e.results.err
+		}
+	}
+
+	if mmGetCartPromo.GetCartPromoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCartPromo.GetCartPromoMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCartPromo.GetCartPromoMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCartPromo.GetCartPromoMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockGetCartPromoParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCartPromo.t.Errorf("RepositoryIfaceMock.GetCartPromo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartPromo.GetCartPromoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCartPromo.t.Errorf("RepositoryIfaceMock.GetCartPromo got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartPromo.GetCartPromoMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCartPromo.t.Errorf("RepositoryIfaceMock.GetCartPromo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCartPromo.GetCartPromoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCartPromo.GetCartPromoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCartPromo.t.Fatal("No results are set for the RepositoryIfaceMock.GetCartPromo")
+		}
+		return (*mm_results).pp1, (*mm_results).err
+	}
+	if mmGetCartPromo.funcGetCartPromo != nil {
+		return mmGetCartPromo.funcGetCartPromo(ctx, userID)
+	}
+	mmGetCartPromo.t.Fatalf("Unexpected call to RepositoryIfaceMock.GetCartPromo. %v %v", ctx, userID)
+	return
+}
+
+// GetCartPromoAfterCounter returns a count of finished RepositoryIfaceMock.GetCartPromo invocations
+func (mmGetCartPromo *RepositoryIfaceMock) GetCartPromoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartPromo.afterGetCartPromoCounter)
+}
+
+// GetCartPromoBeforeCounter returns a count of RepositoryIfaceMock.GetCartPromo invocations
+func (mmGetCartPromo *RepositoryIfaceMock) GetCartPromoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartPromo.beforeGetCartPromoCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.GetCartPromo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCartPromo *mRepositoryIfaceMockGetCartPromo) Calls() []*RepositoryIfaceMockGetCartPromoParams {
+	mmGetCartPromo.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockGetCartPromoParams, len(mmGetCartPromo.callArgs))
+	copy(argCopy, mmGetCartPromo.callArgs)
+
+	mmGetCartPromo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCartPromoDone returns true if the count of the GetCartPromo invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockGetCartPromoDone() bool {
+	if m.GetCartPromoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCartPromoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCartPromoMock.invocationsDone()
+}
+
+// MinimockGetCartPromoInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockGetCartPromoInspect() {
+	for _, e := range m.GetCartPromoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartPromo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCartPromoCounter := mm_atomic.LoadUint64(&m.afterGetCartPromoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCartPromoMock.defaultExpectation != nil && afterGetCartPromoCounter < 1 {
+		if m.GetCartPromoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartPromo at\n%s", m.GetCartPromoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartPromo at\n%s with params: %#v", m.GetCartPromoMock.defaultExpectation.expectationOrigins.origin, *m.GetCartPromoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCartPromo != nil && afterGetCartPromoCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartPromo at\n%s", m.funcGetCartPromoOrigin)
+	}
+
+	if !m.GetCartPromoMock.invocationsDone() && afterGetCartPromoCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.GetCartPromo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCartPromoMock.expectedInvocations), m.GetCartPromoMock.expectedInvocationsOrigin, afterGetCartPromoCounter)
+	}
+}
+
+type mRepositoryIfaceMockGetCartShare struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockGetCartShareExpectation
+	expectations       []*RepositoryIfaceMockGetCartShareExpectation
+
+	callArgs []*RepositoryIfaceMockGetCartShareParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockGetCartShareExpectation specifies expectation struct of the RepositoryIface.GetCartShare
+type RepositoryIfaceMockGetCartShareExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockGetCartShareParams
+	paramPtrs          *RepositoryIfaceMockGetCartShareParamPtrs
+	expectationOrigins RepositoryIfaceMockGetCartShareExpectationOrigins
+	results            *RepositoryIfaceMockGetCartShareResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockGetCartShareParams contains parameters of the RepositoryIface.GetCartShare
+type RepositoryIfaceMockGetCartShareParams struct {
+	ctx context.Context
+	id  uint64
+}
+
+// RepositoryIfaceMockGetCartShareParamPtrs contains pointers to parameters of the RepositoryIface.GetCartShare
+type RepositoryIfaceMockGetCartShareParamPtrs struct {
+	ctx *context.Context
+	id  *uint64
+}
+
+// RepositoryIfaceMockGetCartShareResults contains results of the RepositoryIface.GetCartShare
+type RepositoryIfaceMockGetCartShareResults struct {
+	cp1 *postgres.CartShare
+	err error
+}
+
+// RepositoryIfaceMockGetCartShareOrigins contains origins of expectations of the RepositoryIface.GetCartShare
+type RepositoryIfaceMockGetCartShareExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) Optional() *mRepositoryIfaceMockGetCartShare {
+	mmGetCartShare.optional = true
+	return mmGetCartShare
+}
+
+// Expect sets up expected params for RepositoryIface.GetCartShare
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) Expect(ctx context.Context, id uint64) *mRepositoryIfaceMockGetCartShare {
+	if mmGetCartShare.mock.funcGetCartShare != nil {
+		mmGetCartShare.mock.t.Fatalf("RepositoryIfaceMock.GetCartShare mock is already set by Set")
+	}
+
+	if mmGetCartShare.defaultExpectation == nil {
+		mmGetCartShare.defaultExpectation = &RepositoryIfaceMockGetCartShareExpectation{}
+	}
+
+	if mmGetCartShare.defaultExpectation.paramPtrs != nil {
+		mmGetCartShare.mock.t.Fatalf("RepositoryIfaceMock.GetCartShare mock is already set by ExpectParams functions")
+	}
+
+	mmGetCartShare.defaultExpectation.params = &RepositoryIfaceMockGetCartShareParams{ctx, id}
+	mmGetCartShare.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCartShare.expectations {
+		if minimock.Equal(e.params, mmGetCartShare.defaultExpectation.params) {
+			mmGetCartShare.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCartShare.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCartShare
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.GetCartShare
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockGetCartShare {
+	if mmGetCartShare.mock.funcGetCartShare != nil {
+		mmGetCartShare.mock.t.Fatalf("RepositoryIfaceMock.GetCartShare mock is already set by Set")
+	}
+
+	if mmGetCartShare.defaultExpectation == nil {
+		mmGetCartShare.defaultExpectation = &RepositoryIfaceMockGetCartShareExpectation{}
+	}
+
+	if mmGetCartShare.defaultExpectation.params != nil {
+		mmGetCartShare.mock.t.Fatalf("RepositoryIfaceMock.GetCartShare mock is already set by Expect")
+	}
+
+	if mmGetCartShare.defaultExpectation.paramPtrs == nil {
+		mmGetCartShare.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartShareParamPtrs{}
+	}
+	mmGetCartShare.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCartShare.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCartShare
+}
+
+// ExpectIdParam2 sets up expected param id for RepositoryIface.GetCartShare
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) ExpectIdParam2(id uint64) *mRepositoryIfaceMockGetCartShare {
+	if mmGetCartShare.mock.funcGetCartShare != nil {
+		mmGetCartShare.mock.t.Fatalf("RepositoryIfaceMock.GetCartShare mock is already set by Set")
+	}
+
+	if mmGetCartShare.defaultExpectation == nil {
+		mmGetCartShare.defaultExpectation = &RepositoryIfaceMockGetCartShareExpectation{}
+	}
+
+	if mmGetCartShare.defaultExpectation.params != nil {
+		mmGetCartShare.mock.t.Fatalf("RepositoryIfaceMock.GetCartShare mock is already set by Expect")
+	}
+
+	if mmGetCartShare.defaultExpectation.paramPtrs == nil {
+		mmGetCartShare.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartShareParamPtrs{}
+	}
+	mmGetCartShare.defaultExpectation.paramPtrs.id = &id
+	mmGetCartShare.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetCartShare
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.GetCartShare
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) Inspect(f func(ctx context.Context, id uint64)) *mRepositoryIfaceMockGetCartShare {
+	if mmGetCartShare.mock.inspectFuncGetCartShare != nil {
+		mmGetCartShare.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.GetCartShare")
+	}
+
+	mmGetCartShare.mock.inspectFuncGetCartShare = f
+
+	return mmGetCartShare
+}
+
+// Return sets up results that will be returned by RepositoryIface.GetCartShare
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) Return(cp1 *postgres.CartShare, err error) *RepositoryIfaceMock {
+	if mmGetCartShare.mock.funcGetCartShare != nil {
+		mmGetCartShare.mock.t.Fatalf("RepositoryIfaceMock.GetCartShare mock is already set by Set")
+	}
+
+	if mmGetCartShare.defaultExpectation == nil {
+		mmGetCartShare.defaultExpectation = &RepositoryIfaceMockGetCartShareExpectation{mock: mmGetCartShare.mock}
+	}
+	mmGetCartShare.defaultExpectation.results = &RepositoryIfaceMockGetCartShareResults{cp1, err}
+	mmGetCartShare.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCartShare.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.GetCartShare method
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) Set(f func(ctx context.Context, id uint64) (cp1 *postgres.CartShare, err error)) *RepositoryIfaceMock {
+	if mmGetCartShare.defaultExpectation != nil {
+		mmGetCartShare.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.GetCartShare method")
+	}
+
+	if len(mmGetCartShare.expectations) > 0 {
+		mmGetCartShare.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.GetCartShare method")
+	}
+
+	mmGetCartShare.mock.funcGetCartShare = f
+	mmGetCartShare.mock.funcGetCartShareOrigin = minimock.CallerInfo(1)
+	return mmGetCartShare.mock
+}
+
+// When sets expectation for the RepositoryIface.GetCartShare which will trigger the result defined by the following
+// Then helper
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) When(ctx context.Context, id uint64) *RepositoryIfaceMockGetCartShareExpectation {
+	if mmGetCartShare.mock.funcGetCartShare != nil {
+		mmGetCartShare.mock.t.Fatalf("RepositoryIfaceMock.GetCartShare mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockGetCartShareExpectation{
+		mock:               mmGetCartShare.mock,
+		params:             &RepositoryIfaceMockGetCartShareParams{ctx, id},
+		expectationOrigins: RepositoryIfaceMockGetCartShareExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCartShare.expectations = append(mmGetCartShare.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.GetCartShare return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockGetCartShareExpectation) Then(cp1 *postgres.CartShare, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockGetCartShareResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.GetCartShare should be invoked
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) Times(n uint64) *mRepositoryIfaceMockGetCartShare {
+	if n == 0 {
+		mmGetCartShare.mock.t.Fatalf("Times of RepositoryIfaceMock.GetCartShare mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCartShare.expectedInvocations, n)
+	mmGetCartShare.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCartShare
+}
+
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) invocationsDone() bool {
+	if len(mmGetCartShare.expectations) == 0 && mmGetCartShare.defaultExpectation == nil && mmGetCartShare.mock.funcGetCartShare == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCartShare.mock.afterGetCartShareCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCartShare.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCartShare implements mm_interfaces.RepositoryIface
+func (mmGetCartShare *RepositoryIfaceMock) GetCartShare(ctx context.Context, id uint64) (cp1 *postgres.CartShare, err error) {
+	mm_atomic.AddUint64(&mmGetCartShare.beforeGetCartShareCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCartShare.afterGetCartShareCounter, 1)
+
+	mmGetCartShare.t.Helper()
+
+	if mmGetCartShare.inspectFuncGetCartShare != nil {
+		mmGetCartShare.inspectFuncGetCartShare(ctx, id)
+	}
+
+	mm_params := RepositoryIfaceMockGetCartShareParams{ctx, id}
+
+	// Record call args
+	mmGetCartShare.GetCartShareMock.mutex.Lock()
+	mmGetCartShare.GetCartShareMock.callArgs = append(mmGetCartShare.GetCartShareMock.callArgs, &mm_params)
+	mmGetCartShare.GetCartShareMock.mutex.Unlock()
+
+	for _, e := range mmGetCartShare.GetCartShareMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGetCartShare.GetCartShareMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCartShare.GetCartShareMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCartShare.GetCartShareMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCartShare.GetCartShareMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockGetCartShareParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCartShare.t.Errorf("RepositoryIfaceMock.GetCartShare got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartShare.GetCartShareMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetCartShare.t.Errorf("RepositoryIfaceMock.GetCartShare got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartShare.GetCartShareMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCartShare.t.Errorf("RepositoryIfaceMock.GetCartShare got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCartShare.GetCartShareMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCartShare.GetCartShareMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCartShare.t.Fatal("No results are set for the RepositoryIfaceMock.GetCartShare")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGetCartShare.funcGetCartShare != nil {
+		return mmGetCartShare.funcGetCartShare(ctx, id)
+	}
+	mmGetCartShare.t.Fatalf("Unexpected call to RepositoryIfaceMock.GetCartShare. %v %v", ctx, id)
+	return
+}
+
+// GetCartShareAfterCounter returns a count of finished RepositoryIfaceMock.GetCartShare invocations
+func (mmGetCartShare *RepositoryIfaceMock) GetCartShareAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartShare.afterGetCartShareCounter)
+}
+
+// GetCartShareBeforeCounter returns a count of RepositoryIfaceMock.GetCartShare invocations
+func (mmGetCartShare *RepositoryIfaceMock) GetCartShareBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartShare.beforeGetCartShareCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.GetCartShare.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCartShare *mRepositoryIfaceMockGetCartShare) Calls() []*RepositoryIfaceMockGetCartShareParams {
+	mmGetCartShare.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockGetCartShareParams, len(mmGetCartShare.callArgs))
+	copy(argCopy, mmGetCartShare.callArgs)
+
+	mmGetCartShare.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCartShareDone returns true if the count of the GetCartShare invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockGetCartShareDone() bool {
+	if m.GetCartShareMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCartShareMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCartShareMock.invocationsDone()
+}
+
+// MinimockGetCartShareInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockGetCartShareInspect() {
+	for _, e := range m.GetCartShareMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartShare at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCartShareCounter := mm_atomic.LoadUint64(&m.afterGetCartShareCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCartShareMock.defaultExpectation != nil && afterGetCartShareCounter < 1 {
+		if m.GetCartShareMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartShare at\n%s", m.GetCartShareMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartShare at\n%s with params: %#v", m.GetCartShareMock.defaultExpectation.expectationOrigins.origin, *m.GetCartShareMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCartShare != nil && afterGetCartShareCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.GetCartShare at\n%s", m.funcGetCartShareOrigin)
+	}
+
+	if !m.GetCartShareMock.invocationsDone() && afterGetCartShareCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.GetCartShare at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCartShareMock.expectedInvocations), m.GetCartShareMock.expectedInvocationsOrigin, afterGetCartShareCounter)
+	}
+}
+
+type mRepositoryIfaceMockGetOrder struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockGetOrderExpectation
+	expectations       []*RepositoryIfaceMockGetOrderExpectation
+
+	callArgs []*RepositoryIfaceMockGetOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockGetOrderExpectation specifies expectation struct of the RepositoryIface.GetOrder
+type RepositoryIfaceMockGetOrderExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockGetOrderParams
+	paramPtrs          *RepositoryIfaceMockGetOrderParamPtrs
+	expectationOrigins RepositoryIfaceMockGetOrderExpectationOrigins
+	results            *RepositoryIfaceMockGetOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockGetOrderParams contains parameters of the RepositoryIface.GetOrder
+type RepositoryIfaceMockGetOrderParams struct {
+	ctx     context.Context
+	userID  uint64
+	orderID uint64
+}
+
+// RepositoryIfaceMockGetOrderParamPtrs contains pointers to parameters of the RepositoryIface.GetOrder
+type RepositoryIfaceMockGetOrderParamPtrs struct {
+	ctx     *context.Context
+	userID  *uint64
+	orderID *uint64
+}
+
+// RepositoryIfaceMockGetOrderResults contains results of the RepositoryIface.GetOrder
+type RepositoryIfaceMockGetOrderResults struct {
+	op1 *postgres.Order
+	err error
+}
+
+// RepositoryIfaceMockGetOrderOrigins contains origins of expectations of the RepositoryIface.GetOrder
+type RepositoryIfaceMockGetOrderExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) Optional() *mRepositoryIfaceMockGetOrder {
+	mmGetOrder.optional = true
+	return mmGetOrder
+}
+
+// Expect sets up expected params for RepositoryIface.GetOrder
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) Expect(ctx context.Context, userID uint64, orderID uint64) *mRepositoryIfaceMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("RepositoryIfaceMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &RepositoryIfaceMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs != nil {
+		mmGetOrder.mock.t.Fatalf("RepositoryIfaceMock.GetOrder mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrder.defaultExpectation.params = &RepositoryIfaceMockGetOrderParams{ctx, userID, orderID}
+	mmGetOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrder.expectations {
+		if minimock.Equal(e.params, mmGetOrder.defaultExpectation.params) {
+			mmGetOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.GetOrder
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("RepositoryIfaceMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &RepositoryIfaceMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.params != nil {
+		mmGetOrder.mock.t.Fatalf("RepositoryIfaceMock.GetOrder mock is already set by Expect")
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs == nil {
+		mmGetOrder.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetOrderParamPtrs{}
+	}
+	mmGetOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrder
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.GetOrder
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("RepositoryIfaceMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &RepositoryIfaceMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.params != nil {
+		mmGetOrder.mock.t.Fatalf("RepositoryIfaceMock.GetOrder mock is already set by Expect")
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs == nil {
+		mmGetOrder.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetOrderParamPtrs{}
+	}
+	mmGetOrder.defaultExpectation.paramPtrs.userID = &userID
+	mmGetOrder.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetOrder
+}
+
+// ExpectOrderIDParam3 sets up expected param orderID for RepositoryIface.GetOrder
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) ExpectOrderIDParam3(orderID uint64) *mRepositoryIfaceMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("RepositoryIfaceMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &RepositoryIfaceMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.params != nil {
+		mmGetOrder.mock.t.Fatalf("RepositoryIfaceMock.GetOrder mock is already set by Expect")
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs == nil {
+		mmGetOrder.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetOrderParamPtrs{}
+	}
+	mmGetOrder.defaultExpectation.paramPtrs.orderID = &orderID
+	mmGetOrder.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmGetOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.GetOrder
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) Inspect(f func(ctx context.Context, userID uint64, orderID uint64)) *mRepositoryIfaceMockGetOrder {
+	if mmGetOrder.mock.inspectFuncGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.GetOrder")
+	}
+
+	mmGetOrder.mock.inspectFuncGetOrder = f
+
+	return mmGetOrder
+}
+
+// Return sets up results that will be returned by RepositoryIface.GetOrder
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) Return(op1 *postgres.Order, err error) *RepositoryIfaceMock {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("RepositoryIfaceMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &RepositoryIfaceMockGetOrderExpectation{mock: mmGetOrder.mock}
+	}
+	mmGetOrder.defaultExpectation.results = &RepositoryIfaceMockGetOrderResults{op1, err}
+	mmGetOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrder.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.GetOrder method
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) Set(f func(ctx context.Context, userID uint64, orderID uint64) (op1 *postgres.Order, err error)) *RepositoryIfaceMock {
+	if mmGetOrder.defaultExpectation != nil {
+		mmGetOrder.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.GetOrder method")
+	}
+
+	if len(mmGetOrder.expectations) > 0 {
+		mmGetOrder.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.GetOrder method")
+	}
+
+	mmGetOrder.mock.funcGetOrder = f
+	mmGetOrder.mock.funcGetOrderOrigin = minimock.CallerInfo(1)
+	return mmGetOrder.mock
+}
+
+// When sets expectation for the RepositoryIface.GetOrder which will trigger the result defined by the following
+// Then helper
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) When(ctx context.Context, userID uint64, orderID uint64) *RepositoryIfaceMockGetOrderExpectation {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("RepositoryIfaceMock.GetOrder mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockGetOrderExpectation{
+		mock:               mmGetOrder.mock,
+		params:             &RepositoryIfaceMockGetOrderParams{ctx, userID, orderID},
+		expectationOrigins: RepositoryIfaceMockGetOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrder.expectations = append(mmGetOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.GetOrder return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockGetOrderExpectation) Then(op1 *postgres.Order, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockGetOrderResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.GetOrder should be invoked
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) Times(n uint64) *mRepositoryIfaceMockGetOrder {
+	if n == 0 {
+		mmGetOrder.mock.t.Fatalf("Times of RepositoryIfaceMock.GetOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrder.expectedInvocations, n)
+	mmGetOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrder
+}
+
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) invocationsDone() bool {
+	if len(mmGetOrder.expectations) == 0 && mmGetOrder.defaultExpectation == nil && mmGetOrder.mock.funcGetOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrder.mock.afterGetOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrder implements mm_interfaces.RepositoryIface
+func (mmGetOrder *RepositoryIfaceMock) GetOrder(ctx context.Context, userID uint64, orderID uint64) (op1 *postgres.Order, err error) {
+	mm_atomic.AddUint64(&mmGetOrder.beforeGetOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrder.afterGetOrderCounter, 1)
+
+	mmGetOrder.t.Helper()
+
+	if mmGetOrder.inspectFuncGetOrder != nil {
+		mmGetOrder.inspectFuncGetOrder(ctx, userID, orderID)
+	}
+
+	mm_params := RepositoryIfaceMockGetOrderParams{ctx, userID, orderID}
+
+	// Record call args
+	mmGetOrder.GetOrderMock.mutex.Lock()
+	mmGetOrder.GetOrderMock.callArgs = append(mmGetOrder.GetOrderMock.callArgs, &mm_params)
+	mmGetOrder.GetOrderMock.mutex.Unlock()
+
+	for _, e := range mmGetOrder.GetOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmGetOrder.GetOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrder.GetOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrder.GetOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrder.GetOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockGetOrderParams{ctx, userID, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrder.t.Errorf("RepositoryIfaceMock.GetOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetOrder.t.Errorf("RepositoryIfaceMock.GetOrder got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmGetOrder.t.Errorf("RepositoryIfaceMock.GetOrder got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrder.t.Errorf("RepositoryIfaceMock.GetOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrder.GetOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrder.t.Fatal("No results are set for the RepositoryIfaceMock.GetOrder")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmGetOrder.funcGetOrder != nil {
+		return mmGetOrder.funcGetOrder(ctx, userID, orderID)
+	}
+	mmGetOrder.t.Fatalf("Unexpected call to RepositoryIfaceMock.GetOrder. %v %v %v", ctx, userID, orderID)
+	return
+}
+
+// GetOrderAfterCounter returns a count of finished RepositoryIfaceMock.GetOrder invocations
+func (mmGetOrder *RepositoryIfaceMock) GetOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrder.afterGetOrderCounter)
+}
+
+// GetOrderBeforeCounter returns a count of RepositoryIfaceMock.GetOrder invocations
+func (mmGetOrder *RepositoryIfaceMock) GetOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrder.beforeGetOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.GetOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrder *mRepositoryIfaceMockGetOrder) Calls() []*RepositoryIfaceMockGetOrderParams {
+	mmGetOrder.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockGetOrderParams, len(mmGetOrder.callArgs))
+	copy(argCopy, mmGetOrder.callArgs)
+
+	mmGetOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderDone returns true if the count of the GetOrder invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockGetOrderDone() bool {
+	if m.GetOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrderMock.invocationsDone()
+}
+
+// MinimockGetOrderInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockGetOrderInspect() {
+	for _, e := range m.GetOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrderCounter := mm_atomic.LoadUint64(&m.afterGetOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderMock.defaultExpectation != nil && afterGetOrderCounter < 1 {
+		if m.GetOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetOrder at\n%s", m.GetOrderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GetOrder at\n%s with params: %#v", m.GetOrderMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrder != nil && afterGetOrderCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.GetOrder at\n%s", m.funcGetOrderOrigin)
+	}
+
+	if !m.GetOrderMock.invocationsDone() && afterGetOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.GetOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrderMock.expectedInvocations), m.GetOrderMock.expectedInvocationsOrigin, afterGetOrderCounter)
+	}
+}
+
+type mRepositoryIfaceMockGuestCartID struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockGuestCartIDExpectation
+	expectations       []*RepositoryIfaceMockGuestCartIDExpectation
+
+	callArgs []*RepositoryIfaceMockGuestCartIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockGuestCartIDExpectation specifies expectation struct of the RepositoryIface.GuestCartID
+type RepositoryIfaceMockGuestCartIDExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockGuestCartIDParams
+	paramPtrs          *RepositoryIfaceMockGuestCartIDParamPtrs
+	expectationOrigins RepositoryIfaceMockGuestCartIDExpectationOrigins
+	results            *RepositoryIfaceMockGuestCartIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockGuestCartIDParams contains parameters of the RepositoryIface.GuestCartID
+type RepositoryIfaceMockGuestCartIDParams struct {
+	ctx       context.Context
+	tokenHash string
+}
+
+// RepositoryIfaceMockGuestCartIDParamPtrs contains pointers to parameters of the RepositoryIface.GuestCartID
+type RepositoryIfaceMockGuestCartIDParamPtrs struct {
+	ctx       *context.Context
+	tokenHash *string
+}
+
+// RepositoryIfaceMockGuestCartIDResults contains results of the RepositoryIface.GuestCartID
+type RepositoryIfaceMockGuestCartIDResults struct {
+	u1  uint64
+	err error
+}
+
+// RepositoryIfaceMockGuestCartIDOrigins contains origins of expectations of the RepositoryIface.GuestCartID
+type RepositoryIfaceMockGuestCartIDExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originTokenHash string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) Optional() *mRepositoryIfaceMockGuestCartID {
+	mmGuestCartID.optional = true
+	return mmGuestCartID
+}
+
+// Expect sets up expected params for RepositoryIface.GuestCartID
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) Expect(ctx context.Context, tokenHash string) *mRepositoryIfaceMockGuestCartID {
+	if mmGuestCartID.mock.funcGuestCartID != nil {
+		mmGuestCartID.mock.t.Fatalf("RepositoryIfaceMock.GuestCartID mock is already set by Set")
+	}
+
+	if mmGuestCartID.defaultExpectation == nil {
+		mmGuestCartID.defaultExpectation = &RepositoryIfaceMockGuestCartIDExpectation{}
+	}
+
+	if mmGuestCartID.defaultExpectation.paramPtrs != nil {
+		mmGuestCartID.mock.t.Fatalf("RepositoryIfaceMock.GuestCartID mock is already set by ExpectParams functions")
+	}
+
+	mmGuestCartID.defaultExpectation.params = &RepositoryIfaceMockGuestCartIDParams{ctx, tokenHash}
+	mmGuestCartID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGuestCartID.expectations {
+		if minimock.Equal(e.params, mmGuestCartID.defaultExpectation.params) {
+			mmGuestCartID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGuestCartID.defaultExpectation.params)
+		}
+	}
+
+	return mmGuestCartID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.GuestCartID
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockGuestCartID {
+	if mmGuestCartID.mock.funcGuestCartID != nil {
+		mmGuestCartID.mock.t.Fatalf("RepositoryIfaceMock.GuestCartID mock is already set by Set")
+	}
+
+	if mmGuestCartID.defaultExpectation == nil {
+		mmGuestCartID.defaultExpectation = &RepositoryIfaceMockGuestCartIDExpectation{}
+	}
+
+	if mmGuestCartID.defaultExpectation.params != nil {
+		mmGuestCartID.mock.t.Fatalf("RepositoryIfaceMock.GuestCartID mock is already set by Expect")
+	}
+
+	if mmGuestCartID.defaultExpectation.paramPtrs == nil {
+		mmGuestCartID.defaultExpectation.paramPtrs = &RepositoryIfaceMockGuestCartIDParamPtrs{}
+	}
+	mmGuestCartID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGuestCartID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGuestCartID
+}
+
+// ExpectTokenHashParam2 sets up expected param tokenHash for RepositoryIface.GuestCartID
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) ExpectTokenHashParam2(tokenHash string) *mRepositoryIfaceMockGuestCartID {
+	if mmGuestCartID.mock.funcGuestCartID != nil {
+		mmGuestCartID.mock.t.Fatalf("RepositoryIfaceMock.GuestCartID mock is already set by Set")
+	}
+
+	if mmGuestCartID.defaultExpectation == nil {
+		mmGuestCartID.defaultExpectation = &RepositoryIfaceMockGuestCartIDExpectation{}
+	}
+
+	if mmGuestCartID.defaultExpectation.params != nil {
+		mmGuestCartID.mock.t.Fatalf("RepositoryIfaceMock.GuestCartID mock is already set by Expect")
+	}
+
+	if mmGuestCartID.defaultExpectation.paramPtrs == nil {
+		mmGuestCartID.defaultExpectation.paramPtrs = &RepositoryIfaceMockGuestCartIDParamPtrs{}
+	}
+	mmGuestCartID.defaultExpectation.paramPtrs.tokenHash = &tokenHash
+	mmGuestCartID.defaultExpectation.expectationOrigins.originTokenHash = minimock.CallerInfo(1)
+
+	return mmGuestCartID
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.GuestCartID
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) Inspect(f func(ctx context.Context, tokenHash string)) *mRepositoryIfaceMockGuestCartID {
+	if mmGuestCartID.mock.inspectFuncGuestCartID != nil {
+		mmGuestCartID.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.GuestCartID")
+	}
+
+	mmGuestCartID.mock.inspectFuncGuestCartID = f
+
+	return mmGuestCartID
+}
+
+// Return sets up results that will be returned by RepositoryIface.GuestCartID
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) Return(u1 uint64, err error) *RepositoryIfaceMock {
+	if mmGuestCartID.mock.funcGuestCartID != nil {
+		mmGuestCartID.mock.t.Fatalf("RepositoryIfaceMock.GuestCartID mock is already set by Set")
+	}
+
+	if mmGuestCartID.defaultExpectation == nil {
+		mmGuestCartID.defaultExpectation = &RepositoryIfaceMockGuestCartIDExpectation{mock: mmGuestCartID.mock}
+	}
+	mmGuestCartID.defaultExpectation.results = &RepositoryIfaceMockGuestCartIDResults{u1, err}
+	mmGuestCartID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGuestCartID.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.GuestCartID method
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) Set(f func(ctx context.Context, tokenHash string) (u1 uint64, err error)) *RepositoryIfaceMock {
+	if mmGuestCartID.defaultExpectation != nil {
+		mmGuestCartID.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.GuestCartID method")
+	}
+
+	if len(mmGuestCartID.expectations) > 0 {
+		mmGuestCartID.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.GuestCartID method")
+	}
+
+	mmGuestCartID.mock.funcGuestCartID = f
+	mmGuestCartID.mock.funcGuestCartIDOrigin = minimock.CallerInfo(1)
+	return mmGuestCartID.mock
+}
+
+// When sets expectation for the RepositoryIface.GuestCartID which will trigger the result defined by the following
+// Then helper
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) When(ctx context.Context, tokenHash string) *RepositoryIfaceMockGuestCartIDExpectation {
+	if mmGuestCartID.mock.funcGuestCartID != nil {
+		mmGuestCartID.mock.t.Fatalf("RepositoryIfaceMock.GuestCartID mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockGuestCartIDExpectation{
+		mock:               mmGuestCartID.mock,
+		params:             &RepositoryIfaceMockGuestCartIDParams{ctx, tokenHash},
+		expectationOrigins: RepositoryIfaceMockGuestCartIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGuestCartID.expectations = append(mmGuestCartID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.GuestCartID return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockGuestCartIDExpectation) Then(u1 uint64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockGuestCartIDResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.GuestCartID should be invoked
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) Times(n uint64) *mRepositoryIfaceMockGuestCartID {
+	if n == 0 {
+		mmGuestCartID.mock.t.Fatalf("Times of RepositoryIfaceMock.GuestCartID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGuestCartID.expectedInvocations, n)
+	mmGuestCartID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGuestCartID
+}
+
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) invocationsDone() bool {
+	if len(mmGuestCartID.expectations) == 0 && mmGuestCartID.defaultExpectation == nil && mmGuestCartID.mock.funcGuestCartID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGuestCartID.mock.afterGuestCartIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGuestCartID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GuestCartID implements mm_interfaces.RepositoryIface
+func (mmGuestCartID *RepositoryIfaceMock) GuestCartID(ctx context.Context, tokenHash string) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmGuestCartID.beforeGuestCartIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGuestCartID.afterGuestCartIDCounter, 1)
+
+	mmGuestCartID.t.Helper()
+
+	if mmGuestCartID.inspectFuncGuestCartID != nil {
+		mmGuestCartID.inspectFuncGuestCartID(ctx, tokenHash)
+	}
+
+	mm_params := RepositoryIfaceMockGuestCartIDParams{ctx, tokenHash}
+
+	// Record call args
+	mmGuestCartID.GuestCartIDMock.mutex.Lock()
+	mmGuestCartID.GuestCartIDMock.callArgs = append(mmGuestCartID.GuestCartIDMock.callArgs, &mm_params)
+	mmGuestCartID.GuestCartIDMock.mutex.Unlock()
+
+	for _, e := range mmGuestCartID.GuestCartIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGuestCartID.GuestCartIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGuestCartID.GuestCartIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGuestCartID.GuestCartIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGuestCartID.GuestCartIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockGuestCartIDParams{ctx, tokenHash}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGuestCartID.t.Errorf("RepositoryIfaceMock.GuestCartID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGuestCartID.GuestCartIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.tokenHash != nil && !minimock.Equal(*mm_want_ptrs.tokenHash, mm_got.tokenHash) {
+				mmGuestCartID.t.Errorf("RepositoryIfaceMock.GuestCartID got unexpected parameter tokenHash, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGuestCartID.GuestCartIDMock.defaultExpectation.expectationOrigins.originTokenHash, *mm_want_ptrs.tokenHash, mm_got.tokenHash, minimock.Diff(*mm_want_ptrs.tokenHash, mm_got.tokenHash))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGuestCartID.t.Errorf("RepositoryIfaceMock.GuestCartID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGuestCartID.GuestCartIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGuestCartID.GuestCartIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGuestCartID.t.Fatal("No results are set for the RepositoryIfaceMock.GuestCartID")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGuestCartID.funcGuestCartID != nil {
+		return mmGuestCartID.funcGuestCartID(ctx, tokenHash)
+	}
+	mmGuestCartID.t.Fatalf("Unexpected call to RepositoryIfaceMock.GuestCartID. %v %v", ctx, tokenHash)
+	return
+}
+
+// GuestCartIDAfterCounter returns a count of finished RepositoryIfaceMock.GuestCartID invocations
+func (mmGuestCartID *RepositoryIfaceMock) GuestCartIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGuestCartID.afterGuestCartIDCounter)
+}
+
+// GuestCartIDBeforeCounter returns a count of RepositoryIfaceMock.GuestCartID invocations
+func (mmGuestCartID *RepositoryIfaceMock) GuestCartIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGuestCartID.beforeGuestCartIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.GuestCartID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGuestCartID *mRepositoryIfaceMockGuestCartID) Calls() []*RepositoryIfaceMockGuestCartIDParams {
+	mmGuestCartID.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockGuestCartIDParams, len(mmGuestCartID.callArgs))
+	copy(argCopy, mmGuestCartID.callArgs)
+
+	mmGuestCartID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGuestCartIDDone returns true if the count of the GuestCartID invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockGuestCartIDDone() bool {
+	if m.GuestCartIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GuestCartIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GuestCartIDMock.invocationsDone()
+}
+
+// MinimockGuestCartIDInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockGuestCartIDInspect() {
+	for _, e := range m.GuestCartIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GuestCartID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGuestCartIDCounter := mm_atomic.LoadUint64(&m.afterGuestCartIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GuestCartIDMock.defaultExpectation != nil && afterGuestCartIDCounter < 1 {
+		if m.GuestCartIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GuestCartID at\n%s", m.GuestCartIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.GuestCartID at\n%s with params: %#v", m.GuestCartIDMock.defaultExpectation.expectationOrigins.origin, *m.GuestCartIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGuestCartID != nil && afterGuestCartIDCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.GuestCartID at\n%s", m.funcGuestCartIDOrigin)
+	}
+
+	if !m.GuestCartIDMock.invocationsDone() && afterGuestCartIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.GuestCartID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GuestCartIDMock.expectedInvocations), m.GuestCartIDMock.expectedInvocationsOrigin, afterGuestCartIDCounter)
+	}
+}
+
+type mRepositoryIfaceMockImportItems struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockImportItemsExpectation
+	expectations       []*RepositoryIfaceMockImportItemsExpectation
+
+	callArgs []*RepositoryIfaceMockImportItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockImportItemsExpectation specifies expectation struct of the RepositoryIface.ImportItems
+type RepositoryIfaceMockImportItemsExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockImportItemsParams
+	paramPtrs          *RepositoryIfaceMockImportItemsParamPtrs
+	expectationOrigins RepositoryIfaceMockImportItemsExpectationOrigins
+	results            *RepositoryIfaceMockImportItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockImportItemsParams contains parameters of the RepositoryIface.ImportItems
+type RepositoryIfaceMockImportItemsParams struct {
+	ctx      context.Context
+	userID   uint64
+	shareID  uint64
+	items    []postgres.Position
+	expected *uint64
+}
+
+// RepositoryIfaceMockImportItemsParamPtrs contains pointers to parameters of the RepositoryIface.ImportItems
+type RepositoryIfaceMockImportItemsParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	shareID  *uint64
+	items    *[]postgres.Position
+	expected **uint64
+}
+
+// RepositoryIfaceMockImportItemsResults contains results of the RepositoryIface.ImportItems
+type RepositoryIfaceMockImportItemsResults struct {
+	err error
+}
+
+// RepositoryIfaceMockImportItemsOrigins contains origins of expectations of the RepositoryIface.ImportItems
+type RepositoryIfaceMockImportItemsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originShareID  string
+	originItems    string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmImportItems *mRepositoryIfaceMockImportItems) Optional() *mRepositoryIfaceMockImportItems {
+	mmImportItems.optional = true
+	return mmImportItems
+}
+
+// Expect sets up expected params for RepositoryIface.ImportItems
+func (mmImportItems *mRepositoryIfaceMockImportItems) Expect(ctx context.Context, userID uint64, shareID uint64, items []postgres.Position, expected *uint64) *mRepositoryIfaceMockImportItems {
+	if mmImportItems.mock.funcImportItems != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Set")
+	}
+
+	if mmImportItems.defaultExpectation == nil {
+		mmImportItems.defaultExpectation = &RepositoryIfaceMockImportItemsExpectation{}
+	}
+
+	if mmImportItems.defaultExpectation.paramPtrs != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by ExpectParams functions")
+	}
+
+	mmImportItems.defaultExpectation.params = &RepositoryIfaceMockImportItemsParams{ctx, userID, shareID, items, expected}
+	mmImportItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmImportItems.expectations {
+		if minimock.Equal(e.params, mmImportItems.defaultExpectation.params) {
+			mmImportItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmImportItems.defaultExpectation.params)
+		}
+	}
+
+	return mmImportItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.ImportItems
+func (mmImportItems *mRepositoryIfaceMockImportItems) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockImportItems {
+	if mmImportItems.mock.funcImportItems != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Set")
+	}
+
+	if mmImportItems.defaultExpectation == nil {
+		mmImportItems.defaultExpectation = &RepositoryIfaceMockImportItemsExpectation{}
+	}
+
+	if mmImportItems.defaultExpectation.params != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Expect")
+	}
+
+	if mmImportItems.defaultExpectation.paramPtrs == nil {
+		mmImportItems.defaultExpectation.paramPtrs = &RepositoryIfaceMockImportItemsParamPtrs{}
+	}
+	mmImportItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmImportItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmImportItems
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.ImportItems
+func (mmImportItems *mRepositoryIfaceMockImportItems) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockImportItems {
+	if mmImportItems.mock.funcImportItems != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Set")
+	}
+
+	if mmImportItems.defaultExpectation == nil {
+		mmImportItems.defaultExpectation = &RepositoryIfaceMockImportItemsExpectation{}
+	}
+
+	if mmImportItems.defaultExpectation.params != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Expect")
+	}
+
+	if mmImportItems.defaultExpectation.paramPtrs == nil {
+		mmImportItems.defaultExpectation.paramPtrs = &RepositoryIfaceMockImportItemsParamPtrs{}
+	}
+	mmImportItems.defaultExpectation.paramPtrs.userID = &userID
+	mmImportItems.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmImportItems
+}
+
+// ExpectShareIDParam3 sets up expected param shareID for RepositoryIface.ImportItems
+func (mmImportItems *mRepositoryIfaceMockImportItems) ExpectShareIDParam3(shareID uint64) *mRepositoryIfaceMockImportItems {
+	if mmImportItems.mock.funcImportItems != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Set")
+	}
+
+	if mmImportItems.defaultExpectation == nil {
+		mmImportItems.defaultExpectation = &RepositoryIfaceMockImportItemsExpectation{}
+	}
+
+	if mmImportItems.defaultExpectation.params != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Expect")
+	}
+
+	if mmImportItems.defaultExpectation.paramPtrs == nil {
+		mmImportItems.defaultExpectation.paramPtrs = &RepositoryIfaceMockImportItemsParamPtrs{}
+	}
+	mmImportItems.defaultExpectation.paramPtrs.shareID = &shareID
+	mmImportItems.defaultExpectation.expectationOrigins.originShareID = minimock.CallerInfo(1)
+
+	return mmImportItems
+}
+
+// ExpectItemsParam4 sets up expected param items for RepositoryIface.ImportItems
+func (mmImportItems *mRepositoryIfaceMockImportItems) ExpectItemsParam4(items []postgres.Position) *mRepositoryIfaceMockImportItems {
+	if mmImportItems.mock.funcImportItems != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Set")
+	}
+
+	if mmImportItems.defaultExpectation == nil {
+		mmImportItems.defaultExpectation = &RepositoryIfaceMockImportItemsExpectation{}
+	}
+
+	if mmImportItems.defaultExpectation.params != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Expect")
+	}
+
+	if mmImportItems.defaultExpectation.paramPtrs == nil {
+		mmImportItems.defaultExpectation.paramPtrs = &RepositoryIfaceMockImportItemsParamPtrs{}
+	}
+	mmImportItems.defaultExpectation.paramPtrs.items = &items
+	mmImportItems.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmImportItems
+}
+
+// ExpectExpectedParam5 sets up expected param expected for RepositoryIface.ImportItems
+func (mmImportItems *mRepositoryIfaceMockImportItems) ExpectExpectedParam5(expected *uint64) *mRepositoryIfaceMockImportItems {
+	if mmImportItems.mock.funcImportItems != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Set")
+	}
+
+	if mmImportItems.defaultExpectation == nil {
+		mmImportItems.defaultExpectation = &RepositoryIfaceMockImportItemsExpectation{}
+	}
+
+	if mmImportItems.defaultExpectation.params != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Expect")
+	}
+
+	if mmImportItems.defaultExpectation.paramPtrs == nil {
+		mmImportItems.defaultExpectation.paramPtrs = &RepositoryIfaceMockImportItemsParamPtrs{}
+	}
+	mmImportItems.defaultExpectation.paramPtrs.expected = &expected
+	mmImportItems.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmImportItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.ImportItems
+func (mmImportItems *mRepositoryIfaceMockImportItems) Inspect(f func(ctx context.Context, userID uint64, shareID uint64, items []postgres.Position, expected *uint64)) *mRepositoryIfaceMockImportItems {
+	if mmImportItems.mock.inspectFuncImportItems != nil {
+		mmImportItems.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.ImportItems")
+	}
+
+	mmImportItems.mock.inspectFuncImportItems = f
+
+	return mmImportItems
+}
+
+// Return sets up results that will be returned by RepositoryIface.ImportItems
+func (mmImportItems *mRepositoryIfaceMockImportItems) Return(err error) *RepositoryIfaceMock {
+	if mmImportItems.mock.funcImportItems != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Set")
+	}
+
+	if mmImportItems.defaultExpectation == nil {
+		mmImportItems.defaultExpectation = &RepositoryIfaceMockImportItemsExpectation{mock: mmImportItems.mock}
+	}
+	mmImportItems.defaultExpectation.results = &RepositoryIfaceMockImportItemsResults{err}
+	mmImportItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmImportItems.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.ImportItems method
+func (mmImportItems *mRepositoryIfaceMockImportItems) Set(f func(ctx context.Context, userID uint64, shareID uint64, items []postgres.Position, expected *uint64) (err error)) *RepositoryIfaceMock {
+	if mmImportItems.defaultExpectation != nil {
+		mmImportItems.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.ImportItems method")
+	}
+
+	if len(mmImportItems.expectations) > 0 {
+		mmImportItems.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.ImportItems method")
+	}
+
+	mmImportItems.mock.funcImportItems = f
+	mmImportItems.mock.funcImportItemsOrigin = minimock.CallerInfo(1)
+	return mmImportItems.mock
+}
+
+// When sets expectation for the RepositoryIface.ImportItems which will trigger the result defined by the following
+// Then helper
+func (mmImportItems *mRepositoryIfaceMockImportItems) When(ctx context.Context, userID uint64, shareID uint64, items []postgres.Position, expected *uint64) *RepositoryIfaceMockImportItemsExpectation {
+	if mmImportItems.mock.funcImportItems != nil {
+		mmImportItems.mock.t.Fatalf("RepositoryIfaceMock.ImportItems mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockImportItemsExpectation{
+		mock:               mmImportItems.mock,
+		params:             &RepositoryIfaceMockImportItemsParams{ctx, userID, shareID, items, expected},
+		expectationOrigins: RepositoryIfaceMockImportItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmImportItems.expectations = append(mmImportItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.ImportItems return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockImportItemsExpectation) Then(err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockImportItemsResults{err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.ImportItems should be invoked
+func (mmImportItems *mRepositoryIfaceMockImportItems) Times(n uint64) *mRepositoryIfaceMockImportItems {
+	if n == 0 {
+		mmImportItems.mock.t.Fatalf("Times of RepositoryIfaceMock.ImportItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmImportItems.expectedInvocations, n)
+	mmImportItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmImportItems
+}
+
+func (mmImportItems *mRepositoryIfaceMockImportItems) invocationsDone() bool {
+	if len(mmImportItems.expectations) == 0 && mmImportItems.defaultExpectation == nil && mmImportItems.mock.funcImportItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmImportItems.mock.afterImportItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmImportItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ImportItems implements mm_interfaces.RepositoryIface
+func (mmImportItems *RepositoryIfaceMock) ImportItems(ctx context.Context, userID uint64, shareID uint64, items []postgres.Position, expected *uint64) (err error) {
+	mm_atomic.AddUint64(&mmImportItems.beforeImportItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmImportItems.afterImportItemsCounter, 1)
+
+	mmImportItems.t.Helper()
+
+	if mmImportItems.inspectFuncImportItems != nil {
+		mmImportItems.inspectFuncImportItems(ctx, userID, shareID, items, expected)
+	}
+
+	mm_params := RepositoryIfaceMockImportItemsParams{ctx, userID, shareID, items, expected}
+
+	// Record call args
+	mmImportItems.ImportItemsMock.mutex.Lock()
+	mmImportItems.ImportItemsMock.callArgs = append(mmImportItems.ImportItemsMock.callArgs, &mm_params)
+	mmImportItems.ImportItemsMock.mutex.Unlock()
+
+	for _, e := range mmImportItems.ImportItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmImportItems.ImportItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmImportItems.ImportItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmImportItems.ImportItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmImportItems.ImportItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockImportItemsParams{ctx, userID, shareID, items, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmImportItems.t.Errorf("RepositoryIfaceMock.ImportItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportItems.ImportItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmImportItems.t.Errorf("RepositoryIfaceMock.ImportItems got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportItems.ImportItemsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.shareID != nil && !minimock.Equal(*mm_want_ptrs.shareID, mm_got.shareID) {
+				mmImportItems.t.Errorf("RepositoryIfaceMock.ImportItems got unexpected parameter shareID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportItems.ImportItemsMock.defaultExpectation.expectationOrigins.originShareID, *mm_want_ptrs.shareID, mm_got.shareID, minimock.Diff(*mm_want_ptrs.shareID, mm_got.shareID))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmImportItems.t.Errorf("RepositoryIfaceMock.ImportItems got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportItems.ImportItemsMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmImportItems.t.Errorf("RepositoryIfaceMock.ImportItems got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImportItems.ImportItemsMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmImportItems.t.Errorf("RepositoryIfaceMock.ImportItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmImportItems.ImportItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmImportItems.ImportItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmImportItems.t.Fatal("No results are set for the RepositoryIfaceMock.ImportItems")
+		}
+		return (*mm_results).err
+	}
+	if mmImportItems.funcImportItems != nil {
+		return mmImportItems.funcImportItems(ctx, userID, shareID, items, expected)
+	}
+	mmImportItems.t.Fatalf("Unexpected call to RepositoryIfaceMock.ImportItems. %v %v %v %v %v", ctx, userID, shareID, items, expected)
+	return
+}
+
+// ImportItemsAfterCounter returns a count of finished RepositoryIfaceMock.ImportItems invocations
+func (mmImportItems *RepositoryIfaceMock) ImportItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportItems.afterImportItemsCounter)
+}
+
+// ImportItemsBeforeCounter returns a count of RepositoryIfaceMock.ImportItems invocations
+func (mmImportItems *RepositoryIfaceMock) ImportItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImportItems.beforeImportItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.ImportItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmImportItems *mRepositoryIfaceMockImportItems) Calls() []*RepositoryIfaceMockImportItemsParams {
+	mmImportItems.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockImportItemsParams, len(mmImportItems.callArgs))
+	copy(argCopy, mmImportItems.callArgs)
+
+	mmImportItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockImportItemsDone returns true if the count of the ImportItems invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockImportItemsDone() bool {
+	if m.ImportItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ImportItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ImportItemsMock.invocationsDone()
+}
+
+// MinimockImportItemsInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockImportItemsInspect() {
+	for _, e := range m.ImportItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ImportItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterImportItemsCounter := mm_atomic.LoadUint64(&m.afterImportItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImportItemsMock.defaultExpectation != nil && afterImportItemsCounter < 1 {
+		if m.ImportItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ImportItems at\n%s", m.ImportItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ImportItems at\n%s with params: %#v", m.ImportItemsMock.defaultExpectation.expectationOrigins.origin, *m.ImportItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImportItems != nil && afterImportItemsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.ImportItems at\n%s", m.funcImportItemsOrigin)
+	}
+
+	if !m.ImportItemsMock.invocationsDone() && afterImportItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.ImportItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ImportItemsMock.expectedInvocations), m.ImportItemsMock.expectedInvocationsOrigin, afterImportItemsCounter)
+	}
+}
+
+type mRepositoryIfaceMockListOrders struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockListOrdersExpectation
+	expectations       []*RepositoryIfaceMockListOrdersExpectation
+
+	callArgs []*RepositoryIfaceMockListOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockListOrdersExpectation specifies expectation struct of the RepositoryIface.ListOrders
+type RepositoryIfaceMockListOrdersExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockListOrdersParams
+	paramPtrs          *RepositoryIfaceMockListOrdersParamPtrs
+	expectationOrigins RepositoryIfaceMockListOrdersExpectationOrigins
+	results            *RepositoryIfaceMockListOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockListOrdersParams contains parameters of the RepositoryIface.ListOrders
+type RepositoryIfaceMockListOrdersParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// RepositoryIfaceMockListOrdersParamPtrs contains pointers to parameters of the RepositoryIface.ListOrders
+type RepositoryIfaceMockListOrdersParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// RepositoryIfaceMockListOrdersResults contains results of the RepositoryIface.ListOrders
+type RepositoryIfaceMockListOrdersResults struct {
+	oa1 []postgres.Order
+	err error
+}
+
+// RepositoryIfaceMockListOrdersOrigins contains origins of expectations of the RepositoryIface.ListOrders
+type RepositoryIfaceMockListOrdersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListOrders *mRepositoryIfaceMockListOrders) Optional() *mRepositoryIfaceMockListOrders {
+	mmListOrders.optional = true
+	return mmListOrders
+}
+
+// Expect sets up expected params for RepositoryIface.ListOrders
+func (mmListOrders *mRepositoryIfaceMockListOrders) Expect(ctx context.Context, userID uint64) *mRepositoryIfaceMockListOrders {
+	if mmListOrders.mock.funcListOrders != nil {
+		mmListOrders.mock.t.Fatalf("RepositoryIfaceMock.ListOrders mock is already set by Set")
+	}
+
+	if mmListOrders.defaultExpectation == nil {
+		mmListOrders.defaultExpectation = &RepositoryIfaceMockListOrdersExpectation{}
+	}
+
+	if mmListOrders.defaultExpectation.paramPtrs != nil {
+		mmListOrders.mock.t.Fatalf("RepositoryIfaceMock.ListOrders mock is already set by ExpectParams functions")
+	}
+
+	mmListOrders.defaultExpectation.params = &RepositoryIfaceMockListOrdersParams{ctx, userID}
+	mmListOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListOrders.expectations {
+		if minimock.Equal(e.params, mmListOrders.defaultExpectation.params) {
+			mmListOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmListOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.ListOrders
+func (mmListOrders *mRepositoryIfaceMockListOrders) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockListOrders {
+	if mmListOrders.mock.funcListOrders != nil {
+		mmListOrders.mock.t.Fatalf("RepositoryIfaceMock.ListOrders mock is already set by Set")
+	}
+
+	if mmListOrders.defaultExpectation == nil {
+		mmListOrders.defaultExpectation = &RepositoryIfaceMockListOrdersExpectation{}
+	}
+
+	if mmListOrders.defaultExpectation.params != nil {
+		mmListOrders.mock.t.Fatalf("RepositoryIfaceMock.ListOrders mock is already set by Expect")
+	}
+
+	if mmListOrders.defaultExpectation.paramPtrs == nil {
+		mmListOrders.defaultExpectation.paramPtrs = &RepositoryIfaceMockListOrdersParamPtrs{}
+	}
+	mmListOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListOrders
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.ListOrders
+func (mmListOrders *mRepositoryIfaceMockListOrders) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockListOrders {
+	if mmListOrders.mock.funcListOrders != nil {
+		mmListOrders.mock.t.Fatalf("RepositoryIfaceMock.ListOrders mock is already set by Set")
+	}
+
+	if mmListOrders.defaultExpectation == nil {
+		mmListOrders.defaultExpectation = &RepositoryIfaceMockListOrdersExpectation{}
+	}
+
+	if mmListOrders.defaultExpectation.params != nil {
+		mmListOrders.mock.t.Fatalf("RepositoryIfaceMock.ListOrders mock is already set by Expect")
+	}
+
+	if mmListOrders.defaultExpectation.paramPtrs == nil {
+		mmListOrders.defaultExpectation.paramPtrs = &RepositoryIfaceMockListOrdersParamPtrs{}
+	}
+	mmListOrders.defaultExpectation.paramPtrs.userID = &userID
+	mmListOrders.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.ListOrders
+func (mmListOrders *mRepositoryIfaceMockListOrders) Inspect(f func(ctx context.Context, userID uint64)) *mRepositoryIfaceMockListOrders {
+	if mmListOrders.mock.inspectFuncListOrders != nil {
+		mmListOrders.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.ListOrders")
+	}
+
+	mmListOrders.mock.inspectFuncListOrders = f
+
+	return mmListOrders
+}
+
+// Return sets up results that will be returned by RepositoryIface.ListOrders
+func (mmListOrders *mRepositoryIfaceMockListOrders) Return(oa1 []postgres.Order, err error) *RepositoryIfaceMock {
+	if mmListOrders.mock.funcListOrders != nil {
+		mmListOrders.mock.t.Fatalf("RepositoryIfaceMock.ListOrders mock is already set by Set")
+	}
+
+	if mmListOrders.defaultExpectation == nil {
+		mmListOrders.defaultExpectation = &RepositoryIfaceMockListOrdersExpectation{mock: mmListOrders.mock}
+	}
+	mmListOrders.defaultExpectation.results = &RepositoryIfaceMockListOrdersResults{oa1, err}
+	mmListOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListOrders.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.ListOrders method
+func (mmListOrders *mRepositoryIfaceMockListOrders) Set(f func(ctx context.Context, userID uint64) (oa1 []postgres.Order, err error)) *RepositoryIfaceMock {
+	if mmListOrders.defaultExpectation != nil {
+		mmListOrders.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.ListOrders method")
+	}
+
+	if len(mmListOrders.expectations) > 0 {
+		mmListOrders.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.ListOrders method")
+	}
+
+	mmListOrders.mock.funcListOrders = f
+	mmListOrders.mock.funcListOrdersOrigin = minimock.CallerInfo(1)
+	return mmListOrders.mock
+}
+
+// When sets expectation for the RepositoryIface.ListOrders which will trigger the result defined by the following
+// Then helper
+func (mmListOrders *mRepositoryIfaceMockListOrders) When(ctx context.Context, userID uint64) *RepositoryIfaceMockListOrdersExpectation {
+	if mmListOrders.mock.funcListOrders != nil {
+		mmListOrders.mock.t.Fatalf("RepositoryIfaceMock.ListOrders mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockListOrdersExpectation{
+		mock:               mmListOrders.mock,
+		params:             &RepositoryIfaceMockListOrdersParams{ctx, userID},
+		expectationOrigins: RepositoryIfaceMockListOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListOrders.expectations = append(mmListOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.ListOrders return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockListOrdersExpectation) Then(oa1 []postgres.Order, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockListOrdersResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.ListOrders should be invoked
+func (mmListOrders *mRepositoryIfaceMockListOrders) Times(n uint64) *mRepositoryIfaceMockListOrders {
+	if n == 0 {
+		mmListOrders.mock.t.Fatalf("Times of RepositoryIfaceMock.ListOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListOrders.expectedInvocations, n)
+	mmListOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListOrders
+}
+
+func (mmListOrders *mRepositoryIfaceMockListOrders) invocationsDone() bool {
+	if len(mmListOrders.expectations) == 0 && mmListOrders.defaultExpectation == nil && mmListOrders.mock.funcListOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListOrders.mock.afterListOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListOrders implements mm_interfaces.RepositoryIface
+func (mmListOrders *RepositoryIfaceMock) ListOrders(ctx context.Context, userID uint64) (oa1 []postgres.Order, err error) {
+	mm_atomic.AddUint64(&mmListOrders.beforeListOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListOrders.afterListOrdersCounter, 1)
+
+	mmListOrders.t.Helper()
+
+	if mmListOrders.inspectFuncListOrders != nil {
+		mmListOrders.inspectFuncListOrders(ctx, userID)
+	}
+
+	mm_params := RepositoryIfaceMockListOrdersParams{ctx, userID}
+
+	// Record call args
+	mmListOrders.ListOrdersMock.mutex.Lock()
+	mmListOrders.ListOrdersMock.callArgs = append(mmListOrders.ListOrdersMock.callArgs, &mm_params)
+	mmListOrders.ListOrdersMock.mutex.Unlock()
+
+	for _, e := range mmListOrders.ListOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmListOrders.ListOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListOrders.ListOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListOrders.ListOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmListOrders.ListOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockListOrdersParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListOrders.t.Errorf("RepositoryIfaceMock.ListOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOrders.ListOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListOrders.t.Errorf("RepositoryIfaceMock.ListOrders got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListOrders.ListOrdersMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListOrders.t.Errorf("RepositoryIfaceMock.ListOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListOrders.ListOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListOrders.ListOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListOrders.t.Fatal("No results are set for the RepositoryIfaceMock.ListOrders")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmListOrders.funcListOrders != nil {
+		return mmListOrders.funcListOrders(ctx, userID)
+	}
+	mmListOrders.t.Fatalf("Unexpected call to RepositoryIfaceMock.ListOrders. %v %v", ctx, userID)
+	return
+}
+
+// ListOrdersAfterCounter returns a count of finished RepositoryIfaceMock.ListOrders invocations
+func (mmListOrders *RepositoryIfaceMock) ListOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrders.afterListOrdersCounter)
+}
+
+// ListOrdersBeforeCounter returns a count of RepositoryIfaceMock.ListOrders invocations
+func (mmListOrders *RepositoryIfaceMock) ListOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListOrders.beforeListOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.ListOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListOrders *mRepositoryIfaceMockListOrders) Calls() []*RepositoryIfaceMockListOrdersParams {
+	mmListOrders.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockListOrdersParams, len(mmListOrders.callArgs))
+	copy(argCopy, mmListOrders.callArgs)
+
+	mmListOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListOrdersDone returns true if the count of the ListOrders invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockListOrdersDone() bool {
+	if m.ListOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListOrdersMock.invocationsDone()
+}
+
+// MinimockListOrdersInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockListOrdersInspect() {
+	for _, e := range m.ListOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ListOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListOrdersCounter := mm_atomic.LoadUint64(&m.afterListOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListOrdersMock.defaultExpectation != nil && afterListOrdersCounter < 1 {
+		if m.ListOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ListOrders at\n%s", m.ListOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ListOrders at\n%s with params: %#v", m.ListOrdersMock.defaultExpectation.expectationOrigins.origin, *m.ListOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListOrders != nil && afterListOrdersCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.ListOrders at\n%s", m.funcListOrdersOrigin)
+	}
+
+	if !m.ListOrdersMock.invocationsDone() && afterListOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.ListOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListOrdersMock.expectedInvocations), m.ListOrdersMock.expectedInvocationsOrigin, afterListOrdersCounter)
+	}
+}
+
+type mRepositoryIfaceMockListPromos struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockListPromosExpectation
+	expectations       []*RepositoryIfaceMockListPromosExpectation
+
+	callArgs []*RepositoryIfaceMockListPromosParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockListPromosExpectation specifies expectation struct of the RepositoryIface.ListPromos
+type RepositoryIfaceMockListPromosExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockListPromosParams
+	paramPtrs          *RepositoryIfaceMockListPromosParamPtrs
+	expectationOrigins RepositoryIfaceMockListPromosExpectationOrigins
+	results            *RepositoryIfaceMockListPromosResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockListPromosParams contains parameters of the RepositoryIface.ListPromos
+type RepositoryIfaceMockListPromosParams struct {
+	ctx context.Context
+}
+
+// RepositoryIfaceMockListPromosParamPtrs contains pointers to parameters of the RepositoryIface.ListPromos
+type RepositoryIfaceMockListPromosParamPtrs struct {
+	ctx *context.Context
+}
+
+// RepositoryIfaceMockListPromosResults contains results of the RepositoryIface.ListPromos
+type RepositoryIfaceMockListPromosResults struct {
+	pa1 []postgres.Promo
+	err error
+}
+
+// RepositoryIfaceMockListPromosOrigins contains origins of expectations of the RepositoryIface.ListPromos
+type RepositoryIfaceMockListPromosExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPromos *mRepositoryIfaceMockListPromos) Optional() *mRepositoryIfaceMockListPromos {
+	mmListPromos.optional = true
+	return mmListPromos
+}
+
+// Expect sets up expected params for RepositoryIface.ListPromos
+func (mmListPromos *mRepositoryIfaceMockListPromos) Expect(ctx context.Context) *mRepositoryIfaceMockListPromos {
+	if mmListPromos.mock.funcListPromos != nil {
+		mmListPromos.mock.t.Fatalf("RepositoryIfaceMock.ListPromos mock is already set by Set")
+	}
+
+	if mmListPromos.defaultExpectation == nil {
+		mmListPromos.defaultExpectation = &RepositoryIfaceMockListPromosExpectation{}
+	}
+
+	if mmListPromos.defaultExpectation.paramPtrs != nil {
+		mmListPromos.mock.t.Fatalf("RepositoryIfaceMock.ListPromos mock is already set by ExpectParams functions")
+	}
+
+	mmListPromos.defaultExpectation.params = &RepositoryIfaceMockListPromosParams{ctx}
+	mmListPromos.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPromos.expectations {
+		if minimock.Equal(e.params, mmListPromos.defaultExpectation.params) {
+			mmListPromos.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPromos.defaultExpectation.params)
+		}
+	}
+
+	return mmListPromos
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.ListPromos
+func (mmListPromos *mRepositoryIfaceMockListPromos) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockListPromos {
+	if mmListPromos.mock.funcListPromos != nil {
+		mmListPromos.mock.t.Fatalf("RepositoryIfaceMock.ListPromos mock is already set by Set")
+	}
+
+	if mmListPromos.defaultExpectation == nil {
+		mmListPromos.defaultExpectation = &RepositoryIfaceMockListPromosExpectation{}
+	}
+
+	if mmListPromos.defaultExpectation.params != nil {
+		mmListPromos.mock.t.Fatalf("RepositoryIfaceMock.ListPromos mock is already set by Expect")
+	}
+
+	if mmListPromos.defaultExpectation.paramPtrs == nil {
+		mmListPromos.defaultExpectation.paramPtrs = &RepositoryIfaceMockListPromosParamPtrs{}
+	}
+	mmListPromos.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPromos.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPromos
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.ListPromos
+func (mmListPromos *mRepositoryIfaceMockListPromos) Inspect(f func(ctx context.Context)) *mRepositoryIfaceMockListPromos {
+	if mmListPromos.mock.inspectFuncListPromos != nil {
+		mmListPromos.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.ListPromos")
+	}
+
+	mmListPromos.mock.inspectFuncListPromos = f
+
+	return mmListPromos
+}
+
+// Return sets up results that will be returned by RepositoryIface.ListPromos
+func (mmListPromos *mRepositoryIfaceMockListPromos) Return(pa1 []postgres.Promo, err error) *RepositoryIfaceMock {
+	if mmListPromos.mock.funcListPromos != nil {
+		mmListPromos.mock.t.Fatalf("RepositoryIfaceMock.ListPromos mock is already set by Set")
+	}
+
+	if mmListPromos.defaultExpectation == nil {
+		mmListPromos.defaultExpectation = &RepositoryIfaceMockListPromosExpectation{mock: mmListPromos.mock}
+	}
+	mmListPromos.defaultExpectation.results = &RepositoryIfaceMockListPromosResults{pa1, err}
+	mmListPromos.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPromos.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.ListPromos method
+func (mmListPromos *mRepositoryIfaceMockListPromos) Set(f func(ctx context.Context) (pa1 []postgres.Promo, err error)) *RepositoryIfaceMock {
+	if mmListPromos.defaultExpectation != nil {
+		mmListPromos.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.ListPromos method")
+	}
+
+	if len(mmListPromos.expectations) > 0 {
+		mmListPromos.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.ListPromos method")
+	}
+
+	mmListPromos.mock.funcListPromos = f
+	mmListPromos.mock.funcListPromosOrigin = minimock.CallerInfo(1)
+	return mmListPromos.mock
+}
+
+// When sets expectation for the RepositoryIface.ListPromos which will trigger the result defined by the following
+// Then helper
+func (mmListPromos *mRepositoryIfaceMockListPromos) When(ctx context.Context) *RepositoryIfaceMockListPromosExpectation {
+	if mmListPromos.mock.funcListPromos != nil {
+		mmListPromos.mock.t.Fatalf("RepositoryIfaceMock.ListPromos mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockListPromosExpectation{
+		mock:               mmListPromos.mock,
+		params:             &RepositoryIfaceMockListPromosParams{ctx},
+		expectationOrigins: RepositoryIfaceMockListPromosExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPromos.expectations = append(mmListPromos.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.ListPromos return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockListPromosExpectation) Then(pa1 []postgres.Promo, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockListPromosResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.ListPromos should be invoked
+func (mmListPromos *mRepositoryIfaceMockListPromos) Times(n uint64) *mRepositoryIfaceMockListPromos {
+	if n == 0 {
+		mmListPromos.mock.t.Fatalf("Times of RepositoryIfaceMock.ListPromos mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPromos.expectedInvocations, n)
+	mmListPromos.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPromos
+}
+
+func (mmListPromos *mRepositoryIfaceMockListPromos) invocationsDone() bool {
+	if len(mmListPromos.expectations) == 0 && mmListPromos.defaultExpectation == nil && mmListPromos.mock.funcListPromos == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPromos.mock.afterListPromosCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPromos.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPromos implements mm_interfaces.RepositoryIface
+func (mmListPromos *RepositoryIfaceMock) ListPromos(ctx context.Context) (pa1 []postgres.Promo, err error) {
+	mm_atomic.AddUint64(&mmListPromos.beforeListPromosCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPromos.afterListPromosCounter, 1)
+
+	mmListPromos.t.Helper()
+
+	if mmListPromos.inspectFuncListPromos != nil {
+		mmListPromos.inspectFuncListPromos(ctx)
+	}
+
+	mm_params := RepositoryIfaceMockListPromosParams{ctx}
+
+	// Record call args
+	mmListPromos.ListPromosMock.mutex.Lock()
+	mmListPromos.ListPromosMock.callArgs = append(mmListPromos.ListPromosMock.callArgs, &mm_params)
+	mmListPromos.ListPromosMock.mutex.Unlock()
+
+	for _, e := range mmListPromos.ListPromosMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmListPromos.ListPromosMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPromos.ListPromosMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPromos.ListPromosMock.defaultExpectation.params
+		mm_want_ptrs := mmListPromos.ListPromosMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockListPromosParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPromos.t.Errorf("RepositoryIfaceMock.ListPromos got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPromos.ListPromosMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPromos.t.Errorf("RepositoryIfaceMock.ListPromos got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPromos.ListPromosMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPromos.ListPromosMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPromos.t.Fatal("No results are set for the RepositoryIfaceMock.ListPromos")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmListPromos.funcListPromos != nil {
+		return mmListPromos.funcListPromos(ctx)
+	}
+	mmListPromos.t.Fatalf("Unexpected call to RepositoryIfaceMock.ListPromos. %v", ctx)
+	return
+}
+
+// ListPromosAfterCounter returns a count of finished RepositoryIfaceMock.ListPromos invocations
+func (mmListPromos *RepositoryIfaceMock) ListPromosAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPromos.afterListPromosCounter)
+}
+
+// ListPromosBeforeCounter returns a count of RepositoryIfaceMock.ListPromos invocations
+func (mmListPromos *RepositoryIfaceMock) ListPromosBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPromos.beforeListPromosCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.ListPromos.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPromos *mRepositoryIfaceMockListPromos) Calls() []*RepositoryIfaceMockListPromosParams {
+	mmListPromos.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockListPromosParams, len(mmListPromos.callArgs))
+	copy(argCopy, mmListPromos.callArgs)
+
+	mmListPromos.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPromosDone returns true if the count of the ListPromos invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockListPromosDone() bool {
+	if m.ListPromosMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPromosMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPromosMock.invocationsDone()
+}
+
+// MinimockListPromosInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockListPromosInspect() {
+	for _, e := range m.ListPromosMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ListPromos at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPromosCounter := mm_atomic.LoadUint64(&m.afterListPromosCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPromosMock.defaultExpectation != nil && afterListPromosCounter < 1 {
+		if m.ListPromosMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ListPromos at\n%s", m.ListPromosMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ListPromos at\n%s with params: %#v", m.ListPromosMock.defaultExpectation.expectationOrigins.origin, *m.ListPromosMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPromos != nil && afterListPromosCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.ListPromos at\n%s", m.funcListPromosOrigin)
+	}
+
+	if !m.ListPromosMock.invocationsDone() && afterListPromosCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.ListPromos at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPromosMock.expectedInvocations), m.ListPromosMock.expectedInvocationsOrigin, afterListPromosCounter)
+	}
+}
+
+type mRepositoryIfaceMockListSaved struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockListSavedExpectation
+	expectations       []*RepositoryIfaceMockListSavedExpectation
+
+	callArgs []*RepositoryIfaceMockListSavedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockListSavedExpectation specifies expectation struct of the RepositoryIface.ListSaved
+type RepositoryIfaceMockListSavedExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockListSavedParams
+	paramPtrs          *RepositoryIfaceMockListSavedParamPtrs
+	expectationOrigins RepositoryIfaceMockListSavedExpectationOrigins
+	results            *RepositoryIfaceMockListSavedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockListSavedParams contains parameters of the RepositoryIface.ListSaved
+type RepositoryIfaceMockListSavedParams struct {
+	ctx    context.Context
+	userID uint64
+}
+
+// RepositoryIfaceMockListSavedParamPtrs contains pointers to parameters of the RepositoryIface.ListSaved
+type RepositoryIfaceMockListSavedParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+}
+
+// RepositoryIfaceMockListSavedResults contains results of the RepositoryIface.ListSaved
+type RepositoryIfaceMockListSavedResults struct {
+	sa1 []postgres.SavedItem
+	err error
+}
+
+// RepositoryIfaceMockListSavedOrigins contains origins of expectations of the RepositoryIface.ListSaved
+type RepositoryIfaceMockListSavedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSaved *mRepositoryIfaceMockListSaved) Optional() *mRepositoryIfaceMockListSaved {
+	mmListSaved.optional = true
+	return mmListSaved
+}
+
+// Expect sets up expected params for RepositoryIface.ListSaved
+func (mmListSaved *mRepositoryIfaceMockListSaved) Expect(ctx context.Context, userID uint64) *mRepositoryIfaceMockListSaved {
+	if mmListSaved.mock.funcListSaved != nil {
+		mmListSaved.mock.t.Fatalf("RepositoryIfaceMock.ListSaved mock is already set by Set")
+	}
+
+	if mmListSaved.defaultExpectation == nil {
+		mmListSaved.defaultExpectation = &RepositoryIfaceMockListSavedExpectation{}
+	}
+
+	if mmListSaved.defaultExpectation.paramPtrs != nil {
+		mmListSaved.mock.t.Fatalf("RepositoryIfaceMock.ListSaved mock is already set by ExpectParams functions")
+	}
+
+	mmListSaved.defaultExpectation.params = &RepositoryIfaceMockListSavedParams{ctx, userID}
+	mmListSaved.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSaved.expectations {
+		if minimock.Equal(e.params, mmListSaved.defaultExpectation.params) {
+			mmListSaved.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSaved.defaultExpectation.params)
+		}
+	}
+
+	return mmListSaved
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.ListSaved
+func (mmListSaved *mRepositoryIfaceMockListSaved) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockListSaved {
+	if mmListSaved.mock.funcListSaved != nil {
+		mmListSaved.mock.t.Fatalf("RepositoryIfaceMock.ListSaved mock is already set by Set")
+	}
+
+	if mmListSaved.defaultExpectation == nil {
+		mmListSaved.defaultExpectation = &RepositoryIfaceMockListSavedExpectation{}
+	}
+
+	if mmListSaved.defaultExpectation.params != nil {
+		mmListSaved.mock.t.Fatalf("RepositoryIfaceMock.ListSaved mock is already set by Expect")
+	}
+
+	if mmListSaved.defaultExpectation.paramPtrs == nil {
+		mmListSaved.defaultExpectation.paramPtrs = &RepositoryIfaceMockListSavedParamPtrs{}
+	}
+	mmListSaved.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSaved.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSaved
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.ListSaved
+func (mmListSaved *mRepositoryIfaceMockListSaved) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockListSaved {
+	if mmListSaved.mock.funcListSaved != nil {
+		mmListSaved.mock.t.Fatalf("RepositoryIfaceMock.ListSaved mock is already set by Set")
+	}
+
+	if mmListSaved.defaultExpectation == nil {
+		mmListSaved.defaultExpectation = &RepositoryIfaceMockListSavedExpectation{}
+	}
+
+	if mmListSaved.defaultExpectation.params != nil {
+		mmListSaved.mock.t.Fatalf("RepositoryIfaceMock.ListSaved mock is already set by Expect")
+	}
+
+	if mmListSaved.defaultExpectation.paramPtrs == nil {
+		mmListSaved.defaultExpectation.paramPtrs = &RepositoryIfaceMockListSavedParamPtrs{}
+	}
+	mmListSaved.defaultExpectation.paramPtrs.userID = &userID
+	mmListSaved.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListSaved
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.ListSaved
+func (mmListSaved *mRepositoryIfaceMockListSaved) Inspect(f func(ctx context.Context, userID uint64)) *mRepositoryIfaceMockListSaved {
+	if mmListSaved.mock.inspectFuncListSaved != nil {
+		mmListSaved.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.ListSaved")
+	}
+
+	mmListSaved.mock.inspectFuncListSaved = f
+
+	return mmListSaved
+}
+
+// Return sets up results that will be returned by RepositoryIface.ListSaved
+func (mmListSaved *mRepositoryIfaceMockListSaved) Return(sa1 []postgres.SavedItem, err error) *RepositoryIfaceMock {
+	if mmListSaved.mock.funcListSaved != nil {
+		mmListSaved.mock.t.Fatalf("RepositoryIfaceMock.ListSaved mock is already set by Set")
+	}
+
+	if mmListSaved.defaultExpectation == nil {
+		mmListSaved.defaultExpectation = &RepositoryIfaceMockListSavedExpectation{mock: mmListSaved.mock}
+	}
+	mmListSaved.defaultExpectation.results = &RepositoryIfaceMockListSavedResults{sa1, err}
+	mmListSaved.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSaved.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.ListSaved method
+func (mmListSaved *mRepositoryIfaceMockListSaved) Set(f func(ctx context.Context, userID uint64) (sa1 []postgres.SavedItem, err error)) *RepositoryIfaceMock {
+	if mmListSaved.defaultExpectation != nil {
+		mmListSaved.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.ListSaved method")
+	}
+
+	if len(mmListSaved.expectations) > 0 {
+		mmListSaved.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.ListSaved method")
+	}
+
+	mmListSaved.mock.funcListSaved = f
+	mmListSaved.mock.funcListSavedOrigin = minimock.CallerInfo(1)
+	return mmListSaved.mock
+}
+
+// When sets expectation for the RepositoryIface.ListSaved which will trigger the result defined by the following
+// Then helper
+func (mmListSaved *mRepositoryIfaceMockListSaved) When(ctx context.Context, userID uint64) *RepositoryIfaceMockListSavedExpectation {
+	if mmListSaved.mock.funcListSaved != nil {
+		mmListSaved.mock.t.Fatalf("RepositoryIfaceMock.ListSaved mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockListSavedExpectation{
+		mock:               mmListSaved.mock,
+		params:             &RepositoryIfaceMockListSavedParams{ctx, userID},
+		expectationOrigins: RepositoryIfaceMockListSavedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSaved.expectations = append(mmListSaved.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.ListSaved return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockListSavedExpectation) Then(sa1 []postgres.SavedItem, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockListSavedResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.ListSaved should be invoked
+func (mmListSaved *mRepositoryIfaceMockListSaved) Times(n uint64) *mRepositoryIfaceMockListSaved {
+	if n == 0 {
+		mmListSaved.mock.t.Fatalf("Times of RepositoryIfaceMock.ListSaved mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSaved.expectedInvocations, n)
+	mmListSaved.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSaved
+}
+
+func (mmListSaved *mRepositoryIfaceMockListSaved) invocationsDone() bool {
+	if len(mmListSaved.expectations) == 0 && mmListSaved.defaultExpectation == nil && mmListSaved.mock.funcListSaved == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSaved.mock.afterListSavedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSaved.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSaved implements mm_interfaces.RepositoryIface
+func (mmListSaved *RepositoryIfaceMock) ListSaved(ctx context.Context, userID uint64) (sa1 []postgres.SavedItem, err error) {
+	mm_atomic.AddUint64(&mmListSaved.beforeListSavedCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSaved.afterListSavedCounter, 1)
+
+	mmListSaved.t.Helper()
+
+	if mmListSaved.inspectFuncListSaved != nil {
+		mmListSaved.inspectFuncListSaved(ctx, userID)
+	}
+
+	mm_params := RepositoryIfaceMockListSavedParams{ctx, userID}
+
+	// Record call args
+	mmListSaved.ListSavedMock.mutex.Lock()
+	mmListSaved.ListSavedMock.callArgs = append(mmListSaved.ListSavedMock.callArgs, &mm_params)
+	mmListSaved.ListSavedMock.mutex.Unlock()
+
+	for _, e := range mmListSaved.ListSavedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListSaved.ListSavedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSaved.ListSavedMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSaved.ListSavedMock.defaultExpectation.params
+		mm_want_ptrs := mmListSaved.ListSavedMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockListSavedParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSaved.t.Errorf("RepositoryIfaceMock.ListSaved got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSaved.ListSavedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListSaved.t.Errorf("RepositoryIfaceMock.ListSaved got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSaved.ListSavedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSaved.t.Errorf("RepositoryIfaceMock.ListSaved got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSaved.ListSavedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSaved.ListSavedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSaved.t.Fatal("No results are set for the RepositoryIfaceMock.ListSaved")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListSaved.funcListSaved != nil {
+		return mmListSaved.funcListSaved(ctx, userID)
+	}
+	mmListSaved.t.Fatalf("Unexpected call to RepositoryIfaceMock.ListSaved. %v %v", ctx, userID)
+	return
+}
+
+// ListSavedAfterCounter returns a count of finished RepositoryIfaceMock.ListSaved invocations
+func (mmListSaved *RepositoryIfaceMock) ListSavedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSaved.afterListSavedCounter)
+}
+
+// ListSavedBeforeCounter returns a count of RepositoryIfaceMock.ListSaved invocations
+func (mmListSaved *RepositoryIfaceMock) ListSavedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSaved.beforeListSavedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.ListSaved.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSaved *mRepositoryIfaceMockListSaved) Calls() []*RepositoryIfaceMockListSavedParams {
+	mmListSaved.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockListSavedParams, len(mmListSaved.callArgs))
+	copy(argCopy, mmListSaved.callArgs)
+
+	mmListSaved.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSavedDone returns true if the count of the ListSaved invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockListSavedDone() bool {
+	if m.ListSavedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSavedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSavedMock.invocationsDone()
+}
+
+// MinimockListSavedInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockListSavedInspect() {
+	for _, e := range m.ListSavedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ListSaved at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSavedCounter := mm_atomic.LoadUint64(&m.afterListSavedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSavedMock.defaultExpectation != nil && afterListSavedCounter < 1 {
+		if m.ListSavedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ListSaved at\n%s", m.ListSavedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ListSaved at\n%s with params: %#v", m.ListSavedMock.defaultExpectation.expectationOrigins.origin, *m.ListSavedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSaved != nil && afterListSavedCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.ListSaved at\n%s", m.funcListSavedOrigin)
+	}
+
+	if !m.ListSavedMock.invocationsDone() && afterListSavedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.ListSaved at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSavedMock.expectedInvocations), m.ListSavedMock.expectedInvocationsOrigin, afterListSavedCounter)
+	}
+}
+
+type mRepositoryIfaceMockMarkAbandonedNotified struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockMarkAbandonedNotifiedExpectation
+	expectations       []*RepositoryIfaceMockMarkAbandonedNotifiedExpectation
+
+	callArgs []*RepositoryIfaceMockMarkAbandonedNotifiedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockMarkAbandonedNotifiedExpectation specifies expectation struct of the RepositoryIface.MarkAbandonedNotified
+type RepositoryIfaceMockMarkAbandonedNotifiedExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockMarkAbandonedNotifiedParams
+	paramPtrs          *RepositoryIfaceMockMarkAbandonedNotifiedParamPtrs
+	expectationOrigins RepositoryIfaceMockMarkAbandonedNotifiedExpectationOrigins
+	results            *RepositoryIfaceMockMarkAbandonedNotifiedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockMarkAbandonedNotifiedParams contains parameters of the RepositoryIface.MarkAbandonedNotified
+type RepositoryIfaceMockMarkAbandonedNotifiedParams struct {
+	ctx       context.Context
+	userID    uint64
+	updatedAt time.Time
+}
+
+// RepositoryIfaceMockMarkAbandonedNotifiedParamPtrs contains pointers to parameters of the RepositoryIface.MarkAbandonedNotified
+type RepositoryIfaceMockMarkAbandonedNotifiedParamPtrs struct {
+	ctx       *context.Context
+	userID    *uint64
+	updatedAt *time.Time
+}
+
+// RepositoryIfaceMockMarkAbandonedNotifiedResults contains results of the RepositoryIface.MarkAbandonedNotified
+type RepositoryIfaceMockMarkAbandonedNotifiedResults struct {
+	err error
+}
+
+// RepositoryIfaceMockMarkAbandonedNotifiedOrigins contains origins of expectations of the RepositoryIface.MarkAbandonedNotified
+type RepositoryIfaceMockMarkAbandonedNotifiedExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originUpdatedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) Optional() *mRepositoryIfaceMockMarkAbandonedNotified {
+	mmMarkAbandonedNotified.optional = true
+	return mmMarkAbandonedNotified
+}
+
+// Expect sets up expected params for RepositoryIface.MarkAbandonedNotified
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) Expect(ctx context.Context, userID uint64, updatedAt time.Time) *mRepositoryIfaceMockMarkAbandonedNotified {
+	if mmMarkAbandonedNotified.mock.funcMarkAbandonedNotified != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("RepositoryIfaceMock.MarkAbandonedNotified mock is already set by Set")
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation == nil {
+		mmMarkAbandonedNotified.defaultExpectation = &RepositoryIfaceMockMarkAbandonedNotifiedExpectation{}
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation.paramPtrs != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("RepositoryIfaceMock.MarkAbandonedNotified mock is already set by ExpectParams functions")
+	}
+
+	mmMarkAbandonedNotified.defaultExpectation.params = &RepositoryIfaceMockMarkAbandonedNotifiedParams{ctx, userID, updatedAt}
+	mmMarkAbandonedNotified.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkAbandonedNotified.expectations {
+		if minimock.Equal(e.params, mmMarkAbandonedNotified.defaultExpectation.params) {
+			mmMarkAbandonedNotified.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkAbandonedNotified.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkAbandonedNotified
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.MarkAbandonedNotified
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockMarkAbandonedNotified {
+	if mmMarkAbandonedNotified.mock.funcMarkAbandonedNotified != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("RepositoryIfaceMock.MarkAbandonedNotified mock is already set by Set")
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation == nil {
+		mmMarkAbandonedNotified.defaultExpectation = &RepositoryIfaceMockMarkAbandonedNotifiedExpectation{}
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation.params != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("RepositoryIfaceMock.MarkAbandonedNotified mock is already set by Expect")
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation.paramPtrs == nil {
+		mmMarkAbandonedNotified.defaultExpectation.paramPtrs = &RepositoryIfaceMockMarkAbandonedNotifiedParamPtrs{}
+	}
+	mmMarkAbandonedNotified.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkAbandonedNotified.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkAbandonedNotified
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.MarkAbandonedNotified
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockMarkAbandonedNotified {
+	if mmMarkAbandonedNotified.mock.funcMarkAbandonedNotified != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("RepositoryIfaceMock.MarkAbandonedNotified mock is already set by Set")
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation == nil {
+		mmMarkAbandonedNotified.defaultExpectation = &RepositoryIfaceMockMarkAbandonedNotifiedExpectation{}
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation.params != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("RepositoryIfaceMock.MarkAbandonedNotified mock is already set by Expect")
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation.paramPtrs == nil {
+		mmMarkAbandonedNotified.defaultExpectation.paramPtrs = &RepositoryIfaceMockMarkAbandonedNotifiedParamPtrs{}
+	}
+	mmMarkAbandonedNotified.defaultExpectation.paramPtrs.userID = &userID
+	mmMarkAbandonedNotified.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMarkAbandonedNotified
+}
+
+// ExpectUpdatedAtParam3 sets up expected param updatedAt for RepositoryIface.MarkAbandonedNotified
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) ExpectUpdatedAtParam3(updatedAt time.Time) *mRepositoryIfaceMockMarkAbandonedNotified {
+	if mmMarkAbandonedNotified.mock.funcMarkAbandonedNotified != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("RepositoryIfaceMock.MarkAbandonedNotified mock is already set by Set")
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation == nil {
+		mmMarkAbandonedNotified.defaultExpectation = &RepositoryIfaceMockMarkAbandonedNotifiedExpectation{}
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation.params != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("RepositoryIfaceMock.MarkAbandonedNotified mock is already set by Expect")
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation.paramPtrs == nil {
+		mmMarkAbandonedNotified.defaultExpectation.paramPtrs = &RepositoryIfaceMockMarkAbandonedNotifiedParamPtrs{}
+	}
+	mmMarkAbandonedNotified.defaultExpectation.paramPtrs.updatedAt = &updatedAt
+	mmMarkAbandonedNotified.defaultExpectation.expectationOrigins.originUpdatedAt = minimock.CallerInfo(1)
+
+	return mmMarkAbandonedNotified
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.MarkAbandonedNotified
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) Inspect(f func(ctx context.Context, userID uint64, updatedAt time.Time)) *mRepositoryIfaceMockMarkAbandonedNotified {
+	if mmMarkAbandonedNotified.mock.inspectFuncMarkAbandonedNotified != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.MarkAbandonedNotified")
+	}
+
+	mmMarkAbandonedNotified.mock.inspectFuncMarkAbandonedNotified = f
+
+	return mmMarkAbandonedNotified
+}
+
+// Return sets up results that will be returned by RepositoryIface.MarkAbandonedNotified
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) Return(err error) *RepositoryIfaceMock {
+	if mmMarkAbandonedNotified.mock.funcMarkAbandonedNotified != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("RepositoryIfaceMock.MarkAbandonedNotified mock is already set by Set")
+	}
+
+	if mmMarkAbandonedNotified.defaultExpectation == nil {
+		mmMarkAbandonedNotified.defaultExpectation = &RepositoryIfaceMockMarkAbandonedNotifiedExpectation{mock: mmMarkAbandonedNotified.mock}
+	}
+	mmMarkAbandonedNotified.defaultExpectation.results = &RepositoryIfaceMockMarkAbandonedNotifiedResults{err}
+	mmMarkAbandonedNotified.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkAbandonedNotified.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.MarkAbandonedNotified method
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) Set(f func(ctx context.Context, userID uint64, updatedAt time.Time) (err error)) *RepositoryIfaceMock {
+	if mmMarkAbandonedNotified.defaultExpectation != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.MarkAbandonedNotified method")
+	}
+
+	if len(mmMarkAbandonedNotified.expectations) > 0 {
+		mmMarkAbandonedNotified.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.MarkAbandonedNotified method")
+	}
+
+	mmMarkAbandonedNotified.mock.funcMarkAbandonedNotified = f
+	mmMarkAbandonedNotified.mock.funcMarkAbandonedNotifiedOrigin = minimock.CallerInfo(1)
+	return mmMarkAbandonedNotified.mock
+}
+
+// When sets expectation for the RepositoryIface.MarkAbandonedNotified which will trigger the result defined by the following
+// Then helper
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) When(ctx context.Context, userID uint64, updatedAt time.Time) *RepositoryIfaceMockMarkAbandonedNotifiedExpectation {
+	if mmMarkAbandonedNotified.mock.funcMarkAbandonedNotified != nil {
+		mmMarkAbandonedNotified.mock.t.Fatalf("RepositoryIfaceMock.MarkAbandonedNotified mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockMarkAbandonedNotifiedExpectation{
+		mock:               mmMarkAbandonedNotified.mock,
+		params:             &RepositoryIfaceMockMarkAbandonedNotifiedParams{ctx, userID, updatedAt},
+		expectationOrigins: RepositoryIfaceMockMarkAbandonedNotifiedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkAbandonedNotified.expectations = append(mmMarkAbandonedNotified.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.MarkAbandonedNotified return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockMarkAbandonedNotifiedExpectation) Then(err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockMarkAbandonedNotifiedResults{err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.MarkAbandonedNotified should be invoked
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) Times(n uint64) *mRepositoryIfaceMockMarkAbandonedNotified {
+	if n == 0 {
+		mmMarkAbandonedNotified.mock.t.Fatalf("Times of RepositoryIfaceMock.MarkAbandonedNotified mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkAbandonedNotified.expectedInvocations, n)
+	mmMarkAbandonedNotified.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkAbandonedNotified
+}
+
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) invocationsDone() bool {
+	if len(mmMarkAbandonedNotified.expectations) == 0 && mmMarkAbandonedNotified.defaultExpectation == nil && mmMarkAbandonedNotified.mock.funcMarkAbandonedNotified == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkAbandonedNotified.mock.afterMarkAbandonedNotifiedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkAbandonedNotified.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkAbandonedNotified implements mm_interfaces.RepositoryIface
+func (mmMarkAbandonedNotified *RepositoryIfaceMock) MarkAbandonedNotified(ctx context.Context, userID uint64, updatedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkAbandonedNotified.beforeMarkAbandonedNotifiedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkAbandonedNotified.afterMarkAbandonedNotifiedCounter, 1)
+
+	mmMarkAbandonedNotified.t.Helper()
+
+	if mmMarkAbandonedNotified.inspectFuncMarkAbandonedNotified != nil {
+		mmMarkAbandonedNotified.inspectFuncMarkAbandonedNotified(ctx, userID, updatedAt)
+	}
+
+	mm_params := RepositoryIfaceMockMarkAbandonedNotifiedParams{ctx, userID, updatedAt}
+
+	// Record call args
+	mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.mutex.Lock()
+	mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.callArgs = append(mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.callArgs, &mm_params)
+	mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.mutex.Unlock()
+
+	for _, e := range mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockMarkAbandonedNotifiedParams{ctx, userID, updatedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkAbandonedNotified.t.Errorf("RepositoryIfaceMock.MarkAbandonedNotified got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMarkAbandonedNotified.t.Errorf("RepositoryIfaceMock.MarkAbandonedNotified got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.updatedAt != nil && !minimock.Equal(*mm_want_ptrs.updatedAt, mm_got.updatedAt) {
+				mmMarkAbandonedNotified.t.Errorf("RepositoryIfaceMock.MarkAbandonedNotified got unexpected parameter updatedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.defaultExpectation.expectationOrigins.originUpdatedAt, *mm_want_ptrs.updatedAt, mm_got.updatedAt, minimock.Diff(*mm_want_ptrs.updatedAt, mm_got.updatedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkAbandonedNotified.t.Errorf("RepositoryIfaceMock.MarkAbandonedNotified got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkAbandonedNotified.MarkAbandonedNotifiedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkAbandonedNotified.t.Fatal("No results are set for the RepositoryIfaceMock.MarkAbandonedNotified")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkAbandonedNotified.funcMarkAbandonedNotified != nil {
+		return mmMarkAbandonedNotified.funcMarkAbandonedNotified(ctx, userID, updatedAt)
+	}
+	mmMarkAbandonedNotified.t.Fatalf("Unexpected call to RepositoryIfaceMock.MarkAbandonedNotified. %v %v %v", ctx, userID, updatedAt)
+	return
+}
+
+// MarkAbandonedNotifiedAfterCounter returns a count of finished RepositoryIfaceMock.MarkAbandonedNotified invocations
+func (mmMarkAbandonedNotified *RepositoryIfaceMock) MarkAbandonedNotifiedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkAbandonedNotified.afterMarkAbandonedNotifiedCounter)
+}
+
+// MarkAbandonedNotifiedBeforeCounter returns a count of RepositoryIfaceMock.MarkAbandonedNotified invocations
+func (mmMarkAbandonedNotified *RepositoryIfaceMock) MarkAbandonedNotifiedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkAbandonedNotified.beforeMarkAbandonedNotifiedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.MarkAbandonedNotified.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkAbandonedNotified *mRepositoryIfaceMockMarkAbandonedNotified) Calls() []*RepositoryIfaceMockMarkAbandonedNotifiedParams {
+	mmMarkAbandonedNotified.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockMarkAbandonedNotifiedParams, len(mmMarkAbandonedNotified.callArgs))
+	copy(argCopy, mmMarkAbandonedNotified.callArgs)
+
+	mmMarkAbandonedNotified.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkAbandonedNotifiedDone returns true if the count of the MarkAbandonedNotified invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockMarkAbandonedNotifiedDone() bool {
+	if m.MarkAbandonedNotifiedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkAbandonedNotifiedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkAbandonedNotifiedMock.invocationsDone()
+}
+
+// MinimockMarkAbandonedNotifiedInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockMarkAbandonedNotifiedInspect() {
+	for _, e := range m.MarkAbandonedNotifiedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MarkAbandonedNotified at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkAbandonedNotifiedCounter := mm_atomic.LoadUint64(&m.afterMarkAbandonedNotifiedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkAbandonedNotifiedMock.defaultExpectation != nil && afterMarkAbandonedNotifiedCounter < 1 {
+		if m.MarkAbandonedNotifiedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MarkAbandonedNotified at\n%s", m.MarkAbandonedNotifiedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MarkAbandonedNotified at\n%s with params: %#v", m.MarkAbandonedNotifiedMock.defaultExpectation.expectationOrigins.origin, *m.MarkAbandonedNotifiedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkAbandonedNotified != nil && afterMarkAbandonedNotifiedCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.MarkAbandonedNotified at\n%s", m.funcMarkAbandonedNotifiedOrigin)
+	}
+
+	if !m.MarkAbandonedNotifiedMock.invocationsDone() && afterMarkAbandonedNotifiedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.MarkAbandonedNotified at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkAbandonedNotifiedMock.expectedInvocations), m.MarkAbandonedNotifiedMock.expectedInvocationsOrigin, afterMarkAbandonedNotifiedCounter)
+	}
+}
+
+type mRepositoryIfaceMockMergeCarts struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockMergeCartsExpectation
+	expectations       []*RepositoryIfaceMockMergeCartsExpectation
+
+	callArgs []*RepositoryIfaceMockMergeCartsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockMergeCartsExpectation specifies expectation struct of the RepositoryIface.MergeCarts
+type RepositoryIfaceMockMergeCartsExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockMergeCartsParams
+	paramPtrs          *RepositoryIfaceMockMergeCartsParamPtrs
+	expectationOrigins RepositoryIfaceMockMergeCartsExpectationOrigins
+	results            *RepositoryIfaceMockMergeCartsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockMergeCartsParams contains parameters of the RepositoryIface.MergeCarts
+type RepositoryIfaceMockMergeCartsParams struct {
+	ctx     context.Context
+	guestID uint64
+	userID  uint64
+	plan    func(user, guest []postgres.Position) (map[uint64]uint64, error)
+}
+
+// RepositoryIfaceMockMergeCartsParamPtrs contains pointers to parameters of the RepositoryIface.MergeCarts
+type RepositoryIfaceMockMergeCartsParamPtrs struct {
+	ctx     *context.Context
+	guestID *uint64
+	userID  *uint64
+	plan    *func(user, guest []postgres.Position) (map[uint64]uint64, error)
+}
+
+// RepositoryIfaceMockMergeCartsResults contains results of the RepositoryIface.MergeCarts
+type RepositoryIfaceMockMergeCartsResults struct {
+	pa1 []postgres.Position
+	err error
+}
+
+// RepositoryIfaceMockMergeCartsOrigins contains origins of expectations of the RepositoryIface.MergeCarts
+type RepositoryIfaceMockMergeCartsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originGuestID string
+	originUserID  string
+	originPlan    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) Optional() *mRepositoryIfaceMockMergeCarts {
+	mmMergeCarts.optional = true
+	return mmMergeCarts
+}
+
+// Expect sets up expected params for RepositoryIface.MergeCarts
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) Expect(ctx context.Context, guestID uint64, userID uint64, plan func(user, guest []postgres.Position) (map[uint64]uint64, error)) *mRepositoryIfaceMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &RepositoryIfaceMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by ExpectParams functions")
+	}
+
+	mmMergeCarts.defaultExpectation.params = &RepositoryIfaceMockMergeCartsParams{ctx, guestID, userID, plan}
+	mmMergeCarts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMergeCarts.expectations {
+		if minimock.Equal(e.params, mmMergeCarts.defaultExpectation.params) {
+			mmMergeCarts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMergeCarts.defaultExpectation.params)
+		}
+	}
+
+	return mmMergeCarts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.MergeCarts
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &RepositoryIfaceMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &RepositoryIfaceMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMergeCarts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// ExpectGuestIDParam2 sets up expected param guestID for RepositoryIface.MergeCarts
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) ExpectGuestIDParam2(guestID uint64) *mRepositoryIfaceMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &RepositoryIfaceMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &RepositoryIfaceMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.guestID = &guestID
+	mmMergeCarts.defaultExpectation.expectationOrigins.originGuestID = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// ExpectUserIDParam3 sets up expected param userID for RepositoryIface.MergeCarts
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) ExpectUserIDParam3(userID uint64) *mRepositoryIfaceMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &RepositoryIfaceMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &RepositoryIfaceMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.userID = &userID
+	mmMergeCarts.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// ExpectPlanParam4 sets up expected param plan for RepositoryIface.MergeCarts
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) ExpectPlanParam4(plan func(user, guest []postgres.Position) (map[uint64]uint64, error)) *mRepositoryIfaceMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &RepositoryIfaceMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &RepositoryIfaceMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.plan = &plan
+	mmMergeCarts.defaultExpectation.expectationOrigins.originPlan = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.MergeCarts
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) Inspect(f func(ctx context.Context, guestID uint64, userID uint64, plan func(user, guest []postgres.Position) (map[uint64]uint64, error))) *mRepositoryIfaceMockMergeCarts {
+	if mmMergeCarts.mock.inspectFuncMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.MergeCarts")
+	}
+
+	mmMergeCarts.mock.inspectFuncMergeCarts = f
+
+	return mmMergeCarts
+}
+
+// Return sets up results that will be returned by RepositoryIface.MergeCarts
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) Return(pa1 []postgres.Position, err error) *RepositoryIfaceMock {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &RepositoryIfaceMockMergeCartsExpectation{mock: mmMergeCarts.mock}
+	}
+	mmMergeCarts.defaultExpectation.results = &RepositoryIfaceMockMergeCartsResults{pa1, err}
+	mmMergeCarts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMergeCarts.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.MergeCarts method
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) Set(f func(ctx context.Context, guestID uint64, userID uint64, plan func(user, guest []postgres.Position) (map[uint64]uint64, error)) (pa1 []postgres.Position, err error)) *RepositoryIfaceMock {
+	if mmMergeCarts.defaultExpectation != nil {
+		mmMergeCarts.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.MergeCarts method")
+	}
+
+	if len(mmMergeCarts.expectations) > 0 {
+		mmMergeCarts.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.MergeCarts method")
+	}
+
+	mmMergeCarts.mock.funcMergeCarts = f
+	mmMergeCarts.mock.funcMergeCartsOrigin = minimock.CallerInfo(1)
+	return mmMergeCarts.mock
+}
+
+// When sets expectation for the RepositoryIface.MergeCarts which will trigger the result defined by the following
+// Then helper
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) When(ctx context.Context, guestID uint64, userID uint64, plan func(user, guest []postgres.Position) (map[uint64]uint64, error)) *RepositoryIfaceMockMergeCartsExpectation {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("RepositoryIfaceMock.MergeCarts mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockMergeCartsExpectation{
+		mock:               mmMergeCarts.mock,
+		params:             &RepositoryIfaceMockMergeCartsParams{ctx, guestID, userID, plan},
+		expectationOrigins: RepositoryIfaceMockMergeCartsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMergeCarts.expectations = append(mmMergeCarts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.MergeCarts return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockMergeCartsExpectation) Then(pa1 []postgres.Position, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockMergeCartsResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.MergeCarts should be invoked
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) Times(n uint64) *mRepositoryIfaceMockMergeCarts {
+	if n == 0 {
+		mmMergeCarts.mock.t.Fatalf("Times of RepositoryIfaceMock.MergeCarts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMergeCarts.expectedInvocations, n)
+	mmMergeCarts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMergeCarts
+}
+
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) invocationsDone() bool {
+	if len(mmMergeCarts.expectations) == 0 && mmMergeCarts.defaultExpectation == nil && mmMergeCarts.mock.funcMergeCarts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMergeCarts.mock.afterMergeCartsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMergeCarts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MergeCarts implements mm_interfaces.RepositoryIface
+func (mmMergeCarts *RepositoryIfaceMock) MergeCarts(ctx context.Context, guestID uint64, userID uint64, plan func(user, guest []postgres.Position) (map[uint64]uint64, error)) (pa1 []postgres.Position, err error) {
+	mm_atomic.AddUint64(&mmMergeCarts.beforeMergeCartsCounter, 1)
+	defer mm_atomic.AddUint64(&mmMergeCarts.afterMergeCartsCounter, 1)
+
+	mmMergeCarts.t.Helper()
+
+	if mmMergeCarts.inspectFuncMergeCarts != nil {
+		mmMergeCarts.inspectFuncMergeCarts(ctx, guestID, userID, plan)
+	}
+
+	mm_params := RepositoryIfaceMockMergeCartsParams{ctx, guestID, userID, plan}
+
+	// Record call args
+	mmMergeCarts.MergeCartsMock.mutex.Lock()
+	mmMergeCarts.MergeCartsMock.callArgs = append(mmMergeCarts.MergeCartsMock.callArgs, &mm_params)
+	mmMergeCarts.MergeCartsMock.mutex.Unlock()
+
+	for _, e := range mmMergeCarts.MergeCartsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmMergeCarts.MergeCartsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMergeCarts.MergeCartsMock.defaultExpectation.Counter, 1)
+		mm_want := mmMergeCarts.MergeCartsMock.defaultExpectation.params
+		mm_want_ptrs := mmMergeCarts.MergeCartsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockMergeCartsParams{ctx, guestID, userID, plan}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMergeCarts.t.Errorf("RepositoryIfaceMock.MergeCarts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.guestID != nil && !minimock.Equal(*mm_want_ptrs.guestID, mm_got.guestID) {
+				mmMergeCarts.t.Errorf("RepositoryIfaceMock.MergeCarts got unexpected parameter guestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originGuestID, *mm_want_ptrs.guestID, mm_got.guestID, minimock.Diff(*mm_want_ptrs.guestID, mm_got.guestID))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMergeCarts.t.Errorf("RepositoryIfaceMock.MergeCarts got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.plan != nil && !minimock.Equal(*mm_want_ptrs.plan, mm_got.plan) {
+				mmMergeCarts.t.Errorf("RepositoryIfaceMock.MergeCarts got unexpected parameter plan, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originPlan, *mm_want_ptrs.plan, mm_got.plan, minimock.Diff(*mm_want_ptrs.plan, mm_got.plan))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMergeCarts.t.Errorf("RepositoryIfaceMock.MergeCarts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMergeCarts.MergeCartsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMergeCarts.t.Fatal("No results are set for the RepositoryIfaceMock.MergeCarts")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmMergeCarts.funcMergeCarts != nil {
+		return mmMergeCarts.funcMergeCarts(ctx, guestID, userID, plan)
+	}
+	mmMergeCarts.t.Fatalf("Unexpected call to RepositoryIfaceMock.MergeCarts. %v %v %v %v", ctx, guestID, userID, plan)
+	return
+}
+
+// MergeCartsAfterCounter returns a count of finished RepositoryIfaceMock.MergeCarts invocations
+func (mmMergeCarts *RepositoryIfaceMock) MergeCartsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCarts.afterMergeCartsCounter)
+}
+
+// MergeCartsBeforeCounter returns a count of RepositoryIfaceMock.MergeCarts invocations
+func (mmMergeCarts *RepositoryIfaceMock) MergeCartsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCarts.beforeMergeCartsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.MergeCarts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMergeCarts *mRepositoryIfaceMockMergeCarts) Calls() []*RepositoryIfaceMockMergeCartsParams {
+	mmMergeCarts.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockMergeCartsParams, len(mmMergeCarts.callArgs))
+	copy(argCopy, mmMergeCarts.callArgs)
+
+	mmMergeCarts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergeCartsDone returns true if the count of the MergeCarts invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockMergeCartsDone() bool {
+	if m.MergeCartsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MergeCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MergeCartsMock.invocationsDone()
+}
+
+// MinimockMergeCartsInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockMergeCartsInspect() {
+	for _, e := range m.MergeCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MergeCarts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMergeCartsCounter := mm_atomic.LoadUint64(&m.afterMergeCartsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeCartsMock.defaultExpectation != nil && afterMergeCartsCounter < 1 {
+		if m.MergeCartsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MergeCarts at\n%s", m.MergeCartsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MergeCarts at\n%s with params: %#v", m.MergeCartsMock.defaultExpectation.expectationOrigins.origin, *m.MergeCartsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMergeCarts != nil && afterMergeCartsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.MergeCarts at\n%s", m.funcMergeCartsOrigin)
+	}
+
+	if !m.MergeCartsMock.invocationsDone() && afterMergeCartsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.MergeCarts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MergeCartsMock.expectedInvocations), m.MergeCartsMock.expectedInvocationsOrigin, afterMergeCartsCounter)
+	}
+}
+
+type mRepositoryIfaceMockMoveToCart struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockMoveToCartExpectation
+	expectations       []*RepositoryIfaceMockMoveToCartExpectation
+
+	callArgs []*RepositoryIfaceMockMoveToCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockMoveToCartExpectation specifies expectation struct of the RepositoryIface.MoveToCart
+type RepositoryIfaceMockMoveToCartExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockMoveToCartParams
+	paramPtrs          *RepositoryIfaceMockMoveToCartParamPtrs
+	expectationOrigins RepositoryIfaceMockMoveToCartExpectationOrigins
+	results            *RepositoryIfaceMockMoveToCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockMoveToCartParams contains parameters of the RepositoryIface.MoveToCart
+type RepositoryIfaceMockMoveToCartParams struct {
+	ctx      context.Context
+	userID   uint64
+	skuID    uint64
+	count    uint64
+	price    uint64
+	expected *uint64
+}
+
+// RepositoryIfaceMockMoveToCartParamPtrs contains pointers to parameters of the RepositoryIface.MoveToCart
+type RepositoryIfaceMockMoveToCartParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	skuID    *uint64
+	count    *uint64
+	price    *uint64
+	expected **uint64
+}
+
+// RepositoryIfaceMockMoveToCartResults contains results of the RepositoryIface.MoveToCart
+type RepositoryIfaceMockMoveToCartResults struct {
+	err error
+}
+
+// RepositoryIfaceMockMoveToCartOrigins contains origins of expectations of the RepositoryIface.MoveToCart
+type RepositoryIfaceMockMoveToCartExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originCount    string
+	originPrice    string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) Optional() *mRepositoryIfaceMockMoveToCart {
+	mmMoveToCart.optional = true
+	return mmMoveToCart
+}
+
+// Expect sets up expected params for RepositoryIface.MoveToCart
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) Expect(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) *mRepositoryIfaceMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryIfaceMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by ExpectParams functions")
+	}
+
+	mmMoveToCart.defaultExpectation.params = &RepositoryIfaceMockMoveToCartParams{ctx, userID, skuID, count, price, expected}
+	mmMoveToCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMoveToCart.expectations {
+		if minimock.Equal(e.params, mmMoveToCart.defaultExpectation.params) {
+			mmMoveToCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMoveToCart.defaultExpectation.params)
+		}
+	}
+
+	return mmMoveToCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.MoveToCart
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryIfaceMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.params != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Expect")
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveToCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockMoveToCartParamPtrs{}
+	}
+	mmMoveToCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMoveToCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMoveToCart
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.MoveToCart
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryIfaceMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.params != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Expect")
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveToCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockMoveToCartParamPtrs{}
+	}
+	mmMoveToCart.defaultExpectation.paramPtrs.userID = &userID
+	mmMoveToCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMoveToCart
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for RepositoryIface.MoveToCart
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) ExpectSkuIDParam3(skuID uint64) *mRepositoryIfaceMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryIfaceMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.params != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Expect")
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveToCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockMoveToCartParamPtrs{}
+	}
+	mmMoveToCart.defaultExpectation.paramPtrs.skuID = &skuID
+	mmMoveToCart.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmMoveToCart
+}
+
+// ExpectCountParam4 sets up expected param count for RepositoryIface.MoveToCart
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) ExpectCountParam4(count uint64) *mRepositoryIfaceMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryIfaceMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.params != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Expect")
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveToCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockMoveToCartParamPtrs{}
+	}
+	mmMoveToCart.defaultExpectation.paramPtrs.count = &count
+	mmMoveToCart.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmMoveToCart
+}
+
+// ExpectPriceParam5 sets up expected param price for RepositoryIface.MoveToCart
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) ExpectPriceParam5(price uint64) *mRepositoryIfaceMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryIfaceMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.params != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Expect")
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveToCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockMoveToCartParamPtrs{}
+	}
+	mmMoveToCart.defaultExpectation.paramPtrs.price = &price
+	mmMoveToCart.defaultExpectation.expectationOrigins.originPrice = minimock.CallerInfo(1)
+
+	return mmMoveToCart
+}
+
+// ExpectExpectedParam6 sets up expected param expected for RepositoryIface.MoveToCart
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) ExpectExpectedParam6(expected *uint64) *mRepositoryIfaceMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryIfaceMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.params != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Expect")
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveToCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockMoveToCartParamPtrs{}
+	}
+	mmMoveToCart.defaultExpectation.paramPtrs.expected = &expected
+	mmMoveToCart.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmMoveToCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.MoveToCart
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) Inspect(f func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64)) *mRepositoryIfaceMockMoveToCart {
+	if mmMoveToCart.mock.inspectFuncMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.MoveToCart")
+	}
+
+	mmMoveToCart.mock.inspectFuncMoveToCart = f
+
+	return mmMoveToCart
+}
+
+// Return sets up results that will be returned by RepositoryIface.MoveToCart
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) Return(err error) *RepositoryIfaceMock {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryIfaceMockMoveToCartExpectation{mock: mmMoveToCart.mock}
+	}
+	mmMoveToCart.defaultExpectation.results = &RepositoryIfaceMockMoveToCartResults{err}
+	mmMoveToCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMoveToCart.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.MoveToCart method
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) Set(f func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) (err error)) *RepositoryIfaceMock {
+	if mmMoveToCart.defaultExpectation != nil {
+		mmMoveToCart.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.MoveToCart method")
+	}
+
+	if len(mmMoveToCart.expectations) > 0 {
+		mmMoveToCart.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.MoveToCart method")
+	}
+
+	mmMoveToCart.mock.funcMoveToCart = f
+	mmMoveToCart.mock.funcMoveToCartOrigin = minimock.CallerInfo(1)
+	return mmMoveToCart.mock
+}
+
+// When sets expectation for the RepositoryIface.MoveToCart which will trigger the result defined by the following
+// Then helper
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) When(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) *RepositoryIfaceMockMoveToCartExpectation {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryIfaceMock.MoveToCart mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockMoveToCartExpectation{
+		mock:               mmMoveToCart.mock,
+		params:             &RepositoryIfaceMockMoveToCartParams{ctx, userID, skuID, count, price, expected},
+		expectationOrigins: RepositoryIfaceMockMoveToCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMoveToCart.expectations = append(mmMoveToCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.MoveToCart return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockMoveToCartExpectation) Then(err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockMoveToCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.MoveToCart should be invoked
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) Times(n uint64) *mRepositoryIfaceMockMoveToCart {
+	if n == 0 {
+		mmMoveToCart.mock.t.Fatalf("Times of RepositoryIfaceMock.MoveToCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMoveToCart.expectedInvocations, n)
+	mmMoveToCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMoveToCart
+}
+
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) invocationsDone() bool {
+	if len(mmMoveToCart.expectations) == 0 && mmMoveToCart.defaultExpectation == nil && mmMoveToCart.mock.funcMoveToCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMoveToCart.mock.afterMoveToCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMoveToCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MoveToCart implements mm_interfaces.RepositoryIface
+func (mmMoveToCart *RepositoryIfaceMock) MoveToCart(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) (err error) {
+	mm_atomic.AddUint64(&mmMoveToCart.beforeMoveToCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmMoveToCart.afterMoveToCartCounter, 1)
+
+	mmMoveToCart.t.Helper()
+
+	if mmMoveToCart.inspectFuncMoveToCart != nil {
+		mmMoveToCart.inspectFuncMoveToCart(ctx, userID, skuID, count, price, expected)
+	}
+
+	mm_params := RepositoryIfaceMockMoveToCartParams{ctx, userID, skuID, count, price, expected}
+
+	// Record call args
+	mmMoveToCart.MoveToCartMock.mutex.Lock()
+	mmMoveToCart.MoveToCartMock.callArgs = append(mmMoveToCart.MoveToCartMock.callArgs, &mm_params)
+	mmMoveToCart.MoveToCartMock.mutex.Unlock()
+
+	for _, e := range mmMoveToCart.MoveToCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMoveToCart.MoveToCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMoveToCart.MoveToCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmMoveToCart.MoveToCartMock.defaultExpectation.params
+		mm_want_ptrs := mmMoveToCart.MoveToCartMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockMoveToCartParams{ctx, userID, skuID, count, price, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMoveToCart.t.Errorf("RepositoryIfaceMock.MoveToCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMoveToCart.t.Errorf("RepositoryIfaceMock.MoveToCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmMoveToCart.t.Errorf("RepositoryIfaceMock.MoveToCart got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmMoveToCart.t.Errorf("RepositoryIfaceMock.MoveToCart got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+			if mm_want_ptrs.price != nil && !minimock.Equal(*mm_want_ptrs.price, mm_got.price) {
+				mmMoveToCart.t.Errorf("RepositoryIfaceMock.MoveToCart got unexpected parameter price, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.originPrice, *mm_want_ptrs.price, mm_got.price, minimock.Diff(*mm_want_ptrs.price, mm_got.price))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmMoveToCart.t.Errorf("RepositoryIfaceMock.MoveToCart got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMoveToCart.t.Errorf("RepositoryIfaceMock.MoveToCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMoveToCart.MoveToCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMoveToCart.t.Fatal("No results are set for the RepositoryIfaceMock.MoveToCart")
+		}
+		return (*mm_results).err
+	}
+	if mmMoveToCart.funcMoveToCart != nil {
+		return mmMoveToCart.funcMoveToCart(ctx, userID, skuID, count, price, expected)
+	}
+	mmMoveToCart.t.Fatalf("Unexpected call to RepositoryIfaceMock.MoveToCart. %v %v %v %v %v %v", ctx, userID, skuID, count, price, expected)
+	return
+}
+
+// MoveToCartAfterCounter returns a count of finished RepositoryIfaceMock.MoveToCart invocations
+func (mmMoveToCart *RepositoryIfaceMock) MoveToCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveToCart.afterMoveToCartCounter)
+}
+
+// MoveToCartBeforeCounter returns a count of RepositoryIfaceMock.MoveToCart invocations
+func (mmMoveToCart *RepositoryIfaceMock) MoveToCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveToCart.beforeMoveToCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.MoveToCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMoveToCart *mRepositoryIfaceMockMoveToCart) Calls() []*RepositoryIfaceMockMoveToCartParams {
+	mmMoveToCart.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockMoveToCartParams, len(mmMoveToCart.callArgs))
+	copy(argCopy, mmMoveToCart.callArgs)
+
+	mmMoveToCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMoveToCartDone returns true if the count of the MoveToCart invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockMoveToCartDone() bool {
+	if m.MoveToCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MoveToCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MoveToCartMock.invocationsDone()
+}
+
+// MinimockMoveToCartInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockMoveToCartInspect() {
+	for _, e := range m.MoveToCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MoveToCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMoveToCartCounter := mm_atomic.LoadUint64(&m.afterMoveToCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MoveToCartMock.defaultExpectation != nil && afterMoveToCartCounter < 1 {
+		if m.MoveToCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MoveToCart at\n%s", m.MoveToCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MoveToCart at\n%s with params: %#v", m.MoveToCartMock.defaultExpectation.expectationOrigins.origin, *m.MoveToCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMoveToCart != nil && afterMoveToCartCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.MoveToCart at\n%s", m.funcMoveToCartOrigin)
+	}
+
+	if !m.MoveToCartMock.invocationsDone() && afterMoveToCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.MoveToCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MoveToCartMock.expectedInvocations), m.MoveToCartMock.expectedInvocationsOrigin, afterMoveToCartCounter)
+	}
+}
+
+type mRepositoryIfaceMockMoveToSaved struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockMoveToSavedExpectation
+	expectations       []*RepositoryIfaceMockMoveToSavedExpectation
+
+	callArgs []*RepositoryIfaceMockMoveToSavedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockMoveToSavedExpectation specifies expectation struct of the RepositoryIface.MoveToSaved
+type RepositoryIfaceMockMoveToSavedExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockMoveToSavedParams
+	paramPtrs          *RepositoryIfaceMockMoveToSavedParamPtrs
+	expectationOrigins RepositoryIfaceMockMoveToSavedExpectationOrigins
+	results            *RepositoryIfaceMockMoveToSavedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockMoveToSavedParams contains parameters of the RepositoryIface.MoveToSaved
+type RepositoryIfaceMockMoveToSavedParams struct {
+	ctx      context.Context
+	userID   uint64
+	skuID    uint64
+	expected *uint64
+}
+
+// RepositoryIfaceMockMoveToSavedParamPtrs contains pointers to parameters of the RepositoryIface.MoveToSaved
+type RepositoryIfaceMockMoveToSavedParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	skuID    *uint64
+	expected **uint64
+}
+
+// RepositoryIfaceMockMoveToSavedResults contains results of the RepositoryIface.MoveToSaved
+type RepositoryIfaceMockMoveToSavedResults struct {
+	u1  uint64
+	err error
+}
+
+// RepositoryIfaceMockMoveToSavedOrigins contains origins of expectations of the RepositoryIface.MoveToSaved
+type RepositoryIfaceMockMoveToSavedExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) Optional() *mRepositoryIfaceMockMoveToSaved {
+	mmMoveToSaved.optional = true
+	return mmMoveToSaved
+}
+
+// Expect sets up expected params for RepositoryIface.MoveToSaved
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) Expect(ctx context.Context, userID uint64, skuID uint64, expected *uint64) *mRepositoryIfaceMockMoveToSaved {
+	if mmMoveToSaved.mock.funcMoveToSaved != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Set")
+	}
+
+	if mmMoveToSaved.defaultExpectation == nil {
+		mmMoveToSaved.defaultExpectation = &RepositoryIfaceMockMoveToSavedExpectation{}
+	}
+
+	if mmMoveToSaved.defaultExpectation.paramPtrs != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by ExpectParams functions")
+	}
+
+	mmMoveToSaved.defaultExpectation.params = &RepositoryIfaceMockMoveToSavedParams{ctx, userID, skuID, expected}
+	mmMoveToSaved.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMoveToSaved.expectations {
+		if minimock.Equal(e.params, mmMoveToSaved.defaultExpectation.params) {
+			mmMoveToSaved.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMoveToSaved.defaultExpectation.params)
+		}
+	}
+
+	return mmMoveToSaved
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.MoveToSaved
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockMoveToSaved {
+	if mmMoveToSaved.mock.funcMoveToSaved != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Set")
+	}
+
+	if mmMoveToSaved.defaultExpectation == nil {
+		mmMoveToSaved.defaultExpectation = &RepositoryIfaceMockMoveToSavedExpectation{}
+	}
+
+	if mmMoveToSaved.defaultExpectation.params != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Expect")
+	}
+
+	if mmMoveToSaved.defaultExpectation.paramPtrs == nil {
+		mmMoveToSaved.defaultExpectation.paramPtrs = &RepositoryIfaceMockMoveToSavedParamPtrs{}
+	}
+	mmMoveToSaved.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMoveToSaved.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMoveToSaved
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.MoveToSaved
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockMoveToSaved {
+	if mmMoveToSaved.mock.funcMoveToSaved != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Set")
+	}
+
+	if mmMoveToSaved.defaultExpectation == nil {
+		mmMoveToSaved.defaultExpectation = &RepositoryIfaceMockMoveToSavedExpectation{}
+	}
+
+	if mmMoveToSaved.defaultExpectation.params != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Expect")
+	}
+
+	if mmMoveToSaved.defaultExpectation.paramPtrs == nil {
+		mmMoveToSaved.defaultExpectation.paramPtrs = &RepositoryIfaceMockMoveToSavedParamPtrs{}
+	}
+	mmMoveToSaved.defaultExpectation.paramPtrs.userID = &userID
+	mmMoveToSaved.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMoveToSaved
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for RepositoryIface.MoveToSaved
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) ExpectSkuIDParam3(skuID uint64) *mRepositoryIfaceMockMoveToSaved {
+	if mmMoveToSaved.mock.funcMoveToSaved != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Set")
+	}
+
+	if mmMoveToSaved.defaultExpectation == nil {
+		mmMoveToSaved.defaultExpectation = &RepositoryIfaceMockMoveToSavedExpectation{}
+	}
+
+	if mmMoveToSaved.defaultExpectation.params != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Expect")
+	}
+
+	if mmMoveToSaved.defaultExpectation.paramPtrs == nil {
+		mmMoveToSaved.defaultExpectation.paramPtrs = &RepositoryIfaceMockMoveToSavedParamPtrs{}
+	}
+	mmMoveToSaved.defaultExpectation.paramPtrs.skuID = &skuID
+	mmMoveToSaved.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmMoveToSaved
+}
+
+// ExpectExpectedParam4 sets up expected param expected for RepositoryIface.MoveToSaved
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) ExpectExpectedParam4(expected *uint64) *mRepositoryIfaceMockMoveToSaved {
+	if mmMoveToSaved.mock.funcMoveToSaved != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Set")
+	}
+
+	if mmMoveToSaved.defaultExpectation == nil {
+		mmMoveToSaved.defaultExpectation = &RepositoryIfaceMockMoveToSavedExpectation{}
+	}
+
+	if mmMoveToSaved.defaultExpectation.params != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Expect")
+	}
+
+	if mmMoveToSaved.defaultExpectation.paramPtrs == nil {
+		mmMoveToSaved.defaultExpectation.paramPtrs = &RepositoryIfaceMockMoveToSavedParamPtrs{}
+	}
+	mmMoveToSaved.defaultExpectation.paramPtrs.expected = &expected
+	mmMoveToSaved.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmMoveToSaved
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.MoveToSaved
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) Inspect(f func(ctx context.Context, userID uint64, skuID uint64, expected *uint64)) *mRepositoryIfaceMockMoveToSaved {
+	if mmMoveToSaved.mock.inspectFuncMoveToSaved != nil {
+		mmMoveToSaved.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.MoveToSaved")
+	}
+
+	mmMoveToSaved.mock.inspectFuncMoveToSaved = f
+
+	return mmMoveToSaved
+}
+
+// Return sets up results that will be returned by RepositoryIface.MoveToSaved
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) Return(u1 uint64, err error) *RepositoryIfaceMock {
+	if mmMoveToSaved.mock.funcMoveToSaved != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Set")
+	}
+
+	if mmMoveToSaved.defaultExpectation == nil {
+		mmMoveToSaved.defaultExpectation = &RepositoryIfaceMockMoveToSavedExpectation{mock: mmMoveToSaved.mock}
+	}
+	mmMoveToSaved.defaultExpectation.results = &RepositoryIfaceMockMoveToSavedResults{u1, err}
+	mmMoveToSaved.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMoveToSaved.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.MoveToSaved method
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) Set(f func(ctx context.Context, userID uint64, skuID uint64, expected *uint64) (u1 uint64, err error)) *RepositoryIfaceMock {
+	if mmMoveToSaved.defaultExpectation != nil {
+		mmMoveToSaved.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.MoveToSaved method")
+	}
+
+	if len(mmMoveToSaved.expectations) > 0 {
+		mmMoveToSaved.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.MoveToSaved method")
+	}
+
+	mmMoveToSaved.mock.funcMoveToSaved = f
+	mmMoveToSaved.mock.funcMoveToSavedOrigin = minimock.CallerInfo(1)
+	return mmMoveToSaved.mock
+}
+
+// When sets expectation for the RepositoryIface.MoveToSaved which will trigger the result defined by the following
+// Then helper
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) When(ctx context.Context, userID uint64, skuID uint64, expected *uint64) *RepositoryIfaceMockMoveToSavedExpectation {
+	if mmMoveToSaved.mock.funcMoveToSaved != nil {
+		mmMoveToSaved.mock.t.Fatalf("RepositoryIfaceMock.MoveToSaved mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockMoveToSavedExpectation{
+		mock:               mmMoveToSaved.mock,
+		params:             &RepositoryIfaceMockMoveToSavedParams{ctx, userID, skuID, expected},
+		expectationOrigins: RepositoryIfaceMockMoveToSavedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMoveToSaved.expectations = append(mmMoveToSaved.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.MoveToSaved return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockMoveToSavedExpectation) Then(u1 uint64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockMoveToSavedResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.MoveToSaved should be invoked
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) Times(n uint64) *mRepositoryIfaceMockMoveToSaved {
+	if n == 0 {
+		mmMoveToSaved.mock.t.Fatalf("Times of RepositoryIfaceMock.MoveToSaved mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMoveToSaved.expectedInvocations, n)
+	mmMoveToSaved.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMoveToSaved
+}
+
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) invocationsDone() bool {
+	if len(mmMoveToSaved.expectations) == 0 && mmMoveToSaved.defaultExpectation == nil && mmMoveToSaved.mock.funcMoveToSaved == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMoveToSaved.mock.afterMoveToSavedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMoveToSaved.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MoveToSaved implements mm_interfaces.RepositoryIface
+func (mmMoveToSaved *RepositoryIfaceMock) MoveToSaved(ctx context.Context, userID uint64, skuID uint64, expected *uint64) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmMoveToSaved.beforeMoveToSavedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMoveToSaved.afterMoveToSavedCounter, 1)
+
+	mmMoveToSaved.t.Helper()
+
+	if mmMoveToSaved.inspectFuncMoveToSaved != nil {
+		mmMoveToSaved.inspectFuncMoveToSaved(ctx, userID, skuID, expected)
+	}
+
+	mm_params := RepositoryIfaceMockMoveToSavedParams{ctx, userID, skuID, expected}
+
+	// Record call args
+	mmMoveToSaved.MoveToSavedMock.mutex.Lock()
+	mmMoveToSaved.MoveToSavedMock.callArgs = append(mmMoveToSaved.MoveToSavedMock.callArgs, &mm_params)
+	mmMoveToSaved.MoveToSavedMock.mutex.Unlock()
+
+	for _, e := range mmMoveToSaved.MoveToSavedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmMoveToSaved.MoveToSavedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMoveToSaved.MoveToSavedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMoveToSaved.MoveToSavedMock.defaultExpectation.params
+		mm_want_ptrs := mmMoveToSaved.MoveToSavedMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockMoveToSavedParams{ctx, userID, skuID, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMoveToSaved.t.Errorf("RepositoryIfaceMock.MoveToSaved got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToSaved.MoveToSavedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMoveToSaved.t.Errorf("RepositoryIfaceMock.MoveToSaved got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToSaved.MoveToSavedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmMoveToSaved.t.Errorf("RepositoryIfaceMock.MoveToSaved got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToSaved.MoveToSavedMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmMoveToSaved.t.Errorf("RepositoryIfaceMock.MoveToSaved got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToSaved.MoveToSavedMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMoveToSaved.t.Errorf("RepositoryIfaceMock.MoveToSaved got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMoveToSaved.MoveToSavedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMoveToSaved.MoveToSavedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMoveToSaved.t.Fatal("No results are set for the RepositoryIfaceMock.MoveToSaved")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmMoveToSaved.funcMoveToSaved != nil {
+		return mmMoveToSaved.funcMoveToSaved(ctx, userID, skuID, expected)
+	}
+	mmMoveToSaved.t.Fatalf("Unexpected call to RepositoryIfaceMock.MoveToSaved. %v %v %v %v", ctx, userID, skuID, expected)
+	return
+}
+
+// MoveToSavedAfterCounter returns a count of finished RepositoryIfaceMock.MoveToSaved invocations
+func (mmMoveToSaved *RepositoryIfaceMock) MoveToSavedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveToSaved.afterMoveToSavedCounter)
+}
+
+// MoveToSavedBeforeCounter returns a count of RepositoryIfaceMock.MoveToSaved invocations
+func (mmMoveToSaved *RepositoryIfaceMock) MoveToSavedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveToSaved.beforeMoveToSavedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.MoveToSaved.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMoveToSaved *mRepositoryIfaceMockMoveToSaved) Calls() []*RepositoryIfaceMockMoveToSavedParams {
+	mmMoveToSaved.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockMoveToSavedParams, len(mmMoveToSaved.callArgs))
+	copy(argCopy, mmMoveToSaved.callArgs)
+
+	mmMoveToSaved.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMoveToSavedDone returns true if the count of the MoveToSaved invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockMoveToSavedDone() bool {
+	if m.MoveToSavedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MoveToSavedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MoveToSavedMock.invocationsDone()
+}
+
+// MinimockMoveToSavedInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockMoveToSavedInspect() {
+	for _, e := range m.MoveToSavedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MoveToSaved at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMoveToSavedCounter := mm_atomic.LoadUint64(&m.afterMoveToSavedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MoveToSavedMock.defaultExpectation != nil && afterMoveToSavedCounter < 1 {
+		if m.MoveToSavedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MoveToSaved at\n%s", m.MoveToSavedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.MoveToSaved at\n%s with params: %#v", m.MoveToSavedMock.defaultExpectation.expectationOrigins.origin, *m.MoveToSavedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMoveToSaved != nil && afterMoveToSavedCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.MoveToSaved at\n%s", m.funcMoveToSavedOrigin)
+	}
+
+	if !m.MoveToSavedMock.invocationsDone() && afterMoveToSavedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.MoveToSaved at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MoveToSavedMock.expectedInvocations), m.MoveToSavedMock.expectedInvocationsOrigin, afterMoveToSavedCounter)
+	}
+}
+
+type mRepositoryIfaceMockReleaseIdempotencyKey struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockReleaseIdempotencyKeyExpectation
+	expectations       []*RepositoryIfaceMockReleaseIdempotencyKeyExpectation
+
+	callArgs []*RepositoryIfaceMockReleaseIdempotencyKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockReleaseIdempotencyKeyExpectation specifies expectation struct of the RepositoryIface.ReleaseIdempotencyKey
+type RepositoryIfaceMockReleaseIdempotencyKeyExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockReleaseIdempotencyKeyParams
+	paramPtrs          *RepositoryIfaceMockReleaseIdempotencyKeyParamPtrs
+	expectationOrigins RepositoryIfaceMockReleaseIdempotencyKeyExpectationOrigins
+	results            *RepositoryIfaceMockReleaseIdempotencyKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockReleaseIdempotencyKeyParams contains parameters of the RepositoryIface.ReleaseIdempotencyKey
+type RepositoryIfaceMockReleaseIdempotencyKeyParams struct {
+	ctx    context.Context
+	userID uint64
+	key    string
+}
+
+// RepositoryIfaceMockReleaseIdempotencyKeyParamPtrs contains pointers to parameters of the RepositoryIface.ReleaseIdempotencyKey
+type RepositoryIfaceMockReleaseIdempotencyKeyParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	key    *string
+}
+
+// RepositoryIfaceMockReleaseIdempotencyKeyResults contains results of the RepositoryIface.ReleaseIdempotencyKey
+type RepositoryIfaceMockReleaseIdempotencyKeyResults struct {
+	err error
+}
+
+// RepositoryIfaceMockReleaseIdempotencyKeyOrigins contains origins of expectations of the RepositoryIface.ReleaseIdempotencyKey
+type RepositoryIfaceMockReleaseIdempotencyKeyExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originKey    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) Optional() *mRepositoryIfaceMockReleaseIdempotencyKey {
+	mmReleaseIdempotencyKey.optional = true
+	return mmReleaseIdempotencyKey
+}
+
+// Expect sets up expected params for RepositoryIface.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) Expect(ctx context.Context, userID uint64, key string) *mRepositoryIfaceMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &RepositoryIfaceMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ReleaseIdempotencyKey mock is already set by ExpectParams functions")
+	}
+
+	mmReleaseIdempotencyKey.defaultExpectation.params = &RepositoryIfaceMockReleaseIdempotencyKeyParams{ctx, userID, key}
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReleaseIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmReleaseIdempotencyKey.defaultExpectation.params) {
+			mmReleaseIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReleaseIdempotencyKey.defaultExpectation.params)
+		}
+	}
+
+	return mmReleaseIdempotencyKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &RepositoryIfaceMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.params != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ReleaseIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReleaseIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryIfaceMockReleaseIdempotencyKeyParamPtrs{}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReleaseIdempotencyKey
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &RepositoryIfaceMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.params != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ReleaseIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReleaseIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryIfaceMockReleaseIdempotencyKeyParamPtrs{}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.paramPtrs.userID = &userID
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReleaseIdempotencyKey
+}
+
+// ExpectKeyParam3 sets up expected param key for RepositoryIface.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) ExpectKeyParam3(key string) *mRepositoryIfaceMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &RepositoryIfaceMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.params != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ReleaseIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReleaseIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryIfaceMockReleaseIdempotencyKeyParamPtrs{}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.paramPtrs.key = &key
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmReleaseIdempotencyKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) Inspect(f func(ctx context.Context, userID uint64, key string)) *mRepositoryIfaceMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.inspectFuncReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.ReleaseIdempotencyKey")
+	}
+
+	mmReleaseIdempotencyKey.mock.inspectFuncReleaseIdempotencyKey = f
+
+	return mmReleaseIdempotencyKey
+}
+
+// Return sets up results that will be returned by RepositoryIface.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) Return(err error) *RepositoryIfaceMock {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &RepositoryIfaceMockReleaseIdempotencyKeyExpectation{mock: mmReleaseIdempotencyKey.mock}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.results = &RepositoryIfaceMockReleaseIdempotencyKeyResults{err}
+	mmReleaseIdempotencyKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReleaseIdempotencyKey.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.ReleaseIdempotencyKey method
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) Set(f func(ctx context.Context, userID uint64, key string) (err error)) *RepositoryIfaceMock {
+	if mmReleaseIdempotencyKey.defaultExpectation != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.ReleaseIdempotencyKey method")
+	}
+
+	if len(mmReleaseIdempotencyKey.expectations) > 0 {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.ReleaseIdempotencyKey method")
+	}
+
+	mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey = f
+	mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKeyOrigin = minimock.CallerInfo(1)
+	return mmReleaseIdempotencyKey.mock
+}
+
+// When sets expectation for the RepositoryIface.ReleaseIdempotencyKey which will trigger the result defined by the following
+// Then helper
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) When(ctx context.Context, userID uint64, key string) *RepositoryIfaceMockReleaseIdempotencyKeyExpectation {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryIfaceMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockReleaseIdempotencyKeyExpectation{
+		mock:               mmReleaseIdempotencyKey.mock,
+		params:             &RepositoryIfaceMockReleaseIdempotencyKeyParams{ctx, userID, key},
+		expectationOrigins: RepositoryIfaceMockReleaseIdempotencyKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReleaseIdempotencyKey.expectations = append(mmReleaseIdempotencyKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.ReleaseIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockReleaseIdempotencyKeyExpectation) Then(err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockReleaseIdempotencyKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.ReleaseIdempotencyKey should be invoked
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) Times(n uint64) *mRepositoryIfaceMockReleaseIdempotencyKey {
+	if n == 0 {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Times of RepositoryIfaceMock.ReleaseIdempotencyKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReleaseIdempotencyKey.expectedInvocations, n)
+	mmReleaseIdempotencyKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReleaseIdempotencyKey
+}
+
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) invocationsDone() bool {
+	if len(mmReleaseIdempotencyKey.expectations) == 0 && mmReleaseIdempotencyKey.defaultExpectation == nil && mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.mock.afterReleaseIdempotencyKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReleaseIdempotencyKey implements mm_interfaces.RepositoryIface
+func (mmReleaseIdempotencyKey *RepositoryIfaceMock) ReleaseIdempotencyKey(ctx context.Context, userID uint64, key string) (err error) {
+	mm_atomic.AddUint64(&mmReleaseIdempotencyKey.beforeReleaseIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmReleaseIdempotencyKey.afterReleaseIdempotencyKeyCounter, 1)
+
+	mmReleaseIdempotencyKey.t.Helper()
+
+	if mmReleaseIdempotencyKey.inspectFuncReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.inspectFuncReleaseIdempotencyKey(ctx, userID, key)
+	}
+
+	mm_params := RepositoryIfaceMockReleaseIdempotencyKeyParams{ctx, userID, key}
+
+	// Record call args
+	mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.mutex.Lock()
+	mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.callArgs = append(mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.callArgs, &mm_params)
+	mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.mutex.Unlock()
+
+	for _, e := range mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockReleaseIdempotencyKeyParams{ctx, userID, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReleaseIdempotencyKey.t.Errorf("RepositoryIfaceMock.ReleaseIdempotencyKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReleaseIdempotencyKey.t.Errorf("RepositoryIfaceMock.ReleaseIdempotencyKey got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReleaseIdempotencyKey.t.Errorf("RepositoryIfaceMock.ReleaseIdempotencyKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReleaseIdempotencyKey.t.Errorf("RepositoryIfaceMock.ReleaseIdempotencyKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReleaseIdempotencyKey.t.Fatal("No results are set for the RepositoryIfaceMock.ReleaseIdempotencyKey")
+		}
+		return (*mm_results).err
+	}
+	if mmReleaseIdempotencyKey.funcReleaseIdempotencyKey != nil {
+		return mmReleaseIdempotencyKey.funcReleaseIdempotencyKey(ctx, userID, key)
+	}
+	mmReleaseIdempotencyKey.t.Fatalf("Unexpected call to RepositoryIfaceMock.ReleaseIdempotencyKey. %v %v %v", ctx, userID, key)
+	return
+}
+
+// ReleaseIdempotencyKeyAfterCounter returns a count of finished RepositoryIfaceMock.ReleaseIdempotencyKey invocations
+func (mmReleaseIdempotencyKey *RepositoryIfaceMock) ReleaseIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.afterReleaseIdempotencyKeyCounter)
+}
+
+// ReleaseIdempotencyKeyBeforeCounter returns a count of RepositoryIfaceMock.ReleaseIdempotencyKey invocations
+func (mmReleaseIdempotencyKey *RepositoryIfaceMock) ReleaseIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.beforeReleaseIdempotencyKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.ReleaseIdempotencyKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReleaseIdempotencyKey *mRepositoryIfaceMockReleaseIdempotencyKey) Calls() []*RepositoryIfaceMockReleaseIdempotencyKeyParams {
+	mmReleaseIdempotencyKey.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockReleaseIdempotencyKeyParams, len(mmReleaseIdempotencyKey.callArgs))
+	copy(argCopy, mmReleaseIdempotencyKey.callArgs)
+
+	mmReleaseIdempotencyKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseIdempotencyKeyDone returns true if the count of the ReleaseIdempotencyKey invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockReleaseIdempotencyKeyDone() bool {
+	if m.ReleaseIdempotencyKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReleaseIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReleaseIdempotencyKeyMock.invocationsDone()
+}
+
+// MinimockReleaseIdempotencyKeyInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockReleaseIdempotencyKeyInspect() {
+	for _, e := range m.ReleaseIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ReleaseIdempotencyKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReleaseIdempotencyKeyCounter := mm_atomic.LoadUint64(&m.afterReleaseIdempotencyKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseIdempotencyKeyMock.defaultExpectation != nil && afterReleaseIdempotencyKeyCounter < 1 {
+		if m.ReleaseIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ReleaseIdempotencyKey at\n%s", m.ReleaseIdempotencyKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.ReleaseIdempotencyKey at\n%s with params: %#v", m.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *m.ReleaseIdempotencyKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseIdempotencyKey != nil && afterReleaseIdempotencyKeyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.ReleaseIdempotencyKey at\n%s", m.funcReleaseIdempotencyKeyOrigin)
+	}
+
+	if !m.ReleaseIdempotencyKeyMock.invocationsDone() && afterReleaseIdempotencyKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.ReleaseIdempotencyKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReleaseIdempotencyKeyMock.expectedInvocations), m.ReleaseIdempotencyKeyMock.expectedInvocationsOrigin, afterReleaseIdempotencyKeyCounter)
+	}
+}
+
+type mRepositoryIfaceMockRemoveDelisted struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockRemoveDelistedExpectation
+	expectations       []*RepositoryIfaceMockRemoveDelistedExpectation
+
+	callArgs []*RepositoryIfaceMockRemoveDelistedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockRemoveDelistedExpectation specifies expectation struct of the RepositoryIface.RemoveDelisted
+type RepositoryIfaceMockRemoveDelistedExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockRemoveDelistedParams
+	paramPtrs          *RepositoryIfaceMockRemoveDelistedParamPtrs
+	expectationOrigins RepositoryIfaceMockRemoveDelistedExpectationOrigins
+	results            *RepositoryIfaceMockRemoveDelistedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockRemoveDelistedParams contains parameters of the RepositoryIface.RemoveDelisted
+type RepositoryIfaceMockRemoveDelistedParams struct {
+	ctx    context.Context
+	userID uint64
+	skus   []uint64
+}
+
+// RepositoryIfaceMockRemoveDelistedParamPtrs contains pointers to parameters of the RepositoryIface.RemoveDelisted
+type RepositoryIfaceMockRemoveDelistedParamPtrs struct {
+	ctx    *context.Context
+	userID *uint64
+	skus   *[]uint64
+}
+
+// RepositoryIfaceMockRemoveDelistedResults contains results of the RepositoryIface.RemoveDelisted
+type RepositoryIfaceMockRemoveDelistedResults struct {
+	pa1 []postgres.Position
+	u1  uint64
+	err error
+}
+
+// RepositoryIfaceMockRemoveDelistedOrigins contains origins of expectations of the RepositoryIface.RemoveDelisted
+type RepositoryIfaceMockRemoveDelistedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSkus   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) Optional() *mRepositoryIfaceMockRemoveDelisted {
+	mmRemoveDelisted.optional = true
+	return mmRemoveDelisted
+}
+
+// Expect sets up expected params for RepositoryIface.RemoveDelisted
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) Expect(ctx context.Context, userID uint64, skus []uint64) *mRepositoryIfaceMockRemoveDelisted {
+	if mmRemoveDelisted.mock.funcRemoveDelisted != nil {
+		mmRemoveDelisted.mock.t.Fatalf("RepositoryIfaceMock.RemoveDelisted mock is already set by Set")
+	}
+
+	if mmRemoveDelisted.defaultExpectation == nil {
+		mmRemoveDelisted.defaultExpectation = &RepositoryIfaceMockRemoveDelistedExpectation{}
+	}
+
+	if mmRemoveDelisted.defaultExpectation.paramPtrs != nil {
+		mmRemoveDelisted.mock.t.Fatalf("RepositoryIfaceMock.RemoveDelisted mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveDelisted.defaultExpectation.params = &RepositoryIfaceMockRemoveDelistedParams{ctx, userID, skus}
+	mmRemoveDelisted.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveDelisted.expectations {
+		if minimock.Equal(e.params, mmRemoveDelisted.defaultExpectation.params) {
+			mmRemoveDelisted.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveDelisted.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveDelisted
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.RemoveDelisted
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockRemoveDelisted {
+	if mmRemoveDelisted.mock.funcRemoveDelisted != nil {
+		mmRemoveDelisted.mock.t.Fatalf("RepositoryIfaceMock.RemoveDelisted mock is already set by Set")
+	}
+
+	if mmRemoveDelisted.defaultExpectation == nil {
+		mmRemoveDelisted.defaultExpectation = &RepositoryIfaceMockRemoveDelistedExpectation{}
+	}
+
+	if mmRemoveDelisted.defaultExpectation.params != nil {
+		mmRemoveDelisted.mock.t.Fatalf("RepositoryIfaceMock.RemoveDelisted mock is already set by Expect")
+	}
+
+	if mmRemoveDelisted.defaultExpectation.paramPtrs == nil {
+		mmRemoveDelisted.defaultExpectation.paramPtrs = &RepositoryIfaceMockRemoveDelistedParamPtrs{}
+	}
+	mmRemoveDelisted.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveDelisted.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveDelisted
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.RemoveDelisted
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockRemoveDelisted {
+	if mmRemoveDelisted.mock.funcRemoveDelisted != nil {
+		mmRemoveDelisted.mock.t.Fatalf("RepositoryIfaceMock.RemoveDelisted mock is already set by Set")
+	}
+
+	if mmRemoveDelisted.defaultExpectation == nil {
+		mmRemoveDelisted.defaultExpectation = &RepositoryIfaceMockRemoveDelistedExpectation{}
+	}
+
+	if mmRemoveDelisted.defaultExpectation.params != nil {
+		mmRemoveDelisted.mock.t.Fatalf("RepositoryIfaceMock.RemoveDelisted mock is already set by Expect")
+	}
+
+	if mmRemoveDelisted.defaultExpectation.paramPtrs == nil {
+		mmRemoveDelisted.defaultExpectation.paramPtrs = &RepositoryIfaceMockRemoveDelistedParamPtrs{}
+	}
+	mmRemoveDelisted.defaultExpectation.paramPtrs.userID = &userID
+	mmRemoveDelisted.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemoveDelisted
+}
+
+// ExpectSkusParam3 sets up expected param skus for RepositoryIface.RemoveDelisted
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) ExpectSkusParam3(skus []uint64) *mRepositoryIfaceMockRemoveDelisted {
+	if mmRemoveDelisted.mock.funcRemoveDelisted != nil {
+		mmRemoveDelisted.mock.t.Fatalf("RepositoryIfaceMock.RemoveDelisted mock is already set by Set")
+	}
+
+	if mmRemoveDelisted.defaultExpectation == nil {
+		mmRemoveDelisted.defaultExpectation = &RepositoryIfaceMockRemoveDelistedExpectation{}
+	}
+
+	if mmRemoveDelisted.defaultExpectation.params != nil {
+		mmRemoveDelisted.mock.t.Fatalf("RepositoryIfaceMock.RemoveDelisted mock is already set by Expect")
+	}
+
+	if mmRemoveDelisted.defaultExpectation.paramPtrs == nil {
+		mmRemoveDelisted.defaultExpectation.paramPtrs = &RepositoryIfaceMockRemoveDelistedParamPtrs{}
+	}
+	mmRemoveDelisted.defaultExpectation.paramPtrs.skus = &skus
+	mmRemoveDelisted.defaultExpectation.expectationOrigins.originSkus = minimock.CallerInfo(1)
+
+	return mmRemoveDelisted
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.RemoveDelisted
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) Inspect(f func(ctx context.Context, userID uint64, skus []uint64)) *mRepositoryIfaceMockRemoveDelisted {
+	if mmRemoveDelisted.mock.inspectFuncRemoveDelisted != nil {
+		mmRemoveDelisted.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.RemoveDelisted")
+	}
+
+	mmRemoveDelisted.mock.inspectFuncRemoveDelisted = f
+
+	return mmRemoveDelisted
+}
+
+// Return sets up results that will be returned by RepositoryIface.RemoveDelisted
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) Return(pa1 []postgres.Position, u1 uint64, err error) *RepositoryIfaceMock {
+	if mmRemoveDelisted.mock.funcRemoveDelisted != nil {
+		mmRemoveDelisted.mock.t.Fatalf("RepositoryIfaceMock.RemoveDelisted mock is already set by Set")
+	}
+
+	if mmRemoveDelisted.defaultExpectation == nil {
+		mmRemoveDelisted.defaultExpectation = &RepositoryIfaceMockRemoveDelistedExpectation{mock: mmRemoveDelisted.mock}
+	}
+	mmRemoveDelisted.defaultExpectation.results = &RepositoryIfaceMockRemoveDelistedResults{pa1, u1, err}
+	mmRemoveDelisted.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveDelisted.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.RemoveDelisted method
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) Set(f func(ctx context.Context, userID uint64, skus []uint64) (pa1 []postgres.Position, u1 uint64, err error)) *RepositoryIfaceMock {
+	if mmRemoveDelisted.defaultExpectation != nil {
+		mmRemoveDelisted.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.RemoveDelisted method")
+	}
+
+	if len(mmRemoveDelisted.expectations) > 0 {
+		mmRemoveDelisted.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.RemoveDelisted method")
+	}
+
+	mmRemoveDelisted.mock.funcRemoveDelisted = f
+	mmRemoveDelisted.mock.funcRemoveDelistedOrigin = minimock.CallerInfo(1)
+	return mmRemoveDelisted.mock
+}
+
+// When sets expectation for the RepositoryIface.RemoveDelisted which will trigger the result defined by the following
+// Then helper
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) When(ctx context.Context, userID uint64, skus []uint64) *RepositoryIfaceMockRemoveDelistedExpectation {
+	if mmRemoveDelisted.mock.funcRemoveDelisted != nil {
+		mmRemoveDelisted.mock.t.Fatalf("RepositoryIfaceMock.RemoveDelisted mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockRemoveDelistedExpectation{
+		mock:               mmRemoveDelisted.mock,
+		params:             &RepositoryIfaceMockRemoveDelistedParams{ctx, userID, skus},
+		expectationOrigins: RepositoryIfaceMockRemoveDelistedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveDelisted.expectations = append(mmRemoveDelisted.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.RemoveDelisted return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockRemoveDelistedExpectation) Then(pa1 []postgres.Position, u1 uint64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockRemoveDelistedResults{pa1, u1, err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.RemoveDelisted should be invoked
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) Times(n uint64) *mRepositoryIfaceMockRemoveDelisted {
+	if n == 0 {
+		mmRemoveDelisted.mock.t.Fatalf("Times of RepositoryIfaceMock.RemoveDelisted mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveDelisted.expectedInvocations, n)
+	mmRemoveDelisted.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveDelisted
+}
+
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) invocationsDone() bool {
+	if len(mmRemoveDelisted.expectations) == 0 && mmRemoveDelisted.defaultExpectation == nil && mmRemoveDelisted.mock.funcRemoveDelisted == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveDelisted.mock.afterRemoveDelistedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveDelisted.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveDelisted implements mm_interfaces.RepositoryIface
+func (mmRemoveDelisted *RepositoryIfaceMock) RemoveDelisted(ctx context.Context, userID uint64, skus []uint64) (pa1 []postgres.Position, u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmRemoveDelisted.beforeRemoveDelistedCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveDelisted.afterRemoveDelistedCounter, 1)
+
+	mmRemoveDelisted.t.Helper()
+
+	if mmRemoveDelisted.inspectFuncRemoveDelisted != nil {
+		mmRemoveDelisted.inspectFuncRemoveDelisted(ctx, userID, skus)
+	}
+
+	mm_params := RepositoryIfaceMockRemoveDelistedParams{ctx, userID, skus}
+
+	// Record call args
+	mmRemoveDelisted.RemoveDelistedMock.mutex.Lock()
+	mmRemoveDelisted.RemoveDelistedMock.callArgs = append(mmRemoveDelisted.RemoveDelistedMock.callArgs, &mm_params)
+	mmRemoveDelisted.RemoveDelistedMock.mutex.Unlock()
+
+	for _, e := range mmRemoveDelisted.RemoveDelistedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.u1, e.results.err
+		}
+	}
+
+	if mmRemoveDelisted.RemoveDelistedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveDelisted.RemoveDelistedMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveDelisted.RemoveDelistedMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveDelisted.RemoveDelistedMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockRemoveDelistedParams{ctx, userID, skus}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveDelisted.t.Errorf("RepositoryIfaceMock.RemoveDelisted got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveDelisted.RemoveDelistedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemoveDelisted.t.Errorf("RepositoryIfaceMock.RemoveDelisted got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveDelisted.RemoveDelistedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skus != nil && !minimock.Equal(*mm_want_ptrs.skus, mm_got.skus) {
+				mmRemoveDelisted.t.Errorf("RepositoryIfaceMock.RemoveDelisted got unexpected parameter skus, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveDelisted.RemoveDelistedMock.defaultExpectation.expectationOrigins.originSkus, *mm_want_ptrs.skus, mm_got.skus, minimock.Diff(*mm_want_ptrs.skus, mm_got.skus))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveDelisted.t.Errorf("RepositoryIfaceMock.RemoveDelisted got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveDelisted.RemoveDelistedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveDelisted.RemoveDelistedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveDelisted.t.Fatal("No results are set for the RepositoryIfaceMock.RemoveDelisted")
+		}
+		return (*mm_results).pa1, (*mm_results).u1, (*mm_results).err
+	}
+	if mmRemoveDelisted.funcRemoveDelisted != nil {
+		return mmRemoveDelisted.funcRemoveDelisted(ctx, userID, skus)
+	}
+	mmRemoveDelisted.t.Fatalf("Unexpected call to RepositoryIfaceMock.RemoveDelisted. %v %v %v", ctx, userID, skus)
+	return
+}
+
+// RemoveDelistedAfterCounter returns a count of finished RepositoryIfaceMock.RemoveDelisted invocations
+func (mmRemoveDelisted *RepositoryIfaceMock) RemoveDelistedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveDelisted.afterRemoveDelistedCounter)
+}
+
+// RemoveDelistedBeforeCounter returns a count of RepositoryIfaceMock.RemoveDelisted invocations
+func (mmRemoveDelisted *RepositoryIfaceMock) RemoveDelistedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveDelisted.beforeRemoveDelistedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.RemoveDelisted.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveDelisted *mRepositoryIfaceMockRemoveDelisted) Calls() []*RepositoryIfaceMockRemoveDelistedParams {
+	mmRemoveDelisted.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockRemoveDelistedParams, len(mmRemoveDelisted.callArgs))
+	copy(argCopy, mmRemoveDelisted.callArgs)
+
+	mmRemoveDelisted.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveDelistedDone returns true if the count of the RemoveDelisted invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockRemoveDelistedDone() bool {
+	if m.RemoveDelistedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveDelistedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveDelistedMock.invocationsDone()
+}
+
+// MinimockRemoveDelistedInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockRemoveDelistedInspect() {
+	for _, e := range m.RemoveDelistedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.RemoveDelisted at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveDelistedCounter := mm_atomic.LoadUint64(&m.afterRemoveDelistedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveDelistedMock.defaultExpectation != nil && afterRemoveDelistedCounter < 1 {
+		if m.RemoveDelistedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.RemoveDelisted at\n%s", m.RemoveDelistedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.RemoveDelisted at\n%s with params: %#v", m.RemoveDelistedMock.defaultExpectation.expectationOrigins.origin, *m.RemoveDelistedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveDelisted != nil && afterRemoveDelistedCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.RemoveDelisted at\n%s", m.funcRemoveDelistedOrigin)
+	}
+
+	if !m.RemoveDelistedMock.invocationsDone() && afterRemoveDelistedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.RemoveDelisted at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveDelistedMock.expectedInvocations), m.RemoveDelistedMock.expectedInvocationsOrigin, afterRemoveDelistedCounter)
+	}
+}
+
+type mRepositoryIfaceMockRemovePromo struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockRemovePromoExpectation
+	expectations       []*RepositoryIfaceMockRemovePromoExpectation
+
+	callArgs []*RepositoryIfaceMockRemovePromoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockRemovePromoExpectation specifies expectation struct of the RepositoryIface.RemovePromo
+type RepositoryIfaceMockRemovePromoExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockRemovePromoParams
+	paramPtrs          *RepositoryIfaceMockRemovePromoParamPtrs
+	expectationOrigins RepositoryIfaceMockRemovePromoExpectationOrigins
+	results            *RepositoryIfaceMockRemovePromoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockRemovePromoParams contains parameters of the RepositoryIface.RemovePromo
+type RepositoryIfaceMockRemovePromoParams struct {
+	ctx      context.Context
+	userID   uint64
+	expected *uint64
+}
+
+// RepositoryIfaceMockRemovePromoParamPtrs contains pointers to parameters of the RepositoryIface.RemovePromo
+type RepositoryIfaceMockRemovePromoParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	expected **uint64
+}
+
+// RepositoryIfaceMockRemovePromoResults contains results of the RepositoryIface.RemovePromo
+type RepositoryIfaceMockRemovePromoResults struct {
+	err error
+}
+
+// RepositoryIfaceMockRemovePromoOrigins contains origins of expectations of the RepositoryIface.RemovePromo
+type RepositoryIfaceMockRemovePromoExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originExpected string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) Optional() *mRepositoryIfaceMockRemovePromo {
+	mmRemovePromo.optional = true
+	return mmRemovePromo
+}
+
+// Expect sets up expected params for RepositoryIface.RemovePromo
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) Expect(ctx context.Context, userID uint64, expected *uint64) *mRepositoryIfaceMockRemovePromo {
+	if mmRemovePromo.mock.funcRemovePromo != nil {
+		mmRemovePromo.mock.t.Fatalf("RepositoryIfaceMock.RemovePromo mock is already set by Set")
+	}
+
+	if mmRemovePromo.defaultExpectation == nil {
+		mmRemovePromo.defaultExpectation = &RepositoryIfaceMockRemovePromoExpectation{}
+	}
+
+	if mmRemovePromo.defaultExpectation.paramPtrs != nil {
+		mmRemovePromo.mock.t.Fatalf("RepositoryIfaceMock.RemovePromo mock is already set by ExpectParams functions")
+	}
+
+	mmRemovePromo.defaultExpectation.params = &RepositoryIfaceMockRemovePromoParams{ctx, userID, expected}
+	mmRemovePromo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemovePromo.expectations {
+		if minimock.Equal(e.params, mmRemovePromo.defaultExpectation.params) {
+			mmRemovePromo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemovePromo.defaultExpectation.params)
+		}
+	}
+
+	return mmRemovePromo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.RemovePromo
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockRemovePromo {
+	if mmRemovePromo.mock.funcRemovePromo != nil {
+		mmRemovePromo.mock.t.Fatalf("RepositoryIfaceMock.RemovePromo mock is already set by Set")
+	}
+
+	if mmRemovePromo.defaultExpectation == nil {
+		mmRemovePromo.defaultExpectation = &RepositoryIfaceMockRemovePromoExpectation{}
+	}
+
+	if mmRemovePromo.defaultExpectation.params != nil {
+		mmRemovePromo.mock.t.Fatalf("RepositoryIfaceMock.RemovePromo mock is already set by Expect")
+	}
+
+	if mmRemovePromo.defaultExpectation.paramPtrs == nil {
+		mmRemovePromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockRemovePromoParamPtrs{}
+	}
+	mmRemovePromo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemovePromo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemovePromo
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.RemovePromo
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockRemovePromo {
+	if mmRemovePromo.mock.funcRemovePromo != nil {
+		mmRemovePromo.mock.t.Fatalf("RepositoryIfaceMock.RemovePromo mock is already set by Set")
+	}
+
+	if mmRemovePromo.defaultExpectation == nil {
+		mmRemovePromo.defaultExpectation = &RepositoryIfaceMockRemovePromoExpectation{}
+	}
+
+	if mmRemovePromo.defaultExpectation.params != nil {
+		mmRemovePromo.mock.t.Fatalf("RepositoryIfaceMock.RemovePromo mock is already set by Expect")
+	}
+
+	if mmRemovePromo.defaultExpectation.paramPtrs == nil {
+		mmRemovePromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockRemovePromoParamPtrs{}
+	}
+	mmRemovePromo.defaultExpectation.paramPtrs.userID = &userID
+	mmRemovePromo.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemovePromo
+}
+
+// ExpectExpectedParam3 sets up expected param expected for RepositoryIface.RemovePromo
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) ExpectExpectedParam3(expected *uint64) *mRepositoryIfaceMockRemovePromo {
+	if mmRemovePromo.mock.funcRemovePromo != nil {
+		mmRemovePromo.mock.t.Fatalf("RepositoryIfaceMock.RemovePromo mock is already set by Set")
+	}
+
+	if mmRemovePromo.defaultExpectation == nil {
+		mmRemovePromo.defaultExpectation = &RepositoryIfaceMockRemovePromoExpectation{}
+	}
+
+	if mmRemovePromo.defaultExpectation.params != nil {
+		mmRemovePromo.mock.t.Fatalf("RepositoryIfaceMock.RemovePromo mock is already set by Expect")
+	}
+
+	if mmRemovePromo.defaultExpectation.paramPtrs == nil {
+		mmRemovePromo.defaultExpectation.paramPtrs = &RepositoryIfaceMockRemovePromoParamPtrs{}
+	}
+	mmRemovePromo.defaultExpectation.paramPtrs.expected = &expected
+	mmRemovePromo.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmRemovePromo
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.RemovePromo
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) Inspect(f func(ctx context.Context, userID uint64, expected *uint64)) *mRepositoryIfaceMockRemovePromo {
+	if mmRemovePromo.mock.inspectFuncRemovePromo != nil {
+		mmRemovePromo.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.RemovePromo")
+	}
+
+	mmRemovePromo.mock.inspectFuncRemovePromo = f
+
+	return mmRemovePromo
+}
+
+// Return sets up results that will be returned by RepositoryIface.RemovePromo
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) Return(err error) *RepositoryIfaceMock {
+	if mmRemovePromo.mock.funcRemovePromo != nil {
+		mmRemovePromo.mock.t.Fatalf("RepositoryIfaceMock.RemovePromo mock is already set by Set")
+	}
+
+	if mmRemovePromo.defaultExpectation == nil {
+		mmRemovePromo.defaultExpectation = &RepositoryIfaceMockRemovePromoExpectation{mock: mmRemovePromo.mock}
+	}
+	mmRemovePromo.defaultExpectation.results = &RepositoryIfaceMockRemovePromoResults{err}
+	mmRemovePromo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemovePromo.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.RemovePromo method
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) Set(f func(ctx context.Context, userID uint64, expected *uint64) (err error)) *RepositoryIfaceMock {
+	if mmRemovePromo.defaultExpectation != nil {
+		mmRemovePromo.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.RemovePromo method")
+	}
+
+	if len(mmRemovePromo.expectations) > 0 {
+		mmRemovePromo.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.RemovePromo method")
+	}
+
+	mmRemovePromo.mock.funcRemovePromo = f
+	mmRemovePromo.mock.funcRemovePromoOrigin = minimock.CallerInfo(1)
+	return mmRemovePromo.mock
+}
+
+// When sets expectation for the RepositoryIface.RemovePromo which will trigger the result defined by the following
+// Then helper
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) When(ctx context.Context, userID uint64, expected *uint64) *RepositoryIfaceMockRemovePromoExpectation {
+	if mmRemovePromo.mock.funcRemovePromo != nil {
+		mmRemovePromo.mock.t.Fatalf("RepositoryIfaceMock.RemovePromo mock is already set by Set")
+	}
+
+	expectation := &RepositoryIfaceMockRemovePromoExpectation{
+		mock:               mmRemovePromo.mock,
+		params:             &RepositoryIfaceMockRemovePromoParams{ctx, userID, expected},
+		expectationOrigins: RepositoryIfaceMockRemovePromoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemovePromo.expectations = append(mmRemovePromo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RepositoryIface.RemovePromo return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockRemovePromoExpectation) Then(err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockRemovePromoResults{err}
+	return e.mock
+}
+
+// Times sets number of times RepositoryIface.RemovePromo should be invoked
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) Times(n uint64) *mRepositoryIfaceMockRemovePromo {
+	if n == 0 {
+		mmRemovePromo.mock.t.Fatalf("Times of RepositoryIfaceMock.RemovePromo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemovePromo.expectedInvocations, n)
+	mmRemovePromo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemovePromo
+}
+
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) invocationsDone() bool {
+	if len(mmRemovePromo.expectations) == 0 && mmRemovePromo.defaultExpectation == nil && mmRemovePromo.mock.funcRemovePromo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemovePromo.mock.afterRemovePromoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemovePromo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemovePromo implements mm_interfaces.RepositoryIface
+func (mmRemovePromo *RepositoryIfaceMock) RemovePromo(ctx context.Context, userID uint64, expected *uint64) (err error) {
+	mm_atomic.AddUint64(&mmRemovePromo.beforeRemovePromoCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemovePromo.afterRemovePromoCounter, 1)
+
+	mmRemovePromo.t.Helper()
+
+	if mmRemovePromo.inspectFuncRemovePromo != nil {
+		mmRemovePromo.inspectFuncRemovePromo(ctx, userID, expected)
+	}
+
+	mm_params := RepositoryIfaceMockRemovePromoParams{ctx, userID, expected}
+
+	// Record call args
+	mmRemovePromo.RemovePromoMock.mutex.Lock()
+	mmRemovePromo.RemovePromoMock.callArgs = append(mmRemovePromo.RemovePromoMock.callArgs, &mm_params)
+	mmRemovePromo.RemovePromoMock.mutex.Unlock()
+
+	for _, e := range mmRemovePromo.RemovePromoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemovePromo.RemovePromoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemovePromo.RemovePromoMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemovePromo.RemovePromoMock.defaultExpectation.params
+		mm_want_ptrs := mmRemovePromo.RemovePromoMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockRemovePromoParams{ctx, userID, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemovePromo.t.Errorf("RepositoryIfaceMock.RemovePromo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePromo.RemovePromoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemovePromo.t.Errorf("RepositoryIfaceMock.RemovePromo got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePromo.RemovePromoMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmRemovePromo.t.Errorf("RepositoryIfaceMock.RemovePromo got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePromo.RemovePromoMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemovePromo.t.Errorf("RepositoryIfaceMock.RemovePromo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemovePromo.RemovePromoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemovePromo.RemovePromoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemovePromo.t.Fatal("No results are set for the RepositoryIfaceMock.RemovePromo")
+		}
+		return (*mm_results).err
+	}
+	if mmRemovePromo.funcRemovePromo != nil {
+		return mmRemovePromo.funcRemovePromo(ctx, userID, expected)
+	}
+	mmRemovePromo.t.Fatalf("Unexpected call to RepositoryIfaceMock.RemovePromo. %v %v %v", ctx, userID, expected)
+	return
+}
+
+// RemovePromoAfterCounter returns a count of finished RepositoryIfaceMock.RemovePromo invocations
+func (mmRemovePromo *RepositoryIfaceMock) RemovePromoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePromo.afterRemovePromoCounter)
+}
+
+// RemovePromoBeforeCounter returns a count of RepositoryIfaceMock.RemovePromo invocations
+func (mmRemovePromo *RepositoryIfaceMock) RemovePromoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePromo.beforeRemovePromoCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.RemovePromo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemovePromo *mRepositoryIfaceMockRemovePromo) Calls() []*RepositoryIfaceMockRemovePromoParams {
+	mmRemovePromo.mutex.RLock()
+
+	argCopy := make([]*RepositoryIfaceMockRemovePromoParams, len(mmRemovePromo.callArgs))
+	copy(argCopy, mmRemovePromo.callArgs)
+
+	mmRemovePromo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemovePromoDone returns true if the count of the RemovePromo invocations corresponds
+// the number of defined expectations
+func (m *RepositoryIfaceMock) MinimockRemovePromoDone() bool {
+	if m.RemovePromoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemovePromoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemovePromoMock.invocationsDone()
+}
+
+// MinimockRemovePromoInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockRemovePromoInspect() {
+	for _, e := range m.RemovePromoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.RemovePromo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemovePromoCounter := mm_atomic.LoadUint64(&m.afterRemovePromoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemovePromoMock.defaultExpectation != nil && afterRemovePromoCounter < 1 {
+		if m.RemovePromoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.RemovePromo at\n%s", m.RemovePromoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.RemovePromo at\n%s with params: %#v", m.RemovePromoMock.defaultExpectation.expectationOrigins.origin, *m.RemovePromoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemovePromo != nil && afterRemovePromoCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.RemovePromo at\n%s", m.funcRemovePromoOrigin)
+	}
+
+	if !m.RemovePromoMock.invocationsDone() && afterRemovePromoCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.RemovePromo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemovePromoMock.expectedInvocations), m.RemovePromoMock.expectedInvocationsOrigin, afterRemovePromoCounter)
+	}
+}
+
+type mRepositoryIfaceMockSaveIdempotentResponse struct {
+	optional           bool
+	mock               *RepositoryIfaceMock
+	defaultExpectation *RepositoryIfaceMockSaveIdempotentResponseExpectation
+	expectations       []*RepositoryIfaceMockSaveIdempotentResponseExpectation
+
+	callArgs []*RepositoryIfaceMockSaveIdempotentResponseParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryIfaceMockSaveIdempotentResponseExpectation specifies expectation struct of the RepositoryIface.SaveIdempotentResponse
+type RepositoryIfaceMockSaveIdempotentResponseExpectation struct {
+	mock               *RepositoryIfaceMock
+	params             *RepositoryIfaceMockSaveIdempotentResponseParams
+	paramPtrs          *RepositoryIfaceMockSaveIdempotentResponseParamPtrs
+	expectationOrigins RepositoryIfaceMockSaveIdempotentResponseExpectationOrigins
+	results            *RepositoryIfaceMockSaveIdempotentResponseResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryIfaceMockSaveIdempotentResponseParams contains parameters of the RepositoryIface.SaveIdempotentResponse
+type RepositoryIfaceMockSaveIdempotentResponseParams struct {
+	ctx      context.Context
+	userID   uint64
+	key      string
+	response []byte
+	ttl      time.Duration
 }
 
-// RepositoryIfaceMockClearCartParamPtrs contains pointers to parameters of the RepositoryIface.ClearCart
-type RepositoryIfaceMockClearCartParamPtrs struct {
-	ctx    *context.Context
-	userID *uint64
+// RepositoryIfaceMockSaveIdempotentResponseParamPtrs contains pointers to parameters of the RepositoryIface.SaveIdempotentResponse
+type RepositoryIfaceMockSaveIdempotentResponseParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	key      *string
+	response *[]byte
+	ttl      *time.Duration
 }
 
-// RepositoryIfaceMockClearCartResults contains results of the RepositoryIface.ClearCart
-type RepositoryIfaceMockClearCartResults struct {
+// RepositoryIfaceMockSaveIdempotentResponseResults contains results of the RepositoryIface.SaveIdempotentResponse
+type RepositoryIfaceMockSaveIdempotentResponseResults struct {
 	err error
 }
 
-// RepositoryIfaceMockClearCartOrigins contains origins of expectations of the RepositoryIface.ClearCart
-type RepositoryIfaceMockClearCartExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// RepositoryIfaceMockSaveIdempotentResponseOrigins contains origins of expectations of the RepositoryIface.SaveIdempotentResponse
+type RepositoryIfaceMockSaveIdempotentResponseExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originKey      string
+	originResponse string
+	originTtl      string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -530,344 +13010,438 @@ type RepositoryIfaceMockClearCartExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmClearCart *mRepositoryIfaceMockClearCart) Optional() *mRepositoryIfaceMockClearCart {
-	mmClearCart.optional = true
-	return mmClearCart
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) Optional() *mRepositoryIfaceMockSaveIdempotentResponse {
+	mmSaveIdempotentResponse.optional = true
+	return mmSaveIdempotentResponse
 }
 
-// Expect sets up expected params for RepositoryIface.ClearCart
-func (mmClearCart *mRepositoryIfaceMockClearCart) Expect(ctx context.Context, userID uint64) *mRepositoryIfaceMockClearCart {
-	if mmClearCart.mock.funcClearCart != nil {
-		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+// Expect sets up expected params for RepositoryIface.SaveIdempotentResponse
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) Expect(ctx context.Context, userID uint64, key string, response []byte, ttl time.Duration) *mRepositoryIfaceMockSaveIdempotentResponse {
+	if mmSaveIdempotentResponse.mock.funcSaveIdempotentResponse != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Set")
 	}
 
-	if mmClearCart.defaultExpectation == nil {
-		mmClearCart.defaultExpectation = &RepositoryIfaceMockClearCartExpectation{}
+	if mmSaveIdempotentResponse.defaultExpectation == nil {
+		mmSaveIdempotentResponse.defaultExpectation = &RepositoryIfaceMockSaveIdempotentResponseExpectation{}
 	}
 
-	if mmClearCart.defaultExpectation.paramPtrs != nil {
-		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by ExpectParams functions")
+	if mmSaveIdempotentResponse.defaultExpectation.paramPtrs != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by ExpectParams functions")
 	}
 
-	mmClearCart.defaultExpectation.params = &RepositoryIfaceMockClearCartParams{ctx, userID}
-	mmClearCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmClearCart.expectations {
-		if minimock.Equal(e.params, mmClearCart.defaultExpectation.params) {
-			mmClearCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClearCart.defaultExpectation.params)
+	mmSaveIdempotentResponse.defaultExpectation.params = &RepositoryIfaceMockSaveIdempotentResponseParams{ctx, userID, key, response, ttl}
+	mmSaveIdempotentResponse.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveIdempotentResponse.expectations {
+		if minimock.Equal(e.params, mmSaveIdempotentResponse.defaultExpectation.params) {
+			mmSaveIdempotentResponse.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveIdempotentResponse.defaultExpectation.params)
 		}
 	}
 
-	return mmClearCart
+	return mmSaveIdempotentResponse
 }
 
-// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.ClearCart
-func (mmClearCart *mRepositoryIfaceMockClearCart) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockClearCart {
-	if mmClearCart.mock.funcClearCart != nil {
-		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.SaveIdempotentResponse
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockSaveIdempotentResponse {
+	if mmSaveIdempotentResponse.mock.funcSaveIdempotentResponse != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Set")
 	}
 
-	if mmClearCart.defaultExpectation == nil {
-		mmClearCart.defaultExpectation = &RepositoryIfaceMockClearCartExpectation{}
+	if mmSaveIdempotentResponse.defaultExpectation == nil {
+		mmSaveIdempotentResponse.defaultExpectation = &RepositoryIfaceMockSaveIdempotentResponseExpectation{}
 	}
 
-	if mmClearCart.defaultExpectation.params != nil {
-		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Expect")
+	if mmSaveIdempotentResponse.defaultExpectation.params != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Expect")
 	}
 
-	if mmClearCart.defaultExpectation.paramPtrs == nil {
-		mmClearCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockClearCartParamPtrs{}
+	if mmSaveIdempotentResponse.defaultExpectation.paramPtrs == nil {
+		mmSaveIdempotentResponse.defaultExpectation.paramPtrs = &RepositoryIfaceMockSaveIdempotentResponseParamPtrs{}
 	}
-	mmClearCart.defaultExpectation.paramPtrs.ctx = &ctx
-	mmClearCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSaveIdempotentResponse.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveIdempotentResponse.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmClearCart
+	return mmSaveIdempotentResponse
 }
 
-// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.ClearCart
-func (mmClearCart *mRepositoryIfaceMockClearCart) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockClearCart {
-	if mmClearCart.mock.funcClearCart != nil {
-		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.SaveIdempotentResponse
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockSaveIdempotentResponse {
+	if mmSaveIdempotentResponse.mock.funcSaveIdempotentResponse != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Set")
 	}
 
-	if mmClearCart.defaultExpectation == nil {
-		mmClearCart.defaultExpectation = &RepositoryIfaceMockClearCartExpectation{}
+	if mmSaveIdempotentResponse.defaultExpectation == nil {
+		mmSaveIdempotentResponse.defaultExpectation = &RepositoryIfaceMockSaveIdempotentResponseExpectation{}
 	}
 
-	if mmClearCart.defaultExpectation.params != nil {
-		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Expect")
+	if mmSaveIdempotentResponse.defaultExpectation.params != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Expect")
 	}
 
-	if mmClearCart.defaultExpectation.paramPtrs == nil {
-		mmClearCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockClearCartParamPtrs{}
+	if mmSaveIdempotentResponse.defaultExpectation.paramPtrs == nil {
+		mmSaveIdempotentResponse.defaultExpectation.paramPtrs = &RepositoryIfaceMockSaveIdempotentResponseParamPtrs{}
 	}
-	mmClearCart.defaultExpectation.paramPtrs.userID = &userID
-	mmClearCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmSaveIdempotentResponse.defaultExpectation.paramPtrs.userID = &userID
+	mmSaveIdempotentResponse.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmClearCart
+	return mmSaveIdempotentResponse
 }
 
-// Inspect accepts an inspector function that has same arguments as the RepositoryIface.ClearCart
-func (mmClearCart *mRepositoryIfaceMockClearCart) Inspect(f func(ctx context.Context, userID uint64)) *mRepositoryIfaceMockClearCart {
-	if mmClearCart.mock.inspectFuncClearCart != nil {
-		mmClearCart.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.ClearCart")
+// ExpectKeyParam3 sets up expected param key for RepositoryIface.SaveIdempotentResponse
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) ExpectKeyParam3(key string) *mRepositoryIfaceMockSaveIdempotentResponse {
+	if mmSaveIdempotentResponse.mock.funcSaveIdempotentResponse != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Set")
 	}
 
-	mmClearCart.mock.inspectFuncClearCart = f
+	if mmSaveIdempotentResponse.defaultExpectation == nil {
+		mmSaveIdempotentResponse.defaultExpectation = &RepositoryIfaceMockSaveIdempotentResponseExpectation{}
+	}
 
-	return mmClearCart
+	if mmSaveIdempotentResponse.defaultExpectation.params != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Expect")
+	}
+
+	if mmSaveIdempotentResponse.defaultExpectation.paramPtrs == nil {
+		mmSaveIdempotentResponse.defaultExpectation.paramPtrs = &RepositoryIfaceMockSaveIdempotentResponseParamPtrs{}
+	}
+	mmSaveIdempotentResponse.defaultExpectation.paramPtrs.key = &key
+	mmSaveIdempotentResponse.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmSaveIdempotentResponse
+}
+
+// ExpectResponseParam4 sets up expected param response for RepositoryIface.SaveIdempotentResponse
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) ExpectResponseParam4(response []byte) *mRepositoryIfaceMockSaveIdempotentResponse {
+	if mmSaveIdempotentResponse.mock.funcSaveIdempotentResponse != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Set")
+	}
+
+	if mmSaveIdempotentResponse.defaultExpectation == nil {
+		mmSaveIdempotentResponse.defaultExpectation = &RepositoryIfaceMockSaveIdempotentResponseExpectation{}
+	}
+
+	if mmSaveIdempotentResponse.defaultExpectation.params != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Expect")
+	}
+
+	if mmSaveIdempotentResponse.defaultExpectation.paramPtrs == nil {
+		mmSaveIdempotentResponse.defaultExpectation.paramPtrs = &RepositoryIfaceMockSaveIdempotentResponseParamPtrs{}
+	}
+	mmSaveIdempotentResponse.defaultExpectation.paramPtrs.response = &response
+	mmSaveIdempotentResponse.defaultExpectation.expectationOrigins.originResponse = minimock.CallerInfo(1)
+
+	return mmSaveIdempotentResponse
+}
+
+// ExpectTtlParam5 sets up expected param ttl for RepositoryIface.SaveIdempotentResponse
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) ExpectTtlParam5(ttl time.Duration) *mRepositoryIfaceMockSaveIdempotentResponse {
+	if mmSaveIdempotentResponse.mock.funcSaveIdempotentResponse != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Set")
+	}
+
+	if mmSaveIdempotentResponse.defaultExpectation == nil {
+		mmSaveIdempotentResponse.defaultExpectation = &RepositoryIfaceMockSaveIdempotentResponseExpectation{}
+	}
+
+	if mmSaveIdempotentResponse.defaultExpectation.params != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Expect")
+	}
+
+	if mmSaveIdempotentResponse.defaultExpectation.paramPtrs == nil {
+		mmSaveIdempotentResponse.defaultExpectation.paramPtrs = &RepositoryIfaceMockSaveIdempotentResponseParamPtrs{}
+	}
+	mmSaveIdempotentResponse.defaultExpectation.paramPtrs.ttl = &ttl
+	mmSaveIdempotentResponse.defaultExpectation.expectationOrigins.originTtl = minimock.CallerInfo(1)
+
+	return mmSaveIdempotentResponse
+}
+
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.SaveIdempotentResponse
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) Inspect(f func(ctx context.Context, userID uint64, key string, response []byte, ttl time.Duration)) *mRepositoryIfaceMockSaveIdempotentResponse {
+	if mmSaveIdempotentResponse.mock.inspectFuncSaveIdempotentResponse != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.SaveIdempotentResponse")
+	}
+
+	mmSaveIdempotentResponse.mock.inspectFuncSaveIdempotentResponse = f
+
+	return mmSaveIdempotentResponse
 }
 
-// Return sets up results that will be returned by RepositoryIface.ClearCart
-func (mmClearCart *mRepositoryIfaceMockClearCart) Return(err error) *RepositoryIfaceMock {
-	if mmClearCart.mock.funcClearCart != nil {
-		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+// Return sets up results that will be returned by RepositoryIface.SaveIdempotentResponse
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) Return(err error) *RepositoryIfaceMock {
+	if mmSaveIdempotentResponse.mock.funcSaveIdempotentResponse != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Set")
 	}
 
-	if mmClearCart.defaultExpectation == nil {
-		mmClearCart.defaultExpectation = &RepositoryIfaceMockClearCartExpectation{mock: mmClearCart.mock}
+	if mmSaveIdempotentResponse.defaultExpectation == nil {
+		mmSaveIdempotentResponse.defaultExpectation = &RepositoryIfaceMockSaveIdempotentResponseExpectation{mock: mmSaveIdempotentResponse.mock}
 	}
-	mmClearCart.defaultExpectation.results = &RepositoryIfaceMockClearCartResults{err}
-	mmClearCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmClearCart.mock
+	mmSaveIdempotentResponse.defaultExpectation.results = &RepositoryIfaceMockSaveIdempotentResponseResults{err}
+	mmSaveIdempotentResponse.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveIdempotentResponse.mock
 }
 
-// Set uses given function f to mock the RepositoryIface.ClearCart method
-func (mmClearCart *mRepositoryIfaceMockClearCart) Set(f func(ctx context.Context, userID uint64) (err error)) *RepositoryIfaceMock {
-	if mmClearCart.defaultExpectation != nil {
-		mmClearCart.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.ClearCart method")
+// Set uses given function f to mock the RepositoryIface.SaveIdempotentResponse method
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) Set(f func(ctx context.Context, userID uint64, key string, response []byte, ttl time.Duration) (err error)) *RepositoryIfaceMock {
+	if mmSaveIdempotentResponse.defaultExpectation != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.SaveIdempotentResponse method")
 	}
 
-	if len(mmClearCart.expectations) > 0 {
-		mmClearCart.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.ClearCart method")
+	if len(mmSaveIdempotentResponse.expectations) > 0 {
+		mmSaveIdempotentResponse.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.SaveIdempotentResponse method")
 	}
 
-	mmClearCart.mock.funcClearCart = f
-	mmClearCart.mock.funcClearCartOrigin = minimock.CallerInfo(1)
-	return mmClearCart.mock
+	mmSaveIdempotentResponse.mock.funcSaveIdempotentResponse = f
+	mmSaveIdempotentResponse.mock.funcSaveIdempotentResponseOrigin = minimock.CallerInfo(1)
+	return mmSaveIdempotentResponse.mock
 }
 
-// When sets expectation for the RepositoryIface.ClearCart which will trigger the result defined by the following
+// When sets expectation for the RepositoryIface.SaveIdempotentResponse which will trigger the result defined by the following
 // Then helper
-func (mmClearCart *mRepositoryIfaceMockClearCart) When(ctx context.Context, userID uint64) *RepositoryIfaceMockClearCartExpectation {
-	if mmClearCart.mock.funcClearCart != nil {
-		mmClearCart.mock.t.Fatalf("RepositoryIfaceMock.ClearCart mock is already set by Set")
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) When(ctx context.Context, userID uint64, key string, response []byte, ttl time.Duration) *RepositoryIfaceMockSaveIdempotentResponseExpectation {
+	if mmSaveIdempotentResponse.mock.funcSaveIdempotentResponse != nil {
+		mmSaveIdempotentResponse.mock.t.Fatalf("RepositoryIfaceMock.SaveIdempotentResponse mock is already set by Set")
 	}
 
-	expectation := &RepositoryIfaceMockClearCartExpectation{
-		mock:               mmClearCart.mock,
-		params:             &RepositoryIfaceMockClearCartParams{ctx, userID},
-		expectationOrigins: RepositoryIfaceMockClearCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryIfaceMockSaveIdempotentResponseExpectation{
+		mock:               mmSaveIdempotentResponse.mock,
+		params:             &RepositoryIfaceMockSaveIdempotentResponseParams{ctx, userID, key, response, ttl},
+		expectationOrigins: RepositoryIfaceMockSaveIdempotentResponseExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmClearCart.expectations = append(mmClearCart.expectations, expectation)
+	mmSaveIdempotentResponse.expectations = append(mmSaveIdempotentResponse.expectations, expectation)
 	return expectation
 }
 
-// Then sets up RepositoryIface.ClearCart return parameters for the expectation previously defined by the When method
-func (e *RepositoryIfaceMockClearCartExpectation) Then(err error) *RepositoryIfaceMock {
-	e.results = &RepositoryIfaceMockClearCartResults{err}
+// Then sets up RepositoryIface.SaveIdempotentResponse return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockSaveIdempotentResponseExpectation) Then(err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockSaveIdempotentResponseResults{err}
 	return e.mock
 }
 
-// Times sets number of times RepositoryIface.ClearCart should be invoked
-func (mmClearCart *mRepositoryIfaceMockClearCart) Times(n uint64) *mRepositoryIfaceMockClearCart {
+// Times sets number of times RepositoryIface.SaveIdempotentResponse should be invoked
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) Times(n uint64) *mRepositoryIfaceMockSaveIdempotentResponse {
 	if n == 0 {
-		mmClearCart.mock.t.Fatalf("Times of RepositoryIfaceMock.ClearCart mock can not be zero")
+		mmSaveIdempotentResponse.mock.t.Fatalf("Times of RepositoryIfaceMock.SaveIdempotentResponse mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmClearCart.expectedInvocations, n)
-	mmClearCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmClearCart
+	mm_atomic.StoreUint64(&mmSaveIdempotentResponse.expectedInvocations, n)
+	mmSaveIdempotentResponse.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveIdempotentResponse
 }
 
-func (mmClearCart *mRepositoryIfaceMockClearCart) invocationsDone() bool {
-	if len(mmClearCart.expectations) == 0 && mmClearCart.defaultExpectation == nil && mmClearCart.mock.funcClearCart == nil {
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) invocationsDone() bool {
+	if len(mmSaveIdempotentResponse.expectations) == 0 && mmSaveIdempotentResponse.defaultExpectation == nil && mmSaveIdempotentResponse.mock.funcSaveIdempotentResponse == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmClearCart.mock.afterClearCartCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmClearCart.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveIdempotentResponse.mock.afterSaveIdempotentResponseCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveIdempotentResponse.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ClearCart implements mm_interfaces.RepositoryIface
-func (mmClearCart *RepositoryIfaceMock) ClearCart(ctx context.Context, userID uint64) (err error) {
-	mm_atomic.AddUint64(&mmClearCart.beforeClearCartCounter, 1)
-	defer mm_atomic.AddUint64(&mmClearCart.afterClearCartCounter, 1)
+// SaveIdempotentResponse implements mm_interfaces.RepositoryIface
+func (mmSaveIdempotentResponse *RepositoryIfaceMock) SaveIdempotentResponse(ctx context.Context, userID uint64, key string, response []byte, ttl time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmSaveIdempotentResponse.beforeSaveIdempotentResponseCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveIdempotentResponse.afterSaveIdempotentResponseCounter, 1)
 
-	mmClearCart.t.Helper()
+	mmSaveIdempotentResponse.t.Helper()
 
-	if mmClearCart.inspectFuncClearCart != nil {
-		mmClearCart.inspectFuncClearCart(ctx, userID)
+	if mmSaveIdempotentResponse.inspectFuncSaveIdempotentResponse != nil {
+		mmSaveIdempotentResponse.inspectFuncSaveIdempotentResponse(ctx, userID, key, response, ttl)
 	}
 
-	mm_params := RepositoryIfaceMockClearCartParams{ctx, userID}
+	mm_params := RepositoryIfaceMockSaveIdempotentResponseParams{ctx, userID, key, response, ttl}
 
 	// Record call args
-	mmClearCart.ClearCartMock.mutex.Lock()
-	mmClearCart.ClearCartMock.callArgs = append(mmClearCart.ClearCartMock.callArgs, &mm_params)
-	mmClearCart.ClearCartMock.mutex.Unlock()
+	mmSaveIdempotentResponse.SaveIdempotentResponseMock.mutex.Lock()
+	mmSaveIdempotentResponse.SaveIdempotentResponseMock.callArgs = append(mmSaveIdempotentResponse.SaveIdempotentResponseMock.callArgs, &mm_params)
+	mmSaveIdempotentResponse.SaveIdempotentResponseMock.mutex.Unlock()
 
-	for _, e := range mmClearCart.ClearCartMock.expectations {
+	for _, e := range mmSaveIdempotentResponse.SaveIdempotentResponseMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmClearCart.ClearCartMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmClearCart.ClearCartMock.defaultExpectation.Counter, 1)
-		mm_want := mmClearCart.ClearCartMock.defaultExpectation.params
-		mm_want_ptrs := mmClearCart.ClearCartMock.defaultExpectation.paramPtrs
+	if mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryIfaceMockClearCartParams{ctx, userID}
+		mm_got := RepositoryIfaceMockSaveIdempotentResponseParams{ctx, userID, key, response, ttl}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmClearCart.t.Errorf("RepositoryIfaceMock.ClearCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSaveIdempotentResponse.t.Errorf("RepositoryIfaceMock.SaveIdempotentResponse got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmClearCart.t.Errorf("RepositoryIfaceMock.ClearCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmSaveIdempotentResponse.t.Errorf("RepositoryIfaceMock.SaveIdempotentResponse got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmSaveIdempotentResponse.t.Errorf("RepositoryIfaceMock.SaveIdempotentResponse got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.response != nil && !minimock.Equal(*mm_want_ptrs.response, mm_got.response) {
+				mmSaveIdempotentResponse.t.Errorf("RepositoryIfaceMock.SaveIdempotentResponse got unexpected parameter response, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation.expectationOrigins.originResponse, *mm_want_ptrs.response, mm_got.response, minimock.Diff(*mm_want_ptrs.response, mm_got.response))
+			}
+
+			if mm_want_ptrs.ttl != nil && !minimock.Equal(*mm_want_ptrs.ttl, mm_got.ttl) {
+				mmSaveIdempotentResponse.t.Errorf("RepositoryIfaceMock.SaveIdempotentResponse got unexpected parameter ttl, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation.expectationOrigins.originTtl, *mm_want_ptrs.ttl, mm_got.ttl, minimock.Diff(*mm_want_ptrs.ttl, mm_got.ttl))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmClearCart.t.Errorf("RepositoryIfaceMock.ClearCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmClearCart.ClearCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSaveIdempotentResponse.t.Errorf("RepositoryIfaceMock.SaveIdempotentResponse got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmClearCart.ClearCartMock.defaultExpectation.results
+		mm_results := mmSaveIdempotentResponse.SaveIdempotentResponseMock.defaultExpectation.results
 		if mm_results == nil {
-			mmClearCart.t.Fatal("No results are set for the RepositoryIfaceMock.ClearCart")
+			mmSaveIdempotentResponse.t.Fatal("No results are set for the RepositoryIfaceMock.SaveIdempotentResponse")
 		}
 		return (*mm_results).err
 	}
-	if mmClearCart.funcClearCart != nil {
-		return mmClearCart.funcClearCart(ctx, userID)
+	if mmSaveIdempotentResponse.funcSaveIdempotentResponse != nil {
+		return mmSaveIdempotentResponse.funcSaveIdempotentResponse(ctx, userID, key, response, ttl)
 	}
-	mmClearCart.t.Fatalf("Unexpected call to RepositoryIfaceMock.ClearCart. %v %v", ctx, userID)
+	mmSaveIdempotentResponse.t.Fatalf("Unexpected call to RepositoryIfaceMock.SaveIdempotentResponse. %v %v %v %v %v", ctx, userID, key, response, ttl)
 	return
 }
 
-// ClearCartAfterCounter returns a count of finished RepositoryIfaceMock.ClearCart invocations
-func (mmClearCart *RepositoryIfaceMock) ClearCartAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmClearCart.afterClearCartCounter)
+// SaveIdempotentResponseAfterCounter returns a count of finished RepositoryIfaceMock.SaveIdempotentResponse invocations
+func (mmSaveIdempotentResponse *RepositoryIfaceMock) SaveIdempotentResponseAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveIdempotentResponse.afterSaveIdempotentResponseCounter)
 }
 
-// ClearCartBeforeCounter returns a count of RepositoryIfaceMock.ClearCart invocations
-func (mmClearCart *RepositoryIfaceMock) ClearCartBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmClearCart.beforeClearCartCounter)
+// SaveIdempotentResponseBeforeCounter returns a count of RepositoryIfaceMock.SaveIdempotentResponse invocations
+func (mmSaveIdempotentResponse *RepositoryIfaceMock) SaveIdempotentResponseBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveIdempotentResponse.beforeSaveIdempotentResponseCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryIfaceMock.ClearCart.
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.SaveIdempotentResponse.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmClearCart *mRepositoryIfaceMockClearCart) Calls() []*RepositoryIfaceMockClearCartParams {
-	mmClearCart.mutex.RLock()
+func (mmSaveIdempotentResponse *mRepositoryIfaceMockSaveIdempotentResponse) Calls() []*RepositoryIfaceMockSaveIdempotentResponseParams {
+	mmSaveIdempotentResponse.mutex.RLock()
 
-	argCopy := make([]*RepositoryIfaceMockClearCartParams, len(mmClearCart.callArgs))
-	copy(argCopy, mmClearCart.callArgs)
+	argCopy := make([]*RepositoryIfaceMockSaveIdempotentResponseParams, len(mmSaveIdempotentResponse.callArgs))
+	copy(argCopy, mmSaveIdempotentResponse.callArgs)
 
-	mmClearCart.mutex.RUnlock()
+	mmSaveIdempotentResponse.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockClearCartDone returns true if the count of the ClearCart invocations corresponds
+// MinimockSaveIdempotentResponseDone returns true if the count of the SaveIdempotentResponse invocations corresponds
 // the number of defined expectations
-func (m *RepositoryIfaceMock) MinimockClearCartDone() bool {
-	if m.ClearCartMock.optional {
+func (m *RepositoryIfaceMock) MinimockSaveIdempotentResponseDone() bool {
+	if m.SaveIdempotentResponseMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ClearCartMock.expectations {
+	for _, e := range m.SaveIdempotentResponseMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ClearCartMock.invocationsDone()
+	return m.SaveIdempotentResponseMock.invocationsDone()
 }
 
-// MinimockClearCartInspect logs each unmet expectation
-func (m *RepositoryIfaceMock) MinimockClearCartInspect() {
-	for _, e := range m.ClearCartMock.expectations {
+// MinimockSaveIdempotentResponseInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockSaveIdempotentResponseInspect() {
+	for _, e := range m.SaveIdempotentResponseMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.ClearCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryIfaceMock.SaveIdempotentResponse at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterClearCartCounter := mm_atomic.LoadUint64(&m.afterClearCartCounter)
+	afterSaveIdempotentResponseCounter := mm_atomic.LoadUint64(&m.afterSaveIdempotentResponseCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ClearCartMock.defaultExpectation != nil && afterClearCartCounter < 1 {
-		if m.ClearCartMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.ClearCart at\n%s", m.ClearCartMock.defaultExpectation.returnOrigin)
+	if m.SaveIdempotentResponseMock.defaultExpectation != nil && afterSaveIdempotentResponseCounter < 1 {
+		if m.SaveIdempotentResponseMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.SaveIdempotentResponse at\n%s", m.SaveIdempotentResponseMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.ClearCart at\n%s with params: %#v", m.ClearCartMock.defaultExpectation.expectationOrigins.origin, *m.ClearCartMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryIfaceMock.SaveIdempotentResponse at\n%s with params: %#v", m.SaveIdempotentResponseMock.defaultExpectation.expectationOrigins.origin, *m.SaveIdempotentResponseMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcClearCart != nil && afterClearCartCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryIfaceMock.ClearCart at\n%s", m.funcClearCartOrigin)
+	if m.funcSaveIdempotentResponse != nil && afterSaveIdempotentResponseCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.SaveIdempotentResponse at\n%s", m.funcSaveIdempotentResponseOrigin)
 	}
 
-	if !m.ClearCartMock.invocationsDone() && afterClearCartCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.ClearCart at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ClearCartMock.expectedInvocations), m.ClearCartMock.expectedInvocationsOrigin, afterClearCartCounter)
+	if !m.SaveIdempotentResponseMock.invocationsDone() && afterSaveIdempotentResponseCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.SaveIdempotentResponse at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveIdempotentResponseMock.expectedInvocations), m.SaveIdempotentResponseMock.expectedInvocationsOrigin, afterSaveIdempotentResponseCounter)
 	}
 }
 
-type mRepositoryIfaceMockDeleteItem struct {
+type mRepositoryIfaceMockUpdateItemCount struct {
 	optional           bool
 	mock               *RepositoryIfaceMock
-	defaultExpectation *RepositoryIfaceMockDeleteItemExpectation
-	expectations       []*RepositoryIfaceMockDeleteItemExpectation
+	defaultExpectation *RepositoryIfaceMockUpdateItemCountExpectation
+	expectations       []*RepositoryIfaceMockUpdateItemCountExpectation
 
-	callArgs []*RepositoryIfaceMockDeleteItemParams
+	callArgs []*RepositoryIfaceMockUpdateItemCountParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryIfaceMockDeleteItemExpectation specifies expectation struct of the RepositoryIface.DeleteItem
-type RepositoryIfaceMockDeleteItemExpectation struct {
+// RepositoryIfaceMockUpdateItemCountExpectation specifies expectation struct of the RepositoryIface.UpdateItemCount
+type RepositoryIfaceMockUpdateItemCountExpectation struct {
 	mock               *RepositoryIfaceMock
-	params             *RepositoryIfaceMockDeleteItemParams
-	paramPtrs          *RepositoryIfaceMockDeleteItemParamPtrs
-	expectationOrigins RepositoryIfaceMockDeleteItemExpectationOrigins
-	results            *RepositoryIfaceMockDeleteItemResults
+	params             *RepositoryIfaceMockUpdateItemCountParams
+	paramPtrs          *RepositoryIfaceMockUpdateItemCountParamPtrs
+	expectationOrigins RepositoryIfaceMockUpdateItemCountExpectationOrigins
+	results            *RepositoryIfaceMockUpdateItemCountResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryIfaceMockDeleteItemParams contains parameters of the RepositoryIface.DeleteItem
-type RepositoryIfaceMockDeleteItemParams struct {
-	ctx    context.Context
-	userID uint64
-	skuID  uint64
+// RepositoryIfaceMockUpdateItemCountParams contains parameters of the RepositoryIface.UpdateItemCount
+type RepositoryIfaceMockUpdateItemCountParams struct {
+	ctx      context.Context
+	userID   uint64
+	skuID    uint64
+	count    uint64
+	price    uint64
+	expected *uint64
 }
 
-// RepositoryIfaceMockDeleteItemParamPtrs contains pointers to parameters of the RepositoryIface.DeleteItem
-type RepositoryIfaceMockDeleteItemParamPtrs struct {
-	ctx    *context.Context
-	userID *uint64
-	skuID  *uint64
+// RepositoryIfaceMockUpdateItemCountParamPtrs contains pointers to parameters of the RepositoryIface.UpdateItemCount
+type RepositoryIfaceMockUpdateItemCountParamPtrs struct {
+	ctx      *context.Context
+	userID   *uint64
+	skuID    *uint64
+	count    *uint64
+	price    *uint64
+	expected **uint64
 }
 
-// RepositoryIfaceMockDeleteItemResults contains results of the RepositoryIface.DeleteItem
-type RepositoryIfaceMockDeleteItemResults struct {
+// RepositoryIfaceMockUpdateItemCountResults contains results of the RepositoryIface.UpdateItemCount
+type RepositoryIfaceMockUpdateItemCountResults struct {
+	u1  uint64
 	err error
 }
 
-// RepositoryIfaceMockDeleteItemOrigins contains origins of expectations of the RepositoryIface.DeleteItem
-type RepositoryIfaceMockDeleteItemExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originSkuID  string
+// RepositoryIfaceMockUpdateItemCountOrigins contains origins of expectations of the RepositoryIface.UpdateItemCount
+type RepositoryIfaceMockUpdateItemCountExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originCount    string
+	originPrice    string
+	originExpected string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -875,370 +13449,454 @@ type RepositoryIfaceMockDeleteItemExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Optional() *mRepositoryIfaceMockDeleteItem {
-	mmDeleteItem.optional = true
-	return mmDeleteItem
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) Optional() *mRepositoryIfaceMockUpdateItemCount {
+	mmUpdateItemCount.optional = true
+	return mmUpdateItemCount
 }
 
-// Expect sets up expected params for RepositoryIface.DeleteItem
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Expect(ctx context.Context, userID uint64, skuID uint64) *mRepositoryIfaceMockDeleteItem {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+// Expect sets up expected params for RepositoryIface.UpdateItemCount
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) Expect(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) *mRepositoryIfaceMockUpdateItemCount {
+	if mmUpdateItemCount.mock.funcUpdateItemCount != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{}
+	if mmUpdateItemCount.defaultExpectation == nil {
+		mmUpdateItemCount.defaultExpectation = &RepositoryIfaceMockUpdateItemCountExpectation{}
 	}
 
-	if mmDeleteItem.defaultExpectation.paramPtrs != nil {
-		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by ExpectParams functions")
+	if mmUpdateItemCount.defaultExpectation.paramPtrs != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteItem.defaultExpectation.params = &RepositoryIfaceMockDeleteItemParams{ctx, userID, skuID}
-	mmDeleteItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteItem.expectations {
-		if minimock.Equal(e.params, mmDeleteItem.defaultExpectation.params) {
-			mmDeleteItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteItem.defaultExpectation.params)
+	mmUpdateItemCount.defaultExpectation.params = &RepositoryIfaceMockUpdateItemCountParams{ctx, userID, skuID, count, price, expected}
+	mmUpdateItemCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateItemCount.expectations {
+		if minimock.Equal(e.params, mmUpdateItemCount.defaultExpectation.params) {
+			mmUpdateItemCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateItemCount.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteItem
+	return mmUpdateItemCount
 }
 
-// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.DeleteItem
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockDeleteItem {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.UpdateItemCount
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockUpdateItemCount {
+	if mmUpdateItemCount.mock.funcUpdateItemCount != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{}
+	if mmUpdateItemCount.defaultExpectation == nil {
+		mmUpdateItemCount.defaultExpectation = &RepositoryIfaceMockUpdateItemCountExpectation{}
 	}
 
-	if mmDeleteItem.defaultExpectation.params != nil {
-		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Expect")
+	if mmUpdateItemCount.defaultExpectation.params != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Expect")
 	}
 
-	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
-		mmDeleteItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDeleteItemParamPtrs{}
+	if mmUpdateItemCount.defaultExpectation.paramPtrs == nil {
+		mmUpdateItemCount.defaultExpectation.paramPtrs = &RepositoryIfaceMockUpdateItemCountParamPtrs{}
 	}
-	mmDeleteItem.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateItemCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateItemCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteItem
+	return mmUpdateItemCount
 }
 
-// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.DeleteItem
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockDeleteItem {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.UpdateItemCount
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockUpdateItemCount {
+	if mmUpdateItemCount.mock.funcUpdateItemCount != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{}
+	if mmUpdateItemCount.defaultExpectation == nil {
+		mmUpdateItemCount.defaultExpectation = &RepositoryIfaceMockUpdateItemCountExpectation{}
 	}
 
-	if mmDeleteItem.defaultExpectation.params != nil {
-		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Expect")
+	if mmUpdateItemCount.defaultExpectation.params != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Expect")
 	}
 
-	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
-		mmDeleteItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDeleteItemParamPtrs{}
+	if mmUpdateItemCount.defaultExpectation.paramPtrs == nil {
+		mmUpdateItemCount.defaultExpectation.paramPtrs = &RepositoryIfaceMockUpdateItemCountParamPtrs{}
 	}
-	mmDeleteItem.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmUpdateItemCount.defaultExpectation.paramPtrs.userID = &userID
+	mmUpdateItemCount.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmDeleteItem
+	return mmUpdateItemCount
 }
 
-// ExpectSkuIDParam3 sets up expected param skuID for RepositoryIface.DeleteItem
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) ExpectSkuIDParam3(skuID uint64) *mRepositoryIfaceMockDeleteItem {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+// ExpectSkuIDParam3 sets up expected param skuID for RepositoryIface.UpdateItemCount
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) ExpectSkuIDParam3(skuID uint64) *mRepositoryIfaceMockUpdateItemCount {
+	if mmUpdateItemCount.mock.funcUpdateItemCount != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{}
+	if mmUpdateItemCount.defaultExpectation == nil {
+		mmUpdateItemCount.defaultExpectation = &RepositoryIfaceMockUpdateItemCountExpectation{}
 	}
 
-	if mmDeleteItem.defaultExpectation.params != nil {
-		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Expect")
+	if mmUpdateItemCount.defaultExpectation.params != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Expect")
 	}
 
-	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
-		mmDeleteItem.defaultExpectation.paramPtrs = &RepositoryIfaceMockDeleteItemParamPtrs{}
+	if mmUpdateItemCount.defaultExpectation.paramPtrs == nil {
+		mmUpdateItemCount.defaultExpectation.paramPtrs = &RepositoryIfaceMockUpdateItemCountParamPtrs{}
 	}
-	mmDeleteItem.defaultExpectation.paramPtrs.skuID = &skuID
-	mmDeleteItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmUpdateItemCount.defaultExpectation.paramPtrs.skuID = &skuID
+	mmUpdateItemCount.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
 
-	return mmDeleteItem
+	return mmUpdateItemCount
 }
 
-// Inspect accepts an inspector function that has same arguments as the RepositoryIface.DeleteItem
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Inspect(f func(ctx context.Context, userID uint64, skuID uint64)) *mRepositoryIfaceMockDeleteItem {
-	if mmDeleteItem.mock.inspectFuncDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.DeleteItem")
+// ExpectCountParam4 sets up expected param count for RepositoryIface.UpdateItemCount
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) ExpectCountParam4(count uint64) *mRepositoryIfaceMockUpdateItemCount {
+	if mmUpdateItemCount.mock.funcUpdateItemCount != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Set")
 	}
 
-	mmDeleteItem.mock.inspectFuncDeleteItem = f
+	if mmUpdateItemCount.defaultExpectation == nil {
+		mmUpdateItemCount.defaultExpectation = &RepositoryIfaceMockUpdateItemCountExpectation{}
+	}
 
-	return mmDeleteItem
+	if mmUpdateItemCount.defaultExpectation.params != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Expect")
+	}
+
+	if mmUpdateItemCount.defaultExpectation.paramPtrs == nil {
+		mmUpdateItemCount.defaultExpectation.paramPtrs = &RepositoryIfaceMockUpdateItemCountParamPtrs{}
+	}
+	mmUpdateItemCount.defaultExpectation.paramPtrs.count = &count
+	mmUpdateItemCount.defaultExpectation.expectationOrigins.originCount = minimock.CallerInfo(1)
+
+	return mmUpdateItemCount
 }
 
-// Return sets up results that will be returned by RepositoryIface.DeleteItem
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Return(err error) *RepositoryIfaceMock {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+// ExpectPriceParam5 sets up expected param price for RepositoryIface.UpdateItemCount
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) ExpectPriceParam5(price uint64) *mRepositoryIfaceMockUpdateItemCount {
+	if mmUpdateItemCount.mock.funcUpdateItemCount != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &RepositoryIfaceMockDeleteItemExpectation{mock: mmDeleteItem.mock}
+	if mmUpdateItemCount.defaultExpectation == nil {
+		mmUpdateItemCount.defaultExpectation = &RepositoryIfaceMockUpdateItemCountExpectation{}
 	}
-	mmDeleteItem.defaultExpectation.results = &RepositoryIfaceMockDeleteItemResults{err}
-	mmDeleteItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteItem.mock
+
+	if mmUpdateItemCount.defaultExpectation.params != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Expect")
+	}
+
+	if mmUpdateItemCount.defaultExpectation.paramPtrs == nil {
+		mmUpdateItemCount.defaultExpectation.paramPtrs = &RepositoryIfaceMockUpdateItemCountParamPtrs{}
+	}
+	mmUpdateItemCount.defaultExpectation.paramPtrs.price = &price
+	mmUpdateItemCount.defaultExpectation.expectationOrigins.originPrice = minimock.CallerInfo(1)
+
+	return mmUpdateItemCount
 }
 
-// Set uses given function f to mock the RepositoryIface.DeleteItem method
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Set(f func(ctx context.Context, userID uint64, skuID uint64) (err error)) *RepositoryIfaceMock {
-	if mmDeleteItem.defaultExpectation != nil {
-		mmDeleteItem.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.DeleteItem method")
+// ExpectExpectedParam6 sets up expected param expected for RepositoryIface.UpdateItemCount
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) ExpectExpectedParam6(expected *uint64) *mRepositoryIfaceMockUpdateItemCount {
+	if mmUpdateItemCount.mock.funcUpdateItemCount != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Set")
 	}
 
-	if len(mmDeleteItem.expectations) > 0 {
-		mmDeleteItem.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.DeleteItem method")
+	if mmUpdateItemCount.defaultExpectation == nil {
+		mmUpdateItemCount.defaultExpectation = &RepositoryIfaceMockUpdateItemCountExpectation{}
 	}
 
-	mmDeleteItem.mock.funcDeleteItem = f
-	mmDeleteItem.mock.funcDeleteItemOrigin = minimock.CallerInfo(1)
-	return mmDeleteItem.mock
+	if mmUpdateItemCount.defaultExpectation.params != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Expect")
+	}
+
+	if mmUpdateItemCount.defaultExpectation.paramPtrs == nil {
+		mmUpdateItemCount.defaultExpectation.paramPtrs = &RepositoryIfaceMockUpdateItemCountParamPtrs{}
+	}
+	mmUpdateItemCount.defaultExpectation.paramPtrs.expected = &expected
+	mmUpdateItemCount.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmUpdateItemCount
 }
 
-// When sets expectation for the RepositoryIface.DeleteItem which will trigger the result defined by the following
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.UpdateItemCount
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) Inspect(f func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64)) *mRepositoryIfaceMockUpdateItemCount {
+	if mmUpdateItemCount.mock.inspectFuncUpdateItemCount != nil {
+		mmUpdateItemCount.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.UpdateItemCount")
+	}
+
+	mmUpdateItemCount.mock.inspectFuncUpdateItemCount = f
+
+	return mmUpdateItemCount
+}
+
+// Return sets up results that will be returned by RepositoryIface.UpdateItemCount
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) Return(u1 uint64, err error) *RepositoryIfaceMock {
+	if mmUpdateItemCount.mock.funcUpdateItemCount != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Set")
+	}
+
+	if mmUpdateItemCount.defaultExpectation == nil {
+		mmUpdateItemCount.defaultExpectation = &RepositoryIfaceMockUpdateItemCountExpectation{mock: mmUpdateItemCount.mock}
+	}
+	mmUpdateItemCount.defaultExpectation.results = &RepositoryIfaceMockUpdateItemCountResults{u1, err}
+	mmUpdateItemCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateItemCount.mock
+}
+
+// Set uses given function f to mock the RepositoryIface.UpdateItemCount method
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) Set(f func(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) (u1 uint64, err error)) *RepositoryIfaceMock {
+	if mmUpdateItemCount.defaultExpectation != nil {
+		mmUpdateItemCount.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.UpdateItemCount method")
+	}
+
+	if len(mmUpdateItemCount.expectations) > 0 {
+		mmUpdateItemCount.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.UpdateItemCount method")
+	}
+
+	mmUpdateItemCount.mock.funcUpdateItemCount = f
+	mmUpdateItemCount.mock.funcUpdateItemCountOrigin = minimock.CallerInfo(1)
+	return mmUpdateItemCount.mock
+}
+
+// When sets expectation for the RepositoryIface.UpdateItemCount which will trigger the result defined by the following
 // Then helper
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) When(ctx context.Context, userID uint64, skuID uint64) *RepositoryIfaceMockDeleteItemExpectation {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("RepositoryIfaceMock.DeleteItem mock is already set by Set")
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) When(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) *RepositoryIfaceMockUpdateItemCountExpectation {
+	if mmUpdateItemCount.mock.funcUpdateItemCount != nil {
+		mmUpdateItemCount.mock.t.Fatalf("RepositoryIfaceMock.UpdateItemCount mock is already set by Set")
 	}
 
-	expectation := &RepositoryIfaceMockDeleteItemExpectation{
-		mock:               mmDeleteItem.mock,
-		params:             &RepositoryIfaceMockDeleteItemParams{ctx, userID, skuID},
-		expectationOrigins: RepositoryIfaceMockDeleteItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryIfaceMockUpdateItemCountExpectation{
+		mock:               mmUpdateItemCount.mock,
+		params:             &RepositoryIfaceMockUpdateItemCountParams{ctx, userID, skuID, count, price, expected},
+		expectationOrigins: RepositoryIfaceMockUpdateItemCountExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteItem.expectations = append(mmDeleteItem.expectations, expectation)
+	mmUpdateItemCount.expectations = append(mmUpdateItemCount.expectations, expectation)
 	return expectation
 }
 
-// Then sets up RepositoryIface.DeleteItem return parameters for the expectation previously defined by the When method
-func (e *RepositoryIfaceMockDeleteItemExpectation) Then(err error) *RepositoryIfaceMock {
-	e.results = &RepositoryIfaceMockDeleteItemResults{err}
+// Then sets up RepositoryIface.UpdateItemCount return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockUpdateItemCountExpectation) Then(u1 uint64, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockUpdateItemCountResults{u1, err}
 	return e.mock
 }
 
-// Times sets number of times RepositoryIface.DeleteItem should be invoked
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Times(n uint64) *mRepositoryIfaceMockDeleteItem {
+// Times sets number of times RepositoryIface.UpdateItemCount should be invoked
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) Times(n uint64) *mRepositoryIfaceMockUpdateItemCount {
 	if n == 0 {
-		mmDeleteItem.mock.t.Fatalf("Times of RepositoryIfaceMock.DeleteItem mock can not be zero")
+		mmUpdateItemCount.mock.t.Fatalf("Times of RepositoryIfaceMock.UpdateItemCount mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteItem.expectedInvocations, n)
-	mmDeleteItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteItem
+	mm_atomic.StoreUint64(&mmUpdateItemCount.expectedInvocations, n)
+	mmUpdateItemCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateItemCount
 }
 
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) invocationsDone() bool {
-	if len(mmDeleteItem.expectations) == 0 && mmDeleteItem.defaultExpectation == nil && mmDeleteItem.mock.funcDeleteItem == nil {
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) invocationsDone() bool {
+	if len(mmUpdateItemCount.expectations) == 0 && mmUpdateItemCount.defaultExpectation == nil && mmUpdateItemCount.mock.funcUpdateItemCount == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteItem.mock.afterDeleteItemCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteItem.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateItemCount.mock.afterUpdateItemCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateItemCount.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteItem implements mm_interfaces.RepositoryIface
-func (mmDeleteItem *RepositoryIfaceMock) DeleteItem(ctx context.Context, userID uint64, skuID uint64) (err error) {
-	mm_atomic.AddUint64(&mmDeleteItem.beforeDeleteItemCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteItem.afterDeleteItemCounter, 1)
+// UpdateItemCount implements mm_interfaces.RepositoryIface
+func (mmUpdateItemCount *RepositoryIfaceMock) UpdateItemCount(ctx context.Context, userID uint64, skuID uint64, count uint64, price uint64, expected *uint64) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmUpdateItemCount.beforeUpdateItemCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateItemCount.afterUpdateItemCountCounter, 1)
 
-	mmDeleteItem.t.Helper()
+	mmUpdateItemCount.t.Helper()
 
-	if mmDeleteItem.inspectFuncDeleteItem != nil {
-		mmDeleteItem.inspectFuncDeleteItem(ctx, userID, skuID)
+	if mmUpdateItemCount.inspectFuncUpdateItemCount != nil {
+		mmUpdateItemCount.inspectFuncUpdateItemCount(ctx, userID, skuID, count, price, expected)
 	}
 
-	mm_params := RepositoryIfaceMockDeleteItemParams{ctx, userID, skuID}
+	mm_params := RepositoryIfaceMockUpdateItemCountParams{ctx, userID, skuID, count, price, expected}
 
 	// Record call args
-	mmDeleteItem.DeleteItemMock.mutex.Lock()
-	mmDeleteItem.DeleteItemMock.callArgs = append(mmDeleteItem.DeleteItemMock.callArgs, &mm_params)
-	mmDeleteItem.DeleteItemMock.mutex.Unlock()
+	mmUpdateItemCount.UpdateItemCountMock.mutex.Lock()
+	mmUpdateItemCount.UpdateItemCountMock.callArgs = append(mmUpdateItemCount.UpdateItemCountMock.callArgs, &mm_params)
+	mmUpdateItemCount.UpdateItemCountMock.mutex.Unlock()
 
-	for _, e := range mmDeleteItem.DeleteItemMock.expectations {
+	for _, e := range mmUpdateItemCount.UpdateItemCountMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
-		}
-	}
-
-	if mmDeleteItem.DeleteItemMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteItem.DeleteItemMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteItem.DeleteItemMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteItem.DeleteItemMock.defaultExpectation.paramPtrs
+			return e.results.u1, e.results.err
+		}
+	}
 
-		mm_got := RepositoryIfaceMockDeleteItemParams{ctx, userID, skuID}
+	if mmUpdateItemCount.UpdateItemCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryIfaceMockUpdateItemCountParams{ctx, userID, skuID, count, price, expected}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteItem.t.Errorf("RepositoryIfaceMock.DeleteItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateItemCount.t.Errorf("RepositoryIfaceMock.UpdateItemCount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteItem.t.Errorf("RepositoryIfaceMock.DeleteItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmUpdateItemCount.t.Errorf("RepositoryIfaceMock.UpdateItemCount got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmDeleteItem.t.Errorf("RepositoryIfaceMock.DeleteItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+				mmUpdateItemCount.t.Errorf("RepositoryIfaceMock.UpdateItemCount got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.count != nil && !minimock.Equal(*mm_want_ptrs.count, mm_got.count) {
+				mmUpdateItemCount.t.Errorf("RepositoryIfaceMock.UpdateItemCount got unexpected parameter count, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.expectationOrigins.originCount, *mm_want_ptrs.count, mm_got.count, minimock.Diff(*mm_want_ptrs.count, mm_got.count))
+			}
+
+			if mm_want_ptrs.price != nil && !minimock.Equal(*mm_want_ptrs.price, mm_got.price) {
+				mmUpdateItemCount.t.Errorf("RepositoryIfaceMock.UpdateItemCount got unexpected parameter price, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.expectationOrigins.originPrice, *mm_want_ptrs.price, mm_got.price, minimock.Diff(*mm_want_ptrs.price, mm_got.price))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmUpdateItemCount.t.Errorf("RepositoryIfaceMock.UpdateItemCount got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteItem.t.Errorf("RepositoryIfaceMock.DeleteItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateItemCount.t.Errorf("RepositoryIfaceMock.UpdateItemCount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteItem.DeleteItemMock.defaultExpectation.results
+		mm_results := mmUpdateItemCount.UpdateItemCountMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteItem.t.Fatal("No results are set for the RepositoryIfaceMock.DeleteItem")
+			mmUpdateItemCount.t.Fatal("No results are set for the RepositoryIfaceMock.UpdateItemCount")
 		}
-		return (*mm_results).err
+		return (*mm_results).u1, (*mm_results).err
 	}
-	if mmDeleteItem.funcDeleteItem != nil {
-		return mmDeleteItem.funcDeleteItem(ctx, userID, skuID)
+	if mmUpdateItemCount.funcUpdateItemCount != nil {
+		return mmUpdateItemCount.funcUpdateItemCount(ctx, userID, skuID, count, price, expected)
 	}
-	mmDeleteItem.t.Fatalf("Unexpected call to RepositoryIfaceMock.DeleteItem. %v %v %v", ctx, userID, skuID)
+	mmUpdateItemCount.t.Fatalf("Unexpected call to RepositoryIfaceMock.UpdateItemCount. %v %v %v %v %v %v", ctx, userID, skuID, count, price, expected)
 	return
 }
 
-// DeleteItemAfterCounter returns a count of finished RepositoryIfaceMock.DeleteItem invocations
-func (mmDeleteItem *RepositoryIfaceMock) DeleteItemAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteItem.afterDeleteItemCounter)
+// UpdateItemCountAfterCounter returns a count of finished RepositoryIfaceMock.UpdateItemCount invocations
+func (mmUpdateItemCount *RepositoryIfaceMock) UpdateItemCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateItemCount.afterUpdateItemCountCounter)
 }
 
-// DeleteItemBeforeCounter returns a count of RepositoryIfaceMock.DeleteItem invocations
-func (mmDeleteItem *RepositoryIfaceMock) DeleteItemBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteItem.beforeDeleteItemCounter)
+// UpdateItemCountBeforeCounter returns a count of RepositoryIfaceMock.UpdateItemCount invocations
+func (mmUpdateItemCount *RepositoryIfaceMock) UpdateItemCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateItemCount.beforeUpdateItemCountCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryIfaceMock.DeleteItem.
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.UpdateItemCount.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteItem *mRepositoryIfaceMockDeleteItem) Calls() []*RepositoryIfaceMockDeleteItemParams {
-	mmDeleteItem.mutex.RLock()
+func (mmUpdateItemCount *mRepositoryIfaceMockUpdateItemCount) Calls() []*RepositoryIfaceMockUpdateItemCountParams {
+	mmUpdateItemCount.mutex.RLock()
 
-	argCopy := make([]*RepositoryIfaceMockDeleteItemParams, len(mmDeleteItem.callArgs))
-	copy(argCopy, mmDeleteItem.callArgs)
+	argCopy := make([]*RepositoryIfaceMockUpdateItemCountParams, len(mmUpdateItemCount.callArgs))
+	copy(argCopy, mmUpdateItemCount.callArgs)
 
-	mmDeleteItem.mutex.RUnlock()
+	mmUpdateItemCount.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteItemDone returns true if the count of the DeleteItem invocations corresponds
+// MinimockUpdateItemCountDone returns true if the count of the UpdateItemCount invocations corresponds
 // the number of defined expectations
-func (m *RepositoryIfaceMock) MinimockDeleteItemDone() bool {
-	if m.DeleteItemMock.optional {
+func (m *RepositoryIfaceMock) MinimockUpdateItemCountDone() bool {
+	if m.UpdateItemCountMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteItemMock.expectations {
+	for _, e := range m.UpdateItemCountMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteItemMock.invocationsDone()
+	return m.UpdateItemCountMock.invocationsDone()
 }
 
-// MinimockDeleteItemInspect logs each unmet expectation
-func (m *RepositoryIfaceMock) MinimockDeleteItemInspect() {
-	for _, e := range m.DeleteItemMock.expectations {
+// MinimockUpdateItemCountInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockUpdateItemCountInspect() {
+	for _, e := range m.UpdateItemCountMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryIfaceMock.UpdateItemCount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteItemCounter := mm_atomic.LoadUint64(&m.afterDeleteItemCounter)
+	afterUpdateItemCountCounter := mm_atomic.LoadUint64(&m.afterUpdateItemCountCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteItemMock.defaultExpectation != nil && afterDeleteItemCounter < 1 {
-		if m.DeleteItemMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteItem at\n%s", m.DeleteItemMock.defaultExpectation.returnOrigin)
+	if m.UpdateItemCountMock.defaultExpectation != nil && afterUpdateItemCountCounter < 1 {
+		if m.UpdateItemCountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.UpdateItemCount at\n%s", m.UpdateItemCountMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteItem at\n%s with params: %#v", m.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteItemMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryIfaceMock.UpdateItemCount at\n%s with params: %#v", m.UpdateItemCountMock.defaultExpectation.expectationOrigins.origin, *m.UpdateItemCountMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteItem != nil && afterDeleteItemCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryIfaceMock.DeleteItem at\n%s", m.funcDeleteItemOrigin)
+	if m.funcUpdateItemCount != nil && afterUpdateItemCountCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.UpdateItemCount at\n%s", m.funcUpdateItemCountOrigin)
 	}
 
-	if !m.DeleteItemMock.invocationsDone() && afterDeleteItemCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.DeleteItem at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteItemMock.expectedInvocations), m.DeleteItemMock.expectedInvocationsOrigin, afterDeleteItemCounter)
+	if !m.UpdateItemCountMock.invocationsDone() && afterUpdateItemCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.UpdateItemCount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateItemCountMock.expectedInvocations), m.UpdateItemCountMock.expectedInvocationsOrigin, afterUpdateItemCountCounter)
 	}
 }
 
-type mRepositoryIfaceMockGetCart struct {
+type mRepositoryIfaceMockWithSweepLock struct {
 	optional           bool
 	mock               *RepositoryIfaceMock
-	defaultExpectation *RepositoryIfaceMockGetCartExpectation
-	expectations       []*RepositoryIfaceMockGetCartExpectation
+	defaultExpectation *RepositoryIfaceMockWithSweepLockExpectation
+	expectations       []*RepositoryIfaceMockWithSweepLockExpectation
 
-	callArgs []*RepositoryIfaceMockGetCartParams
+	callArgs []*RepositoryIfaceMockWithSweepLockParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryIfaceMockGetCartExpectation specifies expectation struct of the RepositoryIface.GetCart
-type RepositoryIfaceMockGetCartExpectation struct {
+// RepositoryIfaceMockWithSweepLockExpectation specifies expectation struct of the RepositoryIface.WithSweepLock
+type RepositoryIfaceMockWithSweepLockExpectation struct {
 	mock               *RepositoryIfaceMock
-	params             *RepositoryIfaceMockGetCartParams
-	paramPtrs          *RepositoryIfaceMockGetCartParamPtrs
-	expectationOrigins RepositoryIfaceMockGetCartExpectationOrigins
-	results            *RepositoryIfaceMockGetCartResults
+	params             *RepositoryIfaceMockWithSweepLockParams
+	paramPtrs          *RepositoryIfaceMockWithSweepLockParamPtrs
+	expectationOrigins RepositoryIfaceMockWithSweepLockExpectationOrigins
+	results            *RepositoryIfaceMockWithSweepLockResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryIfaceMockGetCartParams contains parameters of the RepositoryIface.GetCart
-type RepositoryIfaceMockGetCartParams struct {
-	ctx    context.Context
-	userID uint64
+// RepositoryIfaceMockWithSweepLockParams contains parameters of the RepositoryIface.WithSweepLock
+type RepositoryIfaceMockWithSweepLockParams struct {
+	ctx context.Context
+	fn  func() error
 }
 
-// RepositoryIfaceMockGetCartParamPtrs contains pointers to parameters of the RepositoryIface.GetCart
-type RepositoryIfaceMockGetCartParamPtrs struct {
-	ctx    *context.Context
-	userID *uint64
+// RepositoryIfaceMockWithSweepLockParamPtrs contains pointers to parameters of the RepositoryIface.WithSweepLock
+type RepositoryIfaceMockWithSweepLockParamPtrs struct {
+	ctx *context.Context
+	fn  *func() error
 }
 
-// RepositoryIfaceMockGetCartResults contains results of the RepositoryIface.GetCart
-type RepositoryIfaceMockGetCartResults struct {
-	pa1 []postgres.Position
+// RepositoryIfaceMockWithSweepLockResults contains results of the RepositoryIface.WithSweepLock
+type RepositoryIfaceMockWithSweepLockResults struct {
+	b1  bool
 	err error
 }
 
-// RepositoryIfaceMockGetCartOrigins contains origins of expectations of the RepositoryIface.GetCart
-type RepositoryIfaceMockGetCartExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+// RepositoryIfaceMockWithSweepLockOrigins contains origins of expectations of the RepositoryIface.WithSweepLock
+type RepositoryIfaceMockWithSweepLockExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originFn  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1246,292 +13904,292 @@ type RepositoryIfaceMockGetCartExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetCart *mRepositoryIfaceMockGetCart) Optional() *mRepositoryIfaceMockGetCart {
-	mmGetCart.optional = true
-	return mmGetCart
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) Optional() *mRepositoryIfaceMockWithSweepLock {
+	mmWithSweepLock.optional = true
+	return mmWithSweepLock
 }
 
-// Expect sets up expected params for RepositoryIface.GetCart
-func (mmGetCart *mRepositoryIfaceMockGetCart) Expect(ctx context.Context, userID uint64) *mRepositoryIfaceMockGetCart {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Set")
+// Expect sets up expected params for RepositoryIface.WithSweepLock
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) Expect(ctx context.Context, fn func() error) *mRepositoryIfaceMockWithSweepLock {
+	if mmWithSweepLock.mock.funcWithSweepLock != nil {
+		mmWithSweepLock.mock.t.Fatalf("RepositoryIfaceMock.WithSweepLock mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
-		mmGetCart.defaultExpectation = &RepositoryIfaceMockGetCartExpectation{}
+	if mmWithSweepLock.defaultExpectation == nil {
+		mmWithSweepLock.defaultExpectation = &RepositoryIfaceMockWithSweepLockExpectation{}
 	}
 
-	if mmGetCart.defaultExpectation.paramPtrs != nil {
-		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by ExpectParams functions")
+	if mmWithSweepLock.defaultExpectation.paramPtrs != nil {
+		mmWithSweepLock.mock.t.Fatalf("RepositoryIfaceMock.WithSweepLock mock is already set by ExpectParams functions")
 	}
 
-	mmGetCart.defaultExpectation.params = &RepositoryIfaceMockGetCartParams{ctx, userID}
-	mmGetCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetCart.expectations {
-		if minimock.Equal(e.params, mmGetCart.defaultExpectation.params) {
-			mmGetCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCart.defaultExpectation.params)
+	mmWithSweepLock.defaultExpectation.params = &RepositoryIfaceMockWithSweepLockParams{ctx, fn}
+	mmWithSweepLock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWithSweepLock.expectations {
+		if minimock.Equal(e.params, mmWithSweepLock.defaultExpectation.params) {
+			mmWithSweepLock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWithSweepLock.defaultExpectation.params)
 		}
 	}
 
-	return mmGetCart
+	return mmWithSweepLock
 }
 
-// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.GetCart
-func (mmGetCart *mRepositoryIfaceMockGetCart) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockGetCart {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for RepositoryIface.WithSweepLock
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) ExpectCtxParam1(ctx context.Context) *mRepositoryIfaceMockWithSweepLock {
+	if mmWithSweepLock.mock.funcWithSweepLock != nil {
+		mmWithSweepLock.mock.t.Fatalf("RepositoryIfaceMock.WithSweepLock mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
-		mmGetCart.defaultExpectation = &RepositoryIfaceMockGetCartExpectation{}
+	if mmWithSweepLock.defaultExpectation == nil {
+		mmWithSweepLock.defaultExpectation = &RepositoryIfaceMockWithSweepLockExpectation{}
 	}
 
-	if mmGetCart.defaultExpectation.params != nil {
-		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Expect")
+	if mmWithSweepLock.defaultExpectation.params != nil {
+		mmWithSweepLock.mock.t.Fatalf("RepositoryIfaceMock.WithSweepLock mock is already set by Expect")
 	}
 
-	if mmGetCart.defaultExpectation.paramPtrs == nil {
-		mmGetCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartParamPtrs{}
+	if mmWithSweepLock.defaultExpectation.paramPtrs == nil {
+		mmWithSweepLock.defaultExpectation.paramPtrs = &RepositoryIfaceMockWithSweepLockParamPtrs{}
 	}
-	mmGetCart.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmWithSweepLock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWithSweepLock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetCart
+	return mmWithSweepLock
 }
 
-// ExpectUserIDParam2 sets up expected param userID for RepositoryIface.GetCart
-func (mmGetCart *mRepositoryIfaceMockGetCart) ExpectUserIDParam2(userID uint64) *mRepositoryIfaceMockGetCart {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Set")
+// ExpectFnParam2 sets up expected param fn for RepositoryIface.WithSweepLock
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) ExpectFnParam2(fn func() error) *mRepositoryIfaceMockWithSweepLock {
+	if mmWithSweepLock.mock.funcWithSweepLock != nil {
+		mmWithSweepLock.mock.t.Fatalf("RepositoryIfaceMock.WithSweepLock mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
-		mmGetCart.defaultExpectation = &RepositoryIfaceMockGetCartExpectation{}
+	if mmWithSweepLock.defaultExpectation == nil {
+		mmWithSweepLock.defaultExpectation = &RepositoryIfaceMockWithSweepLockExpectation{}
 	}
 
-	if mmGetCart.defaultExpectation.params != nil {
-		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Expect")
+	if mmWithSweepLock.defaultExpectation.params != nil {
+		mmWithSweepLock.mock.t.Fatalf("RepositoryIfaceMock.WithSweepLock mock is already set by Expect")
 	}
 
-	if mmGetCart.defaultExpectation.paramPtrs == nil {
-		mmGetCart.defaultExpectation.paramPtrs = &RepositoryIfaceMockGetCartParamPtrs{}
+	if mmWithSweepLock.defaultExpectation.paramPtrs == nil {
+		mmWithSweepLock.defaultExpectation.paramPtrs = &RepositoryIfaceMockWithSweepLockParamPtrs{}
 	}
-	mmGetCart.defaultExpectation.paramPtrs.userID = &userID
-	mmGetCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmWithSweepLock.defaultExpectation.paramPtrs.fn = &fn
+	mmWithSweepLock.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
 
-	return mmGetCart
+	return mmWithSweepLock
 }
 
-// Inspect accepts an inspector function that has same arguments as the RepositoryIface.GetCart
-func (mmGetCart *mRepositoryIfaceMockGetCart) Inspect(f func(ctx context.Context, userID uint64)) *mRepositoryIfaceMockGetCart {
-	if mmGetCart.mock.inspectFuncGetCart != nil {
-		mmGetCart.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.GetCart")
+// Inspect accepts an inspector function that has same arguments as the RepositoryIface.WithSweepLock
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) Inspect(f func(ctx context.Context, fn func() error)) *mRepositoryIfaceMockWithSweepLock {
+	if mmWithSweepLock.mock.inspectFuncWithSweepLock != nil {
+		mmWithSweepLock.mock.t.Fatalf("Inspect function is already set for RepositoryIfaceMock.WithSweepLock")
 	}
 
-	mmGetCart.mock.inspectFuncGetCart = f
+	mmWithSweepLock.mock.inspectFuncWithSweepLock = f
 
-	return mmGetCart
+	return mmWithSweepLock
 }
 
-// Return sets up results that will be returned by RepositoryIface.GetCart
-func (mmGetCart *mRepositoryIfaceMockGetCart) Return(pa1 []postgres.Position, err error) *RepositoryIfaceMock {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Set")
+// Return sets up results that will be returned by RepositoryIface.WithSweepLock
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) Return(b1 bool, err error) *RepositoryIfaceMock {
+	if mmWithSweepLock.mock.funcWithSweepLock != nil {
+		mmWithSweepLock.mock.t.Fatalf("RepositoryIfaceMock.WithSweepLock mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
-		mmGetCart.defaultExpectation = &RepositoryIfaceMockGetCartExpectation{mock: mmGetCart.mock}
+	if mmWithSweepLock.defaultExpectation == nil {
+		mmWithSweepLock.defaultExpectation = &RepositoryIfaceMockWithSweepLockExpectation{mock: mmWithSweepLock.mock}
 	}
-	mmGetCart.defaultExpectation.results = &RepositoryIfaceMockGetCartResults{pa1, err}
-	mmGetCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetCart.mock
+	mmWithSweepLock.defaultExpectation.results = &RepositoryIfaceMockWithSweepLockResults{b1, err}
+	mmWithSweepLock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWithSweepLock.mock
 }
 
-// Set uses given function f to mock the RepositoryIface.GetCart method
-func (mmGetCart *mRepositoryIfaceMockGetCart) Set(f func(ctx context.Context, userID uint64) (pa1 []postgres.Position, err error)) *RepositoryIfaceMock {
-	if mmGetCart.defaultExpectation != nil {
-		mmGetCart.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.GetCart method")
+// Set uses given function f to mock the RepositoryIface.WithSweepLock method
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) Set(f func(ctx context.Context, fn func() error) (b1 bool, err error)) *RepositoryIfaceMock {
+	if mmWithSweepLock.defaultExpectation != nil {
+		mmWithSweepLock.mock.t.Fatalf("Default expectation is already set for the RepositoryIface.WithSweepLock method")
 	}
 
-	if len(mmGetCart.expectations) > 0 {
-		mmGetCart.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.GetCart method")
+	if len(mmWithSweepLock.expectations) > 0 {
+		mmWithSweepLock.mock.t.Fatalf("Some expectations are already set for the RepositoryIface.WithSweepLock method")
 	}
 
-	mmGetCart.mock.funcGetCart = f
-	mmGetCart.mock.funcGetCartOrigin = minimock.CallerInfo(1)
-	return mmGetCart.mock
+	mmWithSweepLock.mock.funcWithSweepLock = f
+	mmWithSweepLock.mock.funcWithSweepLockOrigin = minimock.CallerInfo(1)
+	return mmWithSweepLock.mock
 }
 
-// When sets expectation for the RepositoryIface.GetCart which will trigger the result defined by the following
+// When sets expectation for the RepositoryIface.WithSweepLock which will trigger the result defined by the following
 // Then helper
-func (mmGetCart *mRepositoryIfaceMockGetCart) When(ctx context.Context, userID uint64) *RepositoryIfaceMockGetCartExpectation {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("RepositoryIfaceMock.GetCart mock is already set by Set")
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) When(ctx context.Context, fn func() error) *RepositoryIfaceMockWithSweepLockExpectation {
+	if mmWithSweepLock.mock.funcWithSweepLock != nil {
+		mmWithSweepLock.mock.t.Fatalf("RepositoryIfaceMock.WithSweepLock mock is already set by Set")
 	}
 
-	expectation := &RepositoryIfaceMockGetCartExpectation{
-		mock:               mmGetCart.mock,
-		params:             &RepositoryIfaceMockGetCartParams{ctx, userID},
-		expectationOrigins: RepositoryIfaceMockGetCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryIfaceMockWithSweepLockExpectation{
+		mock:               mmWithSweepLock.mock,
+		params:             &RepositoryIfaceMockWithSweepLockParams{ctx, fn},
+		expectationOrigins: RepositoryIfaceMockWithSweepLockExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetCart.expectations = append(mmGetCart.expectations, expectation)
+	mmWithSweepLock.expectations = append(mmWithSweepLock.expectations, expectation)
 	return expectation
 }
 
-// Then sets up RepositoryIface.GetCart return parameters for the expectation previously defined by the When method
-func (e *RepositoryIfaceMockGetCartExpectation) Then(pa1 []postgres.Position, err error) *RepositoryIfaceMock {
-	e.results = &RepositoryIfaceMockGetCartResults{pa1, err}
+// Then sets up RepositoryIface.WithSweepLock return parameters for the expectation previously defined by the When method
+func (e *RepositoryIfaceMockWithSweepLockExpectation) Then(b1 bool, err error) *RepositoryIfaceMock {
+	e.results = &RepositoryIfaceMockWithSweepLockResults{b1, err}
 	return e.mock
 }
 
-// Times sets number of times RepositoryIface.GetCart should be invoked
-func (mmGetCart *mRepositoryIfaceMockGetCart) Times(n uint64) *mRepositoryIfaceMockGetCart {
+// Times sets number of times RepositoryIface.WithSweepLock should be invoked
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) Times(n uint64) *mRepositoryIfaceMockWithSweepLock {
 	if n == 0 {
-		mmGetCart.mock.t.Fatalf("Times of RepositoryIfaceMock.GetCart mock can not be zero")
+		mmWithSweepLock.mock.t.Fatalf("Times of RepositoryIfaceMock.WithSweepLock mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetCart.expectedInvocations, n)
-	mmGetCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetCart
+	mm_atomic.StoreUint64(&mmWithSweepLock.expectedInvocations, n)
+	mmWithSweepLock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWithSweepLock
 }
 
-func (mmGetCart *mRepositoryIfaceMockGetCart) invocationsDone() bool {
-	if len(mmGetCart.expectations) == 0 && mmGetCart.defaultExpectation == nil && mmGetCart.mock.funcGetCart == nil {
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) invocationsDone() bool {
+	if len(mmWithSweepLock.expectations) == 0 && mmWithSweepLock.defaultExpectation == nil && mmWithSweepLock.mock.funcWithSweepLock == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetCart.mock.afterGetCartCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetCart.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmWithSweepLock.mock.afterWithSweepLockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWithSweepLock.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetCart implements mm_interfaces.RepositoryIface
-func (mmGetCart *RepositoryIfaceMock) GetCart(ctx context.Context, userID uint64) (pa1 []postgres.Position, err error) {
-	mm_atomic.AddUint64(&mmGetCart.beforeGetCartCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetCart.afterGetCartCounter, 1)
+// WithSweepLock implements mm_interfaces.RepositoryIface
+func (mmWithSweepLock *RepositoryIfaceMock) WithSweepLock(ctx context.Context, fn func() error) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmWithSweepLock.beforeWithSweepLockCounter, 1)
+	defer mm_atomic.AddUint64(&mmWithSweepLock.afterWithSweepLockCounter, 1)
 
-	mmGetCart.t.Helper()
+	mmWithSweepLock.t.Helper()
 
-	if mmGetCart.inspectFuncGetCart != nil {
-		mmGetCart.inspectFuncGetCart(ctx, userID)
+	if mmWithSweepLock.inspectFuncWithSweepLock != nil {
+		mmWithSweepLock.inspectFuncWithSweepLock(ctx, fn)
 	}
 
-	mm_params := RepositoryIfaceMockGetCartParams{ctx, userID}
+	mm_params := RepositoryIfaceMockWithSweepLockParams{ctx, fn}
 
 	// Record call args
-	mmGetCart.GetCartMock.mutex.Lock()
-	mmGetCart.GetCartMock.callArgs = append(mmGetCart.GetCartMock.callArgs, &mm_params)
-	mmGetCart.GetCartMock.mutex.Unlock()
+	mmWithSweepLock.WithSweepLockMock.mutex.Lock()
+	mmWithSweepLock.WithSweepLockMock.callArgs = append(mmWithSweepLock.WithSweepLockMock.callArgs, &mm_params)
+	mmWithSweepLock.WithSweepLockMock.mutex.Unlock()
 
-	for _, e := range mmGetCart.GetCartMock.expectations {
+	for _, e := range mmWithSweepLock.WithSweepLockMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.pa1, e.results.err
+			return e.results.b1, e.results.err
 		}
 	}
 
-	if mmGetCart.GetCartMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetCart.GetCartMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetCart.GetCartMock.defaultExpectation.params
-		mm_want_ptrs := mmGetCart.GetCartMock.defaultExpectation.paramPtrs
+	if mmWithSweepLock.WithSweepLockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWithSweepLock.WithSweepLockMock.defaultExpectation.Counter, 1)
+		mm_want := mmWithSweepLock.WithSweepLockMock.defaultExpectation.params
+		mm_want_ptrs := mmWithSweepLock.WithSweepLockMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryIfaceMockGetCartParams{ctx, userID}
+		mm_got := RepositoryIfaceMockWithSweepLockParams{ctx, fn}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetCart.t.Errorf("RepositoryIfaceMock.GetCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmWithSweepLock.t.Errorf("RepositoryIfaceMock.WithSweepLock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWithSweepLock.WithSweepLockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmGetCart.t.Errorf("RepositoryIfaceMock.GetCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmWithSweepLock.t.Errorf("RepositoryIfaceMock.WithSweepLock got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWithSweepLock.WithSweepLockMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetCart.t.Errorf("RepositoryIfaceMock.GetCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetCart.GetCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmWithSweepLock.t.Errorf("RepositoryIfaceMock.WithSweepLock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWithSweepLock.WithSweepLockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetCart.GetCartMock.defaultExpectation.results
+		mm_results := mmWithSweepLock.WithSweepLockMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetCart.t.Fatal("No results are set for the RepositoryIfaceMock.GetCart")
+			mmWithSweepLock.t.Fatal("No results are set for the RepositoryIfaceMock.WithSweepLock")
 		}
-		return (*mm_results).pa1, (*mm_results).err
+		return (*mm_results).b1, (*mm_results).err
 	}
-	if mmGetCart.funcGetCart != nil {
-		return mmGetCart.funcGetCart(ctx, userID)
+	if mmWithSweepLock.funcWithSweepLock != nil {
+		return mmWithSweepLock.funcWithSweepLock(ctx, fn)
 	}
-	mmGetCart.t.Fatalf("Unexpected call to RepositoryIfaceMock.GetCart. %v %v", ctx, userID)
+	mmWithSweepLock.t.Fatalf("Unexpected call to RepositoryIfaceMock.WithSweepLock. %v %v", ctx, fn)
 	return
 }
 
-// GetCartAfterCounter returns a count of finished RepositoryIfaceMock.GetCart invocations
-func (mmGetCart *RepositoryIfaceMock) GetCartAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCart.afterGetCartCounter)
+// WithSweepLockAfterCounter returns a count of finished RepositoryIfaceMock.WithSweepLock invocations
+func (mmWithSweepLock *RepositoryIfaceMock) WithSweepLockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWithSweepLock.afterWithSweepLockCounter)
 }
 
-// GetCartBeforeCounter returns a count of RepositoryIfaceMock.GetCart invocations
-func (mmGetCart *RepositoryIfaceMock) GetCartBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetCart.beforeGetCartCounter)
+// WithSweepLockBeforeCounter returns a count of RepositoryIfaceMock.WithSweepLock invocations
+func (mmWithSweepLock *RepositoryIfaceMock) WithSweepLockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWithSweepLock.beforeWithSweepLockCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryIfaceMock.GetCart.
+// Calls returns a list of arguments used in each call to RepositoryIfaceMock.WithSweepLock.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetCart *mRepositoryIfaceMockGetCart) Calls() []*RepositoryIfaceMockGetCartParams {
-	mmGetCart.mutex.RLock()
+func (mmWithSweepLock *mRepositoryIfaceMockWithSweepLock) Calls() []*RepositoryIfaceMockWithSweepLockParams {
+	mmWithSweepLock.mutex.RLock()
 
-	argCopy := make([]*RepositoryIfaceMockGetCartParams, len(mmGetCart.callArgs))
-	copy(argCopy, mmGetCart.callArgs)
+	argCopy := make([]*RepositoryIfaceMockWithSweepLockParams, len(mmWithSweepLock.callArgs))
+	copy(argCopy, mmWithSweepLock.callArgs)
 
-	mmGetCart.mutex.RUnlock()
+	mmWithSweepLock.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetCartDone returns true if the count of the GetCart invocations corresponds
+// MinimockWithSweepLockDone returns true if the count of the WithSweepLock invocations corresponds
 // the number of defined expectations
-func (m *RepositoryIfaceMock) MinimockGetCartDone() bool {
-	if m.GetCartMock.optional {
+func (m *RepositoryIfaceMock) MinimockWithSweepLockDone() bool {
+	if m.WithSweepLockMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetCartMock.expectations {
+	for _, e := range m.WithSweepLockMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetCartMock.invocationsDone()
+	return m.WithSweepLockMock.invocationsDone()
 }
 
-// MinimockGetCartInspect logs each unmet expectation
-func (m *RepositoryIfaceMock) MinimockGetCartInspect() {
-	for _, e := range m.GetCartMock.expectations {
+// MinimockWithSweepLockInspect logs each unmet expectation
+func (m *RepositoryIfaceMock) MinimockWithSweepLockInspect() {
+	for _, e := range m.WithSweepLockMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryIfaceMock.WithSweepLock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetCartCounter := mm_atomic.LoadUint64(&m.afterGetCartCounter)
+	afterWithSweepLockCounter := mm_atomic.LoadUint64(&m.afterWithSweepLockCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetCartMock.defaultExpectation != nil && afterGetCartCounter < 1 {
-		if m.GetCartMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCart at\n%s", m.GetCartMock.defaultExpectation.returnOrigin)
+	if m.WithSweepLockMock.defaultExpectation != nil && afterWithSweepLockCounter < 1 {
+		if m.WithSweepLockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryIfaceMock.WithSweepLock at\n%s", m.WithSweepLockMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryIfaceMock.GetCart at\n%s with params: %#v", m.GetCartMock.defaultExpectation.expectationOrigins.origin, *m.GetCartMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryIfaceMock.WithSweepLock at\n%s with params: %#v", m.WithSweepLockMock.defaultExpectation.expectationOrigins.origin, *m.WithSweepLockMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetCart != nil && afterGetCartCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryIfaceMock.GetCart at\n%s", m.funcGetCartOrigin)
+	if m.funcWithSweepLock != nil && afterWithSweepLockCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryIfaceMock.WithSweepLock at\n%s", m.funcWithSweepLockOrigin)
 	}
 
-	if !m.GetCartMock.invocationsDone() && afterGetCartCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.GetCart at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetCartMock.expectedInvocations), m.GetCartMock.expectedInvocationsOrigin, afterGetCartCounter)
+	if !m.WithSweepLockMock.invocationsDone() && afterWithSweepLockCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryIfaceMock.WithSweepLock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WithSweepLockMock.expectedInvocations), m.WithSweepLockMock.expectedInvocationsOrigin, afterWithSweepLockCounter)
 	}
 }
 
@@ -1539,13 +14197,77 @@ func (m *RepositoryIfaceMock) MinimockGetCartInspect() {
 func (m *RepositoryIfaceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAbandonedCartsInspect()
+
 			m.MinimockAddItemInspect()
 
+			m.MinimockApplyPromoInspect()
+
+			m.MinimockBulkUpdateInspect()
+
+			m.MinimockClaimIdempotencyKeyInspect()
+
 			m.MinimockClearCartInspect()
 
+			m.MinimockCreateCartShareInspect()
+
+			m.MinimockCreateGuestCartInspect()
+
+			m.MinimockCreateOrderInspect()
+
+			m.MinimockCreatePromoInspect()
+
+			m.MinimockDecrementItemInspect()
+
+			m.MinimockDeleteExpiredCartSharesInspect()
+
+			m.MinimockDeleteExpiredIdempotencyKeysInspect()
+
 			m.MinimockDeleteItemInspect()
 
+			m.MinimockDisablePromoInspect()
+
+			m.MinimockExpireCartsInspect()
+
 			m.MinimockGetCartInspect()
+
+			m.MinimockGetCartHistoryInspect()
+
+			m.MinimockGetCartPromoInspect()
+
+			m.MinimockGetCartShareInspect()
+
+			m.MinimockGetOrderInspect()
+
+			m.MinimockGuestCartIDInspect()
+
+			m.MinimockImportItemsInspect()
+
+			m.MinimockListOrdersInspect()
+
+			m.MinimockListPromosInspect()
+
+			m.MinimockListSavedInspect()
+
+			m.MinimockMarkAbandonedNotifiedInspect()
+
+			m.MinimockMergeCartsInspect()
+
+			m.MinimockMoveToCartInspect()
+
+			m.MinimockMoveToSavedInspect()
+
+			m.MinimockReleaseIdempotencyKeyInspect()
+
+			m.MinimockRemoveDelistedInspect()
+
+			m.MinimockRemovePromoInspect()
+
+			m.MinimockSaveIdempotentResponseInspect()
+
+			m.MinimockUpdateItemCountInspect()
+
+			m.MinimockWithSweepLockInspect()
 		}
 	})
 }
@@ -1569,8 +14291,40 @@ func (m *RepositoryIfaceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *RepositoryIfaceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAbandonedCartsDone() &&
 		m.MinimockAddItemDone() &&
+		m.MinimockApplyPromoDone() &&
+		m.MinimockBulkUpdateDone() &&
+		m.MinimockClaimIdempotencyKeyDone() &&
 		m.MinimockClearCartDone() &&
+		m.MinimockCreateCartShareDone() &&
+		m.MinimockCreateGuestCartDone() &&
+		m.MinimockCreateOrderDone() &&
+		m.MinimockCreatePromoDone() &&
+		m.MinimockDecrementItemDone() &&
+		m.MinimockDeleteExpiredCartSharesDone() &&
+		m.MinimockDeleteExpiredIdempotencyKeysDone() &&
 		m.MinimockDeleteItemDone() &&
-		m.MinimockGetCartDone()
+		m.MinimockDisablePromoDone() &&
+		m.MinimockExpireCartsDone() &&
+		m.MinimockGetCartDone() &&
+		m.MinimockGetCartHistoryDone() &&
+		m.MinimockGetCartPromoDone() &&
+		m.MinimockGetCartShareDone() &&
+		m.MinimockGetOrderDone() &&
+		m.MinimockGuestCartIDDone() &&
+		m.MinimockImportItemsDone() &&
+		m.MinimockListOrdersDone() &&
+		m.MinimockListPromosDone() &&
+		m.MinimockListSavedDone() &&
+		m.MinimockMarkAbandonedNotifiedDone() &&
+		m.MinimockMergeCartsDone() &&
+		m.MinimockMoveToCartDone() &&
+		m.MinimockMoveToSavedDone() &&
+		m.MinimockReleaseIdempotencyKeyDone() &&
+		m.MinimockRemoveDelistedDone() &&
+		m.MinimockRemovePromoDone() &&
+		m.MinimockSaveIdempotentResponseDone() &&
+		m.MinimockUpdateItemCountDone() &&
+		m.MinimockWithSweepLockDone()
 }
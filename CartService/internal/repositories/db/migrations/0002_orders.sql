@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orders (
+    id          BIGSERIAL PRIMARY KEY,
+    user_id     BIGINT      NOT NULL,
+    total_price BIGINT      NOT NULL CHECK (total_price >= 0),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS orders_user_id_idx ON orders (user_id, id);
+
+CREATE TABLE IF NOT EXISTS order_items (
+    order_id BIGINT NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    sku_id   BIGINT NOT NULL,
+    name     TEXT   NOT NULL,
+    count    BIGINT NOT NULL CHECK (count > 0),
+    price    BIGINT NOT NULL CHECK (price >= 0),
+    PRIMARY KEY (order_id, sku_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS order_items;
+DROP TABLE IF EXISTS orders;
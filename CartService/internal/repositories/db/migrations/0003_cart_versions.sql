@@ -0,0 +1,8 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS carts (
+    user_id BIGINT PRIMARY KEY,
+    version BIGINT NOT NULL CHECK (version > 0)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS carts;
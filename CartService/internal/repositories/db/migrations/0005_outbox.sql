@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS outbox (
+    id           BIGSERIAL   PRIMARY KEY,
+    user_id      BIGINT      NOT NULL,
+    cart_version BIGINT      NOT NULL,
+    event_type   TEXT        NOT NULL,
+    payload      JSONB       NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox;
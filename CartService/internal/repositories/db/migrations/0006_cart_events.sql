@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS cart_events (
+    id           BIGSERIAL   PRIMARY KEY,
+    user_id      BIGINT      NOT NULL,
+    cart_version BIGINT      NOT NULL,
+    operation    TEXT        NOT NULL,
+    sku_id       BIGINT      NOT NULL,
+    delta        BIGINT      NOT NULL,
+    old_count    BIGINT      NOT NULL,
+    new_count    BIGINT      NOT NULL,
+    caller       TEXT        NOT NULL DEFAULT '',
+    request_id   TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS cart_events_user_id_idx ON cart_events (user_id, id);
+
+-- +goose Down
+DROP TABLE IF EXISTS cart_events;
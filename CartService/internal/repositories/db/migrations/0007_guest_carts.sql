@@ -0,0 +1,13 @@
+-- +goose Up
+-- guest carts live in Cart under ids from 2^62 up, below that are user ids
+CREATE SEQUENCE IF NOT EXISTS guest_cart_ids START WITH 4611686018427387904;
+
+CREATE TABLE IF NOT EXISTS guest_carts (
+    token_hash TEXT        PRIMARY KEY,
+    cart_id    BIGINT      NOT NULL UNIQUE DEFAULT nextval('guest_cart_ids'),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS guest_carts;
+DROP SEQUENCE IF EXISTS guest_cart_ids;
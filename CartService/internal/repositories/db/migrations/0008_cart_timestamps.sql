@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE cart
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+-- updated_at of the header moves with every mutation of the cart
+ALTER TABLE carts
+    ADD COLUMN IF NOT EXISTS updated_at            TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN IF NOT EXISTS abandoned_notified_at TIMESTAMPTZ;
+
+-- carts from before 0003 have no header and would never expire. Nothing
+-- records when they last changed, so like all existing headers they count as
+-- changed now and expire one idle period after the migration.
+INSERT INTO carts (user_id, version)
+SELECT DISTINCT user_id, 1 FROM cart
+ON CONFLICT (user_id) DO NOTHING;
+
+CREATE INDEX IF NOT EXISTS carts_updated_at_idx ON carts (updated_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS carts_updated_at_idx;
+ALTER TABLE carts DROP COLUMN IF EXISTS abandoned_notified_at, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE cart DROP COLUMN IF EXISTS updated_at, DROP COLUMN IF EXISTS created_at;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- price of the sku when the user last added it, NULL for older rows
+ALTER TABLE cart ADD COLUMN IF NOT EXISTS added_price BIGINT;
+
+-- +goose Down
+ALTER TABLE cart DROP COLUMN IF EXISTS added_price;
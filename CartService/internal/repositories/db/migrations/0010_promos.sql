@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS promos (
+    code         TEXT        PRIMARY KEY,
+    kind         TEXT        NOT NULL,
+    percent      INT         NOT NULL DEFAULT 0 CHECK (percent BETWEEN 0 AND 100),
+    amount       BIGINT      NOT NULL DEFAULT 0 CHECK (amount >= 0),
+    sku_id       BIGINT      NOT NULL DEFAULT 0,
+    buy_count    BIGINT      NOT NULL DEFAULT 0 CHECK (buy_count >= 0),
+    free_count   BIGINT      NOT NULL DEFAULT 0 CHECK (free_count >= 0),
+    min_subtotal BIGINT      NOT NULL DEFAULT 0 CHECK (min_subtotal >= 0),
+    disabled     BOOLEAN     NOT NULL DEFAULT false,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- the promo code attached to a cart, goes away with the cart header
+CREATE TABLE IF NOT EXISTS cart_promos (
+    user_id    BIGINT      PRIMARY KEY REFERENCES carts (user_id) ON DELETE CASCADE,
+    code       TEXT        NOT NULL REFERENCES promos (code),
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS cart_promos;
+DROP TABLE IF EXISTS promos;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- currency of total_price and the item prices, orders placed before it were in RUB
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB';
+
+-- +goose Down
+ALTER TABLE orders DROP COLUMN IF EXISTS currency;
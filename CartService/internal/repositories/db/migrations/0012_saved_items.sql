@@ -0,0 +1,12 @@
+-- +goose Up
+-- items the user moved out of the cart to buy later, they hold no stock
+CREATE TABLE IF NOT EXISTS saved_items (
+    user_id  BIGINT      NOT NULL,
+    sku_id   BIGINT      NOT NULL,
+    count    BIGINT      NOT NULL CHECK (count > 0),
+    saved_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, sku_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS saved_items;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- immutable snapshots of carts shared by link, the link carries the ID
+CREATE TABLE IF NOT EXISTS cart_shares (
+    id          BIGSERIAL PRIMARY KEY,
+    user_id     BIGINT      NOT NULL,
+    total_price BIGINT      NOT NULL CHECK (total_price >= 0),
+    currency    TEXT        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at  TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS cart_shares_expires_at_idx ON cart_shares (expires_at);
+
+CREATE TABLE IF NOT EXISTS cart_share_items (
+    share_id BIGINT NOT NULL REFERENCES cart_shares (id) ON DELETE CASCADE,
+    sku_id   BIGINT NOT NULL,
+    name     TEXT   NOT NULL,
+    count    BIGINT NOT NULL CHECK (count > 0),
+    price    BIGINT NOT NULL CHECK (price >= 0),
+    PRIMARY KEY (share_id, sku_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS cart_share_items;
+DROP TABLE IF EXISTS cart_shares;
@@ -0,0 +1,66 @@
+package postgres
+
+import "context"
+
+// OpBulk is recorded for positions changed by BulkUpdate.
+const OpBulk = "bulk"
+
+// EventCartBulkUpdated carries a CartEvent with the new counts of the changed
+// positions, zero for removed ones.
+const EventCartBulkUpdated = "CartBulkUpdated"
+
+// CountChange takes a position from Old to New units, zero meaning there is
+// no position. A non-zero Price becomes the added price.
+type CountChange struct {
+	SkuID uint64
+	Old   uint64
+	New   uint64
+	Price uint64
+}
+
+// BulkUpdate applies the changes to the user's cart in one transaction. If a
+// position no longer holds Old units the transaction is rolled back with
+// ErrCartChanged.
+func (s *Store) BulkUpdate(ctx context.Context, userID uint64, changes []CountChange, expected *uint64) error {
+	return s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		skus := make([]uint64, 0, len(changes))
+		for _, ch := range changes {
+			skus = append(skus, ch.SkuID)
+		}
+		query := `SELECT sku_id, count, COALESCE(added_price, 0) FROM Cart WHERE user_id=$1 AND sku_id = ANY($2)`
+		positions, err := queryPositions(ctx, tx, query, userID, skus)
+		if err != nil {
+			return err
+		}
+		current := make(map[uint64]uint64, len(positions))
+		for _, p := range positions {
+			current[p.SkuID] = p.Count
+		}
+
+		event := CartEvent{Items: make([]EventItem, 0, len(changes))}
+		for _, ch := range changes {
+			if current[ch.SkuID] != ch.Old {
+				return ErrCartChanged
+			}
+
+			if ch.New == 0 {
+				query = `DELETE FROM Cart WHERE user_id=$1 AND sku_id=$2`
+				_, err = tx.Exec(ctx, query, userID, ch.SkuID)
+			} else {
+				query = `INSERT INTO Cart (user_id, sku_id, count, added_price) VALUES ($1, $2, $3, NULLIF($4, 0))
+							ON CONFLICT (user_id, sku_id) DO UPDATE SET count = EXCLUDED.count,
+								added_price = COALESCE(EXCLUDED.added_price, cart.added_price), updated_at = now()`
+				_, err = tx.Exec(ctx, query, userID, ch.SkuID, ch.New, ch.Price)
+			}
+			if err != nil {
+				return err
+			}
+			if err := tx.record(ctx, OpBulk, ch.SkuID, ch.Old, ch.New); err != nil {
+				return err
+			}
+			event.Items = append(event.Items, EventItem{SkuID: ch.SkuID, Count: ch.New})
+		}
+
+		return tx.emit(ctx, EventCartBulkUpdated, event)
+	})
+}
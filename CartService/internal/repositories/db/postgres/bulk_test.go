@@ -0,0 +1,71 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+func TestBulkUpdate_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 8, nil, 6)
+	mockPool.ExpectQuery(`(?i)SELECT\s+sku_id,\s*count.*FROM\s+Cart`).
+		WithArgs(uint64(8), []uint64{1001, 1002, 1003}).
+		WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count", "added_price"}).
+			AddRow(uint64(1001), uint64(1), uint64(1500)).
+			AddRow(uint64(1003), uint64(4), uint64(100)))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+Cart\s+\(`).
+		WithArgs(uint64(8), uint64(1001), uint64(3), uint64(1400)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	expectRecord(mockPool, 8, 6, postgres.OpBulk, 1001, 1, 3)
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+Cart\s+\(`).
+		WithArgs(uint64(8), uint64(1002), uint64(2), uint64(900)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	expectRecord(mockPool, 8, 6, postgres.OpBulk, 1002, 0, 2)
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+Cart`).
+		WithArgs(uint64(8), uint64(1003)).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	expectRecord(mockPool, 8, 6, postgres.OpBulk, 1003, 4, 0)
+	expectEvent(mockPool, 8, 6, postgres.EventCartBulkUpdated,
+		`{"items":[{"sku_id":1001,"count":3},{"sku_id":1002,"count":2},{"sku_id":1003,"count":0}]}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	err := store.BulkUpdate(ctx, 8, []postgres.CountChange{
+		{SkuID: 1001, Old: 1, New: 3, Price: 1400},
+		{SkuID: 1002, Old: 0, New: 2, Price: 900},
+		{SkuID: 1003, Old: 4, New: 0},
+	}, nil)
+
+	require.NoError(t, err)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestBulkUpdate_CartChanged(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 8, nil, 6)
+	mockPool.ExpectQuery(`(?i)SELECT\s+sku_id,\s*count.*FROM\s+Cart`).
+		WithArgs(uint64(8), []uint64{1001}).
+		WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count", "added_price"}).
+			AddRow(uint64(1001), uint64(2), uint64(1500)))
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	err := store.BulkUpdate(ctx, 8, []postgres.CountChange{{SkuID: 1001, Old: 1, New: 3, Price: 1400}}, nil)
+
+	require.ErrorIs(t, err, postgres.ErrCartChanged)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import "context"
+
+// OpDelisted is recorded for positions removed because their product is gone.
+const OpDelisted = "delisted"
+
+// RemoveDelisted deletes the positions of the given skus and returns them
+// with the new cart version. Skus no longer in the cart are skipped.
+func (s *Store) RemoveDelisted(ctx context.Context, userID uint64, skus []uint64) ([]Position, uint64, error) {
+	var (
+		removed []Position
+		version uint64
+	)
+	err := s.mutate(ctx, userID, nil, func(tx cartTx) error {
+		version = tx.version
+
+		query := `DELETE FROM Cart WHERE user_id=$1 AND sku_id = ANY($2)
+					RETURNING sku_id, count, COALESCE(added_price, 0)`
+		var err error
+		if removed, err = queryPositions(ctx, tx, query, userID, skus); err != nil {
+			return err
+		}
+
+		for _, p := range removed {
+			if err := tx.record(ctx, OpDelisted, p.SkuID, p.Count, 0); err != nil {
+				return err
+			}
+			if err := tx.emit(ctx, EventItemRemoved, ItemEvent{SkuID: p.SkuID, Delta: -int64(p.Count)}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return removed, version, nil
+}
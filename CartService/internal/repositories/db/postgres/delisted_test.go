@@ -0,0 +1,34 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+func TestRemoveDelisted_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 7, nil, 5)
+	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+Cart\s+WHERE\s+user_id=\$1\s+AND\s+sku_id\s*=\s*ANY\(\$2\)`).
+		WithArgs(uint64(7), []uint64{1002, 1003}).
+		WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count", "added_price"}).AddRow(uint64(1002), uint64(3), uint64(900)))
+	expectRecord(mockPool, 7, 5, postgres.OpDelisted, 1002, 3, 0)
+	expectEvent(mockPool, 7, 5, postgres.EventItemRemoved, `{"sku_id":1002,"count":0,"delta":-3}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	removed, version, err := store.RemoveDelisted(ctx, 7, []uint64{1002, 1003})
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), version)
+	require.Equal(t, []postgres.Position{{SkuID: 1002, Count: 3, AddedPrice: 900}}, removed)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
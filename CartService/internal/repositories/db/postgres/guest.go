@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateGuestCart registers a guest cart under the hash of its token and
+// returns the cart ID its positions are kept under.
+func (s *Store) CreateGuestCart(ctx context.Context, tokenHash string) (uint64, error) {
+	query := `INSERT INTO guest_carts (token_hash) VALUES ($1) RETURNING cart_id`
+	var cartID uint64
+	if err := s.pool.QueryRow(ctx, query, tokenHash).Scan(&cartID); err != nil {
+		return 0, err
+	}
+
+	return cartID, nil
+}
+
+// GuestCartID returns the cart ID of a guest token hash, ErrNotFound if there
+// is no such guest cart.
+func (s *Store) GuestCartID(ctx context.Context, tokenHash string) (uint64, error) {
+	query := `SELECT cart_id FROM guest_carts WHERE token_hash=$1`
+	var cartID uint64
+	err := s.pool.QueryRow(ctx, query, tokenHash).Scan(&cartID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return cartID, nil
+}
+
+// MergeCarts moves the guest cart and its saved list into the user's ones
+// and deletes the guest cart in one transaction. plan gets the positions of
+// both carts and returns the new counts of the user's positions it changes,
+// an error rolls the merge back. The units of both carts that did not make it into the user's cart are
+// returned, their stock is still reserved. ErrNotFound means the guest cart
+// is gone, e.g. merged by a concurrent call.
+func (s *Store) MergeCarts(ctx context.Context, guestID, userID uint64, plan func(user, guest []Position) (map[uint64]uint64, error)) ([]Position, error) {
+	var dropped []Position
+	err := s.mutate(ctx, userID, nil, func(tx cartTx) error {
+		query := `DELETE FROM guest_carts WHERE cart_id=$1`
+		tag, err := tx.Exec(ctx, query, guestID)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrNotFound
+		}
+
+		// waits for a mutation of the guest cart that is still running
+		query = `DELETE FROM carts WHERE user_id=$1`
+		if _, err := tx.Exec(ctx, query, guestID); err != nil {
+			return err
+		}
+
+		// the saved list moves over too, an item both saved keeps the larger count
+		query = `WITH moved AS (DELETE FROM saved_items WHERE user_id=$1 RETURNING sku_id, count, saved_at)
+					INSERT INTO saved_items (user_id, sku_id, count, saved_at) SELECT $2, sku_id, count, saved_at FROM moved
+					ON CONFLICT (user_id, sku_id) DO UPDATE SET count = GREATEST(saved_items.count, EXCLUDED.count)`
+		if _, err := tx.Exec(ctx, query, guestID, userID); err != nil {
+			return err
+		}
+
+		query = `SELECT sku_id, count, COALESCE(added_price, 0) FROM Cart WHERE user_id=$1 ORDER BY sku_id`
+		user, err := queryPositions(ctx, tx, query, userID)
+		if err != nil {
+			return err
+		}
+		query = `DELETE FROM Cart WHERE user_id=$1 RETURNING sku_id, count, COALESCE(added_price, 0)`
+		guest, err := queryPositions(ctx, tx, query, guestID)
+		if err != nil {
+			return err
+		}
+		sort.Slice(guest, func(i, j int) bool { return guest[i].SkuID < guest[j].SkuID })
+
+		counts, err := plan(user, guest)
+		if err != nil {
+			return err
+		}
+
+		before := make(map[uint64]uint64, len(user))
+		for _, p := range user {
+			before[p.SkuID] = p.Count
+		}
+		guestPrices := make(map[uint64]uint64, len(guest))
+		for _, p := range guest {
+			guestPrices[p.SkuID] = p.AddedPrice
+			n, ok := counts[p.SkuID]
+			if !ok {
+				n = before[p.SkuID]
+			}
+			if left := before[p.SkuID] + p.Count - n; left > 0 {
+				dropped = append(dropped, Position{SkuID: p.SkuID, Count: left})
+			}
+		}
+
+		skus := make([]uint64, 0, len(counts))
+		for sku := range counts {
+			skus = append(skus, sku)
+		}
+		sort.Slice(skus, func(i, j int) bool { return skus[i] < skus[j] })
+
+		items := []EventItem{}
+		// a position the user had keeps its added price
+		query = `INSERT INTO Cart (user_id, sku_id, count, added_price) VALUES ($1, $2, $3, NULLIF($4, 0))
+					ON CONFLICT (user_id, sku_id) DO UPDATE SET count = EXCLUDED.count, updated_at = now()`
+		for _, sku := range skus {
+			n := counts[sku]
+			if n == before[sku] {
+				continue
+			}
+			if _, err := tx.Exec(ctx, query, userID, sku, n, guestPrices[sku]); err != nil {
+				return err
+			}
+			if err := tx.record(ctx, OpMerge, sku, before[sku], n); err != nil {
+				return err
+			}
+			items = append(items, EventItem{SkuID: sku, Count: n})
+		}
+
+		return tx.emit(ctx, EventCartsMerged, CartEvent{GuestCartID: guestID, Items: items})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dropped, nil
+}
+
+// queryPositions reads sku_id, count and added_price rows.
+func queryPositions(ctx context.Context, tx pgx.Tx, query string, args ...any) ([]Position, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ans []Position
+	for rows.Next() {
+		var p Position
+		if err := rows.Scan(&p.SkuID, &p.Count, &p.AddedPrice); err != nil {
+			return nil, err
+		}
+		ans = append(ans, p)
+	}
+
+	return ans, rows.Err()
+}
@@ -0,0 +1,84 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+const guestID = uint64(1<<62 + 1)
+
+func TestMergeCarts_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 1, nil, 4)
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+guest_carts\s+WHERE\s+cart_id=\$1`).
+		WithArgs(guestID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+carts\s+WHERE\s+user_id=\$1`).
+		WithArgs(guestID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mockPool.ExpectExec(`(?is)DELETE\s+FROM\s+saved_items\s+WHERE\s+user_id=\$1.*INSERT\s+INTO\s+saved_items`).
+		WithArgs(guestID, uint64(1)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mockPool.ExpectQuery(`(?i)SELECT\s+sku_id,\s*count,\s*COALESCE\(added_price,\s*0\)\s+FROM\s+Cart\s+WHERE\s+user_id=\$1`).
+		WithArgs(uint64(1)).
+		WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count", "added_price"}).AddRow(uint64(1001), uint64(2), uint64(1500)))
+	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+Cart\s+WHERE\s+user_id=\$1\s+RETURNING`).
+		WithArgs(guestID).
+		WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count", "added_price"}).
+			AddRow(uint64(1002), uint64(1), uint64(900)).
+			AddRow(uint64(1001), uint64(3), uint64(1400)))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+Cart\s+\(`).
+		WithArgs(uint64(1), uint64(1001), uint64(4), uint64(1400)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	expectRecord(mockPool, 1, 4, postgres.OpMerge, 1001, 2, 4)
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+Cart\s+\(`).
+		WithArgs(uint64(1), uint64(1002), uint64(1), uint64(900)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	expectRecord(mockPool, 1, 4, postgres.OpMerge, 1002, 0, 1)
+	expectEvent(mockPool, 1, 4, postgres.EventCartsMerged,
+		`{"guest_cart_id":4611686018427387905,"items":[{"sku_id":1001,"count":4},{"sku_id":1002,"count":1}]}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	dropped, err := store.MergeCarts(ctx, guestID, 1, func(user, guest []postgres.Position) (map[uint64]uint64, error) {
+		require.Equal(t, []postgres.Position{{SkuID: 1001, Count: 2, AddedPrice: 1500}}, user)
+		require.Equal(t, []postgres.Position{{SkuID: 1001, Count: 3, AddedPrice: 1400}, {SkuID: 1002, Count: 1, AddedPrice: 900}}, guest)
+		return map[uint64]uint64{1001: 4, 1002: 1}, nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []postgres.Position{{SkuID: 1001, Count: 1}}, dropped)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestMergeCarts_GuestCartGone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 1, nil, 4)
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+guest_carts`).
+		WithArgs(guestID).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	_, err := store.MergeCarts(ctx, guestID, 1, func(user, guest []postgres.Position) (map[uint64]uint64, error) {
+		t.Fatal("nothing to merge")
+		return nil, nil
+	})
+
+	require.ErrorIs(t, err, postgres.ErrNotFound)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
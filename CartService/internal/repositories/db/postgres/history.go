@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/verbovyar/OzonCart/internal/audit"
+)
+
+// Operations recorded in the cart history.
+const (
+	OpAdd       = "add"
+	OpUpdate    = "update"
+	OpDecrement = "decrement"
+	OpDelete    = "delete"
+	OpClear     = "clear"
+	OpCheckout  = "checkout"
+	OpMerge     = "merge"
+)
+
+// HistoryEntry is the change of one position by one mutation.
+type HistoryEntry struct {
+	ID        uint64
+	Version   uint64
+	Operation string
+	SkuID     uint64
+	Delta     int64
+	OldCount  uint64
+	NewCount  uint64
+	Caller    string
+	RequestID string
+	CreatedAt time.Time
+}
+
+// record adds the change of a position to the cart history, together with
+// the caller of the request in ctx.
+func (tx cartTx) record(ctx context.Context, op string, skuID, oldCount, newCount uint64) error {
+	c := audit.FromContext(ctx)
+	query := `INSERT INTO cart_events
+				(user_id, cart_version, operation, sku_id, delta, old_count, new_count, caller, request_id)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+	_, err := tx.Exec(ctx, query, tx.userID, tx.version, op, skuID,
+		int64(newCount)-int64(oldCount), oldCount, newCount, c.Identity, c.RequestID)
+	return err
+}
+
+// GetCartHistory returns up to limit history entries of the user made at or
+// after since and with an ID above after, oldest first.
+func (s *Store) GetCartHistory(ctx context.Context, userID uint64, since time.Time, after uint64, limit int) ([]HistoryEntry, error) {
+	query := `SELECT id, cart_version, operation, sku_id, delta, old_count, new_count, caller, request_id, created_at
+				FROM cart_events
+				WHERE user_id=$1 AND created_at >= $2 AND id > $3
+				ORDER BY id LIMIT $4`
+	rows, err := s.pool.Query(ctx, query, userID, since, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ans []HistoryEntry
+	for rows.Next() {
+		var e HistoryEntry
+		err := rows.Scan(&e.ID, &e.Version, &e.Operation, &e.SkuID, &e.Delta,
+			&e.OldCount, &e.NewCount, &e.Caller, &e.RequestID, &e.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		ans = append(ans, e)
+	}
+
+	return ans, rows.Err()
+}
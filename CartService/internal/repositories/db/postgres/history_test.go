@@ -0,0 +1,62 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/audit"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+func TestDeleteItem_RecordsCaller(t *testing.T) {
+	t.Parallel()
+	ctx := audit.WithCaller(context.Background(), audit.Caller{Identity: "support:alice", RequestID: "req-1"})
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 1, nil, 6)
+	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+Cart\s+WHERE\s+user_id=\$1\s+AND\s+sku_id=\$2`).
+		WithArgs(uint64(1), uint64(1001)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint64(3)))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+cart_events\s`).
+		WithArgs(uint64(1), uint64(6), postgres.OpDelete, uint64(1001), int64(-3), uint64(3), uint64(0), "support:alice", "req-1").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	expectEvent(mockPool, 1, 6, postgres.EventItemRemoved, `{"sku_id":1001,"count":0,"delta":-3}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	_, err := store.DeleteItem(ctx, 1, 1001, nil)
+
+	require.NoError(t, err)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestGetCartHistory_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := since.Add(time.Hour)
+	mockPool.ExpectQuery(`(?i)SELECT\s+id,.*FROM\s+cart_events\s+WHERE\s+user_id=\$1\s+AND\s+created_at\s*>=\s*\$2\s+AND\s+id\s*>\s*\$3\s+ORDER\s+BY\s+id\s+LIMIT\s+\$4`).
+		WithArgs(uint64(1), since, uint64(10), 3).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "cart_version", "operation", "sku_id", "delta", "old_count", "new_count", "caller", "request_id", "created_at"}).
+			AddRow(uint64(11), uint64(4), postgres.OpAdd, uint64(1001), int64(2), uint64(0), uint64(2), "user", "req-1", at).
+			AddRow(uint64(12), uint64(5), postgres.OpDelete, uint64(1001), int64(-2), uint64(2), uint64(0), "support:alice", "req-2", at))
+
+	store := postgres.New(mockPool)
+	out, err := store.GetCartHistory(ctx, 1, since, 10, 3)
+
+	require.NoError(t, err)
+	require.Equal(t, []postgres.HistoryEntry{
+		{ID: 11, Version: 4, Operation: postgres.OpAdd, SkuID: 1001, Delta: 2, OldCount: 0, NewCount: 2, Caller: "user", RequestID: "req-1", CreatedAt: at},
+		{ID: 12, Version: 5, Operation: postgres.OpDelete, SkuID: 1001, Delta: -2, OldCount: 2, NewCount: 0, Caller: "support:alice", RequestID: "req-2", CreatedAt: at},
+	}, out)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// IdempotencyRecord is what is kept for an idempotency key. Response is nil
+// while the first request is still running.
+type IdempotencyRecord struct {
+	Fingerprint string
+	Response    []byte
+}
+
+// ClaimIdempotencyKey reserves the key for a new request for lease. If the key
+// is held by a live record, that record is returned and claimed is false.
+func (s *Store) ClaimIdempotencyKey(ctx context.Context, userID uint64, key, fingerprint string, lease time.Duration) (*IdempotencyRecord, bool, error) {
+	query := `INSERT INTO idempotency_keys (user_id, key, fingerprint, expires_at)
+				VALUES ($1, $2, $3, now() + $4 * interval '1 millisecond')
+				ON CONFLICT (user_id, key) DO UPDATE
+				SET fingerprint = EXCLUDED.fingerprint, response = NULL, expires_at = EXCLUDED.expires_at
+				WHERE idempotency_keys.expires_at < now()
+				RETURNING fingerprint`
+	var fp string
+	err := s.pool.QueryRow(ctx, query, userID, key, fingerprint, lease.Milliseconds()).Scan(&fp)
+	if err == nil {
+		return nil, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, err
+	}
+
+	var rec IdempotencyRecord
+	query = `SELECT fingerprint, response FROM idempotency_keys WHERE user_id=$1 AND key=$2`
+	err = s.pool.QueryRow(ctx, query, userID, key).Scan(&rec.Fingerprint, &rec.Response)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// released in between, report it as still running
+		return &IdempotencyRecord{Fingerprint: fingerprint}, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &rec, false, nil
+}
+
+// SaveIdempotentResponse stores the response of a claimed key and keeps it for ttl.
+func (s *Store) SaveIdempotentResponse(ctx context.Context, userID uint64, key string, response []byte, ttl time.Duration) error {
+	query := `UPDATE idempotency_keys SET response = $3, expires_at = now() + $4 * interval '1 millisecond'
+				WHERE user_id=$1 AND key=$2`
+	_, err := s.pool.Exec(ctx, query, userID, key, response, ttl.Milliseconds())
+
+	return err
+}
+
+// ReleaseIdempotencyKey drops a claim whose request failed, so it can be retried.
+func (s *Store) ReleaseIdempotencyKey(ctx context.Context, userID uint64, key string) error {
+	query := `DELETE FROM idempotency_keys WHERE user_id=$1 AND key=$2 AND response IS NULL`
+	_, err := s.pool.Exec(ctx, query, userID, key)
+
+	return err
+}
+
+// DeleteExpiredIdempotencyKeys removes expired records and returns how many.
+func (s *Store) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	query := `DELETE FROM idempotency_keys WHERE expires_at < now()`
+	tag, err := s.pool.Exec(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
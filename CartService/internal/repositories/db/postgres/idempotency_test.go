@@ -0,0 +1,70 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+func TestClaimIdempotencyKey_Claimed(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+idempotency_keys.*WHERE\s+idempotency_keys\.expires_at\s*<\s*now\(\)`).
+		WithArgs(uint64(1), "k1", "fp", int64(60000)).
+		WillReturnRows(pgxmock.NewRows([]string{"fingerprint"}).AddRow("fp"))
+
+	store := postgres.New(mockPool)
+	rec, claimed, err := store.ClaimIdempotencyKey(ctx, 1, "k1", "fp", time.Minute)
+
+	require.NoError(t, err)
+	require.True(t, claimed)
+	require.Nil(t, rec)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestClaimIdempotencyKey_Taken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+idempotency_keys`).
+		WithArgs(uint64(1), "k1", "fp", int64(60000)).
+		WillReturnRows(pgxmock.NewRows([]string{"fingerprint"}))
+	mockPool.ExpectQuery(`(?i)SELECT\s+fingerprint,\s*response\s+FROM\s+idempotency_keys`).
+		WithArgs(uint64(1), "k1").
+		WillReturnRows(pgxmock.NewRows([]string{"fingerprint", "response"}).AddRow("fp", []byte("first")))
+
+	store := postgres.New(mockPool)
+	rec, claimed, err := store.ClaimIdempotencyKey(ctx, 1, "k1", "fp", time.Minute)
+
+	require.NoError(t, err)
+	require.False(t, claimed)
+	require.Equal(t, &postgres.IdempotencyRecord{Fingerprint: "fp", Response: []byte("first")}, rec)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestSaveIdempotentResponse(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	mockPool.ExpectExec(`(?i)UPDATE\s+idempotency_keys\s+SET\s+response`).
+		WithArgs(uint64(1), "k1", []byte("ok"), int64(86400000)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+	store := postgres.New(mockPool)
+	require.NoError(t, store.SaveIdempotentResponse(ctx, 1, "k1", []byte("ok"), 24*time.Hour))
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
@@ -0,0 +1,153 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrCartChanged = errors.New("cart changed")
+)
+
+type OrderItem struct {
+	SkuID uint64
+	Name  string
+	Count uint64
+	Price uint64
+}
+
+// Order prices are minor units of Currency.
+type Order struct {
+	ID         uint64
+	UserID     uint64
+	TotalPrice uint64
+	Currency   string
+	CreatedAt  time.Time
+	Items      []OrderItem
+}
+
+// CreateOrder saves the order with its items and removes the ordered
+// positions and the promo code from the user's cart in one transaction.
+// Positions left out of items stay in the cart. total includes the
+// discounts. If the cart no longer matches the snapshot in items the
+// transaction is rolled back with ErrCartChanged.
+func (s *Store) CreateOrder(ctx context.Context, userID uint64, items []OrderItem, total money.Money, expected *uint64) (uint64, error) {
+	var orderID uint64
+	err := s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `INSERT INTO orders (user_id, total_price, currency) VALUES ($1, $2, $3) RETURNING id`
+		if err := tx.QueryRow(ctx, query, userID, total.Amount, total.Currency).Scan(&orderID); err != nil {
+			return err
+		}
+
+		query = `INSERT INTO order_items (order_id, sku_id, name, count, price) VALUES ($1, $2, $3, $4, $5)`
+		for _, it := range items {
+			if _, err := tx.Exec(ctx, query, orderID, it.SkuID, it.Name, it.Count, it.Price); err != nil {
+				return err
+			}
+		}
+
+		skus := make([]uint64, 0, len(items))
+		for _, it := range items {
+			skus = append(skus, it.SkuID)
+		}
+		query = `DELETE FROM cart WHERE user_id=$1 AND sku_id = ANY($2) RETURNING sku_id, count`
+		rows, err := tx.Query(ctx, query, userID, skus)
+		if err != nil {
+			return err
+		}
+		cleared := make(map[uint64]uint64)
+		for rows.Next() {
+			var p Position
+			if err := rows.Scan(&p.SkuID, &p.Count); err != nil {
+				rows.Close()
+				return err
+			}
+			cleared[p.SkuID] = p.Count
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		event := CartEvent{OrderID: orderID, Items: make([]EventItem, 0, len(items))}
+		for _, it := range items {
+			if cleared[it.SkuID] != it.Count {
+				return ErrCartChanged
+			}
+			event.Items = append(event.Items, EventItem{SkuID: it.SkuID, Count: it.Count})
+			if err := tx.record(ctx, OpCheckout, it.SkuID, it.Count, 0); err != nil {
+				return err
+			}
+		}
+
+		// a promo code is used up by the order
+		if _, err := tx.Exec(ctx, `DELETE FROM cart_promos WHERE user_id=$1`, userID); err != nil {
+			return err
+		}
+
+		return tx.emit(ctx, EventCartCheckedOut, event)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return orderID, nil
+}
+
+func (s *Store) GetOrder(ctx context.Context, userID, orderID uint64) (*Order, error) {
+	query := `SELECT o.id, o.user_id, o.total_price, o.currency, o.created_at, i.sku_id, i.name, i.count, i.price
+				FROM orders o JOIN order_items i ON i.order_id = o.id
+				WHERE o.id=$1 AND o.user_id=$2 ORDER BY i.sku_id`
+	rows, err := s.pool.Query(ctx, query, orderID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders, err := scanOrders(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return &orders[0], nil
+}
+
+func (s *Store) ListOrders(ctx context.Context, userID uint64) ([]Order, error) {
+	query := `SELECT o.id, o.user_id, o.total_price, o.currency, o.created_at, i.sku_id, i.name, i.count, i.price
+				FROM orders o JOIN order_items i ON i.order_id = o.id
+				WHERE o.user_id=$1 ORDER BY o.id DESC, i.sku_id`
+	rows, err := s.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanOrders(rows)
+}
+
+// scanOrders folds order/item join rows into orders, keeping the row order.
+func scanOrders(rows pgx.Rows) ([]Order, error) {
+	var ans []Order
+	for rows.Next() {
+		var o Order
+		var it OrderItem
+		if err := rows.Scan(&o.ID, &o.UserID, &o.TotalPrice, &o.Currency, &o.CreatedAt, &it.SkuID, &it.Name, &it.Count, &it.Price); err != nil {
+			return nil, err
+		}
+		if len(ans) == 0 || ans[len(ans)-1].ID != o.ID {
+			ans = append(ans, o)
+		}
+		last := &ans[len(ans)-1]
+		last.Items = append(last.Items, it)
+	}
+
+	return ans, rows.Err()
+}
@@ -0,0 +1,129 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+func TestCreateOrder_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	defer mockPool.Close()
+
+	expectBump(mockPool, 7, nil, 4)
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+orders`).
+		WithArgs(uint64(7), uint64(3900), "RUB").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(uint64(42)))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+order_items`).
+		WithArgs(uint64(42), uint64(1001), "Demo T-Shirt", uint64(2), uint64(1500)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+order_items`).
+		WithArgs(uint64(42), uint64(1002), "Coffee Mug", uint64(1), uint64(900)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+cart\s+WHERE\s+user_id=\$1\s+AND\s+sku_id\s*=\s*ANY\(\$2\)`).
+		WithArgs(uint64(7), []uint64{1001, 1002}).
+		WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count"}).
+			AddRow(uint64(1001), uint64(2)).
+			AddRow(uint64(1002), uint64(1)))
+	expectRecord(mockPool, 7, 4, postgres.OpCheckout, 1001, 2, 0)
+	expectRecord(mockPool, 7, 4, postgres.OpCheckout, 1002, 1, 0)
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+cart_promos\s+WHERE\s+user_id=\$1`).
+		WithArgs(uint64(7)).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	expectEvent(mockPool, 7, 4, postgres.EventCartCheckedOut, `{"order_id":42,"items":[{"sku_id":1001,"count":2},{"sku_id":1002,"count":1}]}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	orderID, err := store.CreateOrder(ctx, 7, []postgres.OrderItem{
+		{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: 1500},
+		{SkuID: 1002, Name: "Coffee Mug", Count: 1, Price: 900},
+	}, money.New(3900, "RUB"), nil)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), orderID)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestCreateOrder_CartChanged(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 7, nil, 4)
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+orders`).
+		WithArgs(uint64(7), uint64(3000), "RUB").
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(uint64(42)))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+order_items`).
+		WithArgs(uint64(42), uint64(1001), "Demo T-Shirt", uint64(2), uint64(1500)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+cart\s+WHERE\s+user_id=\$1\s+AND\s+sku_id\s*=\s*ANY\(\$2\)`).
+		WithArgs(uint64(7), []uint64{1001}).
+		WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count"}).
+			AddRow(uint64(1001), uint64(3)))
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	_, err := store.CreateOrder(ctx, 7, []postgres.OrderItem{
+		{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: 1500},
+	}, money.New(3000, "RUB"), nil)
+
+	require.ErrorIs(t, err, postgres.ErrCartChanged)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestListOrders_GroupsItems(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	created := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	rows := pgxmock.NewRows([]string{"id", "user_id", "total_price", "currency", "created_at", "sku_id", "name", "count", "price"}).
+		AddRow(uint64(2), uint64(7), uint64(900), "RUB", created, uint64(1002), "Coffee Mug", uint64(1), uint64(900)).
+		AddRow(uint64(1), uint64(7), uint64(3300), "RUB", created, uint64(1001), "Demo T-Shirt", uint64(2), uint64(1500)).
+		AddRow(uint64(1), uint64(7), uint64(3300), "RUB", created, uint64(1003), "Sticker Pack", uint64(1), uint64(300))
+
+	mockPool.ExpectQuery(`(?i)SELECT\s+.+\s+FROM\s+orders\s+o\s+JOIN\s+order_items`).
+		WithArgs(uint64(7)).
+		WillReturnRows(rows)
+
+	store := postgres.New(mockPool)
+	out, err := store.ListOrders(ctx, 7)
+
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+	require.Len(t, out[0].Items, 1)
+	require.Len(t, out[1].Items, 2)
+	require.Equal(t, uint64(1003), out[1].Items[1].SkuID)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestGetOrder_NotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	mockPool.ExpectQuery(`(?i)SELECT\s+.+\s+FROM\s+orders\s+o\s+JOIN\s+order_items`).
+		WithArgs(uint64(42), uint64(7)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "total_price", "currency", "created_at", "sku_id", "name", "count", "price"}))
+
+	store := postgres.New(mockPool)
+	_, err := store.GetOrder(ctx, 7, 42)
+
+	require.ErrorIs(t, err, postgres.ErrNotFound)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Event types written to the outbox.
+const (
+	EventItemAdded        = "ItemAdded"
+	EventItemCountChanged = "ItemCountChanged"
+	EventItemRemoved      = "ItemRemoved"
+	EventCartCleared      = "CartCleared"
+	EventCartCheckedOut   = "CartCheckedOut"
+	EventCartsMerged      = "CartsMerged"
+)
+
+// OutboxEvent is a cart change waiting to be published. Payload is the JSON
+// encoded event body.
+type OutboxEvent struct {
+	ID        uint64
+	UserID    uint64
+	Version   uint64
+	Type      string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// ItemEvent is the payload of ItemAdded, ItemCountChanged and ItemRemoved.
+// Count is the new count of the position, Delta the change of it.
+type ItemEvent struct {
+	SkuID uint64 `json:"sku_id"`
+	Count uint64 `json:"count"`
+	Delta int64  `json:"delta"`
+}
+
+// CartEvent is the payload of CartCleared, CartCheckedOut, CartsMerged,
+// CartImported and CartBulkUpdated. Items of the last three are the new
+// counts of the changed positions.
+type CartEvent struct {
+	OrderID     uint64      `json:"order_id,omitempty"`
+	GuestCartID uint64      `json:"guest_cart_id,omitempty"`
+	ShareID     uint64      `json:"share_id,omitempty"`
+	Items       []EventItem `json:"items"`
+}
+
+type EventItem struct {
+	SkuID uint64 `json:"sku_id"`
+	Count uint64 `json:"count"`
+}
+
+// cartTx is the transaction of a cart mutation. The events it emits are
+// committed together with the change.
+type cartTx struct {
+	pgx.Tx
+	userID  uint64
+	version uint64
+}
+
+func (tx cartTx) emit(ctx context.Context, eventType string, payload any) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	query := `INSERT INTO outbox (user_id, cart_version, event_type, payload) VALUES ($1, $2, $3, $4)`
+	_, err = tx.Exec(ctx, query, tx.userID, tx.version, eventType, b)
+	return err
+}
+
+// RelayOutbox hands up to limit of the oldest events to publish in order and
+// deletes the published ones. It stops at the first failure, so an event is
+// delivered at least once and never before an older one of the same batch.
+// Rows are locked with SKIP LOCKED, so relays on several instances work on
+// disjoint batches; the global order only holds with a single relay.
+func (s *Store) RelayOutbox(ctx context.Context, limit int, publish func(OutboxEvent) error) (int, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `SELECT id, user_id, cart_version, event_type, payload, created_at
+				FROM outbox ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED`
+	rows, err := tx.Query(ctx, query, limit)
+	if err != nil {
+		return 0, err
+	}
+	var events []OutboxEvent
+	for rows.Next() {
+		var e OutboxEvent
+		if err := rows.Scan(&e.ID, &e.UserID, &e.Version, &e.Type, &e.Payload, &e.CreatedAt); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		events = append(events, e)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	published := make([]uint64, 0, len(events))
+	var publishErr error
+	for _, e := range events {
+		if publishErr = publish(e); publishErr != nil {
+			break
+		}
+		published = append(published, e.ID)
+	}
+
+	if len(published) > 0 {
+		query = `DELETE FROM outbox WHERE id = ANY($1)`
+		if _, err := tx.Exec(ctx, query, published); err != nil {
+			return 0, err
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(published), publishErr
+}
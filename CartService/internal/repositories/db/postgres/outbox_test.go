@@ -0,0 +1,77 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+const relayQuery = `(?i)SELECT\s+id,.*FROM\s+outbox\s+ORDER\s+BY\s+id\s+LIMIT\s+\$1\s+FOR\s+UPDATE\s+SKIP\s+LOCKED`
+
+func outboxRows() *pgxmock.Rows {
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	return pgxmock.NewRows([]string{"id", "user_id", "cart_version", "event_type", "payload", "created_at"}).
+		AddRow(uint64(1), uint64(7), uint64(3), postgres.EventItemAdded, []byte(`{}`), at).
+		AddRow(uint64(2), uint64(7), uint64(4), postgres.EventCartCleared, []byte(`{}`), at)
+}
+
+func TestRelayOutbox_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery(relayQuery).WithArgs(10).WillReturnRows(outboxRows())
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+outbox\s+WHERE\s+id\s*=\s*ANY`).
+		WithArgs([]uint64{1, 2}).
+		WillReturnResult(pgxmock.NewResult("DELETE", 2))
+	mockPool.ExpectCommit()
+
+	var got []postgres.OutboxEvent
+	store := postgres.New(mockPool)
+	n, err := store.RelayOutbox(ctx, 10, func(e postgres.OutboxEvent) error {
+		got = append(got, e)
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, uint64(7), got[0].UserID)
+	require.Equal(t, postgres.EventCartCleared, got[1].Type)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestRelayOutbox_PublishFails(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery(relayQuery).WithArgs(10).WillReturnRows(outboxRows())
+	// only the event delivered before the failure is removed
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+outbox`).
+		WithArgs([]uint64{1}).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	n, err := store.RelayOutbox(ctx, 10, func(e postgres.OutboxEvent) error {
+		if e.ID == 2 {
+			return errors.New("unavailable")
+		}
+		return nil
+	})
+
+	require.Error(t, err)
+	require.Equal(t, 1, n)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
@@ -2,22 +2,31 @@ package postgres
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// ErrVersionMismatch is returned by mutations whose expected cart version is outdated.
+var ErrVersionMismatch = errors.New("cart version mismatch")
+
 type PgxPoolIface interface {
 	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
 	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
 	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
 }
 
 type Position struct {
 	SkuID uint64
 	Count uint64
+	// AddedPrice is the price when the sku was last added, zero if unknown.
+	AddedPrice uint64
 }
 
+// Store keeps the carts in Postgres. Every mutation takes the expected cart
+// version, nil skips the check.
 type Store struct {
 	pool PgxPoolIface
 }
@@ -26,57 +35,241 @@ func New(pool PgxPoolIface) *Store {
 	return &Store{pool: pool}
 }
 
-func (s *Store) AddItem(ctx context.Context, userID, skuID, count uint64) error {
-	query := `INSERT INTO Cart (user_id, sku_id, count) VALUES ($1, $2, $3) 
-				ON CONFLICT (user_id, sku_id) DO UPDATE SET count = cart.count + EXCLUDED.count`
-	rows, err := s.pool.Query(ctx, query, userID, skuID, count)
+// AddItem adds count units of the sku seen at price, which becomes the added
+// price of the whole position.
+func (s *Store) AddItem(ctx context.Context, userID, skuID, count, price uint64, expected *uint64) error {
+	return s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `INSERT INTO Cart (user_id, sku_id, count, added_price) VALUES ($1, $2, $3, $4)
+					ON CONFLICT (user_id, sku_id) DO UPDATE SET count = cart.count + EXCLUDED.count,
+						added_price = EXCLUDED.added_price, updated_at = now()
+					RETURNING count`
+		var total uint64
+		if err := tx.QueryRow(ctx, query, userID, skuID, count, price).Scan(&total); err != nil {
+			return err
+		}
+		if err := tx.record(ctx, OpAdd, skuID, total-count, total); err != nil {
+			return err
+		}
+
+		return tx.emit(ctx, EventItemAdded, ItemEvent{SkuID: skuID, Count: total, Delta: int64(count)})
+	})
+}
+
+// UpdateItemCount sets the count of the position, creating it if needed, and
+// returns the previous count, zero if there was none. A non-zero price
+// becomes the added price, zero keeps the one the position has.
+func (s *Store) UpdateItemCount(ctx context.Context, userID, skuID, count, price uint64, expected *uint64) (uint64, error) {
+	var prev uint64
+	err := s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `SELECT count FROM Cart WHERE user_id=$1 AND sku_id=$2`
+		err := tx.QueryRow(ctx, query, userID, skuID).Scan(&prev)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		query = `INSERT INTO Cart (user_id, sku_id, count, added_price) VALUES ($1, $2, $3, NULLIF($4, 0))
+					ON CONFLICT (user_id, sku_id) DO UPDATE SET count = EXCLUDED.count,
+						added_price = COALESCE(EXCLUDED.added_price, cart.added_price), updated_at = now()`
+		if _, err = tx.Exec(ctx, query, userID, skuID, count, price); err != nil {
+			return err
+		}
+		if err := tx.record(ctx, OpUpdate, skuID, prev, count); err != nil {
+			return err
+		}
+
+		eventType := EventItemCountChanged
+		if prev == 0 {
+			eventType = EventItemAdded
+		}
+		return tx.emit(ctx, eventType, ItemEvent{SkuID: skuID, Count: count, Delta: int64(count) - int64(prev)})
+	})
 	if err != nil {
-		return err
+		return 0, err
 	}
-	defer rows.Close()
 
-	return err
+	return prev, nil
 }
 
-func (s *Store) DeleteItem(ctx context.Context, userID, skuID uint64) error {
-	query := `DELETE FROM Cart WHERE user_id=$1 AND sku_id=$2`
-	rows, err := s.pool.Query(ctx, query, userID, skuID)
+// DecrementItem takes one unit off the position and deletes it once the
+// count reaches zero. It returns the remaining count, ErrNotFound if there
+// was no position.
+func (s *Store) DecrementItem(ctx context.Context, userID, skuID uint64, expected *uint64) (uint64, error) {
+	var count uint64
+	err := s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `SELECT count FROM Cart WHERE user_id=$1 AND sku_id=$2`
+		err := tx.QueryRow(ctx, query, userID, skuID).Scan(&count)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		eventType := EventItemCountChanged
+		if count > 1 {
+			query = `UPDATE Cart SET count = count - 1, updated_at = now() WHERE user_id=$1 AND sku_id=$2`
+		} else {
+			query = `DELETE FROM Cart WHERE user_id=$1 AND sku_id=$2`
+			eventType = EventItemRemoved
+		}
+		if _, err = tx.Exec(ctx, query, userID, skuID); err != nil {
+			return err
+		}
+		if err := tx.record(ctx, OpDecrement, skuID, count, count-1); err != nil {
+			return err
+		}
+
+		return tx.emit(ctx, eventType, ItemEvent{SkuID: skuID, Count: count - 1, Delta: -1})
+	})
 	if err != nil {
-		return err
+		return 0, err
 	}
-	defer rows.Close()
 
-	return err
+	return count - 1, nil
 }
 
-func (s *Store) ClearCart(ctx context.Context, userID uint64) error {
-	query := `DELETE FROM Cart WHERE user_id=$1`
-	rows, err := s.pool.Query(ctx, query, userID)
+// DeleteItem removes the position and returns its count, zero if there was none.
+func (s *Store) DeleteItem(ctx context.Context, userID, skuID uint64, expected *uint64) (uint64, error) {
+	var count uint64
+	err := s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `DELETE FROM Cart WHERE user_id=$1 AND sku_id=$2 RETURNING count`
+		err := tx.QueryRow(ctx, query, userID, skuID).Scan(&count)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := tx.record(ctx, OpDelete, skuID, count, 0); err != nil {
+			return err
+		}
+
+		return tx.emit(ctx, EventItemRemoved, ItemEvent{SkuID: skuID, Delta: -int64(count)})
+	})
 	if err != nil {
-		return err
+		return 0, err
 	}
-	defer rows.Close()
 
-	return err
+	return count, nil
 }
 
-func (s *Store) GetCart(ctx context.Context, userID uint64) ([]Position, error) {
-	query := `SELECT sku_id, count FROM Cart WHERE user_id=$1 ORDER BY sku_id`
-	rows, err := s.pool.Query(ctx, query, userID)
+// ClearCart removes all positions of the user and returns them.
+func (s *Store) ClearCart(ctx context.Context, userID uint64, expected *uint64) ([]Position, error) {
+	var ans []Position
+	err := s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `DELETE FROM Cart WHERE user_id=$1 RETURNING sku_id, count`
+		rows, err := tx.Query(ctx, query, userID)
+		if err != nil {
+			return err
+		}
+
+		items := []EventItem{}
+		for rows.Next() {
+			var temp Position
+			if err := rows.Scan(&temp.SkuID, &temp.Count); err != nil {
+				rows.Close()
+				return err
+			}
+			ans = append(ans, temp)
+			items = append(items, EventItem{SkuID: temp.SkuID, Count: temp.Count})
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
 
+		for _, p := range ans {
+			if err := tx.record(ctx, OpClear, p.SkuID, p.Count, 0); err != nil {
+				return err
+			}
+		}
+
+		return tx.emit(ctx, EventCartCleared, CartEvent{Items: items})
+	})
 	if err != nil {
 		return nil, err
 	}
+
+	return ans, nil
+}
+
+// GetCart returns the positions of the user together with the cart version,
+// both from the same snapshot. A cart that was never changed is at version 0.
+func (s *Store) GetCart(ctx context.Context, userID uint64) ([]Position, uint64, error) {
+	query := `SELECT v.version, c.sku_id, c.count, c.added_price
+				FROM (SELECT COALESCE(MAX(version), 0) AS version FROM carts WHERE user_id=$1) v
+				LEFT JOIN Cart c ON c.user_id=$1
+				ORDER BY c.sku_id`
+	rows, err := s.pool.Query(ctx, query, userID)
+
+	if err != nil {
+		return nil, 0, err
+	}
 	defer rows.Close()
 
 	var ans []Position
+	var version uint64
 	for rows.Next() {
-		var temp Position
-		if err := rows.Scan(&temp.SkuID, &temp.Count); err != nil {
-			return nil, err
+		var skuID, count, price *uint64
+		if err := rows.Scan(&version, &skuID, &count, &price); err != nil {
+			return nil, 0, err
+		}
+		if skuID == nil {
+			// the left join of an empty cart
+			continue
 		}
-		ans = append(ans, temp)
+		p := Position{SkuID: *skuID, Count: *count}
+		if price != nil {
+			p.AddedPrice = *price
+		}
+		ans = append(ans, p)
 	}
 
-	return ans, nil
+	return ans, version, rows.Err()
+}
+
+// mutate runs fn in a transaction that first moves the cart to the next
+// version. The header row stays locked until the end, so changes of one cart
+// are serialized across all instances. Events emitted by fn are written to
+// the outbox in the same transaction.
+func (s *Store) mutate(ctx context.Context, userID uint64, expected *uint64, fn func(tx cartTx) error) error {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	version, err := bumpVersion(ctx, tx, userID, expected)
+	if err != nil {
+		return err
+	}
+	if err := fn(cartTx{Tx: tx, userID: userID, version: version}); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// bumpVersion increments the cart version and returns the new one, failing
+// with ErrVersionMismatch when expected is set and the cart is at another version.
+func bumpVersion(ctx context.Context, tx pgx.Tx, userID uint64, expected *uint64) (uint64, error) {
+	query := `INSERT INTO carts (user_id, version) VALUES ($1, 1)
+				ON CONFLICT (user_id) DO UPDATE SET version = carts.version + 1,
+					updated_at = now(), abandoned_notified_at = NULL
+				WHERE $2::bigint IS NULL OR carts.version = $2
+				RETURNING version`
+	var version uint64
+	err := tx.QueryRow(ctx, query, userID, expected).Scan(&version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrVersionMismatch
+	}
+	if err != nil {
+		return 0, err
+	}
+	// a fresh header was inserted although a later version was expected
+	if expected != nil && version != *expected+1 {
+		return 0, ErrVersionMismatch
+	}
+
+	return version, nil
 }
@@ -10,6 +10,23 @@ import (
 	pgstore "github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
 )
 
+func expectBump(mockPool pgxmock.PgxPoolIface) {
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery(`(?i)^INSERT\s+INTO\s+carts\s`).
+		WithArgs(uint64(1), (*uint64)(nil)).
+		WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(uint64(1)))
+}
+
+func expectRecord(mockPool pgxmock.PgxPoolIface) {
+	mockPool.ExpectExec(`(?i)^INSERT\s+INTO\s+cart_events\s`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+}
+
+func expectEvent(mockPool pgxmock.PgxPoolIface) {
+	mockPool.ExpectExec(`(?i)^INSERT\s+INTO\s+outbox\s`).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+}
+
 func BenchmarkStore_AddItem_QueryStyle(b *testing.B) {
 	ctx := context.Background()
 	mockPool, _ := pgxmock.NewPool()
@@ -20,11 +37,15 @@ func BenchmarkStore_AddItem_QueryStyle(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		mockPool.ExpectQuery(`(?i)^INSERT\s+INTO\s+cart`).
-			WithArgs(uint64(1), uint64(10000+i), uint64(1)).
-			WillReturnRows(pgxmock.NewRows([]string{"dummy"}).AddRow(1))
-
-		store.AddItem(ctx, 1, uint64(10000+i), 1)
+		expectBump(mockPool)
+		mockPool.ExpectQuery(`(?i)^INSERT\s+INTO\s+cart\s+\(`).
+			WithArgs(uint64(1), uint64(10000+i), uint64(1), uint64(100)).
+			WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint64(1)))
+		expectRecord(mockPool)
+		expectEvent(mockPool)
+		mockPool.ExpectCommit()
+
+		store.AddItem(ctx, 1, uint64(10000+i), 1, 100, nil)
 	}
 	mockPool.ExpectationsWereMet()
 }
@@ -39,11 +60,15 @@ func BenchmarkStore_DeleteItem_QueryStyle(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
+		expectBump(mockPool)
 		mockPool.ExpectQuery(`(?i)^DELETE\s+FROM\s+cart\s+WHERE\s+user_id=\$1\s+AND\s+sku_id=\$2`).
 			WithArgs(uint64(1), uint64(20000+i)).
 			WillReturnRows(pgxmock.NewRows([]string{"dummy"}).AddRow(1))
+		expectRecord(mockPool)
+		expectEvent(mockPool)
+		mockPool.ExpectCommit()
 
-		store.DeleteItem(ctx, 1, uint64(20000+i))
+		store.DeleteItem(ctx, 1, uint64(20000+i), nil)
 	}
 	mockPool.ExpectationsWereMet()
 }
@@ -58,11 +83,15 @@ func BenchmarkStore_ClearCart_QueryStyle(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
+		expectBump(mockPool)
 		mockPool.ExpectQuery(`(?i)^DELETE\s+FROM\s+cart\s+WHERE\s+user_id=\$1`).
 			WithArgs(uint64(1)).
-			WillReturnRows(pgxmock.NewRows([]string{"dummy"}).AddRow(1))
+			WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count"}).AddRow(uint64(1), uint64(1)))
+		expectRecord(mockPool)
+		expectEvent(mockPool)
+		mockPool.ExpectCommit()
 
-		store.ClearCart(ctx, 1)
+		store.ClearCart(ctx, 1, nil)
 	}
 	mockPool.ExpectationsWereMet()
 }
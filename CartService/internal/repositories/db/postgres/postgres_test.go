@@ -10,6 +10,28 @@ import (
 	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
 )
 
+// expectBump expects the transaction and version bump every cart mutation starts with.
+func expectBump(mockPool pgxmock.PgxPoolIface, userID uint64, expected *uint64, version uint64) {
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+carts\s`).
+		WithArgs(userID, expected).
+		WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(version))
+}
+
+// expectRecord expects the history row of one changed position.
+func expectRecord(mockPool pgxmock.PgxPoolIface, userID, version uint64, op string, skuID, oldCount, newCount uint64) {
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+cart_events\s`).
+		WithArgs(userID, version, op, skuID, int64(newCount)-int64(oldCount), oldCount, newCount, "", "").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+}
+
+// expectEvent expects the outbox row a mutation writes before it commits.
+func expectEvent(mockPool pgxmock.PgxPoolIface, userID, version uint64, eventType, payload string) {
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+outbox\s`).
+		WithArgs(userID, version, eventType, []byte(payload)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+}
+
 func TestAddItem_OK(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -18,11 +40,15 @@ func TestAddItem_OK(t *testing.T) {
 	require.NoError(t, err)
 	defer mockPool.Close()
 
-	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+Cart`).
-		WithArgs(uint64(1), uint64(1001), uint64(2)).
-		WillReturnRows(pgxmock.NewRows([]string{"dummy"}).AddRow(1))
+	expectBump(mockPool, 1, nil, 1)
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+Cart\s+\(`).
+		WithArgs(uint64(1), uint64(1001), uint64(2), uint64(1500)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint64(2)))
+	expectRecord(mockPool, 1, 1, postgres.OpAdd, 1001, 0, 2)
+	expectEvent(mockPool, 1, 1, postgres.EventItemAdded, `{"sku_id":1001,"count":2,"delta":2}`)
+	mockPool.ExpectCommit()
 	store := postgres.New(mockPool)
-	err = store.AddItem(ctx, 1, 1001, 2)
+	err = store.AddItem(ctx, 1, 1001, 2, 1500, nil)
 
 	require.NoError(t, err)
 	require.NoError(t, mockPool.ExpectationsWereMet())
@@ -35,17 +61,59 @@ func TestAddItem_DBError(t *testing.T) {
 	mockPool, _ := pgxmock.NewPool()
 	defer mockPool.Close()
 
-	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+Cart`).
-		WithArgs(uint64(1), uint64(1001), uint64(2)).
+	expectBump(mockPool, 1, nil, 1)
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+Cart\s+\(`).
+		WithArgs(uint64(1), uint64(1001), uint64(2), uint64(1500)).
 		WillReturnError(errors.New("db fail"))
+	mockPool.ExpectRollback()
 
 	store := postgres.New(mockPool)
-	err := store.AddItem(ctx, 1, 1001, 2)
+	err := store.AddItem(ctx, 1, 1001, 2, 1500, nil)
 
 	require.Error(t, err)
 	require.NoError(t, mockPool.ExpectationsWereMet())
 }
 
+func TestAddItem_VersionMismatch(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expected := uint64(3)
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+carts\s`).
+		WithArgs(uint64(1), &expected).
+		WillReturnRows(pgxmock.NewRows([]string{"version"}))
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	err := store.AddItem(ctx, 1, 1001, 2, 1500, &expected)
+
+	require.ErrorIs(t, err, postgres.ErrVersionMismatch)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestAddItem_VersionOfNewCart(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	// the cart did not exist, so only version 0 may be expected
+	expected := uint64(3)
+	expectBump(mockPool, 1, &expected, 1)
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	err := store.AddItem(ctx, 1, 1001, 2, 1500, &expected)
+
+	require.ErrorIs(t, err, postgres.ErrVersionMismatch)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
 func TestDeleteItem_OK(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -53,14 +121,41 @@ func TestDeleteItem_OK(t *testing.T) {
 	mockPool, _ := pgxmock.NewPool()
 	defer mockPool.Close()
 
+	expected := uint64(4)
+	expectBump(mockPool, 1, &expected, 5)
 	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+Cart\s+WHERE\s+user_id=\$1\s+AND\s+sku_id=\$2`).
 		WithArgs(uint64(1), uint64(1001)).
 		WillReturnRows(pgxmock.NewRows([]string{"dummy"}).AddRow(1))
+	expectRecord(mockPool, 1, 5, postgres.OpDelete, 1001, 1, 0)
+	expectEvent(mockPool, 1, 5, postgres.EventItemRemoved, `{"sku_id":1001,"count":0,"delta":-1}`)
+	mockPool.ExpectCommit()
 
 	store := postgres.New(mockPool)
-	err := store.DeleteItem(ctx, 1, 1001)
+	count, err := store.DeleteItem(ctx, 1, 1001, &expected)
 
 	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestDeleteItem_Missing(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 1, nil, 2)
+	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+Cart\s+WHERE\s+user_id=\$1\s+AND\s+sku_id=\$2`).
+		WithArgs(uint64(1), uint64(1001)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}))
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	count, err := store.DeleteItem(ctx, 1, 1001, nil)
+
+	require.NoError(t, err)
+	require.Zero(t, count)
 	require.NoError(t, mockPool.ExpectationsWereMet())
 }
 
@@ -71,17 +166,27 @@ func TestClearCart_OK(t *testing.T) {
 	mockPool, _ := pgxmock.NewPool()
 	defer mockPool.Close()
 
+	expectBump(mockPool, 7, nil, 3)
 	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+Cart\s+WHERE\s+user_id=\$1`).
 		WithArgs(uint64(7)).
-		WillReturnRows(pgxmock.NewRows([]string{"dummy"}).AddRow(1))
+		WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count"}).
+			AddRow(uint64(1001), uint64(2)).
+			AddRow(uint64(1002), uint64(1)))
+	expectRecord(mockPool, 7, 3, postgres.OpClear, 1001, 2, 0)
+	expectRecord(mockPool, 7, 3, postgres.OpClear, 1002, 1, 0)
+	expectEvent(mockPool, 7, 3, postgres.EventCartCleared, `{"items":[{"sku_id":1001,"count":2},{"sku_id":1002,"count":1}]}`)
+	mockPool.ExpectCommit()
 
 	store := postgres.New(mockPool)
-	err := store.ClearCart(ctx, 7)
+	out, err := store.ClearCart(ctx, 7, nil)
 
 	require.NoError(t, err)
+	require.Equal(t, []postgres.Position{{SkuID: 1001, Count: 2}, {SkuID: 1002, Count: 1}}, out)
 	require.NoError(t, mockPool.ExpectationsWereMet())
 }
 
+const getCartQuery = `(?i)SELECT\s+v\.version,\s*c\.sku_id,\s*c\.count,\s*c\.added_price\s+FROM`
+
 func TestGetCart_OK(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -89,23 +194,51 @@ func TestGetCart_OK(t *testing.T) {
 	mockPool, _ := pgxmock.NewPool()
 	defer mockPool.Close()
 
-	rows := pgxmock.NewRows([]string{"sku_id", "count"}).
-		AddRow(uint64(1001), uint64(2)).
-		AddRow(uint64(1002), uint64(1))
+	one, two := uint64(1001), uint64(1002)
+	cnt2, cnt1 := uint64(2), uint64(1)
+	price := uint64(1500)
+	rows := pgxmock.NewRows([]string{"version", "sku_id", "count", "added_price"}).
+		AddRow(uint64(5), &one, &cnt2, &price).
+		AddRow(uint64(5), &two, &cnt1, (*uint64)(nil))
 
-	mockPool.ExpectQuery(`(?i)SELECT\s+sku_id,\s*count\s+FROM\s+Cart\s+WHERE\s+user_id=\$1\s+ORDER\s+BY\s+sku_id`).
+	mockPool.ExpectQuery(getCartQuery).
 		WithArgs(uint64(42)).
 		WillReturnRows(rows)
 
 	store := postgres.New(mockPool)
-	out, err := store.GetCart(ctx, 42)
+	out, version, err := store.GetCart(ctx, 42)
 
 	require.NoError(t, err)
+	require.Equal(t, uint64(5), version)
 	require.Len(t, out, 2)
 	require.Equal(t, uint64(1001), out[0].SkuID)
 	require.Equal(t, uint64(2), out[0].Count)
+	require.Equal(t, uint64(1500), out[0].AddedPrice)
 	require.Equal(t, uint64(1002), out[1].SkuID)
 	require.Equal(t, uint64(1), out[1].Count)
+	require.Zero(t, out[1].AddedPrice)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestGetCart_EmptyKeepsVersion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	rows := pgxmock.NewRows([]string{"version", "sku_id", "count", "added_price"}).
+		AddRow(uint64(7), (*uint64)(nil), (*uint64)(nil), (*uint64)(nil))
+	mockPool.ExpectQuery(getCartQuery).
+		WithArgs(uint64(42)).
+		WillReturnRows(rows)
+
+	store := postgres.New(mockPool)
+	out, version, err := store.GetCart(ctx, 42)
+
+	require.NoError(t, err)
+	require.Empty(t, out)
+	require.Equal(t, uint64(7), version)
 	require.NoError(t, mockPool.ExpectationsWereMet())
 }
 
@@ -116,12 +249,12 @@ func TestGetCart_QueryError(t *testing.T) {
 	mockPool, _ := pgxmock.NewPool()
 	defer mockPool.Close()
 
-	mockPool.ExpectQuery(`(?i)SELECT\s+sku_id,\s*count\s+FROM\s+Cart\s+WHERE\s+user_id=\$1\s+ORDER\s+BY\s+sku_id`).
+	mockPool.ExpectQuery(getCartQuery).
 		WithArgs(uint64(42)).
 		WillReturnError(errors.New("query failed"))
 
 	store := postgres.New(mockPool)
-	_, err := store.GetCart(ctx, 42)
+	_, _, err := store.GetCart(ctx, 42)
 
 	require.Error(t, err)
 	require.NoError(t, mockPool.ExpectationsWereMet())
@@ -134,16 +267,140 @@ func TestGetCart_ScanError(t *testing.T) {
 	mockPool, _ := pgxmock.NewPool()
 	defer mockPool.Close()
 
-	rows := pgxmock.NewRows([]string{"sku_id", "count"}).
-		AddRow("my", "bad")
+	rows := pgxmock.NewRows([]string{"version", "sku_id", "count", "added_price"}).
+		AddRow("my", "bad", "row", "!")
 
-	mockPool.ExpectQuery(`(?i)SELECT\s+sku_id,\s*count\s+FROM\s+Cart\s+WHERE\s+user_id=\$1\s+ORDER\s+BY\s+sku_id`).
+	mockPool.ExpectQuery(getCartQuery).
 		WithArgs(uint64(99)).
 		WillReturnRows(rows)
 
 	store := postgres.New(mockPool)
-	_, err := store.GetCart(ctx, 99)
+	_, _, err := store.GetCart(ctx, 99)
 
 	require.Error(t, err)
 	require.NoError(t, mockPool.ExpectationsWereMet())
 }
+
+func TestUpdateItemCount_Existing(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 1, nil, 2)
+	mockPool.ExpectQuery(`(?i)SELECT\s+count\s+FROM\s+Cart`).
+		WithArgs(uint64(1), uint64(1001)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint64(2)))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+Cart\s+\(.*SET\s+count\s*=\s*EXCLUDED\.count`).
+		WithArgs(uint64(1), uint64(1001), uint64(5), uint64(1500)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	expectRecord(mockPool, 1, 2, postgres.OpUpdate, 1001, 2, 5)
+	expectEvent(mockPool, 1, 2, postgres.EventItemCountChanged, `{"sku_id":1001,"count":5,"delta":3}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	prev, err := store.UpdateItemCount(ctx, 1, 1001, 5, 1500, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), prev)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestUpdateItemCount_Inserted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 1, nil, 1)
+	mockPool.ExpectQuery(`(?i)SELECT\s+count\s+FROM\s+Cart`).
+		WithArgs(uint64(1), uint64(1001)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+Cart\s+\(`).
+		WithArgs(uint64(1), uint64(1001), uint64(5), uint64(1500)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	expectRecord(mockPool, 1, 1, postgres.OpUpdate, 1001, 0, 5)
+	expectEvent(mockPool, 1, 1, postgres.EventItemAdded, `{"sku_id":1001,"count":5,"delta":5}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	prev, err := store.UpdateItemCount(ctx, 1, 1001, 5, 1500, nil)
+
+	require.NoError(t, err)
+	require.Zero(t, prev)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestDecrementItem_Decrements(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 1, nil, 2)
+	mockPool.ExpectQuery(`(?i)SELECT\s+count\s+FROM\s+Cart`).
+		WithArgs(uint64(1), uint64(1001)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint64(3)))
+	mockPool.ExpectExec(`(?i)UPDATE\s+Cart\s+SET\s+count\s*=\s*count\s*-\s*1`).
+		WithArgs(uint64(1), uint64(1001)).
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	expectRecord(mockPool, 1, 2, postgres.OpDecrement, 1001, 3, 2)
+	expectEvent(mockPool, 1, 2, postgres.EventItemCountChanged, `{"sku_id":1001,"count":2,"delta":-1}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	left, err := store.DecrementItem(ctx, 1, 1001, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), left)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestDecrementItem_DeletesLast(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 1, nil, 2)
+	mockPool.ExpectQuery(`(?i)SELECT\s+count\s+FROM\s+Cart`).
+		WithArgs(uint64(1), uint64(1001)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint64(1)))
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+Cart`).
+		WithArgs(uint64(1), uint64(1001)).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	expectRecord(mockPool, 1, 2, postgres.OpDecrement, 1001, 1, 0)
+	expectEvent(mockPool, 1, 2, postgres.EventItemRemoved, `{"sku_id":1001,"count":0,"delta":-1}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	left, err := store.DecrementItem(ctx, 1, 1001, nil)
+
+	require.NoError(t, err)
+	require.Zero(t, left)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestDecrementItem_Missing(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 1, nil, 2)
+	mockPool.ExpectQuery(`(?i)SELECT\s+count\s+FROM\s+Cart`).
+		WithArgs(uint64(1), uint64(1001)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}))
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	_, err := store.DecrementItem(ctx, 1, 1001, nil)
+
+	require.ErrorIs(t, err, postgres.ErrNotFound)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
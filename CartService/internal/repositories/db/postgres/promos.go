@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrAlreadyExists = errors.New("already exists")
+
+// Rule kinds of a promo.
+const (
+	PromoPercent  = "percent"
+	PromoFixed    = "fixed"
+	PromoBuyNGetM = "buy_n_get_m"
+)
+
+const (
+	EventPromoApplied = "PromoApplied"
+	EventPromoRemoved = "PromoRemoved"
+)
+
+// Promo is a promo code with its rule. Only the fields of its kind are set:
+// Percent for percent, Amount for fixed, SkuID, BuyCount and FreeCount for
+// buy_n_get_m. MinSubtotal applies to every kind, zero means no threshold.
+type Promo struct {
+	Code        string
+	Kind        string
+	Percent     uint32
+	Amount      uint64
+	SkuID       uint64
+	BuyCount    uint64
+	FreeCount   uint64
+	MinSubtotal uint64
+	Disabled    bool
+	CreatedAt   time.Time
+}
+
+// PromoEvent is the payload of PromoApplied and PromoRemoved.
+type PromoEvent struct {
+	Code string `json:"code"`
+}
+
+const promoColumns = `p.code, p.kind, p.percent, p.amount, p.sku_id, p.buy_count, p.free_count, p.min_subtotal, p.disabled, p.created_at`
+
+func scanPromo(row pgx.Row, p *Promo) error {
+	return row.Scan(&p.Code, &p.Kind, &p.Percent, &p.Amount, &p.SkuID, &p.BuyCount, &p.FreeCount, &p.MinSubtotal, &p.Disabled, &p.CreatedAt)
+}
+
+// CreatePromo saves a new enabled promo and returns it as stored,
+// ErrAlreadyExists if the code is taken.
+func (s *Store) CreatePromo(ctx context.Context, p Promo) (*Promo, error) {
+	query := `INSERT INTO promos (code, kind, percent, amount, sku_id, buy_count, free_count, min_subtotal)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (code) DO NOTHING
+				RETURNING created_at`
+	err := s.pool.QueryRow(ctx, query, p.Code, p.Kind, p.Percent, p.Amount, p.SkuID, p.BuyCount, p.FreeCount, p.MinSubtotal).
+		Scan(&p.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrAlreadyExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// DisablePromo stops the promo from giving discounts, carts keep the code.
+func (s *Store) DisablePromo(ctx context.Context, code string) error {
+	query := `UPDATE promos SET disabled = true WHERE code=$1`
+	tag, err := s.pool.Exec(ctx, query, code)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *Store) ListPromos(ctx context.Context) ([]Promo, error) {
+	query := `SELECT ` + promoColumns + ` FROM promos p ORDER BY p.created_at DESC, p.code`
+	rows, err := s.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ans []Promo
+	for rows.Next() {
+		var p Promo
+		if err := scanPromo(rows, &p); err != nil {
+			return nil, err
+		}
+		ans = append(ans, p)
+	}
+
+	return ans, rows.Err()
+}
+
+// ApplyPromo attaches the code to the cart in place of the one it had.
+// ErrNotFound means there is no enabled promo with this code.
+func (s *Store) ApplyPromo(ctx context.Context, userID uint64, code string, expected *uint64) error {
+	return s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `SELECT disabled FROM promos WHERE code=$1 FOR SHARE`
+		var disabled bool
+		err := tx.QueryRow(ctx, query, code).Scan(&disabled)
+		if errors.Is(err, pgx.ErrNoRows) || err == nil && disabled {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		query = `INSERT INTO cart_promos (user_id, code) VALUES ($1, $2)
+					ON CONFLICT (user_id) DO UPDATE SET code = EXCLUDED.code, applied_at = now()`
+		if _, err := tx.Exec(ctx, query, userID, code); err != nil {
+			return err
+		}
+
+		return tx.emit(ctx, EventPromoApplied, PromoEvent{Code: code})
+	})
+}
+
+// RemovePromo detaches the promo code of the cart, ErrNotFound if it has none.
+func (s *Store) RemovePromo(ctx context.Context, userID uint64, expected *uint64) error {
+	return s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `DELETE FROM cart_promos WHERE user_id=$1 RETURNING code`
+		var code string
+		err := tx.QueryRow(ctx, query, userID).Scan(&code)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		return tx.emit(ctx, EventPromoRemoved, PromoEvent{Code: code})
+	})
+}
+
+// GetCartPromo returns the promo attached to the cart, nil if there is none.
+// A disabled promo is returned too.
+func (s *Store) GetCartPromo(ctx context.Context, userID uint64) (*Promo, error) {
+	query := `SELECT ` + promoColumns + ` FROM cart_promos c JOIN promos p ON p.code = c.code WHERE c.user_id=$1`
+	var p Promo
+	err := scanPromo(s.pool.QueryRow(ctx, query, userID), &p)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
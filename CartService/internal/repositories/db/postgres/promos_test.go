@@ -0,0 +1,105 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+func TestApplyPromo_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 7, nil, 4)
+	mockPool.ExpectQuery(`(?i)SELECT\s+disabled\s+FROM\s+promos\s+WHERE\s+code=\$1\s+FOR\s+SHARE`).
+		WithArgs("SALE").
+		WillReturnRows(pgxmock.NewRows([]string{"disabled"}).AddRow(false))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+cart_promos\s`).
+		WithArgs(uint64(7), "SALE").
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	expectEvent(mockPool, 7, 4, postgres.EventPromoApplied, `{"code":"SALE"}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	require.NoError(t, store.ApplyPromo(ctx, 7, "SALE", nil))
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestApplyPromo_Disabled(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 7, nil, 4)
+	mockPool.ExpectQuery(`(?i)SELECT\s+disabled\s+FROM\s+promos`).
+		WithArgs("SALE").
+		WillReturnRows(pgxmock.NewRows([]string{"disabled"}).AddRow(true))
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	require.ErrorIs(t, store.ApplyPromo(ctx, 7, "SALE", nil), postgres.ErrNotFound)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestRemovePromo_None(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 7, nil, 4)
+	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+cart_promos\s+WHERE\s+user_id=\$1`).
+		WithArgs(uint64(7)).
+		WillReturnRows(pgxmock.NewRows([]string{"code"}))
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	require.ErrorIs(t, store.RemovePromo(ctx, 7, nil), postgres.ErrNotFound)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestCreatePromo_Exists(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+promos\s`).
+		WithArgs("SALE", postgres.PromoFixed, uint32(0), uint64(100), uint64(0), uint64(0), uint64(0), uint64(0)).
+		WillReturnRows(pgxmock.NewRows([]string{"created_at"}))
+
+	store := postgres.New(mockPool)
+	_, err := store.CreatePromo(ctx, postgres.Promo{Code: "SALE", Kind: postgres.PromoFixed, Amount: 100})
+
+	require.ErrorIs(t, err, postgres.ErrAlreadyExists)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestGetCartPromo_None(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	mockPool.ExpectQuery(`(?i)FROM\s+cart_promos\s+c\s+JOIN\s+promos`).
+		WithArgs(uint64(7)).
+		WillReturnRows(pgxmock.NewRows([]string{"code"}))
+
+	store := postgres.New(mockPool)
+	promo, err := store.GetCartPromo(ctx, 7)
+
+	require.NoError(t, err)
+	require.Nil(t, promo)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
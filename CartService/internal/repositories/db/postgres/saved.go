@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Operations recorded for positions moved between the cart and the saved list.
+const (
+	OpMoveToSaved = "move_to_saved"
+	OpMoveToCart  = "move_to_cart"
+)
+
+// Events of the moves, their payload is an ItemEvent of the cart position.
+const (
+	EventItemMovedToSaved = "ItemMovedToSaved"
+	EventItemMovedToCart  = "ItemMovedToCart"
+)
+
+// SavedItem is a position of the saved list. Unlike the cart it holds no
+// stock and no price, the price is taken when it moves back to the cart.
+type SavedItem struct {
+	SkuID   uint64
+	Count   uint64
+	SavedAt time.Time
+}
+
+// MoveToSaved moves the whole cart position of the sku to the saved list,
+// adding to what is saved already, and returns the count moved. ErrNotFound
+// means the sku is not in the cart.
+func (s *Store) MoveToSaved(ctx context.Context, userID, skuID uint64, expected *uint64) (uint64, error) {
+	var count uint64
+	err := s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `DELETE FROM Cart WHERE user_id=$1 AND sku_id=$2 RETURNING count`
+		err := tx.QueryRow(ctx, query, userID, skuID).Scan(&count)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+
+		query = `INSERT INTO saved_items (user_id, sku_id, count) VALUES ($1, $2, $3)
+					ON CONFLICT (user_id, sku_id) DO UPDATE SET count = saved_items.count + EXCLUDED.count, saved_at = now()`
+		if _, err := tx.Exec(ctx, query, userID, skuID, count); err != nil {
+			return err
+		}
+		if err := tx.record(ctx, OpMoveToSaved, skuID, count, 0); err != nil {
+			return err
+		}
+
+		return tx.emit(ctx, EventItemMovedToSaved, ItemEvent{SkuID: skuID, Delta: -int64(count)})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// MoveToCart moves the saved position of the sku to the cart as AddItem adds
+// count units at price. ErrNotFound means the sku is not saved with that
+// count, e.g. a concurrent call has moved it already.
+func (s *Store) MoveToCart(ctx context.Context, userID, skuID, count, price uint64, expected *uint64) error {
+	return s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `DELETE FROM saved_items WHERE user_id=$1 AND sku_id=$2 AND count=$3`
+		tag, err := tx.Exec(ctx, query, userID, skuID, count)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return ErrNotFound
+		}
+
+		query = `INSERT INTO Cart (user_id, sku_id, count, added_price) VALUES ($1, $2, $3, $4)
+					ON CONFLICT (user_id, sku_id) DO UPDATE SET count = cart.count + EXCLUDED.count,
+						added_price = EXCLUDED.added_price, updated_at = now()
+					RETURNING count`
+		var total uint64
+		if err := tx.QueryRow(ctx, query, userID, skuID, count, price).Scan(&total); err != nil {
+			return err
+		}
+		if err := tx.record(ctx, OpMoveToCart, skuID, total-count, total); err != nil {
+			return err
+		}
+
+		return tx.emit(ctx, EventItemMovedToCart, ItemEvent{SkuID: skuID, Count: total, Delta: int64(count)})
+	})
+}
+
+// ListSaved returns the saved list, the latest saved first.
+func (s *Store) ListSaved(ctx context.Context, userID uint64) ([]SavedItem, error) {
+	query := `SELECT sku_id, count, saved_at FROM saved_items WHERE user_id=$1 ORDER BY saved_at DESC, sku_id`
+	rows, err := s.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ans []SavedItem
+	for rows.Next() {
+		var it SavedItem
+		if err := rows.Scan(&it.SkuID, &it.Count, &it.SavedAt); err != nil {
+			return nil, err
+		}
+		ans = append(ans, it)
+	}
+
+	return ans, rows.Err()
+}
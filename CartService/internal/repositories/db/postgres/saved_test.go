@@ -0,0 +1,123 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+func TestMoveToSaved_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 7, nil, 5)
+	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+Cart\s+WHERE\s+user_id=\$1\s+AND\s+sku_id=\$2\s+RETURNING\s+count`).
+		WithArgs(uint64(7), uint64(1001)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint64(2)))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+saved_items\s`).
+		WithArgs(uint64(7), uint64(1001), uint64(2)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	expectRecord(mockPool, 7, 5, postgres.OpMoveToSaved, 1001, 2, 0)
+	expectEvent(mockPool, 7, 5, postgres.EventItemMovedToSaved, `{"sku_id":1001,"count":0,"delta":-2}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	count, err := store.MoveToSaved(ctx, 7, 1001, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestMoveToSaved_NotInCart(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 7, nil, 5)
+	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+Cart`).
+		WithArgs(uint64(7), uint64(1001)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}))
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	_, err := store.MoveToSaved(ctx, 7, 1001, nil)
+
+	require.ErrorIs(t, err, postgres.ErrNotFound)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestMoveToCart_AddsToPosition(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 7, nil, 6)
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+saved_items\s+WHERE\s+user_id=\$1\s+AND\s+sku_id=\$2\s+AND\s+count=\$3`).
+		WithArgs(uint64(7), uint64(1001), uint64(2)).
+		WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+Cart\s+\(`).
+		WithArgs(uint64(7), uint64(1001), uint64(2), uint64(1500)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint64(3)))
+	expectRecord(mockPool, 7, 6, postgres.OpMoveToCart, 1001, 1, 3)
+	expectEvent(mockPool, 7, 6, postgres.EventItemMovedToCart, `{"sku_id":1001,"count":3,"delta":2}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	require.NoError(t, store.MoveToCart(ctx, 7, 1001, 2, 1500, nil))
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestMoveToCart_NotSaved(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 7, nil, 6)
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+saved_items`).
+		WithArgs(uint64(7), uint64(1001), uint64(2)).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	require.ErrorIs(t, store.MoveToCart(ctx, 7, 1001, 2, 1500, nil), postgres.ErrNotFound)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestListSaved(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockPool.ExpectQuery(`(?i)SELECT\s+sku_id,\s*count,\s*saved_at\s+FROM\s+saved_items\s+WHERE\s+user_id=\$1`).
+		WithArgs(uint64(7)).
+		WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count", "saved_at"}).
+			AddRow(uint64(1002), uint64(1), at).
+			AddRow(uint64(1001), uint64(2), at.Add(-time.Hour)))
+
+	store := postgres.New(mockPool)
+	items, err := store.ListSaved(ctx, 7)
+
+	require.NoError(t, err)
+	require.Equal(t, []postgres.SavedItem{
+		{SkuID: 1002, Count: 1, SavedAt: at},
+		{SkuID: 1001, Count: 2, SavedAt: at.Add(-time.Hour)},
+	}, items)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
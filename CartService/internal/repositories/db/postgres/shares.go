@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+// OpImport is recorded for positions added from a shared cart.
+const OpImport = "import"
+
+// EventCartImported carries a CartEvent with ShareID and the new counts of
+// the changed positions.
+const EventCartImported = "CartImported"
+
+// CartShare is an immutable snapshot of a cart, its items keep the names and
+// prices of when it was shared.
+type CartShare struct {
+	ID         uint64
+	UserID     uint64
+	TotalPrice uint64
+	Currency   string
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	Items      []OrderItem
+}
+
+// CreateCartShare saves a snapshot of the user's cart and returns its ID. It
+// does not change the cart.
+func (s *Store) CreateCartShare(ctx context.Context, userID uint64, items []OrderItem, total money.Money, expiresAt time.Time) (uint64, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	var id uint64
+	query := `INSERT INTO cart_shares (user_id, total_price, currency, expires_at) VALUES ($1, $2, $3, $4) RETURNING id`
+	if err := tx.QueryRow(ctx, query, userID, total.Amount, total.Currency, expiresAt).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	query = `INSERT INTO cart_share_items (share_id, sku_id, name, count, price) VALUES ($1, $2, $3, $4, $5)`
+	for _, it := range items {
+		if _, err := tx.Exec(ctx, query, id, it.SkuID, it.Name, it.Count, it.Price); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetCartShare returns the snapshot, ErrNotFound if there is none or it has
+// expired.
+func (s *Store) GetCartShare(ctx context.Context, id uint64) (*CartShare, error) {
+	query := `SELECT s.id, s.user_id, s.total_price, s.currency, s.created_at, s.expires_at, i.sku_id, i.name, i.count, i.price
+				FROM cart_shares s JOIN cart_share_items i ON i.share_id = s.id
+				WHERE s.id=$1 AND s.expires_at > now() ORDER BY i.sku_id`
+	rows, err := s.pool.Query(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var share *CartShare
+	for rows.Next() {
+		var sh CartShare
+		var it OrderItem
+		err := rows.Scan(&sh.ID, &sh.UserID, &sh.TotalPrice, &sh.Currency, &sh.CreatedAt, &sh.ExpiresAt, &it.SkuID, &it.Name, &it.Count, &it.Price)
+		if err != nil {
+			return nil, err
+		}
+		if share == nil {
+			share = &sh
+		}
+		share.Items = append(share.Items, it)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if share == nil {
+		return nil, ErrNotFound
+	}
+
+	return share, nil
+}
+
+// ImportItems adds the positions of a shared cart to the user's cart in one
+// transaction. Each position adds Count units as AddItem does, AddedPrice
+// becomes the added price.
+func (s *Store) ImportItems(ctx context.Context, userID, shareID uint64, items []Position, expected *uint64) error {
+	return s.mutate(ctx, userID, expected, func(tx cartTx) error {
+		query := `INSERT INTO Cart (user_id, sku_id, count, added_price) VALUES ($1, $2, $3, $4)
+					ON CONFLICT (user_id, sku_id) DO UPDATE SET count = cart.count + EXCLUDED.count,
+						added_price = EXCLUDED.added_price, updated_at = now()
+					RETURNING count`
+		event := CartEvent{ShareID: shareID, Items: make([]EventItem, 0, len(items))}
+		for _, p := range items {
+			var total uint64
+			if err := tx.QueryRow(ctx, query, userID, p.SkuID, p.Count, p.AddedPrice).Scan(&total); err != nil {
+				return err
+			}
+			if err := tx.record(ctx, OpImport, p.SkuID, total-p.Count, total); err != nil {
+				return err
+			}
+			event.Items = append(event.Items, EventItem{SkuID: p.SkuID, Count: total})
+		}
+
+		return tx.emit(ctx, EventCartImported, event)
+	})
+}
+
+// DeleteExpiredCartShares deletes the snapshots whose links have expired.
+func (s *Store) DeleteExpiredCartShares(ctx context.Context) (int64, error) {
+	tag, err := s.pool.Exec(ctx, `DELETE FROM cart_shares WHERE expires_at <= now()`)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
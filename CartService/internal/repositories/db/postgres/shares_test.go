@@ -0,0 +1,117 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+func TestCreateCartShare_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expires := time.Date(2025, 1, 9, 3, 4, 5, 0, time.UTC)
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+cart_shares\s`).
+		WithArgs(uint64(7), uint64(3000), "RUB", expires).
+		WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(uint64(42)))
+	mockPool.ExpectExec(`(?i)INSERT\s+INTO\s+cart_share_items\s`).
+		WithArgs(uint64(42), uint64(1001), "Demo T-Shirt", uint64(2), uint64(1500)).
+		WillReturnResult(pgxmock.NewResult("INSERT", 1))
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	id, err := store.CreateCartShare(ctx, 7, []postgres.OrderItem{
+		{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: 1500},
+	}, money.New(3000, "RUB"), expires)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), id)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestGetCartShare_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	expires := created.Add(7 * 24 * time.Hour)
+	columns := []string{"id", "user_id", "total_price", "currency", "created_at", "expires_at", "sku_id", "name", "count", "price"}
+	mockPool.ExpectQuery(`(?i)SELECT\s+s\.id,.*FROM\s+cart_shares\s+s\s+JOIN\s+cart_share_items`).
+		WithArgs(uint64(42)).
+		WillReturnRows(pgxmock.NewRows(columns).
+			AddRow(uint64(42), uint64(7), uint64(3900), "RUB", created, expires, uint64(1001), "Demo T-Shirt", uint64(2), uint64(1500)).
+			AddRow(uint64(42), uint64(7), uint64(3900), "RUB", created, expires, uint64(1002), "Coffee Mug", uint64(1), uint64(900)))
+
+	store := postgres.New(mockPool)
+	share, err := store.GetCartShare(ctx, 42)
+
+	require.NoError(t, err)
+	require.Equal(t, &postgres.CartShare{
+		ID: 42, UserID: 7, TotalPrice: 3900, Currency: "RUB", CreatedAt: created, ExpiresAt: expires,
+		Items: []postgres.OrderItem{
+			{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: 1500},
+			{SkuID: 1002, Name: "Coffee Mug", Count: 1, Price: 900},
+		},
+	}, share)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestGetCartShare_NotFound(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	mockPool.ExpectQuery(`(?i)FROM\s+cart_shares`).
+		WithArgs(uint64(42)).
+		WillReturnRows(pgxmock.NewRows([]string{"id", "user_id", "total_price", "currency", "created_at", "expires_at", "sku_id", "name", "count", "price"}))
+
+	store := postgres.New(mockPool)
+	_, err := store.GetCartShare(ctx, 42)
+
+	require.ErrorIs(t, err, postgres.ErrNotFound)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestImportItems_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	expectBump(mockPool, 8, nil, 6)
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+Cart\s+\(`).
+		WithArgs(uint64(8), uint64(1001), uint64(2), uint64(1400)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint64(3)))
+	expectRecord(mockPool, 8, 6, postgres.OpImport, 1001, 1, 3)
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+Cart\s+\(`).
+		WithArgs(uint64(8), uint64(1002), uint64(1), uint64(900)).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(uint64(1)))
+	expectRecord(mockPool, 8, 6, postgres.OpImport, 1002, 0, 1)
+	expectEvent(mockPool, 8, 6, postgres.EventCartImported,
+		`{"share_id":42,"items":[{"sku_id":1001,"count":3},{"sku_id":1002,"count":1}]}`)
+	mockPool.ExpectCommit()
+
+	store := postgres.New(mockPool)
+	err := store.ImportItems(ctx, 8, 42, []postgres.Position{
+		{SkuID: 1001, Count: 2, AddedPrice: 1400},
+		{SkuID: 1002, Count: 1, AddedPrice: 900},
+	}, nil)
+
+	require.NoError(t, err)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
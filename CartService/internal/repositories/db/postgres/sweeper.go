@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/verbovyar/OzonCart/internal/domain"
+)
+
+// sweepLockKey is the advisory lock taken by a running cart sweep.
+const sweepLockKey = 0x636172747377 // "cartsw"
+
+const (
+	OpExpire = "expire"
+
+	EventCartExpired = "CartExpired"
+)
+
+// ExpiredCart is a cart the sweeper emptied, its stock is still reserved.
+type ExpiredCart struct {
+	UserID uint64
+	Items  []Position
+}
+
+// AbandonedCart is a user's cart nobody has touched since UpdatedAt.
+type AbandonedCart struct {
+	UserID    uint64
+	UpdatedAt time.Time
+	Items     []Position
+}
+
+// WithSweepLock runs fn while holding the cart sweep advisory lock and
+// reports false without running it when another instance holds the lock.
+// The lock lives in a transaction of its own, fn uses other connections.
+func (s *Store) WithSweepLock(ctx context.Context, fn func() error) (bool, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	var locked bool
+	if err := tx.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, sweepLockKey).Scan(&locked); err != nil {
+		return false, err
+	}
+	if !locked {
+		return false, nil
+	}
+	if err := fn(); err != nil {
+		return true, err
+	}
+
+	return true, tx.Commit(ctx)
+}
+
+// ExpireCarts empties up to limit carts that have not changed for idle, each
+// in a transaction of its own. Guest carts are deleted together with their
+// token and saved list, including empty ones. A cart that changed after it
+// was picked is left alone.
+func (s *Store) ExpireCarts(ctx context.Context, idle time.Duration, limit int) ([]ExpiredCart, error) {
+	query := `SELECT h.user_id FROM carts h
+				WHERE h.updated_at < now() - $1 * interval '1 millisecond'
+					AND EXISTS (SELECT 1 FROM cart c WHERE c.user_id = h.user_id)
+				ORDER BY h.updated_at LIMIT $2`
+	rows, err := s.pool.Query(ctx, query, idle.Milliseconds(), limit)
+	if err != nil {
+		return nil, err
+	}
+	var ids []uint64
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var ans []ExpiredCart
+	for _, id := range ids {
+		items, err := s.expireCart(ctx, id, idle)
+		if err != nil {
+			return ans, err
+		}
+		if len(items) > 0 {
+			ans = append(ans, ExpiredCart{UserID: id, Items: items})
+		}
+	}
+
+	query = `WITH gone AS (
+				DELETE FROM guest_carts WHERE cart_id IN (
+					SELECT g.cart_id FROM guest_carts g LEFT JOIN carts h ON h.user_id = g.cart_id
+					WHERE COALESCE(h.updated_at, g.created_at) < now() - $1 * interval '1 millisecond'
+						AND NOT EXISTS (SELECT 1 FROM cart c WHERE c.user_id = g.cart_id)
+					LIMIT $2)
+				RETURNING cart_id),
+			saved AS (DELETE FROM saved_items WHERE user_id IN (SELECT cart_id FROM gone))
+			DELETE FROM carts WHERE user_id IN (SELECT cart_id FROM gone)`
+	if _, err := s.pool.Exec(ctx, query, idle.Milliseconds(), limit); err != nil {
+		return ans, err
+	}
+
+	return ans, nil
+}
+
+func (s *Store) expireCart(ctx context.Context, userID uint64, idle time.Duration) ([]Position, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `SELECT updated_at < now() - $2 * interval '1 millisecond' FROM carts WHERE user_id=$1 FOR UPDATE`
+	var stale bool
+	err = tx.QueryRow(ctx, query, userID, idle.Milliseconds()).Scan(&stale)
+	if errors.Is(err, pgx.ErrNoRows) || err == nil && !stale {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	query = `DELETE FROM Cart WHERE user_id=$1 RETURNING sku_id, count, COALESCE(added_price, 0)`
+	items, err := queryPositions(ctx, tx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := bumpVersion(ctx, tx, userID, nil)
+	if err != nil {
+		return nil, err
+	}
+	ctTx := cartTx{Tx: tx, userID: userID, version: version}
+
+	event := CartEvent{Items: make([]EventItem, 0, len(items))}
+	for _, p := range items {
+		if err := ctTx.record(ctx, OpExpire, p.SkuID, p.Count, 0); err != nil {
+			return nil, err
+		}
+		event.Items = append(event.Items, EventItem{SkuID: p.SkuID, Count: p.Count})
+	}
+	if err := ctTx.emit(ctx, EventCartExpired, event); err != nil {
+		return nil, err
+	}
+
+	if userID >= domain.GuestCartIDBase {
+		if _, err := tx.Exec(ctx, `DELETE FROM guest_carts WHERE cart_id=$1`, userID); err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM carts WHERE user_id=$1`, userID); err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM saved_items WHERE user_id=$1`, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// AbandonedCarts returns up to limit user carts with positions that have
+// not changed for idle and were not reported since their last change.
+func (s *Store) AbandonedCarts(ctx context.Context, idle time.Duration, limit int) ([]AbandonedCart, error) {
+	query := `SELECT h.user_id, h.updated_at, c.sku_id, c.count
+				FROM (SELECT user_id, updated_at FROM carts
+						WHERE updated_at < now() - $1 * interval '1 millisecond'
+							AND abandoned_notified_at IS NULL AND user_id < $2
+							AND EXISTS (SELECT 1 FROM cart c WHERE c.user_id = carts.user_id)
+						ORDER BY updated_at LIMIT $3) h
+				JOIN cart c ON c.user_id = h.user_id
+				ORDER BY h.user_id, c.sku_id`
+	rows, err := s.pool.Query(ctx, query, idle.Milliseconds(), uint64(domain.GuestCartIDBase), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ans []AbandonedCart
+	for rows.Next() {
+		var userID uint64
+		var updatedAt time.Time
+		var p Position
+		if err := rows.Scan(&userID, &updatedAt, &p.SkuID, &p.Count); err != nil {
+			return nil, err
+		}
+		if len(ans) == 0 || ans[len(ans)-1].UserID != userID {
+			ans = append(ans, AbandonedCart{UserID: userID, UpdatedAt: updatedAt})
+		}
+		last := &ans[len(ans)-1]
+		last.Items = append(last.Items, p)
+	}
+
+	return ans, rows.Err()
+}
+
+// MarkAbandonedNotified records that the user was told about the cart as of
+// updatedAt. A cart changed in the meantime stays unmarked.
+func (s *Store) MarkAbandonedNotified(ctx context.Context, userID uint64, updatedAt time.Time) error {
+	query := `UPDATE carts SET abandoned_notified_at = now() WHERE user_id=$1 AND updated_at=$2`
+	_, err := s.pool.Exec(ctx, query, userID, updatedAt)
+	return err
+}
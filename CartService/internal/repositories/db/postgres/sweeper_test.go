@@ -0,0 +1,107 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+func TestWithSweepLock_Taken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery(`(?i)SELECT\s+pg_try_advisory_xact_lock`).
+		WithArgs(pgxmock.AnyArg()).
+		WillReturnRows(pgxmock.NewRows([]string{"locked"}).AddRow(false))
+	mockPool.ExpectRollback()
+
+	store := postgres.New(mockPool)
+	locked, err := store.WithSweepLock(ctx, func() error {
+		t.Fatal("another instance is sweeping")
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.False(t, locked)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestExpireCarts_OK(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	ttl := time.Hour
+	mockPool.ExpectQuery(`(?i)SELECT\s+h\.user_id\s+FROM\s+carts\s+h`).
+		WithArgs(ttl.Milliseconds(), 10).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id"}).AddRow(uint64(1)).AddRow(uint64(2)))
+
+	// user 1 is still stale
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery(`(?i)SELECT\s+updated_at\s*<.*FOR\s+UPDATE`).
+		WithArgs(uint64(1), ttl.Milliseconds()).
+		WillReturnRows(pgxmock.NewRows([]string{"stale"}).AddRow(true))
+	mockPool.ExpectQuery(`(?i)DELETE\s+FROM\s+Cart\s+WHERE\s+user_id=\$1\s+RETURNING`).
+		WithArgs(uint64(1)).
+		WillReturnRows(pgxmock.NewRows([]string{"sku_id", "count", "added_price"}).AddRow(uint64(1001), uint64(2), uint64(0)))
+	mockPool.ExpectQuery(`(?i)INSERT\s+INTO\s+carts\s`).
+		WithArgs(uint64(1), (*uint64)(nil)).
+		WillReturnRows(pgxmock.NewRows([]string{"version"}).AddRow(uint64(8)))
+	expectRecord(mockPool, 1, 8, postgres.OpExpire, 1001, 2, 0)
+	expectEvent(mockPool, 1, 8, postgres.EventCartExpired, `{"items":[{"sku_id":1001,"count":2}]}`)
+	mockPool.ExpectCommit()
+
+	// user 2 changed after it was picked
+	mockPool.ExpectBegin()
+	mockPool.ExpectQuery(`(?i)SELECT\s+updated_at\s*<.*FOR\s+UPDATE`).
+		WithArgs(uint64(2), ttl.Milliseconds()).
+		WillReturnRows(pgxmock.NewRows([]string{"stale"}).AddRow(false))
+	mockPool.ExpectRollback()
+
+	mockPool.ExpectExec(`(?i)DELETE\s+FROM\s+guest_carts`).
+		WithArgs(ttl.Milliseconds(), 10).
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	store := postgres.New(mockPool)
+	out, err := store.ExpireCarts(ctx, ttl, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, []postgres.ExpiredCart{{UserID: 1, Items: []postgres.Position{{SkuID: 1001, Count: 2}}}}, out)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
+
+func TestAbandonedCarts_GroupsPositions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	mockPool, _ := pgxmock.NewPool()
+	defer mockPool.Close()
+
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockPool.ExpectQuery(`(?i)SELECT\s+h\.user_id,\s*h\.updated_at,\s*c\.sku_id,\s*c\.count`).
+		WithArgs(int64(86400000), uint64(1<<62), 10).
+		WillReturnRows(pgxmock.NewRows([]string{"user_id", "updated_at", "sku_id", "count"}).
+			AddRow(uint64(1), at, uint64(1001), uint64(2)).
+			AddRow(uint64(1), at, uint64(1002), uint64(1)).
+			AddRow(uint64(3), at, uint64(1001), uint64(5)))
+
+	store := postgres.New(mockPool)
+	out, err := store.AbandonedCarts(ctx, 24*time.Hour, 10)
+
+	require.NoError(t, err)
+	require.Equal(t, []postgres.AbandonedCart{
+		{UserID: 1, UpdatedAt: at, Items: []postgres.Position{{SkuID: 1001, Count: 2}, {SkuID: 1002, Count: 1}}},
+		{UserID: 3, UpdatedAt: at, Items: []postgres.Position{{SkuID: 1001, Count: 5}}},
+	}, out)
+	require.NoError(t, mockPool.ExpectationsWereMet())
+}
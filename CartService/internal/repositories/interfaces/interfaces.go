@@ -2,16 +2,51 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/pkg/money"
 )
 
 // internal/repositories/interfaces/interfaces.go
 //
 //go:generate minimock -i RepositoryIface -o ../../mocks   -s "_mock.go"
 type RepositoryIface interface {
-	AddItem(ctx context.Context, userID, skuID uint64, count uint64) error
-	DeleteItem(ctx context.Context, userID, skuID uint64) error
-	ClearCart(ctx context.Context, userID uint64) error
-	GetCart(ctx context.Context, userID uint64) ([]postgres.Position, error)
+	AddItem(ctx context.Context, userID, skuID, count, price uint64, expected *uint64) error
+	UpdateItemCount(ctx context.Context, userID, skuID, count, price uint64, expected *uint64) (uint64, error)
+	DecrementItem(ctx context.Context, userID, skuID uint64, expected *uint64) (uint64, error)
+	DeleteItem(ctx context.Context, userID, skuID uint64, expected *uint64) (uint64, error)
+	ClearCart(ctx context.Context, userID uint64, expected *uint64) ([]postgres.Position, error)
+	GetCart(ctx context.Context, userID uint64) ([]postgres.Position, uint64, error)
+	RemoveDelisted(ctx context.Context, userID uint64, skus []uint64) ([]postgres.Position, uint64, error)
+	MoveToSaved(ctx context.Context, userID, skuID uint64, expected *uint64) (uint64, error)
+	MoveToCart(ctx context.Context, userID, skuID, count, price uint64, expected *uint64) error
+	ListSaved(ctx context.Context, userID uint64) ([]postgres.SavedItem, error)
+	ApplyPromo(ctx context.Context, userID uint64, code string, expected *uint64) error
+	RemovePromo(ctx context.Context, userID uint64, expected *uint64) error
+	GetCartPromo(ctx context.Context, userID uint64) (*postgres.Promo, error)
+	CreatePromo(ctx context.Context, p postgres.Promo) (*postgres.Promo, error)
+	DisablePromo(ctx context.Context, code string) error
+	ListPromos(ctx context.Context) ([]postgres.Promo, error)
+	CreateOrder(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expected *uint64) (uint64, error)
+	GetOrder(ctx context.Context, userID, orderID uint64) (*postgres.Order, error)
+	ListOrders(ctx context.Context, userID uint64) ([]postgres.Order, error)
+	CreateCartShare(ctx context.Context, userID uint64, items []postgres.OrderItem, total money.Money, expiresAt time.Time) (uint64, error)
+	GetCartShare(ctx context.Context, id uint64) (*postgres.CartShare, error)
+	ImportItems(ctx context.Context, userID, shareID uint64, items []postgres.Position, expected *uint64) error
+	DeleteExpiredCartShares(ctx context.Context) (int64, error)
+	BulkUpdate(ctx context.Context, userID uint64, changes []postgres.CountChange, expected *uint64) error
+	CreateGuestCart(ctx context.Context, tokenHash string) (uint64, error)
+	GuestCartID(ctx context.Context, tokenHash string) (uint64, error)
+	MergeCarts(ctx context.Context, guestID, userID uint64, plan func(user, guest []postgres.Position) (map[uint64]uint64, error)) ([]postgres.Position, error)
+	WithSweepLock(ctx context.Context, fn func() error) (bool, error)
+	ExpireCarts(ctx context.Context, idle time.Duration, limit int) ([]postgres.ExpiredCart, error)
+	AbandonedCarts(ctx context.Context, idle time.Duration, limit int) ([]postgres.AbandonedCart, error)
+	MarkAbandonedNotified(ctx context.Context, userID uint64, updatedAt time.Time) error
+	GetCartHistory(ctx context.Context, userID uint64, since time.Time, after uint64, limit int) ([]postgres.HistoryEntry, error)
+
+	ClaimIdempotencyKey(ctx context.Context, userID uint64, key, fingerprint string, lease time.Duration) (*postgres.IdempotencyRecord, bool, error)
+	SaveIdempotentResponse(ctx context.Context, userID uint64, key string, response []byte, ttl time.Duration) error
+	ReleaseIdempotencyKey(ctx context.Context, userID uint64, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
 }
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrProductServiceUnavailable = errors.New("product service unavailable")
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// CircuitBreaker opens after threshold consecutive failures and rejects calls
+// for coolDown. Then a single trial call is let through: its success closes
+// the circuit, its failure opens it again.
+type CircuitBreaker struct {
+	threshold int
+	coolDown  time.Duration
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+func NewCircuitBreaker(threshold int, coolDown time.Duration) *CircuitBreaker {
+	if threshold <= 0 {
+		threshold = 1
+	}
+
+	return &CircuitBreaker{threshold: threshold, coolDown: coolDown}
+}
+
+// Allow reports whether a call may go through. Every allowed call must be
+// followed by Done.
+func (b *CircuitBreaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if time.Since(b.openedAt) < b.coolDown {
+			return false
+		}
+		b.state = breakerHalfOpen
+		b.trial = true
+		return true
+	case breakerHalfOpen:
+		if b.trial {
+			return false
+		}
+		b.trial = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Release gives back an allowed call without an outcome.
+func (b *CircuitBreaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.trial = false
+}
+
+func (b *CircuitBreaker) Done(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.state = breakerClosed
+		b.failures = 0
+		b.trial = false
+		return
+	}
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		b.state = breakerOpen
+		b.openedAt = time.Now()
+		b.trial = false
+	}
+}
+
+// BreakerProductClient guards a ClientIface with a CircuitBreaker. While the
+// circuit is open calls fail fast with ErrProductServiceUnavailable.
+type BreakerProductClient struct {
+	next ClientIface
+	cb   *CircuitBreaker
+}
+
+func NewBreakerProductClient(next ClientIface, cb *CircuitBreaker) *BreakerProductClient {
+	return &BreakerProductClient{next: next, cb: cb}
+}
+
+func (c *BreakerProductClient) GetProduct(ctx context.Context, sku uint64) (*Product, error) {
+	var p *Product
+	err := c.call(ctx, func() (err error) {
+		p, err = c.next.GetProduct(ctx, sku)
+		return err
+	})
+
+	return p, err
+}
+
+func (c *BreakerProductClient) GetProducts(ctx context.Context, skus []uint64) (map[uint64]*Product, error) {
+	var res map[uint64]*Product
+	err := c.call(ctx, func() (err error) {
+		res, err = c.next.GetProducts(ctx, skus)
+		return err
+	})
+
+	return res, err
+}
+
+func (c *BreakerProductClient) ReserveStock(ctx context.Context, sku, count uint64) error {
+	return c.call(ctx, func() error {
+		return c.next.ReserveStock(ctx, sku, count)
+	})
+}
+
+func (c *BreakerProductClient) ReleaseStock(ctx context.Context, sku, count uint64) error {
+	return c.call(ctx, func() error {
+		return c.next.ReleaseStock(ctx, sku, count)
+	})
+}
+
+func (c *BreakerProductClient) CommitStock(ctx context.Context, sku, count uint64) error {
+	return c.call(ctx, func() error {
+		return c.next.CommitStock(ctx, sku, count)
+	})
+}
+
+func (c *BreakerProductClient) call(ctx context.Context, fn func() error) error {
+	if !c.cb.Allow() {
+		return ErrProductServiceUnavailable
+	}
+
+	err := fn()
+	if err != nil && ctx.Err() != nil {
+		// the caller gave up, this says nothing about ProductService
+		c.cb.Release()
+		return err
+	}
+	c.cb.Done(!isProductServiceFailure(err))
+
+	return err
+}
+
+// isProductServiceFailure tells ProductService faults apart from business answers.
+func isProductServiceFailure(err error) bool {
+	return err != nil &&
+		!errors.Is(err, ErrProductNotFound) &&
+		!errors.Is(err, ErrInsufficientStock)
+}
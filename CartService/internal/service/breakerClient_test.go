@@ -0,0 +1,75 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/service"
+)
+
+func TestCircuitBreaker_States(t *testing.T) {
+	cb := service.NewCircuitBreaker(2, 20*time.Millisecond)
+
+	require.True(t, cb.Allow())
+	cb.Done(false)
+	require.True(t, cb.Allow())
+	cb.Done(false)
+
+	// open: fail fast until the cool-down passes
+	require.False(t, cb.Allow())
+
+	time.Sleep(30 * time.Millisecond)
+
+	// half-open: exactly one trial call
+	require.True(t, cb.Allow())
+	require.False(t, cb.Allow())
+	cb.Done(false)
+	require.False(t, cb.Allow())
+
+	time.Sleep(30 * time.Millisecond)
+
+	require.True(t, cb.Allow())
+	cb.Done(true)
+	require.True(t, cb.Allow())
+	require.True(t, cb.Allow())
+}
+
+func TestBreakerProductClient_FailsFastWhenOpen(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	next := mocks.NewClientIfaceMock(mc)
+	next.GetProductMock.Times(3).Return(nil, errors.New("bad status: 500"))
+
+	pc := service.NewBreakerProductClient(next, service.NewCircuitBreaker(3, time.Minute))
+	for i := 0; i < 3; i++ {
+		_, err := pc.GetProduct(ctx, 1001)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, service.ErrProductServiceUnavailable)
+	}
+
+	_, err := pc.GetProduct(ctx, 1001)
+	require.ErrorIs(t, err, service.ErrProductServiceUnavailable)
+	require.ErrorIs(t, pc.ReserveStock(ctx, 1001, 1), service.ErrProductServiceUnavailable)
+}
+
+func TestBreakerProductClient_BusinessErrorsKeepCircuitClosed(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	next := mocks.NewClientIfaceMock(mc)
+	next.GetProductMock.Return(nil, service.ErrProductNotFound)
+	next.ReserveStockMock.Return(service.ErrInsufficientStock)
+
+	pc := service.NewBreakerProductClient(next, service.NewCircuitBreaker(1, time.Minute))
+	for i := 0; i < 3; i++ {
+		_, err := pc.GetProduct(ctx, 21)
+		require.ErrorIs(t, err, service.ErrProductNotFound)
+		require.ErrorIs(t, pc.ReserveStock(ctx, 21, 1), service.ErrInsufficientStock)
+	}
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// CachedProductClient is a ClientIface decorator that keeps products in a
+// size-bounded LRU. Found products live for ttl, ErrProductNotFound answers
+// for negativeTTL. Stock calls are passed through untouched.
+type CachedProductClient struct {
+	next        ClientIface
+	size        int
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	mu      sync.Mutex
+	entries map[uint64]*list.Element
+	lru     *list.List
+
+	group  singleflight.Group
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+type cacheEntry struct {
+	sku       uint64
+	product   *Product // nil for a cached ErrProductNotFound
+	expiresAt time.Time
+}
+
+func NewCachedProductClient(next ClientIface, size int, ttl, negativeTTL time.Duration) *CachedProductClient {
+	if size <= 0 {
+		size = 1
+	}
+
+	return &CachedProductClient{
+		next:        next,
+		size:        size,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		entries:     make(map[uint64]*list.Element, size),
+		lru:         list.New(),
+	}
+}
+
+// Stats returns the number of cache hits and misses so far.
+func (c *CachedProductClient) Stats() (hits, misses uint64) {
+	return c.hits.Load(), c.misses.Load()
+}
+
+// GetProduct serves sku from the cache. Concurrent misses for the same sku
+// share one upstream call.
+func (c *CachedProductClient) GetProduct(ctx context.Context, sku uint64) (*Product, error) {
+	if e, ok := c.get(sku); ok {
+		c.hits.Add(1)
+		return e.result()
+	}
+	c.misses.Add(1)
+
+	ch := c.group.DoChan(strconv.FormatUint(sku, 10), func() (any, error) {
+		// the shared call must not fail because the first caller went away
+		p, err := c.next.GetProduct(context.WithoutCancel(ctx), sku)
+		switch {
+		case err == nil:
+			c.put(sku, p, c.ttl)
+		case errors.Is(err, ErrProductNotFound):
+			c.put(sku, nil, c.negativeTTL)
+		}
+		return p, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-ch:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		return copyProduct(r.Val.(*Product)), nil
+	}
+}
+
+// GetProducts serves what it can from the cache and asks upstream for the
+// rest in one batch. Concurrent misses of the same skus share that batch.
+// Skus missing from the upstream answer are cached as not found.
+func (c *CachedProductClient) GetProducts(ctx context.Context, skus []uint64) (map[uint64]*Product, error) {
+	res := make(map[uint64]*Product, len(skus))
+	missing := make([]uint64, 0, len(skus))
+	for _, sku := range skus {
+		e, ok := c.get(sku)
+		if !ok {
+			missing = append(missing, sku)
+			continue
+		}
+		if e.product != nil {
+			res[sku] = copyProduct(e.product)
+		}
+	}
+	c.hits.Add(uint64(len(skus) - len(missing)))
+	c.misses.Add(uint64(len(missing)))
+
+	if len(missing) == 0 {
+		return res, nil
+	}
+
+	ch := c.group.DoChan(batchKey(missing), func() (any, error) {
+		// the shared call must not fail because the first caller went away
+		found, err := c.next.GetProducts(context.WithoutCancel(ctx), missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, sku := range missing {
+			p, ok := found[sku]
+			if !ok {
+				c.put(sku, nil, c.negativeTTL)
+				continue
+			}
+			c.put(sku, p, c.ttl)
+		}
+		return found, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-ch:
+		if r.Err != nil {
+			return nil, r.Err
+		}
+		found := r.Val.(map[uint64]*Product)
+		for _, sku := range missing {
+			if p, ok := found[sku]; ok {
+				res[sku] = copyProduct(p)
+			}
+		}
+		return res, nil
+	}
+}
+
+// batchKey is the singleflight key of a batch of skus, the same for any
+// order. It cannot collide with the key of a single sku.
+func batchKey(skus []uint64) string {
+	sorted := slices.Clone(skus)
+	slices.Sort(sorted)
+
+	var b strings.Builder
+	b.WriteString("batch")
+	for _, sku := range sorted {
+		b.WriteByte(':')
+		b.WriteString(strconv.FormatUint(sku, 10))
+	}
+
+	return b.String()
+}
+
+func (c *CachedProductClient) ReserveStock(ctx context.Context, sku, count uint64) error {
+	return c.next.ReserveStock(ctx, sku, count)
+}
+
+func (c *CachedProductClient) ReleaseStock(ctx context.Context, sku, count uint64) error {
+	return c.next.ReleaseStock(ctx, sku, count)
+}
+
+func (c *CachedProductClient) CommitStock(ctx context.Context, sku, count uint64) error {
+	return c.next.CommitStock(ctx, sku, count)
+}
+
+func (c *CachedProductClient) get(sku uint64) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[sku]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	e := el.Value.(*cacheEntry)
+	if time.Now().After(e.expiresAt) {
+		c.lru.Remove(el)
+		delete(c.entries, sku)
+		return cacheEntry{}, false
+	}
+	c.lru.MoveToFront(el)
+
+	return *e, true
+}
+
+func (c *CachedProductClient) put(sku uint64, p *Product, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &cacheEntry{sku: sku, product: copyProduct(p), expiresAt: time.Now().Add(ttl)}
+	if el, ok := c.entries[sku]; ok {
+		el.Value = e
+		c.lru.MoveToFront(el)
+		return
+	}
+
+	c.entries[sku] = c.lru.PushFront(e)
+	for c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cacheEntry).sku)
+	}
+}
+
+func (e cacheEntry) result() (*Product, error) {
+	if e.product == nil {
+		return nil, ErrProductNotFound
+	}
+
+	return copyProduct(e.product), nil
+}
+
+func copyProduct(p *Product) *Product {
+	if p == nil {
+		return nil
+	}
+	cp := *p
+
+	return &cp
+}
@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/service"
+)
+
+func TestCachedProductClient_HitAfterMiss(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	next := mocks.NewClientIfaceMock(mc)
+	next.GetProductMock.Times(1).Expect(minimock.AnyContext, uint64(1001)).
+		Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+
+	pc := service.NewCachedProductClient(next, 10, time.Minute, time.Minute)
+	for i := 0; i < 3; i++ {
+		p, err := pc.GetProduct(ctx, 1001)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1500), p.Price)
+	}
+
+	hits, misses := pc.Stats()
+	require.Equal(t, uint64(2), hits)
+	require.Equal(t, uint64(1), misses)
+}
+
+func TestCachedProductClient_NegativeTTL(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	next := mocks.NewClientIfaceMock(mc)
+	next.GetProductMock.Times(2).Expect(minimock.AnyContext, uint64(21)).
+		Return(nil, service.ErrProductNotFound)
+
+	pc := service.NewCachedProductClient(next, 10, time.Minute, 20*time.Millisecond)
+
+	_, err := pc.GetProduct(ctx, 21)
+	require.ErrorIs(t, err, service.ErrProductNotFound)
+	_, err = pc.GetProduct(ctx, 21)
+	require.ErrorIs(t, err, service.ErrProductNotFound)
+
+	time.Sleep(30 * time.Millisecond)
+	_, err = pc.GetProduct(ctx, 21)
+	require.ErrorIs(t, err, service.ErrProductNotFound)
+}
+
+func TestCachedProductClient_EvictsLeastRecentlyUsed(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	next := mocks.NewClientIfaceMock(mc)
+	next.GetProductMock.Set(func(_ context.Context, sku uint64) (*service.Product, error) {
+		return &service.Product{Name: "p", Price: sku}, nil
+	})
+
+	pc := service.NewCachedProductClient(next, 2, time.Minute, time.Minute)
+	for _, sku := range []uint64{1, 2, 1, 3, 1, 2} {
+		_, err := pc.GetProduct(ctx, sku)
+		require.NoError(t, err)
+	}
+
+	// 1 stays hot, 2 is evicted by 3 and fetched again at the end
+	require.Equal(t, uint64(4), next.GetProductAfterCounter())
+}
+
+func TestCachedProductClient_SingleflightSharesMiss(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	release := make(chan struct{})
+	next := mocks.NewClientIfaceMock(mc)
+	next.GetProductMock.Set(func(_ context.Context, sku uint64) (*service.Product, error) {
+		<-release
+		return &service.Product{Name: "Coffee Mug", Price: 900}, nil
+	})
+
+	pc := service.NewCachedProductClient(next, 10, time.Minute, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p, err := pc.GetProduct(ctx, 1002)
+			require.NoError(t, err)
+			require.Equal(t, uint64(900), p.Price)
+		}()
+	}
+
+	require.Eventually(t, func() bool { return next.GetProductBeforeCounter() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, uint64(1), next.GetProductAfterCounter())
+}
+
+func TestCachedProductClient_SingleflightSharesBatchMiss(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	release := make(chan struct{})
+	next := mocks.NewClientIfaceMock(mc)
+	next.GetProductsMock.Set(func(_ context.Context, skus []uint64) (map[uint64]*service.Product, error) {
+		<-release
+		return map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500},
+			1002: {Name: "Coffee Mug", Price: 900},
+		}, nil
+	})
+
+	pc := service.NewCachedProductClient(next, 10, time.Minute, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		skus := []uint64{1001, 1002}
+		if i%2 == 1 {
+			skus = []uint64{1002, 1001}
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := pc.GetProducts(ctx, skus)
+			require.NoError(t, err)
+			require.Len(t, res, 2)
+			require.Equal(t, uint64(900), res[1002].Price)
+		}()
+	}
+
+	require.Eventually(t, func() bool { return next.GetProductsBeforeCounter() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, uint64(1), next.GetProductsAfterCounter())
+}
+
+func TestCachedProductClient_GetProductsFetchesOnlyMisses(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	next := mocks.NewClientIfaceMock(mc)
+	next.GetProductsMock.When(minimock.AnyContext, []uint64{1001, 1002, 1003}).Then(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500},
+			1002: {Name: "Coffee Mug", Price: 900},
+		}, nil,
+	)
+	next.GetProductsMock.When(minimock.AnyContext, []uint64{1004}).Then(
+		map[uint64]*service.Product{
+			1004: {Name: "Sticker Pack", Price: 300},
+		}, nil,
+	)
+
+	pc := service.NewCachedProductClient(next, 10, time.Minute, time.Minute)
+
+	res, err := pc.GetProducts(ctx, []uint64{1001, 1002, 1003})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	res, err = pc.GetProducts(ctx, []uint64{1001, 1002, 1003, 1004})
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	require.NotContains(t, res, uint64(1003))
+
+	hits, misses := pc.Stats()
+	require.Equal(t, uint64(3), hits)
+	require.Equal(t, uint64(4), misses)
+}
@@ -8,11 +8,30 @@ import (
 	"fmt"
 	"net/http"
 	"time"
+
+	"github.com/verbovyar/OzonCart/pkg/retry"
 )
 
+// Product is what ProductService knows about a sku. Price is in minor units
+// of Currency, an empty Currency is the cart's one.
 type Product struct {
-	Name  string `json:"name"`
-	Price uint64 `json:"price"`
+	Name        string `json:"name"`
+	Price       uint64 `json:"price"`
+	Currency    string `json:"currency"`
+	WeightGrams uint64 `json:"weight_grams"`
+}
+
+// MaxSkusPerRequest is the largest batch ProductService accepts in /list_skus.
+const MaxSkusPerRequest = 100
+
+type listSkusResponse struct {
+	Products []struct {
+		SKU         uint64 `json:"sku"`
+		Name        string `json:"name"`
+		Price       uint64 `json:"price"`
+		Currency    string `json:"currency"`
+		WeightGrams uint64 `json:"weight_grams"`
+	} `json:"products"`
 }
 
 // internal/service/client.go
@@ -20,59 +39,146 @@ type Product struct {
 //go:generate minimock -i ClientIface     -o ../mocks      -s "_mock.go"
 type ClientIface interface {
 	GetProduct(ctx context.Context, sku uint64) (*Product, error)
+	GetProducts(ctx context.Context, skus []uint64) (map[uint64]*Product, error)
+	ReserveStock(ctx context.Context, sku, count uint64) error
+	ReleaseStock(ctx context.Context, sku, count uint64) error
+	CommitStock(ctx context.Context, sku, count uint64) error
 }
 
+// ErrRateLimited is wrapped into 420/429 answers of ProductService.
+var ErrRateLimited = errors.New("rate limited")
+
 type ProductClient struct {
 	baseURL string
 	token   string
 	client  *http.Client
-	retries uint64
-	delay   time.Duration
+	policy  retry.Policy
+	// stock operations are not idempotent, so they are repeated only when
+	// ProductService explicitly rejected them
+	stockPolicy retry.Policy
 }
 
-func NewClient(baseURL, token string, retries uint64, delay time.Duration) *ProductClient {
+func NewClient(baseURL, token string, policy retry.Policy) *ProductClient {
+	stockPolicy := policy
+	stockPolicy.Retryable = func(err error) bool {
+		return errors.Is(err, ErrRateLimited)
+	}
+
 	return &ProductClient{
-		baseURL: baseURL,
-		token:   token,
-		client:  &http.Client{Timeout: 5 * time.Second},
-		retries: retries,
-		delay:   delay,
+		baseURL:     baseURL,
+		token:       token,
+		client:      &http.Client{Timeout: 5 * time.Second},
+		policy:      policy,
+		stockPolicy: stockPolicy,
 	}
 }
 
 func (c *ProductClient) GetProduct(ctx context.Context, sku uint64) (*Product, error) {
 	reqBody := map[string]any{"token": c.token, "sku": sku}
-	b, _ := json.Marshal(reqBody)
-
-	var lastErr error
-	for i := 0; i < int(c.retries); i++ {
-		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/get_product", bytes.NewReader(b))
-		req.Header.Set("Content-Type", "application/json")
-		resp, err := c.client.Do(req)
-		if err != nil {
-			lastErr = err
-		} else {
-			defer resp.Body.Close()
-			if resp.StatusCode == 420 || resp.StatusCode == 429 {
-				lastErr = fmt.Errorf("rate limited: %d", resp.StatusCode)
-			} else if resp.StatusCode == http.StatusNotFound {
-				return nil, ErrProductNotFound
-			} else if resp.StatusCode != http.StatusOK {
-				lastErr = fmt.Errorf("bad status: %d", resp.StatusCode)
-			} else {
-				var p Product
-				if err := json.NewDecoder(resp.Body).Decode(&p); err != nil {
-					return nil, err
-				}
-				return &p, nil
-			}
+
+	var p Product
+	if err := c.post(ctx, c.policy, "/get_product", reqBody, &p); err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// GetProducts looks the skus up through /list_skus, splitting them into
+// chunks of MaxSkusPerRequest. Unknown skus are absent from the result.
+func (c *ProductClient) GetProducts(ctx context.Context, skus []uint64) (map[uint64]*Product, error) {
+	res := make(map[uint64]*Product, len(skus))
+	for start := 0; start < len(skus); start += MaxSkusPerRequest {
+		end := min(start+MaxSkusPerRequest, len(skus))
+		reqBody := map[string]any{"token": c.token, "skus": skus[start:end]}
+
+		var resp listSkusResponse
+		if err := c.post(ctx, c.policy, "/list_skus", reqBody, &resp); err != nil {
+			return nil, err
+		}
+		for _, p := range resp.Products {
+			res[p.SKU] = &Product{Name: p.Name, Price: p.Price, Currency: p.Currency, WeightGrams: p.WeightGrams}
 		}
-		time.Sleep(c.delay)
 	}
 
-	if lastErr == nil {
-		lastErr = errors.New("unknown product client error")
+	return res, nil
+}
+
+func (c *ProductClient) ReserveStock(ctx context.Context, sku, count uint64) error {
+	return c.postStock(ctx, "/reserve_stock", sku, count)
+}
+
+func (c *ProductClient) ReleaseStock(ctx context.Context, sku, count uint64) error {
+	return c.postStock(ctx, "/release_stock", sku, count)
+}
+
+func (c *ProductClient) CommitStock(ctx context.Context, sku, count uint64) error {
+	return c.postStock(ctx, "/commit_stock", sku, count)
+}
+
+func (c *ProductClient) postStock(ctx context.Context, path string, sku, count uint64) error {
+	reqBody := map[string]any{"token": c.token, "sku": sku, "count": count}
+
+	return stockError(c.post(ctx, c.stockPolicy, path, reqBody, nil))
+}
+
+// stockError reports a sku without a stock record as out of stock: the
+// product is listed, its inventory has not been imported yet.
+func stockError(err error) error {
+	if errors.Is(err, ErrProductNotFound) {
+		return fmt.Errorf("%w: no stock record", ErrInsufficientStock)
 	}
 
-	return nil, lastErr
+	return err
+}
+
+func (c *ProductClient) post(ctx context.Context, policy retry.Policy, path string, body, out any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	return policy.Do(ctx, func(ctx context.Context) error {
+		return c.postOnce(ctx, path, b, out)
+	})
+}
+
+// postOnce makes a single request and classifies the answer for retry.Policy.
+func (c *ProductClient) postOnce(ctx context.Context, path string, body []byte, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return retry.Permanent(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		if out == nil {
+			return nil
+		}
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return retry.Permanent(err)
+		}
+		return nil
+	case resp.StatusCode == 420 || resp.StatusCode == http.StatusTooManyRequests:
+		err := fmt.Errorf("%w: %d", ErrRateLimited, resp.StatusCode)
+		if after, ok := retry.ParseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			return &retry.RetryAfterError{Err: err, After: after}
+		}
+		return err
+	case resp.StatusCode == http.StatusNotFound:
+		return retry.Permanent(ErrProductNotFound)
+	case resp.StatusCode == http.StatusConflict:
+		return retry.Permanent(ErrInsufficientStock)
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("bad status: %d", resp.StatusCode)
+	default:
+		return retry.Permanent(fmt.Errorf("bad status: %d", resp.StatusCode))
+	}
 }
@@ -0,0 +1,121 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/retry"
+)
+
+func TestProductClient_GetProducts_Chunks(t *testing.T) {
+	var calls atomic.Int32
+	ps := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/list_skus", r.URL.Path)
+		calls.Add(1)
+
+		var req struct {
+			SKUs []uint64 `json:"skus"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.LessOrEqual(t, len(req.SKUs), service.MaxSkusPerRequest)
+
+		type item struct {
+			SKU   uint64 `json:"sku"`
+			Name  string `json:"name"`
+			Price uint64 `json:"price"`
+		}
+		var resp struct {
+			Products []item `json:"products"`
+		}
+		for _, sku := range req.SKUs {
+			if sku%2 == 0 {
+				resp.Products = append(resp.Products, item{SKU: sku, Name: "even", Price: sku})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer ps.Close()
+
+	skus := make([]uint64, 0, 250)
+	for i := 1; i <= 250; i++ {
+		skus = append(skus, uint64(i))
+	}
+
+	pc := service.NewClient(ps.URL, "dev-token", retry.Policy{MaxAttempts: 1})
+	res, err := pc.GetProducts(context.Background(), skus)
+
+	require.NoError(t, err)
+	require.Equal(t, int32(3), calls.Load())
+	require.Len(t, res, 125)
+	require.Equal(t, uint64(250), res[250].Price)
+	require.NotContains(t, res, uint64(249))
+}
+
+func TestProductClient_GetProduct_RetriesRateLimit(t *testing.T) {
+	var calls atomic.Int32
+	ps := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(service.Product{Name: "Coffee Mug", Price: 900, Currency: "RUB"})
+	}))
+	defer ps.Close()
+
+	pc := service.NewClient(ps.URL, "dev-token", retry.Policy{MaxAttempts: 3})
+	p, err := pc.GetProduct(context.Background(), 1002)
+
+	require.NoError(t, err)
+	require.Equal(t, &service.Product{Name: "Coffee Mug", Price: 900, Currency: "RUB"}, p)
+	require.Equal(t, int32(2), calls.Load())
+}
+
+func TestProductClient_GetProduct_NotFoundIsNotRetried(t *testing.T) {
+	var calls atomic.Int32
+	ps := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "not found", http.StatusNotFound)
+	}))
+	defer ps.Close()
+
+	pc := service.NewClient(ps.URL, "dev-token", retry.Policy{MaxAttempts: 3})
+	_, err := pc.GetProduct(context.Background(), 21)
+
+	require.ErrorIs(t, err, service.ErrProductNotFound)
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestProductClient_ReserveStock_NoStockRecord(t *testing.T) {
+	ps := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no stock record", http.StatusNotFound)
+	}))
+	defer ps.Close()
+
+	pc := service.NewClient(ps.URL, "dev-token", retry.Policy{MaxAttempts: 3})
+	err := pc.ReserveStock(context.Background(), 21, 1)
+
+	require.ErrorIs(t, err, service.ErrInsufficientStock)
+	require.NotErrorIs(t, err, service.ErrProductNotFound)
+}
+
+func TestProductClient_ReserveStock_NotRetriedOnServerError(t *testing.T) {
+	var calls atomic.Int32
+	ps := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+	}))
+	defer ps.Close()
+
+	pc := service.NewClient(ps.URL, "dev-token", retry.Policy{MaxAttempts: 3})
+	err := pc.ReserveStock(context.Background(), 1001, 1)
+
+	require.Error(t, err)
+	require.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/verbovyar/OzonCart/infrastructure/productServiceClient/api/ProductServiceApiPb"
+	"github.com/verbovyar/OzonCart/pkg/retry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcProductClient is a ClientIface talking to the ProductService gRPC API.
+// The token travels in the authorization metadata instead of the request body.
+type GrpcProductClient struct {
+	client      ProductServiceApiPb.ProductServiceClient
+	token       string
+	timeout     time.Duration
+	policy      retry.Policy
+	stockPolicy retry.Policy
+}
+
+func NewGrpcClient(conn grpc.ClientConnInterface, token string, policy retry.Policy) *GrpcProductClient {
+	stockPolicy := policy
+	stockPolicy.Retryable = func(err error) bool {
+		return errors.Is(err, ErrRateLimited)
+	}
+
+	return &GrpcProductClient{
+		client:      ProductServiceApiPb.NewProductServiceClient(conn),
+		token:       token,
+		timeout:     5 * time.Second,
+		policy:      policy,
+		stockPolicy: stockPolicy,
+	}
+}
+
+func (c *GrpcProductClient) GetProduct(ctx context.Context, sku uint64) (*Product, error) {
+	var p *Product
+	err := c.call(ctx, c.policy, func(ctx context.Context) error {
+		resp, err := c.client.GetProduct(ctx, &ProductServiceApiPb.GetProductRequest{Sku: sku})
+		if err != nil {
+			return err
+		}
+		p = fromPbProduct(resp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// GetProducts looks the skus up through ListSkus, splitting them into
+// chunks of MaxSkusPerRequest. Unknown skus are absent from the result.
+func (c *GrpcProductClient) GetProducts(ctx context.Context, skus []uint64) (map[uint64]*Product, error) {
+	res := make(map[uint64]*Product, len(skus))
+	for start := 0; start < len(skus); start += MaxSkusPerRequest {
+		end := min(start+MaxSkusPerRequest, len(skus))
+		req := &ProductServiceApiPb.ListSkusRequest{Skus: skus[start:end]}
+
+		err := c.call(ctx, c.policy, func(ctx context.Context) error {
+			resp, err := c.client.ListSkus(ctx, req)
+			if err != nil {
+				return err
+			}
+			for _, p := range resp.Products {
+				res[p.Sku] = fromPbProduct(p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// fromPbProduct falls back to the bare price of a ProductService without
+// currencies, which leaves the currency to the cart.
+func fromPbProduct(p *ProductServiceApiPb.Product) *Product {
+	if m := p.GetUnitPrice(); m != nil {
+		return &Product{Name: p.Name, Price: m.Amount, Currency: m.Currency, WeightGrams: p.WeightGrams}
+	}
+
+	return &Product{Name: p.Name, Price: p.Price, WeightGrams: p.WeightGrams}
+}
+
+func (c *GrpcProductClient) ReserveStock(ctx context.Context, sku, count uint64) error {
+	return stockError(c.call(ctx, c.stockPolicy, func(ctx context.Context) error {
+		_, err := c.client.ReserveStock(ctx, &ProductServiceApiPb.StockRequest{Sku: sku, Count: count})
+		return err
+	}))
+}
+
+func (c *GrpcProductClient) ReleaseStock(ctx context.Context, sku, count uint64) error {
+	return stockError(c.call(ctx, c.stockPolicy, func(ctx context.Context) error {
+		_, err := c.client.ReleaseStock(ctx, &ProductServiceApiPb.StockRequest{Sku: sku, Count: count})
+		return err
+	}))
+}
+
+func (c *GrpcProductClient) CommitStock(ctx context.Context, sku, count uint64) error {
+	return stockError(c.call(ctx, c.stockPolicy, func(ctx context.Context) error {
+		_, err := c.client.CommitStock(ctx, &ProductServiceApiPb.StockRequest{Sku: sku, Count: count})
+		return err
+	}))
+}
+
+func (c *GrpcProductClient) call(ctx context.Context, policy retry.Policy, op func(ctx context.Context) error) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+c.token)
+
+	return policy.Do(ctx, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+
+		return classifyGrpcError(op(ctx))
+	})
+}
+
+// classifyGrpcError maps status codes onto the errors of ProductClient so
+// retry.Policy and the decorators treat both transports alike.
+func classifyGrpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.NotFound:
+		return retry.Permanent(ErrProductNotFound)
+	case codes.FailedPrecondition:
+		return retry.Permanent(ErrInsufficientStock)
+	case codes.ResourceExhausted:
+		return fmt.Errorf("%w: %s", ErrRateLimited, st.Message())
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Aborted, codes.Internal, codes.Unknown:
+		return err
+	default:
+		return retry.Permanent(err)
+	}
+}
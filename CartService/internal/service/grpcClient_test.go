@@ -0,0 +1,178 @@
+package service_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/infrastructure/productServiceClient/api/ProductServiceApiPb"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/retry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeProductServer struct {
+	ProductServiceApiPb.UnimplementedProductServiceServer
+
+	calls     atomic.Int32
+	failFirst codes.Code
+}
+
+func (s *fakeProductServer) GetProduct(ctx context.Context, in *ProductServiceApiPb.GetProductRequest) (*ProductServiceApiPb.Product, error) {
+	if err := s.check(ctx); err != nil {
+		return nil, err
+	}
+	if in.Sku == 404 {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &ProductServiceApiPb.Product{
+		Sku:       in.Sku,
+		Name:      "Coffee Mug",
+		Price:     900,
+		UnitPrice: &ProductServiceApiPb.Money{Amount: 900, Currency: "RUB"},
+	}, nil
+}
+
+func (s *fakeProductServer) ListSkus(ctx context.Context, in *ProductServiceApiPb.ListSkusRequest) (*ProductServiceApiPb.ListSkusResponse, error) {
+	if err := s.check(ctx); err != nil {
+		return nil, err
+	}
+	if len(in.Skus) > service.MaxSkusPerRequest {
+		return nil, status.Error(codes.InvalidArgument, "too many skus")
+	}
+
+	resp := &ProductServiceApiPb.ListSkusResponse{}
+	for _, sku := range in.Skus {
+		if sku%2 == 0 {
+			// a ProductService without currencies sends only the price
+			resp.Products = append(resp.Products, &ProductServiceApiPb.Product{Sku: sku, Name: "even", Price: sku})
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *fakeProductServer) ReserveStock(ctx context.Context, in *ProductServiceApiPb.StockRequest) (*ProductServiceApiPb.StockResponse, error) {
+	if err := s.check(ctx); err != nil {
+		return nil, err
+	}
+	if in.Sku == 21 {
+		return nil, status.Error(codes.NotFound, "no stock record")
+	}
+	if in.Count > 10 {
+		return nil, status.Error(codes.FailedPrecondition, "insufficient stock")
+	}
+
+	return &ProductServiceApiPb.StockResponse{}, nil
+}
+
+// check counts the call, verifies the token and fails the first call with failFirst if set.
+func (s *fakeProductServer) check(ctx context.Context) error {
+	n := s.calls.Add(1)
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if v := md.Get("authorization"); len(v) != 1 || v[0] != "Bearer dev-token" {
+		return status.Error(codes.Unauthenticated, "bad token")
+	}
+	if n == 1 && s.failFirst != codes.OK {
+		return status.Error(s.failFirst, "try again")
+	}
+
+	return nil
+}
+
+func newGrpcClient(t *testing.T, srv *fakeProductServer, token string, policy retry.Policy) *service.GrpcProductClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	ProductServiceApiPb.RegisterProductServiceServer(s, srv)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return service.NewGrpcClient(conn, token, policy)
+}
+
+func TestGrpcProductClient_GetProduct(t *testing.T) {
+	srv := &fakeProductServer{}
+	pc := newGrpcClient(t, srv, "dev-token", retry.Policy{MaxAttempts: 3})
+
+	p, err := pc.GetProduct(context.Background(), 1002)
+	require.NoError(t, err)
+	require.Equal(t, &service.Product{Name: "Coffee Mug", Price: 900, Currency: "RUB"}, p)
+
+	_, err = pc.GetProduct(context.Background(), 404)
+	require.ErrorIs(t, err, service.ErrProductNotFound)
+	require.Equal(t, int32(2), srv.calls.Load())
+}
+
+func TestGrpcProductClient_GetProduct_RetriesUnavailable(t *testing.T) {
+	srv := &fakeProductServer{failFirst: codes.Unavailable}
+	pc := newGrpcClient(t, srv, "dev-token", retry.Policy{MaxAttempts: 3})
+
+	p, err := pc.GetProduct(context.Background(), 1002)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(900), p.Price)
+	require.Equal(t, int32(2), srv.calls.Load())
+}
+
+func TestGrpcProductClient_BadTokenIsNotRetried(t *testing.T) {
+	srv := &fakeProductServer{}
+	pc := newGrpcClient(t, srv, "wrong", retry.Policy{MaxAttempts: 3})
+
+	_, err := pc.GetProduct(context.Background(), 1002)
+
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Equal(t, int32(1), srv.calls.Load())
+}
+
+func TestGrpcProductClient_GetProducts_Chunks(t *testing.T) {
+	srv := &fakeProductServer{}
+	pc := newGrpcClient(t, srv, "dev-token", retry.Policy{MaxAttempts: 1})
+
+	skus := make([]uint64, 0, 250)
+	for i := 1; i <= 250; i++ {
+		skus = append(skus, uint64(i))
+	}
+	res, err := pc.GetProducts(context.Background(), skus)
+
+	require.NoError(t, err)
+	require.Equal(t, int32(3), srv.calls.Load())
+	require.Len(t, res, 125)
+	require.Equal(t, &service.Product{Name: "even", Price: 250}, res[250])
+	require.NotContains(t, res, uint64(249))
+}
+
+func TestGrpcProductClient_ReserveStock(t *testing.T) {
+	srv := &fakeProductServer{failFirst: codes.Unavailable}
+	pc := newGrpcClient(t, srv, "dev-token", retry.Policy{MaxAttempts: 3})
+
+	// not idempotent, so an unavailable ProductService is not asked again
+	err := pc.ReserveStock(context.Background(), 1002, 1)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	err = pc.ReserveStock(context.Background(), 1002, 11)
+	require.ErrorIs(t, err, service.ErrInsufficientStock)
+	err = pc.ReserveStock(context.Background(), 21, 1)
+	require.ErrorIs(t, err, service.ErrInsufficientStock)
+	require.NotErrorIs(t, err, service.ErrProductNotFound)
+	require.Equal(t, int32(3), srv.calls.Load())
+}
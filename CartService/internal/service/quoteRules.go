@@ -0,0 +1,115 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+// QuoteRules is a TaxCalculator and ShippingCalculator over rule tables. The
+// first rule matching the address wins, an empty Country or Region in a rule
+// matches any. Amounts are minor units of Currency, empty means the cart's.
+type QuoteRules struct {
+	Currency      string         `json:"currency"`
+	TaxRules      []TaxRule      `json:"tax"`
+	ShippingRules []ShippingRule `json:"shipping"`
+}
+
+// TaxRule charges RateBps basis points of the goods, 2000 is 20%. An address
+// no rule matches pays no tax.
+type TaxRule struct {
+	Country string `json:"country"`
+	Region  string `json:"region"`
+	RateBps uint64 `json:"rate_bps"`
+}
+
+// ShippingRule prices parcels up to MaxWeightGrams, zero is no bound, worth
+// at least MinValue. Tiers are listed from the cheapest, e.g. free shipping
+// above some value first.
+type ShippingRule struct {
+	Country        string `json:"country"`
+	Region         string `json:"region"`
+	MaxWeightGrams uint64 `json:"max_weight_grams"`
+	MinValue       uint64 `json:"min_value"`
+	Price          uint64 `json:"price"`
+}
+
+// LoadQuoteRules reads QuoteRules from a JSON file.
+func LoadQuoteRules(path string) (*QuoteRules, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	var r QuoteRules
+	if err := dec.Decode(&r); err != nil {
+		return nil, fmt.Errorf("quote rules %s: %w", path, err)
+	}
+
+	r.Currency = strings.ToUpper(r.Currency)
+	for i := range r.TaxRules {
+		r.TaxRules[i].Country = strings.ToUpper(r.TaxRules[i].Country)
+		r.TaxRules[i].Region = strings.ToUpper(r.TaxRules[i].Region)
+	}
+	for i := range r.ShippingRules {
+		r.ShippingRules[i].Country = strings.ToUpper(r.ShippingRules[i].Country)
+		r.ShippingRules[i].Region = strings.ToUpper(r.ShippingRules[i].Region)
+	}
+
+	return &r, nil
+}
+
+func (r *QuoteRules) Tax(_ context.Context, addr domain.Address, taxable money.Money) (money.Money, error) {
+	if err := r.checkCurrency(taxable.Currency); err != nil {
+		return money.Money{}, err
+	}
+
+	for _, rule := range r.TaxRules {
+		if matchesAddress(rule.Country, rule.Region, addr) {
+			return taxable.MulDiv(rule.RateBps, 10000)
+		}
+	}
+
+	return money.New(0, taxable.Currency), nil
+}
+
+func (r *QuoteRules) Shipping(_ context.Context, addr domain.Address, parcel Parcel) (money.Money, error) {
+	if err := r.checkCurrency(parcel.Value.Currency); err != nil {
+		return money.Money{}, err
+	}
+
+	for _, rule := range r.ShippingRules {
+		if !matchesAddress(rule.Country, rule.Region, addr) {
+			continue
+		}
+		if rule.MaxWeightGrams > 0 && parcel.WeightGrams > rule.MaxWeightGrams {
+			continue
+		}
+		if parcel.Value.Amount < rule.MinValue {
+			continue
+		}
+		return money.New(rule.Price, parcel.Value.Currency), nil
+	}
+
+	return money.Money{}, ErrNotDeliverable
+}
+
+func (r *QuoteRules) checkCurrency(currency string) error {
+	if r.Currency != "" && r.Currency != currency {
+		return fmt.Errorf("%w: quote rules are in %s, cart is in %s", ErrCurrencyMismatch, r.Currency, currency)
+	}
+
+	return nil
+}
+
+func matchesAddress(country, region string, addr domain.Address) bool {
+	return (country == "" || country == addr.Country) && (region == "" || region == addr.Region)
+}
@@ -0,0 +1,72 @@
+package service_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+func writeRules(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "rules.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	return path
+}
+
+func TestLoadQuoteRules(t *testing.T) {
+	path := writeRules(t, `{
+		"currency": "rub",
+		"tax": [{"country": "ru", "rate_bps": 2000}],
+		"shipping": [{"country": "ru", "region": "ru-mow", "max_weight_grams": 1000, "price": 199}]
+	}`)
+
+	rules, err := service.LoadQuoteRules(path)
+	require.NoError(t, err)
+	require.Equal(t, &service.QuoteRules{
+		Currency:      "RUB",
+		TaxRules:      []service.TaxRule{{Country: "RU", RateBps: 2000}},
+		ShippingRules: []service.ShippingRule{{Country: "RU", Region: "RU-MOW", MaxWeightGrams: 1000, Price: 199}},
+	}, rules)
+}
+
+func TestLoadQuoteRules_UnknownField(t *testing.T) {
+	path := writeRules(t, `{"tax": [{"country": "RU", "rate": 20}]}`)
+
+	_, err := service.LoadQuoteRules(path)
+	require.Error(t, err)
+}
+
+func TestQuoteRules_Shipping_WeightTiers(t *testing.T) {
+	ctx := context.Background()
+	rules := &service.QuoteRules{ShippingRules: []service.ShippingRule{
+		{Country: "RU", MaxWeightGrams: 1000, Price: 199},
+		{Country: "RU", MaxWeightGrams: 5000, Price: 349},
+	}}
+	addr := domain.Address{Country: "RU"}
+
+	price, err := rules.Shipping(ctx, addr, service.Parcel{WeightGrams: 1000, Value: money.New(100, "RUB")})
+	require.NoError(t, err)
+	require.Equal(t, money.New(199, "RUB"), price)
+
+	price, err = rules.Shipping(ctx, addr, service.Parcel{WeightGrams: 1001, Value: money.New(100, "RUB")})
+	require.NoError(t, err)
+	require.Equal(t, money.New(349, "RUB"), price)
+
+	_, err = rules.Shipping(ctx, addr, service.Parcel{WeightGrams: 5001, Value: money.New(100, "RUB")})
+	require.ErrorIs(t, err, service.ErrNotDeliverable)
+}
+
+func TestQuoteRules_Tax_OtherCurrency(t *testing.T) {
+	rules := &service.QuoteRules{Currency: "RUB", TaxRules: []service.TaxRule{{RateBps: 2000}}}
+
+	_, err := rules.Tax(context.Background(), domain.Address{Country: "US"}, money.New(100, "USD"))
+	require.ErrorIs(t, err, service.ErrCurrencyMismatch)
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+var ErrInvalidBulkOp = errors.New("invalid bulk operation")
+
+// MaxBulkOps is the most ops one BulkUpdateCart call takes.
+const MaxBulkOps = 100
+
+// bulkOpErrors fail a single op of BulkUpdateCart, any other error fails the
+// whole call.
+var bulkOpErrors = []error{
+	ErrInvalidBulkOp,
+	ErrProductNotFound,
+	ErrCurrencyMismatch,
+	ErrPriceOverflow,
+	ErrLimitExceeded,
+	ErrInsufficientStock,
+}
+
+// BulkUpdateCart applies ops to the cart in order with one product lookup and
+// one store transaction and returns a result per op. Added and set positions
+// are priced and limited as AddToCart and UpdateItemCount do them, stock is
+// reserved or released for the difference of each position. With
+// allOrNothing a failed op leaves the cart as it was, otherwise the other ops
+// are applied.
+func (c *CartService) BulkUpdateCart(ctx context.Context, userID uint64, ops []domain.BulkOp, allOrNothing bool, expectedVersion *uint64) ([]domain.BulkOpResult, error) {
+	if len(ops) == 0 || len(ops) > MaxBulkOps {
+		return nil, ErrInvalidBulkOp
+	}
+
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	var results []domain.BulkOpResult
+	err := retryMoved(func() error {
+		var err error
+		results, err = c.bulkUpdate(ctx, userID, ops, allOrNothing, expectedVersion)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (c *CartService) bulkUpdate(ctx context.Context, userID uint64, ops []domain.BulkOp, allOrNothing bool, expectedVersion *uint64) ([]domain.BulkOpResult, error) {
+	positions, version, err := c.store.GetCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	prev := make(map[uint64]uint64, len(positions))
+	skus := make([]uint64, 0, len(positions)+len(ops))
+	for _, p := range positions {
+		prev[p.SkuID] = p.Count
+		skus = append(skus, p.SkuID)
+	}
+	seen := make(map[uint64]bool, len(ops))
+	for _, op := range ops {
+		if _, ok := prev[op.SkuID]; ok || seen[op.SkuID] || op.SkuID == 0 || op.Op == domain.BulkOpDelete {
+			continue
+		}
+		seen[op.SkuID] = true
+		skus = append(skus, op.SkuID)
+	}
+	products, err := c.lookupProducts(ctx, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uint64]uint64, len(prev)+len(ops))
+	for sku, n := range prev {
+		counts[sku] = n
+	}
+	prices := make(map[uint64]uint64, len(ops))
+	results := make([]domain.BulkOpResult, len(ops))
+	failed := false
+	for i, op := range ops {
+		results[i] = domain.BulkOpResult{SkuID: op.SkuID, Op: op.Op}
+
+		n, price, err := c.bulkCount(ctx, op, counts[op.SkuID], products)
+		if err == nil {
+			err = c.checkCounts(ctx, cartPositions(counts), map[uint64]uint64{op.SkuID: n}, products)
+		}
+		if err != nil {
+			if !isBulkOpError(err) {
+				return nil, err
+			}
+			results[i].Status = domain.BulkOpFailed
+			results[i].Count = counts[op.SkuID]
+			results[i].Error = err.Error()
+			failed = true
+			continue
+		}
+
+		counts[op.SkuID] = n
+		if price > 0 {
+			prices[op.SkuID] = price
+		}
+		results[i].Status = domain.BulkOpApplied
+		results[i].Count = n
+	}
+	if failed && allOrNothing {
+		return rejectBulk(results, prev), nil
+	}
+	expected, err := c.checkedVersion(expectedVersion, version)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make([]uint64, 0, len(counts))
+	for sku, n := range counts {
+		if n != prev[sku] {
+			changed = append(changed, sku)
+		}
+	}
+	sort.Slice(changed, func(i, j int) bool { return changed[i] < changed[j] })
+
+	var reserved []postgres.Position
+	for _, sku := range changed {
+		if counts[sku] <= prev[sku] {
+			continue
+		}
+		delta := counts[sku] - prev[sku]
+		err := c.pc.ReserveStock(ctx, sku, delta)
+		if err == nil {
+			reserved = append(reserved, postgres.Position{SkuID: sku, Count: delta})
+			continue
+		}
+		if !errors.Is(err, ErrInsufficientStock) || allOrNothing {
+			for _, r := range reserved {
+				c.releaseStock(ctx, r.SkuID, r.Count)
+			}
+			if errors.Is(err, ErrInsufficientStock) {
+				failSku(results, sku, prev[sku], err)
+				return rejectBulk(results, prev), nil
+			}
+			return nil, err
+		}
+		// the position is left as it was, dropping it only lowers the cart
+		// so the limits of the other ops still hold
+		counts[sku] = prev[sku]
+		failSku(results, sku, prev[sku], err)
+	}
+
+	changes := make([]postgres.CountChange, 0, len(changed))
+	var released []postgres.Position
+	for _, sku := range changed {
+		n := counts[sku]
+		if n == prev[sku] {
+			continue
+		}
+		changes = append(changes, postgres.CountChange{SkuID: sku, Old: prev[sku], New: n, Price: prices[sku]})
+		if n < prev[sku] {
+			released = append(released, postgres.Position{SkuID: sku, Count: prev[sku] - n})
+		}
+	}
+	if len(changes) == 0 {
+		return results, nil
+	}
+
+	if err := c.store.BulkUpdate(ctx, userID, changes, expected); err != nil {
+		for _, r := range reserved {
+			c.releaseStock(ctx, r.SkuID, r.Count)
+		}
+		// the cart moved on since GetCart, as if the caller had passed its version
+		if errors.Is(err, postgres.ErrCartChanged) {
+			return nil, ErrVersionMismatch
+		}
+		return nil, checkedError(err, expectedVersion)
+	}
+	for _, r := range released {
+		c.releaseStock(ctx, r.SkuID, r.Count)
+	}
+
+	return results, nil
+}
+
+// bulkCount returns the count of the position of op.SkuID after op and the
+// unit price it is added at, zero when it is removed.
+func (c *CartService) bulkCount(ctx context.Context, op domain.BulkOp, prev uint64, products map[uint64]*Product) (uint64, uint64, error) {
+	if op.SkuID == 0 {
+		return 0, 0, ErrInvalidBulkOp
+	}
+
+	var count uint64
+	switch op.Op {
+	case domain.BulkOpAdd:
+		if op.Count == 0 || op.Count > math.MaxUint64-prev {
+			return 0, 0, ErrInvalidBulkOp
+		}
+		count = prev + op.Count
+	case domain.BulkOpSet:
+		count = op.Count
+	case domain.BulkOpDelete:
+		return 0, 0, nil
+	default:
+		return 0, 0, ErrInvalidBulkOp
+	}
+	if count == 0 {
+		return 0, 0, nil
+	}
+
+	pr, ok := products[op.SkuID]
+	if !ok {
+		return 0, 0, ErrProductNotFound
+	}
+	price, err := c.unitPrice(ctx, pr)
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := checked(price.Mul(count)); err != nil {
+		return 0, 0, err
+	}
+
+	return count, price.Amount, nil
+}
+
+func isBulkOpError(err error) bool {
+	for _, e := range bulkOpErrors {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func cartPositions(counts map[uint64]uint64) []postgres.Position {
+	ans := make([]postgres.Position, 0, len(counts))
+	for sku, n := range counts {
+		if n > 0 {
+			ans = append(ans, postgres.Position{SkuID: sku, Count: n})
+		}
+	}
+
+	return ans
+}
+
+// failSku fails the applied ops of skuID with err and reports the position
+// at count for all its ops.
+func failSku(results []domain.BulkOpResult, skuID, count uint64, err error) {
+	for i := range results {
+		if results[i].SkuID != skuID {
+			continue
+		}
+		if results[i].Status == domain.BulkOpApplied {
+			results[i].Status = domain.BulkOpFailed
+			results[i].Error = err.Error()
+		}
+		results[i].Count = count
+	}
+}
+
+// rejectBulk turns an all-or-nothing batch with a failed op into one that
+// applies nothing.
+func rejectBulk(results []domain.BulkOpResult, prev map[uint64]uint64) []domain.BulkOpResult {
+	for i := range results {
+		if results[i].Status == domain.BulkOpApplied {
+			results[i].Status = domain.BulkOpSkipped
+		}
+		results[i].Count = prev[results[i].SkuID]
+	}
+
+	return results
+}
@@ -0,0 +1,186 @@
+package service_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+)
+
+// bulkOps adds to the cart of bulkMocks, the fourth op goes over a limit of
+// three units and the fifth adds a delisted sku.
+var bulkOps = []domain.BulkOp{
+	{Op: domain.BulkOpAdd, SkuID: 1001, Count: 2},
+	{Op: domain.BulkOpSet, SkuID: 1002, Count: 3},
+	{Op: domain.BulkOpDelete, SkuID: 1003},
+	{Op: domain.BulkOpAdd, SkuID: 1002, Count: 1},
+	{Op: domain.BulkOpAdd, SkuID: 3003, Count: 1},
+}
+
+// bulkMocks sets up a cart with one T-shirt and one product lookup of skus,
+// the T-shirt now costs 1400 and 3003 is gone.
+func bulkMocks(mc *minimock.Controller, skus ...uint64) (*mocks.RepositoryIfaceMock, *mocks.ClientIfaceMock) {
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(8)).Return([]postgres.Position{{SkuID: 1001, Count: 1}}, 5, nil)
+	pc.GetProductsMock.Expect(ctx, skus).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1400},
+			1002: {Name: "Coffee Mug", Price: 900},
+		}, nil,
+	)
+
+	return repo, pc
+}
+
+func TestCartService_BulkUpdateCart_BestEffort(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	// limits are checked against the cart read at version 5
+	version := uint64(5)
+	repo, pc := bulkMocks(mc, 1001, 1002, 3003)
+	pc.ReserveStockMock.When(ctx, uint64(1001), uint64(2)).Then(nil)
+	pc.ReserveStockMock.When(ctx, uint64(1002), uint64(3)).Then(nil)
+	repo.BulkUpdateMock.Expect(ctx, uint64(8), []postgres.CountChange{
+		{SkuID: 1001, Old: 1, New: 3, Price: 1400},
+		{SkuID: 1002, Old: 0, New: 3, Price: 900},
+	}, &version).Return(nil)
+
+	cs := service.New(repo, pc, service.WithLimits(service.Limits{MaxItemCount: 3}))
+	res, err := cs.BulkUpdateCart(ctx, 8, bulkOps, false, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, []domain.BulkOpResult{
+		{SkuID: 1001, Op: domain.BulkOpAdd, Status: domain.BulkOpApplied, Count: 3},
+		{SkuID: 1002, Op: domain.BulkOpSet, Status: domain.BulkOpApplied, Count: 3},
+		{SkuID: 1003, Op: domain.BulkOpDelete, Status: domain.BulkOpApplied, Count: 0},
+		{SkuID: 1002, Op: domain.BulkOpAdd, Status: domain.BulkOpFailed, Count: 3,
+			Error: "cart limit exceeded: at most 3 units of sku 1002"},
+		{SkuID: 3003, Op: domain.BulkOpAdd, Status: domain.BulkOpFailed, Count: 0, Error: "product not found"},
+	}, res)
+}
+
+func TestCartService_BulkUpdateCart_AllOrNothing(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	repo, pc := bulkMocks(mc, 1001, 1002, 3003)
+
+	cs := service.New(repo, pc, service.WithLimits(service.Limits{MaxItemCount: 3}))
+	res, err := cs.BulkUpdateCart(context.Background(), 8, bulkOps, true, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		domain.BulkOpSkipped, domain.BulkOpSkipped, domain.BulkOpSkipped, domain.BulkOpFailed, domain.BulkOpFailed,
+	}, []string{res[0].Status, res[1].Status, res[2].Status, res[3].Status, res[4].Status})
+	require.Equal(t, uint64(1), res[0].Count)
+	require.Equal(t, uint64(0), res[1].Count)
+}
+
+func TestCartService_BulkUpdateCart_OutOfStock(t *testing.T) {
+	ops := bulkOps[:2]
+	skus := []uint64{1001, 1002}
+
+	t.Run("best effort", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		ctx := context.Background()
+
+		repo, pc := bulkMocks(mc, skus...)
+		pc.ReserveStockMock.When(ctx, uint64(1001), uint64(2)).Then(nil)
+		pc.ReserveStockMock.When(ctx, uint64(1002), uint64(3)).Then(service.ErrInsufficientStock)
+		repo.BulkUpdateMock.Expect(ctx, uint64(8), []postgres.CountChange{
+			{SkuID: 1001, Old: 1, New: 3, Price: 1400},
+		}, nil).Return(nil)
+
+		res, err := service.New(repo, pc).BulkUpdateCart(ctx, 8, ops, false, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, domain.BulkOpApplied, res[0].Status)
+		require.Equal(t, domain.BulkOpResult{
+			SkuID: 1002, Op: domain.BulkOpSet, Status: domain.BulkOpFailed, Count: 0, Error: "insufficient stock",
+		}, res[1])
+	})
+
+	t.Run("all or nothing", func(t *testing.T) {
+		mc := minimock.NewController(t)
+		ctx := context.Background()
+
+		repo, pc := bulkMocks(mc, skus...)
+		pc.ReserveStockMock.When(ctx, uint64(1001), uint64(2)).Then(nil)
+		pc.ReserveStockMock.When(ctx, uint64(1002), uint64(3)).Then(service.ErrInsufficientStock)
+		pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+
+		res, err := service.New(repo, pc).BulkUpdateCart(ctx, 8, ops, true, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, domain.BulkOpSkipped, res[0].Status)
+		require.Equal(t, uint64(1), res[0].Count)
+		require.Equal(t, domain.BulkOpFailed, res[1].Status)
+	})
+}
+
+func TestCartService_BulkUpdateCart_ReleasesAfterCommit(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(8)).Return([]postgres.Position{{SkuID: 1001, Count: 5}}, 5, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1001}).Return(map[uint64]*service.Product{1001: {Price: 1500}}, nil)
+	repo.BulkUpdateMock.Expect(ctx, uint64(8), []postgres.CountChange{
+		{SkuID: 1001, Old: 5, New: 2, Price: 1500},
+	}, nil).Return(nil)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(3)).Return(nil)
+
+	_, err := service.New(repo, pc).BulkUpdateCart(ctx, 8, []domain.BulkOp{{Op: domain.BulkOpSet, SkuID: 1001, Count: 2}}, true, nil)
+	require.NoError(t, err)
+}
+
+func TestCartService_BulkUpdateCart_CartChangedReleases(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo, pc := bulkMocks(mc, 1001)
+	pc.ReserveStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+	repo.BulkUpdateMock.Return(postgres.ErrCartChanged)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+
+	_, err := service.New(repo, pc).BulkUpdateCart(ctx, 8, bulkOps[:1], false, nil)
+	require.ErrorIs(t, err, service.ErrVersionMismatch)
+}
+
+func TestCartService_BulkUpdateCart_InvalidOps(t *testing.T) {
+	mc := minimock.NewController(t)
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), mocks.NewClientIfaceMock(mc))
+
+	_, err := cs.BulkUpdateCart(context.Background(), 8, nil, false, nil)
+	require.ErrorIs(t, err, service.ErrInvalidBulkOp)
+	_, err = cs.BulkUpdateCart(context.Background(), 8, make([]domain.BulkOp, service.MaxBulkOps+1), false, nil)
+	require.ErrorIs(t, err, service.ErrInvalidBulkOp)
+}
+
+func TestCartService_BulkUpdateCart_AddOverflowFails(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	repo, pc := bulkMocks(mc, 1001)
+
+	cs := service.New(repo, pc)
+	ops := []domain.BulkOp{{Op: domain.BulkOpAdd, SkuID: 1001, Count: math.MaxUint64}}
+	res, err := cs.BulkUpdateCart(context.Background(), 8, ops, false, nil)
+
+	// the T-shirt in the cart would wrap the count around
+	require.NoError(t, err)
+	require.Equal(t, domain.BulkOpFailed, res[0].Status)
+	require.Equal(t, uint64(1), res[0].Count)
+	require.Equal(t, service.ErrInvalidBulkOp.Error(), res[0].Error)
+}
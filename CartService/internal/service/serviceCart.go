@@ -3,70 +3,481 @@ package service
 import (
 	"context"
 	"errors"
+	"log"
+	"time"
 
 	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
 	"github.com/verbovyar/OzonCart/internal/repositories/interfaces"
+	"github.com/verbovyar/OzonCart/pkg/money"
+	"github.com/verbovyar/OzonCart/pkg/striped"
+	"golang.org/x/sync/errgroup"
 )
 
-var ErrProductNotFound = errors.New("product not found")
+var (
+	ErrProductNotFound   = errors.New("product not found")
+	ErrInsufficientStock = errors.New("insufficient stock")
+	ErrItemNotFound      = errors.New("item not in cart")
+	ErrVersionMismatch   = errors.New("cart version mismatch")
+)
+
+const (
+	DefaultProductConcurrency = 8
+	DefaultUserLockStripes    = 1024
+)
 
+// CartService owns the cart use cases. Cart mutations take the version the
+// caller last saw and fail with ErrVersionMismatch if the cart has moved on,
+// a nil version applies the change to whatever the cart is at.
 type CartService struct {
 	store interfaces.RepositoryIface
 	pc    ClientIface
+
+	batchLookup bool
+	concurrency int
+	limits      Limits
+
+	idempotencyTTL time.Duration
+	mergeStrategy  MergeStrategy
+
+	removeDelisted bool
+
+	currency string
+	rates    money.RateProvider
+
+	tax      TaxCalculator
+	shipping ShippingCalculator
+
+	shareKey []byte
+	shareTTL time.Duration
+
+	sweep    SweepPolicy
+	notifier AbandonedCartNotifier
+
+	// userLocks serializes changes of one cart inside this process, the
+	// store keeps them consistent across instances
+	userLocks *striped.Mutex
 }
 
-func New(store interfaces.RepositoryIface, pc ClientIface) *CartService {
-	return &CartService{
-		store: store,
-		pc:    pc,
+type Option func(*CartService)
+
+// WithBatchLookup switches GetCart between one ClientIface.GetProducts call
+// and a fan-out of GetProduct calls for ProductService without /list_skus.
+func WithBatchLookup(enabled bool) Option {
+	return func(c *CartService) {
+		c.batchLookup = enabled
+	}
+}
+
+// WithDelistedCleanup makes GetCart remove the positions of skus that
+// ProductService no longer knows instead of only reporting them.
+func WithDelistedCleanup(enabled bool) Option {
+	return func(c *CartService) {
+		c.removeDelisted = enabled
+	}
+}
+
+// WithProductConcurrency limits GetProduct calls in flight during the fan-out.
+func WithProductConcurrency(n int) Option {
+	return func(c *CartService) {
+		if n > 0 {
+			c.concurrency = n
+		}
 	}
 }
 
-func (c *CartService) AddToCart(ctx context.Context, userID, skuID, count uint64) error {
-	_, err := c.pc.GetProduct(ctx, skuID)
+// WithUserLockStripes sets how many locks the users are spread over.
+func WithUserLockStripes(n int) Option {
+	return func(c *CartService) {
+		if n > 0 {
+			c.userLocks = striped.New(n)
+		}
+	}
+}
+
+func New(store interfaces.RepositoryIface, pc ClientIface, opts ...Option) *CartService {
+	c := &CartService{
+		store:       store,
+		pc:          pc,
+		batchLookup: true,
+		concurrency: DefaultProductConcurrency,
+		userLocks:   striped.New(DefaultUserLockStripes),
+
+		idempotencyTTL: DefaultIdempotencyTTL,
+		mergeStrategy:  MergeSum,
+		currency:       DefaultCurrency,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+func (c *CartService) AddToCart(ctx context.Context, userID, skuID, count uint64, expectedVersion *uint64) error {
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	pr, err := c.pc.GetProduct(ctx, skuID)
 	if err != nil {
 		if errors.Is(err, ErrProductNotFound) {
 			return ErrProductNotFound
 		}
 		return err
 	}
+	price, err := c.unitPrice(ctx, pr)
+	if err != nil {
+		return err
+	}
+	if _, err := checked(price.Mul(count)); err != nil {
+		return err
+	}
+
+	return retryMoved(func() error {
+		return c.addItem(ctx, userID, skuID, count, price.Amount, expectedVersion)
+	})
+}
+
+// addItem adds count units at price within the limits and with their stock
+// reserved.
+func (c *CartService) addItem(ctx context.Context, userID, skuID, count, price uint64, expectedVersion *uint64) error {
+	expected := expectedVersion
+	if c.limits != (Limits{}) {
+		_, version, err := c.checkLimits(ctx, userID, skuID, price, func(prev uint64) uint64 { return prev + count })
+		if err != nil {
+			return err
+		}
+		if expected, err = c.checkedVersion(expectedVersion, version); err != nil {
+			return err
+		}
+	}
+
+	if err := c.pc.ReserveStock(ctx, skuID, count); err != nil {
+		return err
+	}
+
+	if err := c.store.AddItem(ctx, userID, skuID, count, price, expected); err != nil {
+		c.releaseStock(ctx, skuID, count)
+		return checkedError(err, expectedVersion)
+	}
 
-	return c.store.AddItem(ctx, userID, skuID, count)
+	return nil
 }
 
-func (c *CartService) DeleteItem(userID, skuID uint64) error {
-	return c.store.DeleteItem(context.Background(), userID, skuID)
+// UpdateItemCount sets the count of skuID in the cart, zero removes it. Stock
+// for the difference to the previous count is reserved before the cart is
+// changed and released once it has been.
+func (c *CartService) UpdateItemCount(ctx context.Context, userID, skuID, count uint64, expectedVersion *uint64) error {
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	if count == 0 {
+		return c.deleteItem(ctx, userID, skuID, expectedVersion)
+	}
+
+	pr, err := c.pc.GetProduct(ctx, skuID)
+	if err != nil {
+		return err
+	}
+	price, err := c.unitPrice(ctx, pr)
+	if err != nil {
+		return err
+	}
+	if _, err := checked(price.Mul(count)); err != nil {
+		return err
+	}
+
+	return retryMoved(func() error {
+		return c.updateItemCount(ctx, userID, skuID, count, price.Amount, expectedVersion)
+	})
 }
 
-func (c *CartService) ClearCart(userID uint64) error {
-	return c.store.ClearCart(context.Background(), userID)
+func (c *CartService) updateItemCount(ctx context.Context, userID, skuID, count, price uint64, expectedVersion *uint64) error {
+	positions, version, err := c.checkLimits(ctx, userID, skuID, price, func(uint64) uint64 { return count })
+	if err != nil {
+		return err
+	}
+	expected, err := c.checkedVersion(expectedVersion, version)
+	if err != nil {
+		return err
+	}
+	var prev uint64
+	for _, p := range positions {
+		if p.SkuID == skuID {
+			prev = p.Count
+		}
+	}
+	var reserved uint64
+	if count > prev {
+		reserved = count - prev
+		if err := c.pc.ReserveStock(ctx, skuID, reserved); err != nil {
+			return err
+		}
+	}
+
+	replaced, err := c.store.UpdateItemCount(ctx, userID, skuID, count, price, expected)
+	if err != nil {
+		if reserved > 0 {
+			c.releaseStock(ctx, skuID, reserved)
+		}
+		return checkedError(err, expectedVersion)
+	}
+	c.settleStock(ctx, skuID, int64(count)-int64(replaced)-int64(reserved))
+
+	return nil
+}
+
+// settleStock reserves or releases the units still owed after a change. It is
+// best effort as the cart is already changed: only another instance changing
+// the position since it was read leaves a positive diff.
+func (c *CartService) settleStock(ctx context.Context, skuID uint64, diff int64) {
+	switch {
+	case diff > 0:
+		if err := c.pc.ReserveStock(ctx, skuID, uint64(diff)); err != nil {
+			log.Printf("settle stock sku=%d count=%d: %v", skuID, diff, err)
+		}
+	case diff < 0:
+		c.releaseStock(ctx, skuID, uint64(-diff))
+	}
+}
+
+// DecrementItem takes one unit of skuID out of the cart, the position is
+// removed once nothing is left.
+func (c *CartService) DecrementItem(ctx context.Context, userID, skuID uint64, expectedVersion *uint64) error {
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	if _, err := c.store.DecrementItem(ctx, userID, skuID, expectedVersion); err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			return ErrItemNotFound
+		}
+		return storeError(err)
+	}
+	c.releaseStock(ctx, skuID, 1)
+
+	return nil
+}
+
+func (c *CartService) DeleteItem(ctx context.Context, userID, skuID uint64, expectedVersion *uint64) error {
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	return c.deleteItem(ctx, userID, skuID, expectedVersion)
+}
+
+func (c *CartService) deleteItem(ctx context.Context, userID, skuID uint64, expectedVersion *uint64) error {
+	count, err := c.store.DeleteItem(ctx, userID, skuID, expectedVersion)
+	if err != nil {
+		return storeError(err)
+	}
+	if count > 0 {
+		c.releaseStock(ctx, skuID, count)
+	}
+
+	return nil
+}
+
+func (c *CartService) ClearCart(ctx context.Context, userID uint64, expectedVersion *uint64) error {
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	positions, err := c.store.ClearCart(ctx, userID, expectedVersion)
+	if err != nil {
+		return storeError(err)
+	}
+	for _, p := range positions {
+		c.releaseStock(ctx, p.SkuID, p.Count)
+	}
+
+	return nil
+}
+
+// storeError translates the store errors shared by all cart mutations.
+func storeError(err error) error {
+	if errors.Is(err, postgres.ErrVersionMismatch) {
+		return ErrVersionMismatch
+	}
+
+	return err
+}
+
+// releaseStock is best effort: the cart is already changed, so a failed
+// release is only logged and left for ProductService to reconcile.
+func (c *CartService) releaseStock(ctx context.Context, skuID, count uint64) {
+	if err := c.pc.ReleaseStock(ctx, skuID, count); err != nil {
+		log.Printf("release stock sku=%d count=%d: %v", skuID, count, err)
+	}
 }
 
 func (c *CartService) GetCart(ctx context.Context, userID uint64) (*domain.GetCartResponse, error) {
-	positions, err := c.store.GetCart(ctx, userID)
+	res, delisted, err := c.readCart(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	items := make([]domain.CartItem, 0, len(positions))
-	var total uint64
+	if c.removeDelisted && len(delisted) > 0 {
+		if err := c.removeDelistedItems(ctx, userID, delisted, res); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// readCart builds the cart with current prices in the cart currency and its
+// promo, and returns the skus ProductService no longer knows. It changes
+// nothing, so it may run under the user's lock.
+func (c *CartService) readCart(ctx context.Context, userID uint64) (*domain.GetCartResponse, []uint64, error) {
+	positions, version, err := c.store.GetCart(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skus := make([]uint64, 0, len(positions))
 	for _, p := range positions {
-		pr, err := c.pc.GetProduct(ctx, p.SkuID)
+		skus = append(skus, p.SkuID)
+	}
+	products, err := c.lookupProducts(ctx, skus)
+	if err != nil {
+		return nil, nil, err
+	}
+	promo, err := c.store.GetCartPromo(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	res := &domain.GetCartResponse{
+		Items:            make([]domain.CartItem, 0, len(positions)),
+		UnavailableItems: []domain.UnavailableItem{},
+		Version:          version,
+	}
+	var delisted []uint64
+	for _, p := range positions {
+		pr, ok := products[p.SkuID]
+		if !ok {
+			res.UnavailableItems = append(res.UnavailableItems, domain.UnavailableItem{
+				SkuID:  p.SkuID,
+				Count:  p.Count,
+				Reason: domain.UnavailableDelisted,
+			})
+			delisted = append(delisted, p.SkuID)
+			continue
+		}
+		price, err := c.unitPrice(ctx, pr)
+		if errors.Is(err, ErrCurrencyMismatch) {
+			res.UnavailableItems = append(res.UnavailableItems, domain.UnavailableItem{
+				SkuID:  p.SkuID,
+				Count:  p.Count,
+				Reason: domain.UnavailableCurrency,
+			})
+			continue
+		}
 		if err != nil {
-			if errors.Is(err, ErrProductNotFound) {
-				continue
-			}
-			return nil, err
+			return nil, nil, err
+		}
+		// positions added before prices were kept have no added price
+		added := price
+		if p.AddedPrice != 0 {
+			added = money.New(p.AddedPrice, price.Currency)
 		}
 		item := domain.CartItem{
-			SkuID: p.SkuID,
-			Name:  pr.Name,
-			Count: p.Count,
-			Price: pr.Price,
+			SkuID:        p.SkuID,
+			Name:         pr.Name,
+			Count:        p.Count,
+			Price:        price,
+			AddedPrice:   &added,
+			CurrentPrice: &price,
+			WeightGrams:  pr.WeightGrams,
+		}
+		res.Items = append(res.Items, item)
+		if added != price {
+			res.PricesChanged = true
+		}
+	}
+	if err := priceCart(res, promo, c.currency); err != nil {
+		return nil, nil, err
+	}
+
+	return res, delisted, nil
+}
+
+// removeDelistedItems drops the delisted positions from the cart and marks
+// the ones it removed in res. A position that is gone already was removed
+// by a concurrent call and stays unmarked.
+func (c *CartService) removeDelistedItems(ctx context.Context, userID uint64, skus []uint64, res *domain.GetCartResponse) error {
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	removed, version, err := c.store.RemoveDelisted(ctx, userID, skus)
+	if err != nil {
+		return err
+	}
+	// with another change in between res does not show the new version, the
+	// old one makes the next conditional mutation fail and the client reload
+	if version == res.Version+1 {
+		res.Version = version
+	}
+
+	gone := make(map[uint64]bool, len(removed))
+	for _, p := range removed {
+		gone[p.SkuID] = true
+		c.releaseStock(ctx, p.SkuID, p.Count)
+	}
+	for i := range res.UnavailableItems {
+		res.UnavailableItems[i].Removed = gone[res.UnavailableItems[i].SkuID]
+	}
+
+	return nil
+}
+
+func (c *CartService) lookupProducts(ctx context.Context, skus []uint64) (map[uint64]*Product, error) {
+	if len(skus) == 0 {
+		return map[uint64]*Product{}, nil
+	}
+	if c.batchLookup {
+		return c.pc.GetProducts(ctx, skus)
+	}
+
+	return c.fetchProducts(ctx, skus)
+}
+
+// fetchProducts calls GetProduct for every sku with at most c.concurrency
+// calls in flight. Unknown skus are left out of the result, any other error
+// cancels the calls still running and is returned.
+func (c *CartService) fetchProducts(ctx context.Context, skus []uint64) (map[uint64]*Product, error) {
+	found := make([]*Product, len(skus))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(c.concurrency)
+	for i, sku := range skus {
+		if gctx.Err() != nil {
+			break
+		}
+		g.Go(func() error {
+			pr, err := c.pc.GetProduct(gctx, sku)
+			if err != nil {
+				if errors.Is(err, ErrProductNotFound) {
+					return nil
+				}
+				return err
+			}
+			found[i] = pr
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	res := make(map[uint64]*Product, len(skus))
+	for i, pr := range found {
+		if pr != nil {
+			res[skus[i]] = pr
 		}
-		items = append(items, item)
-		total += pr.Price * p.Count
 	}
 
-	return &domain.GetCartResponse{Items: items, TotalPrice: total}, nil
+	return res, nil
 }
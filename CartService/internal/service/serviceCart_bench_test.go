@@ -0,0 +1,56 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+)
+
+// productLatency imitates one round trip to ProductService.
+const productLatency = time.Millisecond
+
+func benchmarkGetCartFanOut(b *testing.B, cartSize, concurrency int) {
+	mc := minimock.NewController(b)
+
+	positions := make([]postgres.Position, 0, cartSize)
+	for i := 1; i <= cartSize; i++ {
+		positions = append(positions, postgres.Position{SkuID: uint64(i), Count: 1})
+	}
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Return(positions, 0, nil)
+	repo.GetCartPromoMock.Return(nil, nil)
+	pc.GetProductMock.Set(func(ctx context.Context, sku uint64) (*service.Product, error) {
+		time.Sleep(productLatency)
+		return &service.Product{Name: "Demo T-Shirt", Price: 1500}, nil
+	})
+
+	cs := service.New(repo, pc, service.WithBatchLookup(false), service.WithProductConcurrency(concurrency))
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := cs.GetCart(ctx, 1); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCartService_GetCart_FanOut(b *testing.B) {
+	for _, size := range []int{1, 10, 50} {
+		for _, conc := range []int{1, 8, 32} {
+			b.Run(fmt.Sprintf("items=%d/concurrency=%d", size, conc), func(b *testing.B) {
+				benchmarkGetCartFanOut(b, size, conc)
+			})
+		}
+	}
+}
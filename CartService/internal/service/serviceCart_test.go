@@ -2,13 +2,16 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
 	"github.com/verbovyar/OzonCart/internal/mocks"
 	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
 	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
 )
 
 func TestCartService_Add_Succes(t *testing.T) {
@@ -23,10 +26,84 @@ func TestCartService_Add_Succes(t *testing.T) {
 	repo := mocks.NewRepositoryIfaceMock(mc)
 
 	pc.GetProductMock.Expect(ctx, skuID).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
-	repo.AddItemMock.Expect(ctx, userID, skuID, uint64(count)).Return(nil)
+	pc.ReserveStockMock.Expect(ctx, skuID, count).Return(nil)
+	repo.AddItemMock.Expect(ctx, userID, skuID, uint64(count), uint64(1500), nil).Return(nil)
 
 	cs := service.New(repo, pc)
-	require.NoError(t, cs.AddToCart(ctx, userID, skuID, count))
+	require.NoError(t, cs.AddToCart(ctx, userID, skuID, count, nil))
+}
+
+func TestCartService_Add_InsufficientStock(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	ctx := context.Background()
+	userID := uint64(1)
+	skuID := uint64(1001)
+	count := uint64(5)
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	pc.GetProductMock.Expect(ctx, skuID).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	pc.ReserveStockMock.Expect(ctx, skuID, count).Return(service.ErrInsufficientStock)
+
+	cs := service.New(repo, pc)
+	err := cs.AddToCart(ctx, userID, skuID, count, nil)
+	require.ErrorIs(t, err, service.ErrInsufficientStock)
+}
+
+func TestCartService_Add_DBErrorReleasesStock(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	ctx := context.Background()
+	userID := uint64(1)
+	skuID := uint64(1001)
+	count := uint64(2)
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	pc.GetProductMock.Expect(ctx, skuID).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	pc.ReserveStockMock.Expect(ctx, skuID, count).Return(nil)
+	repo.AddItemMock.Expect(ctx, userID, skuID, count, uint64(1500), nil).Return(errors.New("db fail"))
+	pc.ReleaseStockMock.Expect(ctx, skuID, count).Return(nil)
+
+	cs := service.New(repo, pc)
+	require.Error(t, cs.AddToCart(ctx, userID, skuID, count, nil))
+}
+
+func TestCartService_DeleteItem_ReleasesStock(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	repo.DeleteItemMock.Expect(ctx, uint64(1), uint64(1001), nil).Return(3, nil)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(3)).Return(nil)
+
+	cs := service.New(repo, pc)
+	require.NoError(t, cs.DeleteItem(ctx, 1, 1001, nil))
+}
+
+func TestCartService_ClearCart_ReleasesStock(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	repo.ClearCartMock.Expect(ctx, uint64(1), nil).Return([]postgres.Position{
+		{SkuID: 1001, Count: 2},
+		{SkuID: 1002, Count: 1},
+	}, nil)
+	pc.ReleaseStockMock.When(ctx, uint64(1001), uint64(2)).Then(nil)
+	pc.ReleaseStockMock.When(ctx, uint64(1002), uint64(1)).Then(nil)
+
+	cs := service.New(repo, pc)
+	require.NoError(t, cs.ClearCart(ctx, 1, nil))
 }
 
 func TestCartService_Add_Not_Found(t *testing.T) {
@@ -43,7 +120,7 @@ func TestCartService_Add_Not_Found(t *testing.T) {
 	pc.GetProductMock.Expect(ctx, skuID).Return(nil, service.ErrProductNotFound)
 
 	cs := service.New(repo, pc)
-	err := cs.AddToCart(ctx, userID, skuID, count)
+	err := cs.AddToCart(ctx, userID, skuID, count, nil)
 	require.ErrorIs(t, err, service.ErrProductNotFound)
 }
 
@@ -59,22 +136,24 @@ func TestCartService_GetCart_AllProducts(t *testing.T) {
 		[]postgres.Position{
 			{SkuID: 1001, Count: 2},
 			{SkuID: 1002, Count: 1},
-		}, nil,
+		}, 0, nil,
 	)
+	repo.GetCartPromoMock.Return(nil, nil)
 
-	pc.GetProductMock.When(minimock.AnyContext, uint64(1001)).
-		Then(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
-
-	pc.GetProductMock.When(minimock.AnyContext, uint64(1002)).
-		Then(&service.Product{Name: "Coffee Mug", Price: 900}, nil)
+	pc.GetProductsMock.Expect(minimock.AnyContext, []uint64{1001, 1002}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500},
+			1002: {Name: "Coffee Mug", Price: 900},
+		}, nil,
+	)
 
 	cs := service.New(repo, pc)
 	res, err := cs.GetCart(context.Background(), userID)
-	t.Logf("%d", res.TotalPrice)
+	t.Logf("%v", res.TotalPrice)
 
 	require.NoError(t, err)
 	require.Len(t, res.Items, 2)
-	require.Equal(t, uint64(3900), res.TotalPrice)
+	require.Equal(t, money.New(3900, "RUB"), res.TotalPrice)
 }
 
 func TestCartService_GetCart_SkipMissing(t *testing.T) {
@@ -89,19 +168,332 @@ func TestCartService_GetCart_SkipMissing(t *testing.T) {
 		[]postgres.Position{
 			{SkuID: 1001, Count: 2},
 			{SkuID: 1002, Count: 1},
+		}, 0, nil,
+	)
+	repo.GetCartPromoMock.Return(nil, nil)
+
+	pc.GetProductsMock.Expect(minimock.AnyContext, []uint64{1001, 1002}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500},
 		}, nil,
 	)
 
+	cs := service.New(repo, pc)
+	res, err := cs.GetCart(context.Background(), userID)
+
+	require.NoError(t, err)
+	require.Len(t, res.Items, 1)
+	require.Equal(t, money.New(3000, "RUB"), res.TotalPrice)
+	require.Equal(t, []domain.UnavailableItem{{SkuID: 1002, Count: 1, Reason: domain.UnavailableDelisted}}, res.UnavailableItems)
+}
+
+func TestCartService_GetCart_RemovesDelisted(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return(
+		[]postgres.Position{{SkuID: 1001, Count: 2}, {SkuID: 1002, Count: 3}}, 4, nil,
+	)
+	repo.GetCartPromoMock.Return(nil, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1001, 1002}).Return(
+		map[uint64]*service.Product{1001: {Name: "Demo T-Shirt", Price: 1500}}, nil,
+	)
+	repo.RemoveDelistedMock.Expect(ctx, uint64(7), []uint64{1002}).Return(
+		[]postgres.Position{{SkuID: 1002, Count: 3}}, 5, nil,
+	)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1002), uint64(3)).Return(nil)
+
+	cs := service.New(repo, pc, service.WithBatchLookup(true), service.WithDelistedCleanup(true))
+	res, err := cs.GetCart(ctx, 7)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.Version)
+	require.Equal(t, []domain.UnavailableItem{{SkuID: 1002, Count: 3, Reason: domain.UnavailableDelisted, Removed: true}}, res.UnavailableItems)
+	require.Equal(t, money.New(3000, "RUB"), res.TotalPrice)
+}
+
+func TestCartService_GetCart_RemoveDelistedKeepsVersionAfterOtherChange(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return([]postgres.Position{{SkuID: 1002, Count: 3}}, 4, nil)
+	repo.GetCartPromoMock.Return(nil, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1002}).Return(map[uint64]*service.Product{}, nil)
+	// the position went away with another change before the cleanup
+	repo.RemoveDelistedMock.Expect(ctx, uint64(7), []uint64{1002}).Return(nil, 6, nil)
+
+	cs := service.New(repo, pc, service.WithBatchLookup(true), service.WithDelistedCleanup(true))
+	res, err := cs.GetCart(ctx, 7)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.Version)
+	require.False(t, res.UnavailableItems[0].Removed)
+}
+
+func TestCartService_GetCart_PricesChanged(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(minimock.AnyContext, uint64(7)).Return(
+		[]postgres.Position{
+			{SkuID: 1001, Count: 2, AddedPrice: 1200},
+			{SkuID: 1002, Count: 1},
+		}, 0, nil,
+	)
+	repo.GetCartPromoMock.Return(nil, nil)
+	pc.GetProductsMock.Expect(minimock.AnyContext, []uint64{1001, 1002}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500},
+			1002: {Name: "Coffee Mug", Price: 900},
+		}, nil,
+	)
+
+	cs := service.New(repo, pc)
+	res, err := cs.GetCart(context.Background(), 7)
+
+	require.NoError(t, err)
+	require.True(t, res.PricesChanged)
+	require.Equal(t, money.New(1200, "RUB"), *res.Items[0].AddedPrice)
+	require.Equal(t, money.New(1500, "RUB"), *res.Items[0].CurrentPrice)
+	// an unknown added price is taken as unchanged
+	require.Equal(t, money.New(900, "RUB"), *res.Items[1].AddedPrice)
+	require.Equal(t, money.New(3900, "RUB"), res.TotalPrice)
+}
+
+func TestCartService_GetCart_PricesUnchanged(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(minimock.AnyContext, uint64(7)).Return(
+		[]postgres.Position{{SkuID: 1001, Count: 2, AddedPrice: 1500}}, 0, nil,
+	)
+	repo.GetCartPromoMock.Return(nil, nil)
+	pc.GetProductsMock.Expect(minimock.AnyContext, []uint64{1001}).Return(
+		map[uint64]*service.Product{1001: {Name: "Demo T-Shirt", Price: 1500}}, nil,
+	)
+
+	cs := service.New(repo, pc)
+	res, err := cs.GetCart(context.Background(), 7)
+
+	require.NoError(t, err)
+	require.False(t, res.PricesChanged)
+}
+
+func TestCartService_GetCart_FanOutKeepsOrder(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	userID := uint64(7)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(minimock.AnyContext, userID).Return(
+		[]postgres.Position{
+			{SkuID: 1001, Count: 2},
+			{SkuID: 1002, Count: 1},
+			{SkuID: 1003, Count: 4},
+		}, 0, nil,
+	)
+	repo.GetCartPromoMock.Return(nil, nil)
+
 	pc.GetProductMock.When(minimock.AnyContext, uint64(1001)).
 		Then(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
-
 	pc.GetProductMock.When(minimock.AnyContext, uint64(1002)).
 		Then(nil, service.ErrProductNotFound)
+	pc.GetProductMock.When(minimock.AnyContext, uint64(1003)).
+		Then(&service.Product{Name: "Sticker Pack", Price: 300}, nil)
 
-	cs := service.New(repo, pc)
+	cs := service.New(repo, pc, service.WithBatchLookup(false), service.WithProductConcurrency(3))
 	res, err := cs.GetCart(context.Background(), userID)
 
 	require.NoError(t, err)
-	require.Len(t, res.Items, 1)
-	require.Equal(t, uint64(3000), res.TotalPrice)
+	require.Len(t, res.Items, 2)
+	require.Equal(t, uint64(1001), res.Items[0].SkuID)
+	require.Equal(t, uint64(1003), res.Items[1].SkuID)
+	require.Equal(t, money.New(4200, "RUB"), res.TotalPrice)
+}
+
+func TestCartService_GetCart_FanOutCancelsOnError(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	userID := uint64(7)
+	positions := make([]postgres.Position, 0, 20)
+	for i := uint64(1); i <= 20; i++ {
+		positions = append(positions, postgres.Position{SkuID: i, Count: 1})
+	}
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(minimock.AnyContext, userID).Return(positions, 0, nil)
+
+	fail := errors.New("product service down")
+	pc.GetProductMock.Set(func(ctx context.Context, sku uint64) (*service.Product, error) {
+		if sku == 1 {
+			return nil, fail
+		}
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	cs := service.New(repo, pc, service.WithBatchLookup(false), service.WithProductConcurrency(4))
+	_, err := cs.GetCart(context.Background(), userID)
+
+	require.ErrorIs(t, err, fail)
+	require.Less(t, pc.GetProductAfterCounter(), uint64(len(positions)))
+}
+
+func TestCartService_UpdateItemCount_ReservesDifference(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	repo.GetCartMock.Expect(ctx, uint64(1)).Return([]postgres.Position{{SkuID: 1001, Count: 2}}, 0, nil)
+	pc.ReserveStockMock.Expect(ctx, uint64(1001), uint64(3)).Return(nil)
+	repo.UpdateItemCountMock.Expect(ctx, uint64(1), uint64(1001), uint64(5), uint64(1500), nil).Return(2, nil)
+
+	cs := service.New(repo, pc)
+	require.NoError(t, cs.UpdateItemCount(ctx, 1, 1001, 5, nil))
+}
+
+func TestCartService_UpdateItemCount_ReleasesDifference(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	repo.GetCartMock.Expect(ctx, uint64(1)).Return([]postgres.Position{{SkuID: 1001, Count: 5}}, 0, nil)
+	repo.UpdateItemCountMock.Expect(ctx, uint64(1), uint64(1001), uint64(2), uint64(1500), nil).Return(5, nil)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(3)).Return(nil)
+
+	cs := service.New(repo, pc)
+	require.NoError(t, cs.UpdateItemCount(ctx, 1, 1001, 2, nil))
+}
+
+func TestCartService_UpdateItemCount_InsufficientStockLeavesCart(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	repo.GetCartMock.Expect(ctx, uint64(1)).Return([]postgres.Position{{SkuID: 1001, Count: 2}}, 0, nil)
+	pc.ReserveStockMock.Expect(ctx, uint64(1001), uint64(48)).Return(service.ErrInsufficientStock)
+
+	cs := service.New(repo, pc)
+	err := cs.UpdateItemCount(ctx, 1, 1001, 50, nil)
+	require.ErrorIs(t, err, service.ErrInsufficientStock)
+	require.Zero(t, repo.UpdateItemCountAfterCounter())
+}
+
+func TestCartService_UpdateItemCount_VersionMismatchReleases(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	version := uint64(3)
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	repo.GetCartMock.Expect(ctx, uint64(1)).Return(nil, 0, nil)
+	pc.ReserveStockMock.Expect(ctx, uint64(1001), uint64(4)).Return(nil)
+	repo.UpdateItemCountMock.Expect(ctx, uint64(1), uint64(1001), uint64(4), uint64(1500), &version).
+		Return(0, postgres.ErrVersionMismatch)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(4)).Return(nil)
+
+	cs := service.New(repo, pc)
+	require.ErrorIs(t, cs.UpdateItemCount(ctx, 1, 1001, 4, &version), service.ErrVersionMismatch)
+}
+
+func TestCartService_UpdateItemCount_ZeroDeletes(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	repo.DeleteItemMock.Expect(ctx, uint64(1), uint64(1001), nil).Return(3, nil)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(3)).Return(nil)
+
+	cs := service.New(repo, pc)
+	require.NoError(t, cs.UpdateItemCount(ctx, 1, 1001, 0, nil))
+}
+
+func TestCartService_DecrementItem(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	repo.DecrementItemMock.Expect(ctx, uint64(1), uint64(1001), nil).Return(0, nil)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(1)).Return(nil)
+
+	cs := service.New(repo, pc)
+	require.NoError(t, cs.DecrementItem(ctx, 1, 1001, nil))
+}
+
+func TestCartService_DecrementItem_Missing(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	repo.DecrementItemMock.Expect(ctx, uint64(1), uint64(1001), nil).Return(0, postgres.ErrNotFound)
+
+	cs := service.New(repo, pc)
+	err := cs.DecrementItem(ctx, 1, 1001, nil)
+	require.ErrorIs(t, err, service.ErrItemNotFound)
+}
+
+func TestCartService_Add_VersionMismatchReleasesStock(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	version := uint64(3)
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	pc.ReserveStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+	repo.AddItemMock.Expect(ctx, uint64(1), uint64(1001), uint64(2), uint64(1500), &version).Return(postgres.ErrVersionMismatch)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+
+	cs := service.New(repo, pc)
+	err := cs.AddToCart(ctx, 1, 1001, 2, &version)
+	require.ErrorIs(t, err, service.ErrVersionMismatch)
+}
+
+func TestCartService_GetCart_ReturnsVersion(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(1)).Return(nil, 9, nil)
+	repo.GetCartPromoMock.Return(nil, nil)
+
+	cs := service.New(repo, pc)
+	cart, err := cs.GetCart(ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, cart.Items)
+	require.Equal(t, uint64(9), cart.Version)
 }
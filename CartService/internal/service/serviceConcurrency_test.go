@@ -0,0 +1,321 @@
+package service_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+// memStore is an in-memory cart store with a fixed latency per call. Like
+// Postgres every single call is atomic, but nothing spans two calls. Versions
+// are not tracked.
+type memStore struct {
+	latency time.Duration
+
+	mu    sync.Mutex
+	carts map[uint64]map[uint64]uint64
+}
+
+func newMemStore(latency time.Duration) *memStore {
+	return &memStore{latency: latency, carts: make(map[uint64]map[uint64]uint64)}
+}
+
+func (s *memStore) do(fn func()) {
+	time.Sleep(s.latency)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn()
+}
+
+func (s *memStore) AddItem(_ context.Context, userID, skuID, count, _ uint64, _ *uint64) error {
+	s.do(func() {
+		if s.carts[userID] == nil {
+			s.carts[userID] = make(map[uint64]uint64)
+		}
+		s.carts[userID][skuID] += count
+	})
+	return nil
+}
+
+func (s *memStore) UpdateItemCount(_ context.Context, userID, skuID, count, _ uint64, _ *uint64) (prev uint64, _ error) {
+	s.do(func() {
+		if s.carts[userID] == nil {
+			s.carts[userID] = make(map[uint64]uint64)
+		}
+		prev = s.carts[userID][skuID]
+		s.carts[userID][skuID] = count
+	})
+	return prev, nil
+}
+
+func (s *memStore) DecrementItem(_ context.Context, userID, skuID uint64, _ *uint64) (left uint64, err error) {
+	s.do(func() {
+		n, ok := s.carts[userID][skuID]
+		if !ok {
+			err = postgres.ErrNotFound
+			return
+		}
+		left = n - 1
+		if left == 0 {
+			delete(s.carts[userID], skuID)
+			return
+		}
+		s.carts[userID][skuID] = left
+	})
+	return left, err
+}
+
+func (s *memStore) DeleteItem(_ context.Context, userID, skuID uint64, _ *uint64) (n uint64, _ error) {
+	s.do(func() {
+		n = s.carts[userID][skuID]
+		delete(s.carts[userID], skuID)
+	})
+	return n, nil
+}
+
+func (s *memStore) ClearCart(_ context.Context, userID uint64, _ *uint64) (res []postgres.Position, _ error) {
+	s.do(func() {
+		for sku, n := range s.carts[userID] {
+			res = append(res, postgres.Position{SkuID: sku, Count: n})
+		}
+		delete(s.carts, userID)
+	})
+	return res, nil
+}
+
+func (s *memStore) GetCart(_ context.Context, userID uint64) (res []postgres.Position, _ uint64, _ error) {
+	s.do(func() {
+		for sku, n := range s.carts[userID] {
+			res = append(res, postgres.Position{SkuID: sku, Count: n})
+		}
+	})
+	sort.Slice(res, func(i, j int) bool { return res[i].SkuID < res[j].SkuID })
+	return res, 0, nil
+}
+
+func (s *memStore) RemoveDelisted(context.Context, uint64, []uint64) ([]postgres.Position, uint64, error) {
+	panic("not used")
+}
+
+func (s *memStore) MoveToSaved(context.Context, uint64, uint64, *uint64) (uint64, error) {
+	panic("not used")
+}
+
+func (s *memStore) MoveToCart(context.Context, uint64, uint64, uint64, uint64, *uint64) error {
+	panic("not used")
+}
+
+func (s *memStore) ListSaved(context.Context, uint64) ([]postgres.SavedItem, error) {
+	panic("not used")
+}
+
+func (s *memStore) CreateCartShare(context.Context, uint64, []postgres.OrderItem, money.Money, time.Time) (uint64, error) {
+	panic("not used")
+}
+
+func (s *memStore) GetCartShare(context.Context, uint64) (*postgres.CartShare, error) {
+	panic("not used")
+}
+
+func (s *memStore) ImportItems(context.Context, uint64, uint64, []postgres.Position, *uint64) error {
+	panic("not used")
+}
+
+func (s *memStore) DeleteExpiredCartShares(context.Context) (int64, error) {
+	panic("not used")
+}
+
+func (s *memStore) BulkUpdate(context.Context, uint64, []postgres.CountChange, *uint64) error {
+	panic("not used")
+}
+
+func (s *memStore) ApplyPromo(context.Context, uint64, string, *uint64) error {
+	panic("not used")
+}
+
+func (s *memStore) RemovePromo(context.Context, uint64, *uint64) error {
+	panic("not used")
+}
+
+func (s *memStore) GetCartPromo(context.Context, uint64) (*postgres.Promo, error) {
+	panic("not used")
+}
+
+func (s *memStore) CreatePromo(context.Context, postgres.Promo) (*postgres.Promo, error) {
+	panic("not used")
+}
+
+func (s *memStore) DisablePromo(context.Context, string) error {
+	panic("not used")
+}
+
+func (s *memStore) ListPromos(context.Context) ([]postgres.Promo, error) {
+	panic("not used")
+}
+
+func (s *memStore) CreateOrder(context.Context, uint64, []postgres.OrderItem, money.Money, *uint64) (uint64, error) {
+	panic("not used")
+}
+
+func (s *memStore) GetOrder(context.Context, uint64, uint64) (*postgres.Order, error) {
+	panic("not used")
+}
+
+func (s *memStore) ListOrders(context.Context, uint64) ([]postgres.Order, error) {
+	panic("not used")
+}
+
+func (s *memStore) CreateGuestCart(context.Context, string) (uint64, error) {
+	panic("not used")
+}
+
+func (s *memStore) GuestCartID(context.Context, string) (uint64, error) {
+	panic("not used")
+}
+
+func (s *memStore) MergeCarts(context.Context, uint64, uint64, func(user, guest []postgres.Position) (map[uint64]uint64, error)) ([]postgres.Position, error) {
+	panic("not used")
+}
+
+func (s *memStore) WithSweepLock(context.Context, func() error) (bool, error) {
+	panic("not used")
+}
+
+func (s *memStore) ExpireCarts(context.Context, time.Duration, int) ([]postgres.ExpiredCart, error) {
+	panic("not used")
+}
+
+func (s *memStore) AbandonedCarts(context.Context, time.Duration, int) ([]postgres.AbandonedCart, error) {
+	panic("not used")
+}
+
+func (s *memStore) MarkAbandonedNotified(context.Context, uint64, time.Time) error {
+	panic("not used")
+}
+
+func (s *memStore) GetCartHistory(context.Context, uint64, time.Time, uint64, int) ([]postgres.HistoryEntry, error) {
+	panic("not used")
+}
+
+func (s *memStore) ClaimIdempotencyKey(context.Context, uint64, string, string, time.Duration) (*postgres.IdempotencyRecord, bool, error) {
+	panic("not used")
+}
+
+func (s *memStore) SaveIdempotentResponse(context.Context, uint64, string, []byte, time.Duration) error {
+	panic("not used")
+}
+
+func (s *memStore) ReleaseIdempotencyKey(context.Context, uint64, string) error {
+	panic("not used")
+}
+
+func (s *memStore) DeleteExpiredIdempotencyKeys(context.Context) (int64, error) {
+	panic("not used")
+}
+
+// stockClient knows every sku and keeps track of reserved units.
+type stockClient struct {
+	mu       sync.Mutex
+	reserved map[uint64]uint64
+}
+
+func (c *stockClient) GetProduct(context.Context, uint64) (*service.Product, error) {
+	return &service.Product{Name: "Demo", Price: 100}, nil
+}
+
+func (c *stockClient) GetProducts(_ context.Context, skus []uint64) (map[uint64]*service.Product, error) {
+	res := make(map[uint64]*service.Product, len(skus))
+	for _, sku := range skus {
+		res[sku] = &service.Product{Name: "Demo", Price: 100}
+	}
+	return res, nil
+}
+
+func (c *stockClient) ReserveStock(_ context.Context, sku, count uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reserved[sku] += count
+	return nil
+}
+
+func (c *stockClient) ReleaseStock(_ context.Context, sku, count uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reserved[sku] -= count
+	return nil
+}
+
+func (c *stockClient) CommitStock(context.Context, uint64, uint64) error {
+	return nil
+}
+
+func TestCartService_Concurrent_SameUserRespectsLimit(t *testing.T) {
+	store := newMemStore(0)
+	pc := &stockClient{reserved: make(map[uint64]uint64)}
+	cs := service.New(store, pc, service.WithLimits(service.Limits{MaxItemCount: 50}))
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = cs.AddToCart(ctx, 1, 1001, 1, nil)
+			if i%4 == 0 {
+				_ = cs.DecrementItem(ctx, 1, 1001, nil)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// without per-user serialization concurrent checks would let the cart
+	// grow past the limit and reservations drift from the cart
+	cart, _, err := store.GetCart(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, cart, 1)
+	require.LessOrEqual(t, cart[0].Count, uint64(50))
+	require.Equal(t, cart[0].Count, pc.reserved[1001])
+}
+
+func TestCartService_Concurrent_UsersRunInParallel(t *testing.T) {
+	const (
+		users   = 32
+		latency = 5 * time.Millisecond
+	)
+	store := newMemStore(latency)
+	pc := &stockClient{reserved: make(map[uint64]uint64)}
+	cs := service.New(store, pc)
+
+	ctx := context.Background()
+	start := time.Now()
+	var wg sync.WaitGroup
+	for u := uint64(1); u <= users; u++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 4; i++ {
+				assert.NoError(t, cs.AddToCart(ctx, u, 1001, 1, nil))
+			}
+		}()
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	for u := uint64(1); u <= users; u++ {
+		cart, _, err := store.GetCart(ctx, u)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4), cart[0].Count)
+	}
+
+	// one request at a time would need users*4*latency
+	serial := users * 4 * latency
+	require.Less(t, elapsed, serial/4)
+}
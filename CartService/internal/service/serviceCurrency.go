@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("price currency differs from cart currency")
+	ErrPriceOverflow    = errors.New("price overflow")
+)
+
+// DefaultCurrency is the currency of the carts unless WithCurrency says otherwise.
+const DefaultCurrency = "RUB"
+
+// WithCurrency sets the ISO 4217 currency every cart is priced in.
+func WithCurrency(currency string) Option {
+	return func(c *CartService) {
+		if currency != "" {
+			c.currency = currency
+		}
+	}
+}
+
+// WithRateProvider lets carts hold products priced in other currencies,
+// their prices are converted into the cart currency. Without it such
+// products are refused.
+func WithRateProvider(rates money.RateProvider) Option {
+	return func(c *CartService) {
+		c.rates = rates
+	}
+}
+
+// unitPrice is the price of the product in the cart currency. It fails with
+// ErrCurrencyMismatch if the product is priced in another currency that
+// cannot be converted.
+func (c *CartService) unitPrice(ctx context.Context, pr *Product) (money.Money, error) {
+	price := money.New(pr.Price, pr.Currency)
+	if price.Currency == "" || price.Currency == c.currency {
+		price.Currency = c.currency
+		return price, nil
+	}
+	if c.rates == nil {
+		return money.Money{}, fmt.Errorf("%w: %s, cart is in %s", ErrCurrencyMismatch, price.Currency, c.currency)
+	}
+
+	converted, err := c.rates.Convert(ctx, price, c.currency)
+	switch {
+	case errors.Is(err, money.ErrNoRate):
+		return money.Money{}, fmt.Errorf("%w: %v", ErrCurrencyMismatch, err)
+	case errors.Is(err, money.ErrOverflow):
+		return money.Money{}, ErrPriceOverflow
+	case err != nil:
+		return money.Money{}, err
+	}
+
+	return converted, nil
+}
+
+// checked turns the overflow of money arithmetic into ErrPriceOverflow and
+// mixed currencies into ErrCurrencyMismatch.
+func checked(m money.Money, err error) (money.Money, error) {
+	if errors.Is(err, money.ErrOverflow) {
+		return money.Money{}, ErrPriceOverflow
+	}
+	if errors.Is(err, money.ErrCurrencyMismatch) {
+		return money.Money{}, fmt.Errorf("%w: %v", ErrCurrencyMismatch, err)
+	}
+
+	return m, err
+}
@@ -0,0 +1,124 @@
+package service_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+func TestCartService_Add_RefusesOtherCurrency(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 20, Currency: "USD"}, nil)
+
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), pc)
+	err := cs.AddToCart(ctx, 7, 1001, 1, nil)
+	require.ErrorIs(t, err, service.ErrCurrencyMismatch)
+}
+
+func TestCartService_Add_ConvertsWithRates(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 200, Currency: "USD"}, nil)
+	pc.ReserveStockMock.Expect(ctx, uint64(1001), uint64(1)).Return(nil)
+	repo.AddItemMock.Expect(ctx, uint64(7), uint64(1001), uint64(1), uint64(18500), nil).Return(nil)
+
+	rates := money.StaticRates{"USD/RUB": {Num: 925, Den: 10}}
+	cs := service.New(repo, pc, service.WithRateProvider(rates))
+	require.NoError(t, cs.AddToCart(ctx, 7, 1001, 1, nil))
+}
+
+func TestCartService_Add_PriceOverflow(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Gold Bar", Price: math.MaxUint64 / 2}, nil)
+
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), pc)
+	err := cs.AddToCart(ctx, 7, 1001, 3, nil)
+	require.ErrorIs(t, err, service.ErrPriceOverflow)
+}
+
+func TestCartService_GetCart_OtherCurrencyUnavailable(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return(
+		[]postgres.Position{{SkuID: 1001, Count: 2}, {SkuID: 1002, Count: 1}}, 3, nil,
+	)
+	repo.GetCartPromoMock.Return(nil, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1001, 1002}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500, Currency: "RUB"},
+			1002: {Name: "Coffee Mug", Price: 10, Currency: "USD"},
+		}, nil,
+	)
+
+	cs := service.New(repo, pc, service.WithBatchLookup(true))
+	res, err := cs.GetCart(ctx, 7)
+
+	require.NoError(t, err)
+	require.Equal(t, "RUB", res.TotalPrice.Currency)
+	require.Len(t, res.Items, 1)
+	require.Equal(t, money.New(3000, "RUB"), res.TotalPrice)
+	require.Equal(t, []domain.UnavailableItem{{SkuID: 1002, Count: 1, Reason: domain.UnavailableCurrency}}, res.UnavailableItems)
+}
+
+func TestCartService_GetCart_Overflow(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	// each line fits, their sum does not
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return(
+		[]postgres.Position{{SkuID: 1001, Count: 1}, {SkuID: 1002, Count: 1}}, 3, nil,
+	)
+	repo.GetCartPromoMock.Return(nil, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1001, 1002}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Gold Bar", Price: math.MaxUint64},
+			1002: {Name: "Coffee Mug", Price: 900},
+		}, nil,
+	)
+
+	cs := service.New(repo, pc, service.WithBatchLookup(true))
+	_, err := cs.GetCart(ctx, 7)
+	require.ErrorIs(t, err, service.ErrPriceOverflow)
+}
+
+func TestCartService_Limits_OverflowExceedsTotal(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return([]postgres.Position{{SkuID: 1002, Count: 2}}, 3, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1002}).Return(
+		map[uint64]*service.Product{1002: {Name: "Gold Bar", Price: math.MaxUint64 / 2}}, nil,
+	)
+
+	cs := service.New(repo, pc, service.WithLimits(service.Limits{MaxTotalPrice: 10000}))
+	err := cs.AddToCart(ctx, 7, 1001, 1, nil)
+	require.ErrorIs(t, err, service.ErrLimitExceeded)
+}
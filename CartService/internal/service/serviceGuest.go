@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+var (
+	ErrGuestCartNotFound    = errors.New("guest cart not found")
+	ErrInvalidUserID        = errors.New("invalid user id")
+	ErrInvalidMergeStrategy = errors.New("invalid merge strategy")
+)
+
+// MergeStrategy decides the count of a sku that is in both carts.
+type MergeStrategy string
+
+const (
+	MergeSum        MergeStrategy = "sum"
+	MergeMax        MergeStrategy = "max"
+	MergePreferUser MergeStrategy = "prefer-user"
+)
+
+func ParseMergeStrategy(s string) (MergeStrategy, error) {
+	switch st := MergeStrategy(s); st {
+	case MergeSum, MergeMax, MergePreferUser:
+		return st, nil
+	default:
+		return "", ErrInvalidMergeStrategy
+	}
+}
+
+// WithMergeStrategy sets the strategy MergeCarts uses when the caller does
+// not pick one.
+func WithMergeStrategy(s MergeStrategy) Option {
+	return func(c *CartService) {
+		if s != "" {
+			c.mergeStrategy = s
+		}
+	}
+}
+
+// CreateGuestCart starts a cart for an anonymous shopper. The returned token
+// is the only way to reach the cart, only its hash is stored.
+func (c *CartService) CreateGuestCart(ctx context.Context) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	if _, err := c.store.CreateGuestCart(ctx, hashGuestToken(token)); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// CartOwner returns the ID the cart operations take: the cart of guestToken
+// if it is set, otherwise userID.
+func (c *CartService) CartOwner(ctx context.Context, userID uint64, guestToken string) (uint64, error) {
+	if guestToken == "" {
+		if !isUserID(userID) {
+			return 0, ErrInvalidUserID
+		}
+		return userID, nil
+	}
+
+	id, err := c.store.GuestCartID(ctx, hashGuestToken(guestToken))
+	if errors.Is(err, postgres.ErrNotFound) {
+		return 0, ErrGuestCartNotFound
+	}
+
+	return id, err
+}
+
+// MergeCarts moves the guest cart into the user's cart, typically on login,
+// and deletes the guest cart. The per-sku limit caps merged counts, skus over
+// the distinct sku limit or the total price limit are left out; the stock of
+// everything that does not end up in the user's cart is released. An empty
+// strategy uses the configured one.
+func (c *CartService) MergeCarts(ctx context.Context, guestToken string, userID uint64, strategy MergeStrategy) error {
+	if strategy == "" {
+		strategy = c.mergeStrategy
+	}
+	if _, err := ParseMergeStrategy(string(strategy)); err != nil {
+		return err
+	}
+	if !isUserID(userID) {
+		return ErrInvalidUserID
+	}
+
+	guestID, err := c.CartOwner(ctx, 0, guestToken)
+	if err != nil {
+		return err
+	}
+
+	// the guest cart changes too, it must not take a write of its own meanwhile
+	c.userLocks.LockPair(userID, guestID)
+	defer c.userLocks.UnlockPair(userID, guestID)
+
+	var products map[uint64]*Product
+	if c.limits.MaxTotalPrice > 0 {
+		if products, err = c.mergeProducts(ctx, userID, guestID); err != nil {
+			return err
+		}
+	}
+
+	dropped, err := c.store.MergeCarts(ctx, guestID, userID, func(user, guest []postgres.Position) (map[uint64]uint64, error) {
+		return c.mergePlan(ctx, strategy, user, guest, products)
+	})
+	if errors.Is(err, postgres.ErrNotFound) {
+		return ErrGuestCartNotFound
+	}
+	if err != nil {
+		return storeError(err)
+	}
+
+	for _, p := range dropped {
+		c.releaseStock(ctx, p.SkuID, p.Count)
+	}
+
+	return nil
+}
+
+// mergeProducts looks up the products of both carts for the total price
+// limit of the merge.
+func (c *CartService) mergeProducts(ctx context.Context, userID, guestID uint64) (map[uint64]*Product, error) {
+	user, _, err := c.store.GetCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	guest, _, err := c.store.GetCart(ctx, guestID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint64]bool, len(user)+len(guest))
+	skus := make([]uint64, 0, len(user)+len(guest))
+	for _, p := range append(user, guest...) {
+		if !seen[p.SkuID] {
+			seen[p.SkuID] = true
+			skus = append(skus, p.SkuID)
+		}
+	}
+
+	return c.lookupProducts(ctx, skus)
+}
+
+// mergePlan returns the new counts of the user's positions after the merge.
+// Guest positions are taken in order while they fit, products prices them
+// for the total price limit.
+func (c *CartService) mergePlan(ctx context.Context, strategy MergeStrategy, user, guest []postgres.Position, products map[uint64]*Product) (map[uint64]uint64, error) {
+	counts := make(map[uint64]uint64, len(user))
+	for _, p := range user {
+		counts[p.SkuID] = p.Count
+	}
+	distinct := len(counts)
+
+	res := make(map[uint64]uint64, len(guest))
+	for _, p := range guest {
+		prev, ok := counts[p.SkuID]
+		if !ok && c.limits.MaxDistinctSkus > 0 && distinct >= c.limits.MaxDistinctSkus {
+			continue
+		}
+
+		var n uint64
+		switch {
+		case !ok:
+			n = p.Count
+		case strategy == MergeSum:
+			n = prev + p.Count
+		case strategy == MergeMax:
+			n = max(prev, p.Count)
+		default:
+			n = prev
+		}
+		// a cart already above a lowered limit is not cut down by the merge
+		if limit := c.limits.MaxItemCount; limit > 0 && n > limit {
+			n = max(limit, prev)
+		}
+
+		err := c.checkCounts(ctx, cartPositions(counts), map[uint64]uint64{p.SkuID: n}, products)
+		if errors.Is(err, ErrLimitExceeded) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			distinct++
+		}
+		counts[p.SkuID] = n
+		res[p.SkuID] = n
+	}
+
+	return res, nil
+}
+
+// isUserID reports whether id is a registered user and not a guest cart.
+func isUserID(id uint64) bool {
+	return id > 0 && id < domain.GuestCartIDBase
+}
+
+func hashGuestToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,163 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+)
+
+const guestID = domain.GuestCartIDBase + 1
+
+// mergeWith runs MergeCarts over the given carts and returns the counts the
+// store was asked to write.
+func mergeWith(t *testing.T, strategy service.MergeStrategy, limits service.Limits, user, guest []postgres.Position) map[uint64]uint64 {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GuestCartIDMock.Return(guestID, nil)
+
+	var counts map[uint64]uint64
+	repo.MergeCartsMock.Set(func(_ context.Context, gID, userID uint64, plan func(user, guest []postgres.Position) (map[uint64]uint64, error)) ([]postgres.Position, error) {
+		require.Equal(t, uint64(guestID), gID)
+		require.Equal(t, uint64(1), userID)
+		var err error
+		counts, err = plan(user, guest)
+		return nil, err
+	})
+
+	cs := service.New(repo, pc, service.WithLimits(limits))
+	require.NoError(t, cs.MergeCarts(ctx, "token", 1, strategy))
+
+	return counts
+}
+
+func TestCartService_MergeCarts_Strategies(t *testing.T) {
+	user := []postgres.Position{{SkuID: 1001, Count: 2}, {SkuID: 1002, Count: 5}}
+	guest := []postgres.Position{{SkuID: 1001, Count: 3}, {SkuID: 1003, Count: 1}}
+
+	require.Equal(t, map[uint64]uint64{1001: 5, 1003: 1}, mergeWith(t, service.MergeSum, service.Limits{}, user, guest))
+	require.Equal(t, map[uint64]uint64{1001: 3, 1003: 1}, mergeWith(t, service.MergeMax, service.Limits{}, user, guest))
+	require.Equal(t, map[uint64]uint64{1001: 2, 1003: 1}, mergeWith(t, service.MergePreferUser, service.Limits{}, user, guest))
+}
+
+func TestCartService_MergeCarts_Limits(t *testing.T) {
+	user := []postgres.Position{{SkuID: 1001, Count: 8}, {SkuID: 1002, Count: 12}}
+	guest := []postgres.Position{{SkuID: 1001, Count: 5}, {SkuID: 1002, Count: 1}, {SkuID: 1003, Count: 1}, {SkuID: 1004, Count: 1}}
+
+	counts := mergeWith(t, service.MergeSum, service.Limits{MaxItemCount: 10, MaxDistinctSkus: 3}, user, guest)
+
+	// 1002 was above the limit before and is left as it is, 1004 does not fit
+	require.Equal(t, map[uint64]uint64{1001: 10, 1002: 12, 1003: 1}, counts)
+}
+
+func TestCartService_MergeCarts_TotalPriceLimit(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	user := []postgres.Position{{SkuID: 1001, Count: 2}}
+	guest := []postgres.Position{{SkuID: 1001, Count: 1}, {SkuID: 1002, Count: 2}, {SkuID: 1003, Count: 1}}
+	repo.GuestCartIDMock.Return(guestID, nil)
+	repo.GetCartMock.When(ctx, uint64(1)).Then(user, 3, nil)
+	repo.GetCartMock.When(ctx, uint64(guestID)).Then(guest, 1, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1001, 1002, 1003}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500},
+			1002: {Name: "Coffee Mug", Price: 900},
+			1003: {Name: "Sticker", Price: 100},
+		}, nil,
+	)
+
+	var counts map[uint64]uint64
+	repo.MergeCartsMock.Set(func(_ context.Context, _, _ uint64, plan func(user, guest []postgres.Position) (map[uint64]uint64, error)) ([]postgres.Position, error) {
+		var err error
+		counts, err = plan(user, guest)
+		return nil, err
+	})
+
+	cs := service.New(repo, pc, service.WithLimits(service.Limits{MaxTotalPrice: 5000}))
+	require.NoError(t, cs.MergeCarts(ctx, "token", 1, service.MergeSum))
+
+	// 1002 would take the cart to 6300, the sticker after it still fits
+	require.Equal(t, map[uint64]uint64{1001: 3, 1003: 1}, counts)
+}
+
+func TestCartService_MergeCarts_ReleasesDropped(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GuestCartIDMock.Return(guestID, nil)
+	repo.MergeCartsMock.Return([]postgres.Position{{SkuID: 1001, Count: 3}}, nil)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(3)).Return(nil)
+
+	cs := service.New(repo, pc, service.WithMergeStrategy(service.MergeMax))
+	require.NoError(t, cs.MergeCarts(ctx, "token", 1, ""))
+}
+
+func TestCartService_MergeCarts_GuestCartGone(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.GuestCartIDMock.Return(0, postgres.ErrNotFound)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	err := cs.MergeCarts(context.Background(), "token", 1, service.MergeSum)
+
+	require.ErrorIs(t, err, service.ErrGuestCartNotFound)
+}
+
+func TestCartService_MergeCarts_InvalidStrategy(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), mocks.NewClientIfaceMock(mc))
+	err := cs.MergeCarts(context.Background(), "token", 1, "newest")
+
+	require.ErrorIs(t, err, service.ErrInvalidMergeStrategy)
+}
+
+func TestCartService_CartOwner(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	var hash string
+	repo.CreateGuestCartMock.Set(func(_ context.Context, tokenHash string) (uint64, error) {
+		hash = tokenHash
+		return guestID, nil
+	})
+	repo.GuestCartIDMock.Set(func(_ context.Context, tokenHash string) (uint64, error) {
+		require.Equal(t, hash, tokenHash)
+		return guestID, nil
+	})
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+
+	token, err := cs.CreateGuestCart(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, token, hash, "only the hash of the token is stored")
+
+	owner, err := cs.CartOwner(ctx, 0, token)
+	require.NoError(t, err)
+	require.Equal(t, uint64(guestID), owner)
+
+	owner, err = cs.CartOwner(ctx, 7, "")
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), owner)
+
+	_, err = cs.CartOwner(ctx, guestID, "")
+	require.ErrorIs(t, err, service.ErrInvalidUserID)
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/verbovyar/OzonCart/internal/domain"
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+const (
+	DefaultHistoryPageSize = 50
+	MaxHistoryPageSize     = 500
+)
+
+// GetCartHistory returns the changes of the user's cart made since the given
+// time, oldest first. pageToken is the NextPageToken of the previous page,
+// empty for the first one.
+func (c *CartService) GetCartHistory(ctx context.Context, userID uint64, since time.Time, pageToken string, pageSize int) (*domain.CartHistoryResponse, error) {
+	var after uint64
+	if pageToken != "" {
+		var err error
+		if after, err = decodePageToken(pageToken); err != nil {
+			return nil, err
+		}
+	}
+
+	if pageSize <= 0 {
+		pageSize = DefaultHistoryPageSize
+	}
+	pageSize = min(pageSize, MaxHistoryPageSize)
+
+	// one entry more tells whether there is a next page
+	entries, err := c.store.GetCartHistory(ctx, userID, since, after, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &domain.CartHistoryResponse{Events: make([]domain.CartHistoryEvent, 0, min(len(entries), pageSize))}
+	if len(entries) > pageSize {
+		entries = entries[:pageSize]
+		res.NextPageToken = encodePageToken(entries[pageSize-1].ID)
+	}
+	for _, e := range entries {
+		res.Events = append(res.Events, domain.CartHistoryEvent{
+			ID:        e.ID,
+			At:        e.CreatedAt,
+			Operation: e.Operation,
+			SkuID:     e.SkuID,
+			Delta:     e.Delta,
+			OldCount:  e.OldCount,
+			NewCount:  e.NewCount,
+			Caller:    e.Caller,
+			RequestID: e.RequestID,
+			Version:   e.Version,
+		})
+	}
+
+	return res, nil
+}
+
+func encodePageToken(id uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(id, 10)))
+}
+
+func decodePageToken(token string) (uint64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+	id, err := strconv.ParseUint(string(b), 10, 64)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+
+	return id, nil
+}
@@ -0,0 +1,59 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+)
+
+func TestCartService_GetCartHistory_Pages(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.GetCartHistoryMock.Set(func(_ context.Context, userID uint64, s time.Time, after uint64, limit int) ([]postgres.HistoryEntry, error) {
+		require.Equal(t, uint64(1), userID)
+		require.Equal(t, since, s)
+		require.Equal(t, 3, limit)
+
+		var res []postgres.HistoryEntry
+		for id := after + 1; id <= 5 && len(res) < limit; id++ {
+			res = append(res, postgres.HistoryEntry{ID: id, Operation: postgres.OpAdd, SkuID: 1001, Caller: "support:alice"})
+		}
+		return res, nil
+	})
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+
+	var ids []uint64
+	token := ""
+	for pages := 0; pages < 5; pages++ {
+		res, err := cs.GetCartHistory(ctx, 1, since, token, 2)
+		require.NoError(t, err)
+		for _, e := range res.Events {
+			ids = append(ids, e.ID)
+		}
+		token = res.NextPageToken
+		if token == "" {
+			break
+		}
+	}
+
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, ids)
+}
+
+func TestCartService_GetCartHistory_InvalidToken(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), mocks.NewClientIfaceMock(mc))
+	_, err := cs.GetCartHistory(context.Background(), 1, time.Time{}, "not a token!", 10)
+
+	require.ErrorIs(t, err, service.ErrInvalidPageToken)
+}
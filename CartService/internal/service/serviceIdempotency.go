@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+var (
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused with another request")
+	ErrRequestInProgress    = errors.New("request with this idempotency key is in progress")
+)
+
+const (
+	DefaultIdempotencyTTL = 24 * time.Hour
+	// idempotencyLease bounds how long a crashed request blocks its key.
+	idempotencyLease = time.Minute
+)
+
+// WithIdempotencyTTL sets how long the responses of idempotent requests are kept.
+func WithIdempotencyTTL(ttl time.Duration) Option {
+	return func(c *CartService) {
+		if ttl > 0 {
+			c.idempotencyTTL = ttl
+		}
+	}
+}
+
+// Idempotent runs fn once per user and key and stores its response. A repeated
+// request with the same fingerprint gets the stored response back without fn
+// running again, another fingerprint fails with ErrIdempotencyKeyReused. When
+// fn fails nothing is stored and the key may be used again. An empty key
+// just calls fn.
+func (c *CartService) Idempotent(ctx context.Context, userID uint64, key, fingerprint string, fn func() ([]byte, error)) ([]byte, error) {
+	if key == "" {
+		return fn()
+	}
+
+	rec, claimed, err := c.store.ClaimIdempotencyKey(ctx, userID, key, fingerprint, idempotencyLease)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		switch {
+		case rec.Fingerprint != fingerprint:
+			return nil, ErrIdempotencyKeyReused
+		case rec.Response == nil:
+			return nil, ErrRequestInProgress
+		default:
+			return rec.Response, nil
+		}
+	}
+
+	// the outcome has to be recorded even if the caller is gone by now
+	storeCtx := context.WithoutCancel(ctx)
+
+	resp, err := fn()
+	if err != nil {
+		if rerr := c.store.ReleaseIdempotencyKey(storeCtx, userID, key); rerr != nil {
+			log.Printf("release idempotency key user=%d: %v", userID, rerr)
+		}
+		return nil, err
+	}
+	if err := c.store.SaveIdempotentResponse(storeCtx, userID, key, resp, c.idempotencyTTL); err != nil {
+		// the change is done, a lost record only means a retry is not deduplicated
+		log.Printf("save idempotent response user=%d: %v", userID, err)
+	}
+
+	return resp, nil
+}
+
+// PurgeIdempotencyKeys deletes expired idempotency records.
+func (c *CartService) PurgeIdempotencyKeys(ctx context.Context) (int64, error) {
+	return c.store.DeleteExpiredIdempotencyKeys(ctx)
+}
@@ -0,0 +1,109 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+)
+
+func TestCartService_Idempotent_FirstRunSaves(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.ClaimIdempotencyKeyMock.Return(nil, true, nil)
+	repo.SaveIdempotentResponseMock.Set(func(_ context.Context, userID uint64, key string, response []byte, _ time.Duration) error {
+		require.Equal(t, uint64(1), userID)
+		require.Equal(t, "k1", key)
+		require.Equal(t, []byte("ok"), response)
+		return nil
+	})
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	calls := 0
+	out, err := cs.Idempotent(ctx, 1, "k1", "fp", func() ([]byte, error) {
+		calls++
+		return []byte("ok"), nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []byte("ok"), out)
+	require.Equal(t, 1, calls)
+}
+
+func TestCartService_Idempotent_Replay(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.ClaimIdempotencyKeyMock.Return(&postgres.IdempotencyRecord{Fingerprint: "fp", Response: []byte("first")}, false, nil)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	out, err := cs.Idempotent(ctx, 1, "k1", "fp", func() ([]byte, error) {
+		t.Fatal("replay must not run the request again")
+		return nil, nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), out)
+}
+
+func TestCartService_Idempotent_KeyReused(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.ClaimIdempotencyKeyMock.Return(&postgres.IdempotencyRecord{Fingerprint: "other", Response: []byte("first")}, false, nil)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	_, err := cs.Idempotent(ctx, 1, "k1", "fp", func() ([]byte, error) { return nil, nil })
+
+	require.ErrorIs(t, err, service.ErrIdempotencyKeyReused)
+}
+
+func TestCartService_Idempotent_InProgress(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.ClaimIdempotencyKeyMock.Return(&postgres.IdempotencyRecord{Fingerprint: "fp"}, false, nil)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	_, err := cs.Idempotent(ctx, 1, "k1", "fp", func() ([]byte, error) { return nil, nil })
+
+	require.ErrorIs(t, err, service.ErrRequestInProgress)
+}
+
+func TestCartService_Idempotent_FailureReleasesKey(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.ClaimIdempotencyKeyMock.Return(nil, true, nil)
+	repo.ReleaseIdempotencyKeyMock.Expect(minimock.AnyContext, uint64(1), "k1").Return(nil)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	_, err := cs.Idempotent(ctx, 1, "k1", "fp", func() ([]byte, error) {
+		return nil, service.ErrInsufficientStock
+	})
+
+	require.ErrorIs(t, err, service.ErrInsufficientStock)
+}
+
+func TestCartService_Idempotent_NoKey(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), mocks.NewClientIfaceMock(mc))
+	_, err := cs.Idempotent(context.Background(), 1, "", "fp", func() ([]byte, error) {
+		return nil, errors.New("boom")
+	})
+
+	require.EqualError(t, err, "boom")
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+var ErrLimitExceeded = errors.New("cart limit exceeded")
+
+// errCartMoved fails a write whose limit check read a cart another instance
+// has changed since, the change is checked again.
+var errCartMoved = errors.New("cart changed since the limit check")
+
+// maxLimitChecks bounds how often one change is checked against a cart that
+// keeps changing under it.
+const maxLimitChecks = 3
+
+// Limits bounds what a single cart may hold, zero fields are not enforced.
+type Limits struct {
+	MaxItemCount    uint64 // units of one sku
+	MaxDistinctSkus int
+	MaxTotalPrice   uint64
+}
+
+// WithLimits enables the cart limits checked by AddToCart and UpdateItemCount.
+func WithLimits(l Limits) Option {
+	return func(c *CartService) {
+		c.limits = l
+	}
+}
+
+// checkLimits reads the user's cart and verifies it as it would be with
+// skuID at newCount(prev) units of the given price, returning the positions
+// and version it read. Changes that do not grow the position always pass,
+// so a cart above lowered limits can still shrink.
+func (c *CartService) checkLimits(ctx context.Context, userID, skuID, price uint64, newCount func(prev uint64) uint64) ([]postgres.Position, uint64, error) {
+	positions, version, err := c.store.GetCart(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if c.limits == (Limits{}) {
+		return positions, version, nil
+	}
+
+	var prev uint64
+	found := false
+	others := make([]uint64, 0, len(positions))
+	counts := make(map[uint64]uint64, len(positions))
+	for _, p := range positions {
+		if p.SkuID == skuID {
+			prev, found = p.Count, true
+			continue
+		}
+		others = append(others, p.SkuID)
+		counts[p.SkuID] = p.Count
+	}
+
+	count := newCount(prev)
+	if count <= prev {
+		return positions, version, nil
+	}
+
+	if c.limits.MaxItemCount > 0 && count > c.limits.MaxItemCount {
+		return nil, 0, fmt.Errorf("%w: at most %d units of sku %d", ErrLimitExceeded, c.limits.MaxItemCount, skuID)
+	}
+	if c.limits.MaxDistinctSkus > 0 && !found && len(positions)+1 > c.limits.MaxDistinctSkus {
+		return nil, 0, fmt.Errorf("%w: at most %d different skus", ErrLimitExceeded, c.limits.MaxDistinctSkus)
+	}
+
+	if c.limits.MaxTotalPrice > 0 {
+		products, err := c.lookupProducts(ctx, others)
+		if err != nil {
+			return nil, 0, err
+		}
+		// a total that does not fit is above any limit
+		total, err := checked(money.New(price, c.currency).Mul(count))
+		for sku, pr := range products {
+			if err != nil {
+				break
+			}
+			total, err = c.addLine(ctx, total, pr, counts[sku])
+		}
+		if errors.Is(err, ErrPriceOverflow) || err == nil && total.Amount > c.limits.MaxTotalPrice {
+			return nil, 0, fmt.Errorf("%w: total price above %d", ErrLimitExceeded, c.limits.MaxTotalPrice)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return positions, version, nil
+}
+
+// checkCounts verifies the cart of positions as it would be with the new
+// counts in next, zero removing a position. products must hold the priced
+// skus of the resulting cart. As in checkLimits a change that grows no
+// position always passes.
+func (c *CartService) checkCounts(ctx context.Context, positions []postgres.Position, next map[uint64]uint64, products map[uint64]*Product) error {
+	if c.limits == (Limits{}) {
+		return nil
+	}
+
+	counts := make(map[uint64]uint64, len(positions)+len(next))
+	for _, p := range positions {
+		counts[p.SkuID] = p.Count
+	}
+	distinct := len(counts)
+
+	skus := make([]uint64, 0, len(next))
+	for sku := range next {
+		skus = append(skus, sku)
+	}
+	sort.Slice(skus, func(i, j int) bool { return skus[i] < skus[j] })
+
+	grows := false
+	for _, sku := range skus {
+		n := next[sku]
+		if n <= counts[sku] {
+			continue
+		}
+		grows = true
+		if c.limits.MaxItemCount > 0 && n > c.limits.MaxItemCount {
+			return fmt.Errorf("%w: at most %d units of sku %d", ErrLimitExceeded, c.limits.MaxItemCount, sku)
+		}
+	}
+	if !grows {
+		return nil
+	}
+
+	for sku, n := range next {
+		if n == 0 {
+			delete(counts, sku)
+		} else {
+			counts[sku] = n
+		}
+	}
+	if c.limits.MaxDistinctSkus > 0 && len(counts) > distinct && len(counts) > c.limits.MaxDistinctSkus {
+		return fmt.Errorf("%w: at most %d different skus", ErrLimitExceeded, c.limits.MaxDistinctSkus)
+	}
+
+	if c.limits.MaxTotalPrice > 0 {
+		total := money.New(0, c.currency)
+		var err error
+		for sku, n := range counts {
+			pr, ok := products[sku]
+			if !ok {
+				continue
+			}
+			if total, err = c.addLine(ctx, total, pr, n); err != nil {
+				break
+			}
+		}
+		if errors.Is(err, ErrPriceOverflow) || err == nil && total.Amount > c.limits.MaxTotalPrice {
+			return fmt.Errorf("%w: total price above %d", ErrLimitExceeded, c.limits.MaxTotalPrice)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkedVersion returns the version the write of a change checked against
+// the cart read at version expects. The check only holds for that version,
+// so with limits set the write fails once another instance changed the cart.
+// A caller's version other than the one read fails right away.
+func (c *CartService) checkedVersion(expected *uint64, version uint64) (*uint64, error) {
+	if c.limits == (Limits{}) {
+		return expected, nil
+	}
+	if expected != nil && *expected != version {
+		return nil, ErrVersionMismatch
+	}
+
+	return &version, nil
+}
+
+// checkedError translates the error of a write made with checkedVersion. A
+// mismatch the caller did not ask for is errCartMoved.
+func checkedError(err error, expected *uint64) error {
+	if expected == nil && errors.Is(err, postgres.ErrVersionMismatch) {
+		return errCartMoved
+	}
+
+	return storeError(err)
+}
+
+// retryMoved runs fn again while it fails with errCartMoved. A cart that is
+// still changing after maxLimitChecks runs fails with ErrVersionMismatch.
+func retryMoved(fn func() error) error {
+	for i := 1; ; i++ {
+		err := fn()
+		if !errors.Is(err, errCartMoved) {
+			return err
+		}
+		if i == maxLimitChecks {
+			return ErrVersionMismatch
+		}
+	}
+}
+
+// addLine adds count units of pr to total. Products GetCart reports as
+// unavailable for their currency are not counted.
+func (c *CartService) addLine(ctx context.Context, total money.Money, pr *Product, count uint64) (money.Money, error) {
+	unit, err := c.unitPrice(ctx, pr)
+	if errors.Is(err, ErrCurrencyMismatch) {
+		return total, nil
+	}
+	if err != nil {
+		return money.Money{}, err
+	}
+	line, err := checked(unit.Mul(count))
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	return checked(total.Add(line))
+}
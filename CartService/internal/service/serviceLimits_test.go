@@ -0,0 +1,111 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+)
+
+func TestCartService_Add_ItemCountLimit(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	repo.GetCartMock.Expect(ctx, uint64(1)).Return([]postgres.Position{{SkuID: 1001, Count: 8}}, 0, nil)
+
+	cs := service.New(repo, pc, service.WithLimits(service.Limits{MaxItemCount: 10}))
+	err := cs.AddToCart(ctx, 1, 1001, 3, nil)
+	require.ErrorIs(t, err, service.ErrLimitExceeded)
+}
+
+func TestCartService_Add_DistinctSkusLimit(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	positions := []postgres.Position{{SkuID: 1001, Count: 1}, {SkuID: 1002, Count: 1}}
+	pc.GetProductMock.Set(func(_ context.Context, sku uint64) (*service.Product, error) {
+		return &service.Product{Name: "Demo", Price: 100}, nil
+	})
+	version := uint64(2)
+	repo.GetCartMock.Expect(ctx, uint64(1)).Return(positions, version, nil)
+	pc.ReserveStockMock.Expect(ctx, uint64(1002), uint64(1)).Return(nil)
+	repo.AddItemMock.Expect(ctx, uint64(1), uint64(1002), uint64(1), uint64(100), &version).Return(nil)
+
+	cs := service.New(repo, pc, service.WithLimits(service.Limits{MaxDistinctSkus: 2}))
+
+	// a sku already in the cart does not count again
+	require.NoError(t, cs.AddToCart(ctx, 1, 1002, 1, nil))
+	require.ErrorIs(t, cs.AddToCart(ctx, 1, 1003, 1, nil), service.ErrLimitExceeded)
+}
+
+func TestCartService_Add_TotalPriceLimit(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	repo.GetCartMock.Expect(ctx, uint64(1)).Return([]postgres.Position{{SkuID: 2002, Count: 2}}, 0, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{2002}).Return(map[uint64]*service.Product{2002: {Name: "Mug", Price: 900}}, nil)
+
+	cs := service.New(repo, pc, service.WithLimits(service.Limits{MaxTotalPrice: 4000}))
+	err := cs.AddToCart(ctx, 1, 1001, 2, nil)
+	require.ErrorIs(t, err, service.ErrLimitExceeded)
+}
+
+func TestCartService_UpdateItemCount_ShrinkIgnoresLimits(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	version := uint64(4)
+	repo.GetCartMock.Expect(ctx, uint64(1)).Return([]postgres.Position{{SkuID: 1001, Count: 20}}, version, nil)
+	repo.UpdateItemCountMock.Expect(ctx, uint64(1), uint64(1001), uint64(15), uint64(1500), &version).Return(20, nil)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(5)).Return(nil)
+
+	cs := service.New(repo, pc, service.WithLimits(service.Limits{MaxItemCount: 10}))
+	require.NoError(t, cs.UpdateItemCount(ctx, 1, 1001, 15, nil))
+}
+
+func TestCartService_Add_RecheckedAfterCartChanged(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	pc := mocks.NewClientIfaceMock(mc)
+	repo := mocks.NewRepositoryIfaceMock(mc)
+
+	// another instance adds a sku between the check and the write
+	carts := [][]postgres.Position{
+		{{SkuID: 1001, Count: 1}},
+		{{SkuID: 1001, Count: 1}, {SkuID: 1002, Count: 1}},
+	}
+	version := uint64(3)
+	pc.GetProductMock.Expect(ctx, uint64(1003)).Return(&service.Product{Name: "Demo", Price: 100}, nil)
+	repo.GetCartMock.Set(func(_ context.Context, _ uint64) ([]postgres.Position, uint64, error) {
+		reads := repo.GetCartBeforeCounter()
+		return carts[reads-1], version + reads - 1, nil
+	})
+	pc.ReserveStockMock.Expect(ctx, uint64(1003), uint64(1)).Return(nil)
+	repo.AddItemMock.Expect(ctx, uint64(1), uint64(1003), uint64(1), uint64(100), &version).Return(postgres.ErrVersionMismatch)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1003), uint64(1)).Return(nil)
+
+	cs := service.New(repo, pc, service.WithLimits(service.Limits{MaxDistinctSkus: 2}))
+
+	require.ErrorIs(t, cs.AddToCart(ctx, 1, 1003, 1, nil), service.ErrLimitExceeded)
+	require.Equal(t, uint64(2), repo.GetCartAfterCounter())
+}
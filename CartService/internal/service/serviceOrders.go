@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+var (
+	ErrEmptyCart     = errors.New("cart is empty")
+	ErrCartChanged   = errors.New("cart changed during checkout")
+	ErrOrderNotFound = errors.New("order not found")
+)
+
+// Checkout turns the current cart into an order. Prices are taken from the
+// same enrichment GetCart does, so the order keeps what the user saw.
+// Unavailable positions are not ordered and stay in the cart with their
+// stock reserved. Guests have no orders, their cart is merged on login.
+func (c *CartService) Checkout(ctx context.Context, userID uint64, expectedVersion *uint64) (uint64, error) {
+	if !isUserID(userID) {
+		return 0, ErrInvalidUserID
+	}
+
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	cart, _, err := c.readCart(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	if len(cart.Items) == 0 {
+		return 0, ErrEmptyCart
+	}
+
+	items := make([]postgres.OrderItem, 0, len(cart.Items))
+	for _, it := range cart.Items {
+		items = append(items, postgres.OrderItem{
+			SkuID: it.SkuID,
+			Name:  it.Name,
+			Count: it.Count,
+			Price: it.Price.Amount,
+		})
+	}
+
+	orderID, err := c.store.CreateOrder(ctx, userID, items, cart.TotalPrice, expectedVersion)
+	if err != nil {
+		if errors.Is(err, postgres.ErrCartChanged) {
+			return 0, ErrCartChanged
+		}
+		return 0, storeError(err)
+	}
+
+	for _, it := range items {
+		if err := c.pc.CommitStock(ctx, it.SkuID, it.Count); err != nil {
+			log.Printf("commit stock order=%d sku=%d count=%d: %v", orderID, it.SkuID, it.Count, err)
+		}
+	}
+
+	return orderID, nil
+}
+
+func (c *CartService) GetOrder(ctx context.Context, userID, orderID uint64) (*domain.Order, error) {
+	if !isUserID(userID) {
+		return nil, ErrInvalidUserID
+	}
+
+	o, err := c.store.GetOrder(ctx, userID, orderID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, err
+	}
+
+	res := toDomainOrder(*o)
+	return &res, nil
+}
+
+func (c *CartService) ListOrders(ctx context.Context, userID uint64) (*domain.ListOrdersResponse, error) {
+	if !isUserID(userID) {
+		return nil, ErrInvalidUserID
+	}
+
+	orders, err := c.store.ListOrders(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]domain.Order, 0, len(orders))
+	for _, o := range orders {
+		res = append(res, toDomainOrder(o))
+	}
+
+	return &domain.ListOrdersResponse{Orders: res}, nil
+}
+
+func toDomainOrder(o postgres.Order) domain.Order {
+	items := make([]domain.CartItem, 0, len(o.Items))
+	for _, it := range o.Items {
+		items = append(items, domain.CartItem{
+			SkuID: it.SkuID,
+			Name:  it.Name,
+			Count: it.Count,
+			Price: money.New(it.Price, o.Currency),
+		})
+	}
+
+	return domain.Order{
+		OrderID:    o.ID,
+		Items:      items,
+		TotalPrice: money.New(o.TotalPrice, o.Currency),
+		CreatedAt:  o.CreatedAt,
+	}
+}
@@ -0,0 +1,193 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+func TestCartService_Checkout_Success(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	userID := uint64(7)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(minimock.AnyContext, userID).Return(
+		[]postgres.Position{
+			{SkuID: 1001, Count: 2},
+			{SkuID: 1002, Count: 1},
+		}, 0, nil,
+	)
+	repo.GetCartPromoMock.Return(nil, nil)
+
+	pc.GetProductsMock.Expect(minimock.AnyContext, []uint64{1001, 1002}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500},
+			1002: {Name: "Coffee Mug", Price: 900},
+		}, nil,
+	)
+
+	repo.CreateOrderMock.Expect(minimock.AnyContext, userID, []postgres.OrderItem{
+		{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: 1500},
+		{SkuID: 1002, Name: "Coffee Mug", Count: 1, Price: 900},
+	}, money.New(3900, "RUB"), nil).Return(42, nil)
+
+	pc.CommitStockMock.When(minimock.AnyContext, uint64(1001), uint64(2)).Then(nil)
+	pc.CommitStockMock.When(minimock.AnyContext, uint64(1002), uint64(1)).Then(nil)
+
+	cs := service.New(repo, pc)
+	orderID, err := cs.Checkout(context.Background(), userID, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), orderID)
+}
+
+func TestCartService_Orders_RejectGuestIDs(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), mocks.NewClientIfaceMock(mc))
+
+	for _, id := range []uint64{0, domain.GuestCartIDBase, domain.GuestCartIDBase + 7} {
+		_, err := cs.Checkout(ctx, id, nil)
+		require.ErrorIs(t, err, service.ErrInvalidUserID)
+		_, err = cs.GetOrder(ctx, id, 1)
+		require.ErrorIs(t, err, service.ErrInvalidUserID)
+		_, err = cs.ListOrders(ctx, id)
+		require.ErrorIs(t, err, service.ErrInvalidUserID)
+	}
+}
+
+func TestCartService_Checkout_AppliesPromo(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return([]postgres.Position{{SkuID: 1001, Count: 2}}, 0, nil)
+	repo.GetCartPromoMock.Expect(ctx, uint64(7)).Return(&postgres.Promo{Code: "SALE", Kind: postgres.PromoPercent, Percent: 10}, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1001}).Return(
+		map[uint64]*service.Product{1001: {Name: "Demo T-Shirt", Price: 1500}}, nil,
+	)
+	repo.CreateOrderMock.Expect(ctx, uint64(7), []postgres.OrderItem{
+		{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: 1500},
+	}, money.New(2700, "RUB"), nil).Return(42, nil)
+	pc.CommitStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+
+	cs := service.New(repo, pc, service.WithBatchLookup(true))
+	_, err := cs.Checkout(ctx, 7, nil)
+	require.NoError(t, err)
+}
+
+func TestCartService_Checkout_LeavesDelistedToOrder(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return(
+		[]postgres.Position{{SkuID: 1001, Count: 2}, {SkuID: 1002, Count: 1}}, 0, nil,
+	)
+	repo.GetCartPromoMock.Return(nil, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1001, 1002}).Return(
+		map[uint64]*service.Product{1001: {Name: "Demo T-Shirt", Price: 1500}}, nil,
+	)
+	repo.CreateOrderMock.Return(42, nil)
+	pc.CommitStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+
+	// the checkout holds the user's lock, a cleanup under it would deadlock
+	cs := service.New(repo, pc, service.WithBatchLookup(true), service.WithDelistedCleanup(true))
+	_, err := cs.Checkout(ctx, 7, nil)
+	require.NoError(t, err)
+}
+
+func TestCartService_Checkout_EmptyCart(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	userID := uint64(7)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(minimock.AnyContext, userID).Return(nil, 0, nil)
+	repo.GetCartPromoMock.Return(nil, nil)
+
+	cs := service.New(repo, pc)
+	_, err := cs.Checkout(context.Background(), userID, nil)
+
+	require.ErrorIs(t, err, service.ErrEmptyCart)
+}
+
+func TestCartService_Checkout_CartChanged(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	userID := uint64(7)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(minimock.AnyContext, userID).Return(
+		[]postgres.Position{{SkuID: 1001, Count: 2}}, 0, nil,
+	)
+	repo.GetCartPromoMock.Return(nil, nil)
+	pc.GetProductsMock.Expect(minimock.AnyContext, []uint64{1001}).
+		Return(map[uint64]*service.Product{1001: {Name: "Demo T-Shirt", Price: 1500}}, nil)
+	repo.CreateOrderMock.Return(0, postgres.ErrCartChanged)
+
+	cs := service.New(repo, pc)
+	_, err := cs.Checkout(context.Background(), userID, nil)
+
+	require.ErrorIs(t, err, service.ErrCartChanged)
+}
+
+func TestCartService_GetOrder_NotFound(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetOrderMock.Expect(minimock.AnyContext, uint64(7), uint64(42)).Return(nil, postgres.ErrNotFound)
+
+	cs := service.New(repo, pc)
+	_, err := cs.GetOrder(context.Background(), 7, 42)
+
+	require.ErrorIs(t, err, service.ErrOrderNotFound)
+}
+
+func TestCartService_ListOrders(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	created := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	repo.ListOrdersMock.Expect(minimock.AnyContext, uint64(7)).Return([]postgres.Order{
+		{ID: 2, UserID: 7, TotalPrice: 900, Currency: "RUB", CreatedAt: created, Items: []postgres.OrderItem{
+			{SkuID: 1002, Name: "Coffee Mug", Count: 1, Price: 900},
+		}},
+		{ID: 1, UserID: 7, TotalPrice: 3000, Currency: "RUB", CreatedAt: created, Items: []postgres.OrderItem{
+			{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: 1500},
+		}},
+	}, nil)
+
+	cs := service.New(repo, pc)
+	res, err := cs.ListOrders(context.Background(), 7)
+
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 2)
+	require.Equal(t, uint64(2), res.Orders[0].OrderID)
+	require.Equal(t, "Coffee Mug", res.Orders[0].Items[0].Name)
+	require.Equal(t, money.New(3000, "RUB"), res.Orders[1].TotalPrice)
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+var (
+	ErrPromoNotFound = errors.New("promo code not found")
+	ErrPromoExists   = errors.New("promo code already exists")
+	ErrInvalidPromo  = errors.New("invalid promo")
+)
+
+const maxPromoCodeLen = 64
+
+// normalizePromoCode makes codes case-insensitive.
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CreatePromo saves a new enabled promo code and returns it as stored.
+func (c *CartService) CreatePromo(ctx context.Context, p domain.Promo) (*domain.Promo, error) {
+	p.Code = normalizePromoCode(p.Code)
+	if !validPromo(p) {
+		return nil, ErrInvalidPromo
+	}
+
+	created, err := c.store.CreatePromo(ctx, postgres.Promo{
+		Code:        p.Code,
+		Kind:        p.Kind,
+		Percent:     p.Percent,
+		Amount:      p.Amount,
+		SkuID:       p.SkuID,
+		BuyCount:    p.BuyCount,
+		FreeCount:   p.FreeCount,
+		MinSubtotal: p.MinSubtotal,
+	})
+	if errors.Is(err, postgres.ErrAlreadyExists) {
+		return nil, ErrPromoExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := toDomainPromo(*created)
+	return &res, nil
+}
+
+func validPromo(p domain.Promo) bool {
+	if p.Code == "" || len(p.Code) > maxPromoCodeLen {
+		return false
+	}
+
+	switch p.Kind {
+	case postgres.PromoPercent:
+		return p.Percent > 0 && p.Percent <= 100
+	case postgres.PromoFixed:
+		return p.Amount > 0
+	case postgres.PromoBuyNGetM:
+		return p.SkuID > 0 && p.BuyCount > 0 && p.FreeCount > 0
+	default:
+		return false
+	}
+}
+
+// DisablePromo stops the code from giving discounts, carts keep it attached.
+func (c *CartService) DisablePromo(ctx context.Context, code string) error {
+	err := c.store.DisablePromo(ctx, normalizePromoCode(code))
+	if errors.Is(err, postgres.ErrNotFound) {
+		return ErrPromoNotFound
+	}
+
+	return err
+}
+
+func (c *CartService) ListPromos(ctx context.Context) (*domain.ListPromosResponse, error) {
+	promos, err := c.store.ListPromos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &domain.ListPromosResponse{Promos: make([]domain.Promo, 0, len(promos))}
+	for _, p := range promos {
+		res.Promos = append(res.Promos, toDomainPromo(p))
+	}
+
+	return res, nil
+}
+
+func toDomainPromo(p postgres.Promo) domain.Promo {
+	return domain.Promo{
+		Code:        p.Code,
+		Kind:        p.Kind,
+		Percent:     p.Percent,
+		Amount:      p.Amount,
+		SkuID:       p.SkuID,
+		BuyCount:    p.BuyCount,
+		FreeCount:   p.FreeCount,
+		MinSubtotal: p.MinSubtotal,
+		Disabled:    p.Disabled,
+		CreatedAt:   p.CreatedAt,
+	}
+}
+
+// ApplyPromo attaches the code to the cart, replacing the one it had. Its
+// threshold is checked on every read, so the code may give no discount yet.
+func (c *CartService) ApplyPromo(ctx context.Context, userID uint64, code string, expectedVersion *uint64) error {
+	code = normalizePromoCode(code)
+	if code == "" || len(code) > maxPromoCodeLen {
+		return ErrPromoNotFound
+	}
+
+	err := c.store.ApplyPromo(ctx, userID, code, expectedVersion)
+	if errors.Is(err, postgres.ErrNotFound) {
+		return ErrPromoNotFound
+	}
+
+	return storeError(err)
+}
+
+// RemovePromo detaches the promo code of the cart, ErrPromoNotFound if it has none.
+func (c *CartService) RemovePromo(ctx context.Context, userID uint64, expectedVersion *uint64) error {
+	err := c.store.RemovePromo(ctx, userID, expectedVersion)
+	if errors.Is(err, postgres.ErrNotFound) {
+		return ErrPromoNotFound
+	}
+
+	return storeError(err)
+}
+
+// priceCart fills res.Price and res.TotalPrice in currency from res.Items and
+// the promo of the cart, nil if it has none. A sum that does not fit into the
+// amount fails with ErrPriceOverflow.
+func priceCart(res *domain.GetCartResponse, promo *postgres.Promo, currency string) error {
+	subtotal := money.New(0, currency)
+	for _, it := range res.Items {
+		line, err := checked(it.Price.Mul(it.Count))
+		if err != nil {
+			return err
+		}
+		if subtotal, err = checked(subtotal.Add(line)); err != nil {
+			return err
+		}
+	}
+	res.Price = domain.PriceBreakdown{Subtotal: subtotal, Discounts: []domain.Discount{}}
+
+	if promo != nil {
+		res.PromoCode = promo.Code
+		amount, err := promoDiscount(promo, res.Items, subtotal)
+		if err != nil {
+			return err
+		}
+		if !amount.IsZero() {
+			res.Price.Discounts = append(res.Price.Discounts, domain.Discount{
+				Code:   promo.Code,
+				Kind:   promo.Kind,
+				Amount: amount,
+			})
+		}
+	}
+
+	total := subtotal
+	for _, d := range res.Price.Discounts {
+		// discounts never take the total below zero
+		total, _ = total.Sub(money.Min(d.Amount, total))
+	}
+	res.Price.Total = total
+	res.TotalPrice = total
+
+	return nil
+}
+
+// promoDiscount is what the promo takes off a cart of items with the given
+// subtotal, never more than the subtotal.
+func promoDiscount(p *postgres.Promo, items []domain.CartItem, subtotal money.Money) (money.Money, error) {
+	none := money.New(0, subtotal.Currency)
+	if p.Disabled || subtotal.Amount < p.MinSubtotal {
+		return none, nil
+	}
+
+	amount := none
+	var err error
+	switch p.Kind {
+	case postgres.PromoPercent:
+		amount, err = subtotal.MulDiv(uint64(p.Percent), 100)
+	case postgres.PromoFixed:
+		amount = money.New(p.Amount, subtotal.Currency)
+	case postgres.PromoBuyNGetM:
+		if p.BuyCount+p.FreeCount == 0 {
+			return none, nil
+		}
+		for _, it := range items {
+			if it.SkuID == p.SkuID {
+				free := it.Count / (p.BuyCount + p.FreeCount) * p.FreeCount
+				amount, err = it.Price.Mul(free)
+			}
+		}
+	}
+	if err != nil {
+		return money.Money{}, ErrPriceOverflow
+	}
+
+	return money.Min(amount, subtotal), nil
+}
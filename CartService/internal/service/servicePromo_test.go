@@ -0,0 +1,154 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+// cartWithPromo reads a cart of two T-shirts at 1500 and a mug at 900 with promo attached.
+func cartWithPromo(t *testing.T, promo *postgres.Promo) *domain.GetCartResponse {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return(
+		[]postgres.Position{{SkuID: 1001, Count: 2}, {SkuID: 1002, Count: 1}}, 3, nil,
+	)
+	pc.GetProductsMock.Expect(ctx, []uint64{1001, 1002}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500},
+			1002: {Name: "Coffee Mug", Price: 900},
+		}, nil,
+	)
+	repo.GetCartPromoMock.Expect(ctx, uint64(7)).Return(promo, nil)
+
+	cs := service.New(repo, pc, service.WithBatchLookup(true))
+	res, err := cs.GetCart(ctx, 7)
+	require.NoError(t, err)
+
+	return res
+}
+
+func TestCartService_GetCart_NoPromo(t *testing.T) {
+	res := cartWithPromo(t, nil)
+
+	require.Empty(t, res.PromoCode)
+	require.Equal(t, domain.PriceBreakdown{
+		Subtotal:  money.New(3900, "RUB"),
+		Discounts: []domain.Discount{},
+		Total:     money.New(3900, "RUB"),
+	}, res.Price)
+	require.Equal(t, money.New(3900, "RUB"), res.TotalPrice)
+}
+
+func TestCartService_GetCart_PromoRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		promo    postgres.Promo
+		discount uint64
+	}{
+		{"percent", postgres.Promo{Kind: postgres.PromoPercent, Percent: 10}, 390},
+		{"fixed", postgres.Promo{Kind: postgres.PromoFixed, Amount: 500}, 500},
+		{"fixed above subtotal", postgres.Promo{Kind: postgres.PromoFixed, Amount: 5000}, 3900},
+		{"buy one get one", postgres.Promo{Kind: postgres.PromoBuyNGetM, SkuID: 1001, BuyCount: 1, FreeCount: 1}, 1500},
+		{"buy two get one", postgres.Promo{Kind: postgres.PromoBuyNGetM, SkuID: 1001, BuyCount: 2, FreeCount: 1}, 0},
+		{"other sku", postgres.Promo{Kind: postgres.PromoBuyNGetM, SkuID: 3003, BuyCount: 1, FreeCount: 1}, 0},
+		{"threshold met", postgres.Promo{Kind: postgres.PromoFixed, Amount: 500, MinSubtotal: 3900}, 500},
+		{"below threshold", postgres.Promo{Kind: postgres.PromoFixed, Amount: 500, MinSubtotal: 4000}, 0},
+		{"disabled", postgres.Promo{Kind: postgres.PromoPercent, Percent: 10, Disabled: true}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			promo := tt.promo
+			promo.Code = "SALE"
+			res := cartWithPromo(t, &promo)
+
+			require.Equal(t, "SALE", res.PromoCode)
+			require.Equal(t, money.New(3900, "RUB"), res.Price.Subtotal)
+			require.Equal(t, money.New(3900-tt.discount, "RUB"), res.Price.Total)
+			require.Equal(t, res.Price.Total, res.TotalPrice)
+			if tt.discount == 0 {
+				require.Empty(t, res.Price.Discounts)
+				return
+			}
+			require.Equal(t, []domain.Discount{{Code: "SALE", Kind: promo.Kind, Amount: money.New(tt.discount, "RUB")}}, res.Price.Discounts)
+		})
+	}
+}
+
+func TestCartService_ApplyPromo_NormalizesCode(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.ApplyPromoMock.Expect(ctx, uint64(7), "SALE10", nil).Return(nil)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	require.NoError(t, cs.ApplyPromo(ctx, 7, " sale10 ", nil))
+}
+
+func TestCartService_ApplyPromo_Unknown(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.ApplyPromoMock.Expect(ctx, uint64(7), "NOPE", nil).Return(postgres.ErrNotFound)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	require.ErrorIs(t, cs.ApplyPromo(ctx, 7, "nope", nil), service.ErrPromoNotFound)
+}
+
+func TestCartService_RemovePromo_None(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.RemovePromoMock.Expect(ctx, uint64(7), nil).Return(postgres.ErrNotFound)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	require.ErrorIs(t, cs.RemovePromo(ctx, 7, nil), service.ErrPromoNotFound)
+}
+
+func TestCartService_CreatePromo_Invalid(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), mocks.NewClientIfaceMock(mc))
+
+	invalid := []domain.Promo{
+		{Code: "", Kind: postgres.PromoPercent, Percent: 10},
+		{Code: "P", Kind: postgres.PromoPercent, Percent: 0},
+		{Code: "P", Kind: postgres.PromoPercent, Percent: 101},
+		{Code: "F", Kind: postgres.PromoFixed},
+		{Code: "B", Kind: postgres.PromoBuyNGetM, SkuID: 1001, BuyCount: 2},
+		{Code: "X", Kind: "gift"},
+	}
+	for _, p := range invalid {
+		_, err := cs.CreatePromo(ctx, p)
+		require.ErrorIs(t, err, service.ErrInvalidPromo, "%+v", p)
+	}
+}
+
+func TestCartService_CreatePromo_Exists(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.CreatePromoMock.Expect(ctx, postgres.Promo{Code: "SALE", Kind: postgres.PromoFixed, Amount: 100}).
+		Return(nil, postgres.ErrAlreadyExists)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	_, err := cs.CreatePromo(ctx, domain.Promo{Code: "sale", Kind: postgres.PromoFixed, Amount: 100})
+	require.ErrorIs(t, err, service.ErrPromoExists)
+}
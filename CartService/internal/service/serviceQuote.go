@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/bits"
+	"strings"
+
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+var (
+	ErrQuoteUnavailable = errors.New("cart quotes are not configured")
+	ErrNotDeliverable   = errors.New("cart cannot be shipped to the address")
+	ErrInvalidAddress   = errors.New("invalid address")
+)
+
+// TaxCalculator estimates the tax on goods worth taxable shipped to addr.
+type TaxCalculator interface {
+	Tax(ctx context.Context, addr domain.Address, taxable money.Money) (money.Money, error)
+}
+
+// Parcel is a cart as ShippingCalculator sees it, Value is after discounts.
+type Parcel struct {
+	WeightGrams uint64
+	Value       money.Money
+}
+
+// ShippingCalculator estimates the delivery of parcel to addr, it fails with
+// ErrNotDeliverable where it does not ship.
+type ShippingCalculator interface {
+	Shipping(ctx context.Context, addr domain.Address, parcel Parcel) (money.Money, error)
+}
+
+// WithQuotes enables QuoteCart with the given calculators.
+func WithQuotes(tax TaxCalculator, shipping ShippingCalculator) Option {
+	return func(c *CartService) {
+		c.tax = tax
+		c.shipping = shipping
+	}
+}
+
+// QuoteCart estimates what the cart costs shipped to addr. Tax is charged on
+// the goods after discounts. Unavailable items are left out as in GetCart.
+func (c *CartService) QuoteCart(ctx context.Context, userID uint64, addr domain.Address) (*domain.CartQuote, error) {
+	if c.tax == nil || c.shipping == nil {
+		return nil, ErrQuoteUnavailable
+	}
+	addr.Country = strings.ToUpper(strings.TrimSpace(addr.Country))
+	addr.Region = strings.ToUpper(strings.TrimSpace(addr.Region))
+	if len(addr.Country) != 2 {
+		return nil, ErrInvalidAddress
+	}
+
+	cart, _, err := c.readCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(cart.Items) == 0 {
+		return nil, ErrEmptyCart
+	}
+
+	goods := cart.Price.Total
+	weight := cartWeight(cart.Items)
+
+	tax, err := checked(c.tax.Tax(ctx, addr, goods))
+	if err != nil {
+		return nil, err
+	}
+	shipping, err := checked(c.shipping.Shipping(ctx, addr, Parcel{WeightGrams: weight, Value: goods}))
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := checked(goods.Add(tax))
+	if err != nil {
+		return nil, err
+	}
+	if total, err = checked(total.Add(shipping)); err != nil {
+		return nil, err
+	}
+
+	// the discounts never take the total below zero, so this cannot fail
+	discount, _ := cart.Price.Subtotal.Sub(goods)
+
+	return &domain.CartQuote{
+		Subtotal:    cart.Price.Subtotal,
+		Discount:    discount,
+		Tax:         tax,
+		Shipping:    shipping,
+		Total:       total,
+		WeightGrams: weight,
+	}, nil
+}
+
+// cartWeight saturates instead of overflowing, such a parcel is only priced
+// by shipping rules without a weight bound.
+func cartWeight(items []domain.CartItem) uint64 {
+	var total uint64
+	for _, it := range items {
+		hi, w := bits.Mul64(it.WeightGrams, it.Count)
+		sum, carry := bits.Add64(total, w, 0)
+		if hi != 0 || carry != 0 {
+			return math.MaxUint64
+		}
+		total = sum
+	}
+
+	return total
+}
@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+var testQuoteRules = &service.QuoteRules{
+	Currency: "RUB",
+	TaxRules: []service.TaxRule{
+		{Country: "RU", RateBps: 2000},
+	},
+	ShippingRules: []service.ShippingRule{
+		{Country: "RU", Region: "RU-MOW", MinValue: 5000, Price: 0},
+		{Country: "RU", Region: "RU-MOW", MaxWeightGrams: 1000, Price: 199},
+		{Country: "RU", MaxWeightGrams: 5000, Price: 349},
+	},
+}
+
+// quoteCart quotes a cart of two T-shirts at 1500 and 300 g and a mug at 900
+// and 400 g shipped to addr.
+func quoteCart(t *testing.T, promo *postgres.Promo, addr domain.Address) (*domain.CartQuote, error) {
+	return quoteCartWith(t, testQuoteRules, promo, addr)
+}
+
+func quoteCartWith(t *testing.T, rules *service.QuoteRules, promo *postgres.Promo, addr domain.Address) (*domain.CartQuote, error) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return(
+		[]postgres.Position{{SkuID: 1001, Count: 2}, {SkuID: 1002, Count: 1}}, 3, nil,
+	)
+	repo.GetCartPromoMock.Return(promo, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1001, 1002}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500, WeightGrams: 300},
+			1002: {Name: "Coffee Mug", Price: 900, WeightGrams: 400},
+		}, nil,
+	)
+
+	cs := service.New(repo, pc, service.WithQuotes(rules, rules))
+	return cs.QuoteCart(ctx, 7, addr)
+}
+
+func TestCartService_QuoteCart(t *testing.T) {
+	quote, err := quoteCart(t, nil, domain.Address{Country: "ru", Region: "ru-spe"})
+
+	require.NoError(t, err)
+	require.Equal(t, &domain.CartQuote{
+		Subtotal:    money.New(3900, "RUB"),
+		Discount:    money.New(0, "RUB"),
+		Tax:         money.New(780, "RUB"),
+		Shipping:    money.New(349, "RUB"),
+		Total:       money.New(5029, "RUB"),
+		WeightGrams: 1000,
+	}, quote)
+}
+
+func TestCartService_QuoteCart_TaxAfterDiscount(t *testing.T) {
+	promo := &postgres.Promo{Code: "SALE", Kind: postgres.PromoFixed, Amount: 900}
+	quote, err := quoteCart(t, promo, domain.Address{Country: "RU", Region: "RU-MOW"})
+
+	require.NoError(t, err)
+	require.Equal(t, money.New(900, "RUB"), quote.Discount)
+	require.Equal(t, money.New(600, "RUB"), quote.Tax)
+	require.Equal(t, money.New(199, "RUB"), quote.Shipping)
+	require.Equal(t, money.New(3000+600+199, "RUB"), quote.Total)
+}
+
+func TestCartService_QuoteCart_NotDeliverable(t *testing.T) {
+	_, err := quoteCart(t, nil, domain.Address{Country: "DE"})
+	require.ErrorIs(t, err, service.ErrNotDeliverable)
+}
+
+func TestCartService_QuoteCart_NotConfigured(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), mocks.NewClientIfaceMock(mc))
+	_, err := cs.QuoteCart(context.Background(), 7, domain.Address{Country: "RU"})
+	require.ErrorIs(t, err, service.ErrQuoteUnavailable)
+}
+
+func TestCartService_QuoteCart_InvalidAddress(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), mocks.NewClientIfaceMock(mc),
+		service.WithQuotes(testQuoteRules, testQuoteRules))
+	_, err := cs.QuoteCart(context.Background(), 7, domain.Address{Region: "RU-MOW"})
+	require.ErrorIs(t, err, service.ErrInvalidAddress)
+}
+
+func TestCartService_QuoteCart_RulesInOtherCurrency(t *testing.T) {
+	rules := &service.QuoteRules{Currency: "USD", TaxRules: []service.TaxRule{{RateBps: 2000}}}
+	_, err := quoteCartWith(t, rules, nil, domain.Address{Country: "RU"})
+
+	require.ErrorIs(t, err, service.ErrCurrencyMismatch)
+}
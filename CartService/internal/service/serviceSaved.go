@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+)
+
+var ErrSavedItemNotFound = errors.New("item not in saved list")
+
+// MoveToSaved moves the whole position of skuID from the cart to the saved
+// list and releases its stock, saved items hold none.
+func (c *CartService) MoveToSaved(ctx context.Context, userID, skuID uint64, expectedVersion *uint64) error {
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	count, err := c.store.MoveToSaved(ctx, userID, skuID, expectedVersion)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNotFound) {
+			return ErrItemNotFound
+		}
+		return storeError(err)
+	}
+	c.releaseStock(ctx, skuID, count)
+
+	return nil
+}
+
+// MoveToCart moves the saved position of skuID back to the cart as AddToCart
+// adds it: at the current price, within the limits and with its stock
+// reserved.
+func (c *CartService) MoveToCart(ctx context.Context, userID, skuID uint64, expectedVersion *uint64) error {
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	saved, err := c.store.ListSaved(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var count uint64
+	for _, it := range saved {
+		if it.SkuID == skuID {
+			count = it.Count
+			break
+		}
+	}
+	if count == 0 {
+		return ErrSavedItemNotFound
+	}
+
+	pr, err := c.pc.GetProduct(ctx, skuID)
+	if err != nil {
+		return err
+	}
+	price, err := c.unitPrice(ctx, pr)
+	if err != nil {
+		return err
+	}
+	if _, err := checked(price.Mul(count)); err != nil {
+		return err
+	}
+
+	return retryMoved(func() error {
+		return c.moveToCart(ctx, userID, skuID, count, price.Amount, expectedVersion)
+	})
+}
+
+func (c *CartService) moveToCart(ctx context.Context, userID, skuID, count, price uint64, expectedVersion *uint64) error {
+	expected := expectedVersion
+	if c.limits != (Limits{}) {
+		_, version, err := c.checkLimits(ctx, userID, skuID, price, func(prev uint64) uint64 { return prev + count })
+		if err != nil {
+			return err
+		}
+		if expected, err = c.checkedVersion(expectedVersion, version); err != nil {
+			return err
+		}
+	}
+
+	if err := c.pc.ReserveStock(ctx, skuID, count); err != nil {
+		return err
+	}
+
+	if err := c.store.MoveToCart(ctx, userID, skuID, count, price, expected); err != nil {
+		c.releaseStock(ctx, skuID, count)
+		if errors.Is(err, postgres.ErrNotFound) {
+			return ErrSavedItemNotFound
+		}
+		return checkedError(err, expectedVersion)
+	}
+
+	return nil
+}
+
+// ListSaved returns the saved list with current names and prices in the cart
+// currency. Items GetCart would report as unavailable are reported the same
+// way, they stay saved.
+func (c *CartService) ListSaved(ctx context.Context, userID uint64) (*domain.ListSavedResponse, error) {
+	saved, err := c.store.ListSaved(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	skus := make([]uint64, 0, len(saved))
+	for _, it := range saved {
+		skus = append(skus, it.SkuID)
+	}
+	products, err := c.lookupProducts(ctx, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &domain.ListSavedResponse{
+		Items:            make([]domain.SavedItem, 0, len(saved)),
+		UnavailableItems: []domain.UnavailableItem{},
+	}
+	for _, it := range saved {
+		pr, ok := products[it.SkuID]
+		if !ok {
+			res.UnavailableItems = append(res.UnavailableItems, domain.UnavailableItem{
+				SkuID:  it.SkuID,
+				Count:  it.Count,
+				Reason: domain.UnavailableDelisted,
+			})
+			continue
+		}
+		price, err := c.unitPrice(ctx, pr)
+		if errors.Is(err, ErrCurrencyMismatch) {
+			res.UnavailableItems = append(res.UnavailableItems, domain.UnavailableItem{
+				SkuID:  it.SkuID,
+				Count:  it.Count,
+				Reason: domain.UnavailableCurrency,
+			})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res.Items = append(res.Items, domain.SavedItem{
+			SkuID:   it.SkuID,
+			Name:    pr.Name,
+			Count:   it.Count,
+			Price:   price,
+			SavedAt: it.SavedAt,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,137 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+func TestCartService_MoveToSaved_ReleasesStock(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.MoveToSavedMock.Expect(ctx, uint64(7), uint64(1001), nil).Return(2, nil)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+
+	cs := service.New(repo, pc)
+	require.NoError(t, cs.MoveToSaved(ctx, 7, 1001, nil))
+}
+
+func TestCartService_MoveToSaved_NotInCart(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.MoveToSavedMock.Expect(ctx, uint64(7), uint64(1001), nil).Return(0, postgres.ErrNotFound)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	require.ErrorIs(t, cs.MoveToSaved(ctx, 7, 1001, nil), service.ErrItemNotFound)
+}
+
+func TestCartService_MoveToCart_ReservesAtCurrentPrice(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.ListSavedMock.Expect(ctx, uint64(7)).Return([]postgres.SavedItem{
+		{SkuID: 1002, Count: 1},
+		{SkuID: 1001, Count: 2},
+	}, nil)
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1400}, nil)
+	pc.ReserveStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+	repo.MoveToCartMock.Expect(ctx, uint64(7), uint64(1001), uint64(2), uint64(1400), nil).Return(nil)
+
+	cs := service.New(repo, pc)
+	require.NoError(t, cs.MoveToCart(ctx, 7, 1001, nil))
+}
+
+func TestCartService_MoveToCart_NotSaved(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.ListSavedMock.Expect(ctx, uint64(7)).Return([]postgres.SavedItem{{SkuID: 1002, Count: 1}}, nil)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc))
+	require.ErrorIs(t, cs.MoveToCart(ctx, 7, 1001, nil), service.ErrSavedItemNotFound)
+}
+
+func TestCartService_MoveToCart_VersionMismatchReleases(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	version := uint64(3)
+	repo.ListSavedMock.Expect(ctx, uint64(7)).Return([]postgres.SavedItem{{SkuID: 1001, Count: 2}}, nil)
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	pc.ReserveStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+	repo.MoveToCartMock.Expect(ctx, uint64(7), uint64(1001), uint64(2), uint64(1500), &version).
+		Return(postgres.ErrVersionMismatch)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+
+	cs := service.New(repo, pc)
+	require.ErrorIs(t, cs.MoveToCart(ctx, 7, 1001, &version), service.ErrVersionMismatch)
+}
+
+func TestCartService_MoveToCart_OverLimit(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.ListSavedMock.Expect(ctx, uint64(7)).Return([]postgres.SavedItem{{SkuID: 1001, Count: 2}}, nil)
+	pc.GetProductMock.Expect(ctx, uint64(1001)).Return(&service.Product{Name: "Demo T-Shirt", Price: 1500}, nil)
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return([]postgres.Position{{SkuID: 1001, Count: 2}}, 3, nil)
+
+	cs := service.New(repo, pc, service.WithLimits(service.Limits{MaxItemCount: 3}))
+	require.ErrorIs(t, cs.MoveToCart(ctx, 7, 1001, nil), service.ErrLimitExceeded)
+}
+
+func TestCartService_ListSaved(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	repo.ListSavedMock.Expect(ctx, uint64(7)).Return([]postgres.SavedItem{
+		{SkuID: 1002, Count: 1, SavedAt: at},
+		{SkuID: 3003, Count: 4, SavedAt: at},
+		{SkuID: 1001, Count: 2, SavedAt: at.Add(-time.Hour)},
+	}, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1002, 3003, 1001}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1500},
+			1002: {Name: "Coffee Mug", Price: 900},
+		}, nil,
+	)
+
+	cs := service.New(repo, pc, service.WithBatchLookup(true))
+	res, err := cs.ListSaved(ctx, 7)
+
+	require.NoError(t, err)
+	require.Equal(t, &domain.ListSavedResponse{
+		Items: []domain.SavedItem{
+			{SkuID: 1002, Name: "Coffee Mug", Count: 1, Price: money.New(900, "RUB"), SavedAt: at},
+			{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: money.New(1500, "RUB"), SavedAt: at.Add(-time.Hour)},
+		},
+		UnavailableItems: []domain.UnavailableItem{{SkuID: 3003, Count: 4, Reason: domain.UnavailableDelisted}},
+	}, res)
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+var (
+	ErrSharingUnavailable = errors.New("cart sharing is not configured")
+	ErrInvalidShareToken  = errors.New("invalid share token")
+	ErrShareExpired       = errors.New("share link expired")
+	ErrSharedCartNotFound = errors.New("shared cart not found")
+)
+
+const DefaultShareTTL = 7 * 24 * time.Hour
+
+// WithCartSharing enables ShareCart with tokens signed by key that expire
+// after ttl, DefaultShareTTL if it is not positive. An empty key leaves
+// sharing off.
+func WithCartSharing(key []byte, ttl time.Duration) Option {
+	return func(c *CartService) {
+		if ttl <= 0 {
+			ttl = DefaultShareTTL
+		}
+		c.shareKey = key
+		c.shareTTL = ttl
+	}
+}
+
+// ShareCart saves a snapshot of the cart with its current names and prices
+// and returns a token that reads it until it expires. Unavailable items are
+// left out, the promo code is not shared.
+func (c *CartService) ShareCart(ctx context.Context, userID uint64) (*domain.ShareCartResponse, error) {
+	if len(c.shareKey) == 0 {
+		return nil, ErrSharingUnavailable
+	}
+
+	cart, _, err := c.readCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(cart.Items) == 0 {
+		return nil, ErrEmptyCart
+	}
+
+	items := make([]postgres.OrderItem, 0, len(cart.Items))
+	for _, it := range cart.Items {
+		items = append(items, postgres.OrderItem{SkuID: it.SkuID, Name: it.Name, Count: it.Count, Price: it.Price.Amount})
+	}
+	// truncated as the token keeps whole seconds
+	expiresAt := time.Now().Add(c.shareTTL).Truncate(time.Second)
+
+	id, err := c.store.CreateCartShare(ctx, userID, items, cart.Price.Subtotal, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ShareCartResponse{Token: c.signShare(id, expiresAt), ExpiresAt: expiresAt}, nil
+}
+
+// GetSharedCart returns the snapshot of the token as it was shared.
+func (c *CartService) GetSharedCart(ctx context.Context, token string) (*domain.SharedCart, error) {
+	share, err := c.cartShare(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.CartItem, 0, len(share.Items))
+	for _, it := range share.Items {
+		items = append(items, domain.CartItem{SkuID: it.SkuID, Name: it.Name, Count: it.Count, Price: money.New(it.Price, share.Currency)})
+	}
+
+	return &domain.SharedCart{
+		Items:      items,
+		TotalPrice: money.New(share.TotalPrice, share.Currency),
+		CreatedAt:  share.CreatedAt,
+		ExpiresAt:  share.ExpiresAt,
+	}, nil
+}
+
+// ImportSharedCart adds the items of the shared cart to the user's cart as
+// AddToCart adds them, at the current prices and with their stock reserved.
+// Either all items are added or, over a limit or out of stock, none.
+// Items that are no longer available are skipped and their skus returned,
+// ErrEmptyCart means none is left.
+func (c *CartService) ImportSharedCart(ctx context.Context, token string, userID uint64, expectedVersion *uint64) ([]uint64, error) {
+	share, err := c.cartShare(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	c.userLocks.Lock(userID)
+	defer c.userLocks.Unlock(userID)
+
+	var skipped []uint64
+	err = retryMoved(func() error {
+		var err error
+		skipped, err = c.importShare(ctx, share, userID, expectedVersion)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return skipped, nil
+}
+
+func (c *CartService) importShare(ctx context.Context, share *postgres.CartShare, userID uint64, expectedVersion *uint64) ([]uint64, error) {
+	positions, version, err := c.store.GetCart(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[uint64]uint64, len(positions))
+	skus := make([]uint64, 0, len(positions)+len(share.Items))
+	for _, p := range positions {
+		counts[p.SkuID] = p.Count
+		skus = append(skus, p.SkuID)
+	}
+	for _, it := range share.Items {
+		if _, ok := counts[it.SkuID]; !ok {
+			skus = append(skus, it.SkuID)
+		}
+	}
+	products, err := c.lookupProducts(ctx, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []postgres.Position
+	skipped := []uint64{}
+	next := make(map[uint64]uint64, len(share.Items))
+	for _, it := range share.Items {
+		pr, ok := products[it.SkuID]
+		if !ok {
+			skipped = append(skipped, it.SkuID)
+			continue
+		}
+		price, err := c.unitPrice(ctx, pr)
+		if errors.Is(err, ErrCurrencyMismatch) {
+			skipped = append(skipped, it.SkuID)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err := checked(price.Mul(it.Count)); err != nil {
+			return nil, err
+		}
+		items = append(items, postgres.Position{SkuID: it.SkuID, Count: it.Count, AddedPrice: price.Amount})
+		next[it.SkuID] = counts[it.SkuID] + it.Count
+	}
+	if len(items) == 0 {
+		return nil, ErrEmptyCart
+	}
+
+	if err := c.checkCounts(ctx, positions, next, products); err != nil {
+		return nil, err
+	}
+	expected, err := c.checkedVersion(expectedVersion, version)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, p := range items {
+		if err := c.pc.ReserveStock(ctx, p.SkuID, p.Count); err != nil {
+			for _, r := range items[:i] {
+				c.releaseStock(ctx, r.SkuID, r.Count)
+			}
+			return nil, err
+		}
+	}
+
+	if err := c.store.ImportItems(ctx, userID, share.ID, items, expected); err != nil {
+		for _, p := range items {
+			c.releaseStock(ctx, p.SkuID, p.Count)
+		}
+		return nil, checkedError(err, expectedVersion)
+	}
+
+	return skipped, nil
+}
+
+// cartShare checks the token and reads its snapshot.
+func (c *CartService) cartShare(ctx context.Context, token string) (*postgres.CartShare, error) {
+	if len(c.shareKey) == 0 {
+		return nil, ErrSharingUnavailable
+	}
+	id, expiresAt, err := c.parseShareToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(expiresAt) {
+		return nil, ErrShareExpired
+	}
+
+	share, err := c.store.GetCartShare(ctx, id)
+	if errors.Is(err, postgres.ErrNotFound) {
+		return nil, ErrSharedCartNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return share, nil
+}
+
+// A share token is the snapshot ID and the expiry in Unix seconds, both
+// big-endian, followed by their HMAC-SHA256, in unpadded base64url.
+const sharePayloadLen = 16
+
+func (c *CartService) signShare(id uint64, expiresAt time.Time) string {
+	b := make([]byte, sharePayloadLen, sharePayloadLen+sha256.Size)
+	binary.BigEndian.PutUint64(b, id)
+	binary.BigEndian.PutUint64(b[8:], uint64(expiresAt.Unix()))
+
+	return base64.RawURLEncoding.EncodeToString(c.shareMAC(b, b))
+}
+
+func (c *CartService) parseShareToken(token string) (uint64, time.Time, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) != sharePayloadLen+sha256.Size {
+		return 0, time.Time{}, ErrInvalidShareToken
+	}
+	payload := b[:sharePayloadLen]
+	if !hmac.Equal(b[sharePayloadLen:], c.shareMAC(nil, payload)) {
+		return 0, time.Time{}, ErrInvalidShareToken
+	}
+
+	id := binary.BigEndian.Uint64(payload)
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(payload[8:])), 0)
+
+	return id, expiresAt, nil
+}
+
+// shareMAC appends the MAC of payload to dst.
+func (c *CartService) shareMAC(dst, payload []byte) []byte {
+	m := hmac.New(sha256.New, c.shareKey)
+	m.Write(payload)
+
+	return m.Sum(dst)
+}
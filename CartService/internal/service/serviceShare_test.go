@@ -0,0 +1,224 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+const shareKey = "test-share-key"
+
+// shareToken shares a cart of two T-shirts signed with key, the snapshot gets ID 42.
+func shareToken(t *testing.T, key string, ttl time.Duration) string {
+	mc := minimock.NewController(t)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Return([]postgres.Position{{SkuID: 1001, Count: 2}}, 3, nil)
+	repo.GetCartPromoMock.Return(nil, nil)
+	pc.GetProductsMock.Return(map[uint64]*service.Product{1001: {Name: "Demo T-Shirt", Price: 1500}}, nil)
+	repo.CreateCartShareMock.Return(42, nil)
+
+	cs := service.New(repo, pc, service.WithCartSharing([]byte(key), ttl))
+	res, err := cs.ShareCart(context.Background(), 7)
+	require.NoError(t, err)
+
+	return res.Token
+}
+
+// sharedCart is the snapshot behind shareToken, 3003 has been delisted since.
+var sharedCart = &postgres.CartShare{
+	ID:         42,
+	UserID:     7,
+	TotalPrice: 4000,
+	Currency:   "RUB",
+	Items: []postgres.OrderItem{
+		{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: 1500},
+		{SkuID: 1002, Name: "Coffee Mug", Count: 1, Price: 900},
+		{SkuID: 3003, Name: "Poster", Count: 1, Price: 100},
+	},
+}
+
+func TestCartService_ShareCart_Snapshot(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartMock.Expect(ctx, uint64(7)).Return(
+		[]postgres.Position{{SkuID: 1001, Count: 2}, {SkuID: 3003, Count: 1}}, 3, nil,
+	)
+	repo.GetCartPromoMock.Return(&postgres.Promo{Code: "SALE", Kind: postgres.PromoPercent, Percent: 10}, nil)
+	pc.GetProductsMock.Return(map[uint64]*service.Product{1001: {Name: "Demo T-Shirt", Price: 1500}}, nil)
+
+	var expiresAt time.Time
+	repo.CreateCartShareMock.Set(func(_ context.Context, userID uint64, items []postgres.OrderItem, total money.Money, at time.Time) (uint64, error) {
+		require.Equal(t, uint64(7), userID)
+		require.Equal(t, []postgres.OrderItem{{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: 1500}}, items)
+		// the discount of the sharer is left out
+		require.Equal(t, money.New(3000, "RUB"), total)
+		expiresAt = at
+		return 42, nil
+	})
+
+	cs := service.New(repo, pc, service.WithCartSharing([]byte(shareKey), time.Hour))
+	res, err := cs.ShareCart(ctx, 7)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Token)
+	require.Equal(t, expiresAt, res.ExpiresAt)
+	require.WithinDuration(t, time.Now().Add(time.Hour), res.ExpiresAt, time.Second)
+}
+
+func TestCartService_GetSharedCart(t *testing.T) {
+	token := shareToken(t, shareKey, time.Hour)
+
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.GetCartShareMock.Expect(ctx, uint64(42)).Return(sharedCart, nil)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc), service.WithCartSharing([]byte(shareKey), time.Hour))
+	res, err := cs.GetSharedCart(ctx, token)
+
+	require.NoError(t, err)
+	require.Equal(t, money.New(4000, "RUB"), res.TotalPrice)
+	require.Equal(t, []domain.CartItem{
+		{SkuID: 1001, Name: "Demo T-Shirt", Count: 2, Price: money.New(1500, "RUB")},
+		{SkuID: 1002, Name: "Coffee Mug", Count: 1, Price: money.New(900, "RUB")},
+		{SkuID: 3003, Name: "Poster", Count: 1, Price: money.New(100, "RUB")},
+	}, res.Items)
+}
+
+func TestCartService_GetSharedCart_BadTokens(t *testing.T) {
+	token := shareToken(t, shareKey, time.Hour)
+
+	mc := minimock.NewController(t)
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), mocks.NewClientIfaceMock(mc),
+		service.WithCartSharing([]byte(shareKey), time.Hour))
+
+	tampered := []byte(token)
+	tampered[3] ^= 1
+	for _, tok := range []string{"", "not a token", string(tampered), shareToken(t, "other-key", time.Hour)} {
+		_, err := cs.GetSharedCart(context.Background(), tok)
+		require.ErrorIs(t, err, service.ErrInvalidShareToken, tok)
+	}
+
+	_, err := cs.GetSharedCart(context.Background(), shareToken(t, shareKey, time.Nanosecond))
+	require.ErrorIs(t, err, service.ErrShareExpired)
+}
+
+func TestCartService_ShareCart_NotConfigured(t *testing.T) {
+	mc := minimock.NewController(t)
+	cs := service.New(mocks.NewRepositoryIfaceMock(mc), mocks.NewClientIfaceMock(mc))
+
+	_, err := cs.ShareCart(context.Background(), 7)
+	require.ErrorIs(t, err, service.ErrSharingUnavailable)
+	_, err = cs.GetSharedCart(context.Background(), "token")
+	require.ErrorIs(t, err, service.ErrSharingUnavailable)
+}
+
+// importMocks sets up the user's cart with one T-shirt and the current
+// products, the T-shirt now costs 1400 and the poster is gone.
+func importMocks(mc *minimock.Controller) (*mocks.RepositoryIfaceMock, *mocks.ClientIfaceMock) {
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+
+	repo.GetCartShareMock.Expect(ctx, uint64(42)).Return(sharedCart, nil)
+	repo.GetCartMock.Expect(ctx, uint64(8)).Return([]postgres.Position{{SkuID: 1001, Count: 1}}, 5, nil)
+	pc.GetProductsMock.Expect(ctx, []uint64{1001, 1002, 3003}).Return(
+		map[uint64]*service.Product{
+			1001: {Name: "Demo T-Shirt", Price: 1400},
+			1002: {Name: "Coffee Mug", Price: 900},
+		}, nil,
+	)
+
+	return repo, pc
+}
+
+func TestCartService_ImportSharedCart(t *testing.T) {
+	token := shareToken(t, shareKey, time.Hour)
+
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	version := uint64(5)
+	repo, pc := importMocks(mc)
+	pc.ReserveStockMock.When(ctx, uint64(1001), uint64(2)).Then(nil)
+	pc.ReserveStockMock.When(ctx, uint64(1002), uint64(1)).Then(nil)
+	repo.ImportItemsMock.Expect(ctx, uint64(8), uint64(42), []postgres.Position{
+		{SkuID: 1001, Count: 2, AddedPrice: 1400},
+		{SkuID: 1002, Count: 1, AddedPrice: 900},
+	}, &version).Return(nil)
+
+	cs := service.New(repo, pc,
+		service.WithCartSharing([]byte(shareKey), time.Hour),
+		service.WithLimits(service.Limits{MaxItemCount: 3, MaxDistinctSkus: 2, MaxTotalPrice: 5100}),
+	)
+	skipped, err := cs.ImportSharedCart(ctx, token, 8, nil)
+	require.NoError(t, err)
+	// 3003 is delisted
+	require.Equal(t, []uint64{3003}, skipped)
+}
+
+func TestCartService_ImportSharedCart_OverLimit(t *testing.T) {
+	token := shareToken(t, shareKey, time.Hour)
+
+	limits := []service.Limits{
+		{MaxItemCount: 2},
+		{MaxDistinctSkus: 1},
+		{MaxTotalPrice: 5000},
+	}
+	for _, l := range limits {
+		mc := minimock.NewController(t)
+
+		repo, pc := importMocks(mc)
+		cs := service.New(repo, pc, service.WithCartSharing([]byte(shareKey), time.Hour), service.WithLimits(l))
+
+		_, err := cs.ImportSharedCart(context.Background(), token, 8, nil)
+		require.ErrorIs(t, err, service.ErrLimitExceeded, "%+v", l)
+	}
+}
+
+func TestCartService_ImportSharedCart_OutOfStockReleases(t *testing.T) {
+	token := shareToken(t, shareKey, time.Hour)
+
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo, pc := importMocks(mc)
+	pc.ReserveStockMock.When(ctx, uint64(1001), uint64(2)).Then(nil)
+	pc.ReserveStockMock.When(ctx, uint64(1002), uint64(1)).Then(service.ErrInsufficientStock)
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+
+	cs := service.New(repo, pc, service.WithCartSharing([]byte(shareKey), time.Hour))
+	_, err := cs.ImportSharedCart(ctx, token, 8, nil)
+	require.ErrorIs(t, err, service.ErrInsufficientStock)
+}
+
+func TestCartService_SweepCarts_DeletesExpiredShares(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	withLock(repo)
+	repo.DeleteExpiredCartSharesMock.Return(3, nil)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc), service.WithCartSharing([]byte(shareKey), time.Hour))
+	res, err := cs.SweepCarts(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, 3, res.SharesDeleted)
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/verbovyar/OzonCart/internal/domain"
+)
+
+const DefaultSweepBatch = 100
+
+// AbandonedCartNotifier reminds users of carts they left alone.
+type AbandonedCartNotifier interface {
+	NotifyAbandonedCart(ctx context.Context, cart domain.AbandonedCart) error
+}
+
+// SweepPolicy configures SweepCarts, a zero duration disables that part.
+type SweepPolicy struct {
+	// CartTTL is how long a cart may stay unchanged before it is emptied.
+	CartTTL time.Duration
+	// AbandonedAfter is how long a cart stays unchanged before the user is
+	// reminded of it, once per period of inactivity.
+	AbandonedAfter time.Duration
+	Batch          int
+}
+
+// WithSweeper sets what SweepCarts does and who is told about abandoned carts.
+func WithSweeper(p SweepPolicy, n AbandonedCartNotifier) Option {
+	return func(c *CartService) {
+		if p.Batch <= 0 {
+			p.Batch = DefaultSweepBatch
+		}
+		c.sweep = p
+		c.notifier = n
+	}
+}
+
+type SweepResult struct {
+	Expired  int
+	Notified int
+	// SharesDeleted counts the snapshots of expired share links.
+	SharesDeleted int
+	// Skipped is set when another instance was sweeping.
+	Skipped bool
+}
+
+// SweepCarts expires stale carts and reports abandoned ones in batches, and
+// deletes the snapshots of expired share links. Only one instance sweeps at
+// a time, the others skip the round.
+func (c *CartService) SweepCarts(ctx context.Context) (SweepResult, error) {
+	var res SweepResult
+	locked, err := c.store.WithSweepLock(ctx, func() error {
+		if c.sweep.CartTTL > 0 {
+			if err := c.expireCarts(ctx, &res); err != nil {
+				return err
+			}
+		}
+		if len(c.shareKey) > 0 {
+			n, err := c.store.DeleteExpiredCartShares(ctx)
+			if err != nil {
+				return err
+			}
+			res.SharesDeleted = int(n)
+		}
+		if c.sweep.AbandonedAfter > 0 && c.notifier != nil {
+			return c.notifyAbandoned(ctx, &res)
+		}
+		return nil
+	})
+	res.Skipped = !locked
+
+	return res, err
+}
+
+func (c *CartService) expireCarts(ctx context.Context, res *SweepResult) error {
+	for {
+		carts, err := c.store.ExpireCarts(ctx, c.sweep.CartTTL, c.sweep.Batch)
+		for _, cart := range carts {
+			for _, p := range cart.Items {
+				c.releaseStock(ctx, p.SkuID, p.Count)
+			}
+		}
+		res.Expired += len(carts)
+		if err != nil || len(carts) < c.sweep.Batch {
+			return err
+		}
+	}
+}
+
+func (c *CartService) notifyAbandoned(ctx context.Context, res *SweepResult) error {
+	for {
+		carts, err := c.store.AbandonedCarts(ctx, c.sweep.AbandonedAfter, c.sweep.Batch)
+		if err != nil {
+			return err
+		}
+
+		failed := false
+		for _, cart := range carts {
+			items := make([]domain.CartItem, 0, len(cart.Items))
+			for _, p := range cart.Items {
+				items = append(items, domain.CartItem{SkuID: p.SkuID, Count: p.Count})
+			}
+			err := c.notifier.NotifyAbandonedCart(ctx, domain.AbandonedCart{
+				UserID:    cart.UserID,
+				Items:     items,
+				UpdatedAt: cart.UpdatedAt,
+			})
+			if err != nil {
+				// the cart stays unmarked and is reported by a later sweep
+				log.Printf("notify abandoned cart user=%d: %v", cart.UserID, err)
+				failed = true
+				continue
+			}
+			if err := c.store.MarkAbandonedNotified(ctx, cart.UserID, cart.UpdatedAt); err != nil {
+				return err
+			}
+			res.Notified++
+		}
+
+		// failed carts would come back in the next batch
+		if failed || len(carts) < c.sweep.Batch {
+			return nil
+		}
+	}
+}
+
+// LogNotifier writes abandoned carts as JSON lines instead of reaching the
+// users, for local runs.
+type LogNotifier struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func NewLogNotifier(w io.Writer) *LogNotifier {
+	return &LogNotifier{enc: json.NewEncoder(w)}
+}
+
+func (n *LogNotifier) NotifyAbandonedCart(_ context.Context, cart domain.AbandonedCart) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return n.enc.Encode(cart)
+}
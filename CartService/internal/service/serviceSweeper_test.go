@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/internal/domain"
+	"github.com/verbovyar/OzonCart/internal/mocks"
+	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
+	"github.com/verbovyar/OzonCart/internal/service"
+)
+
+type notifierFunc func(ctx context.Context, cart domain.AbandonedCart) error
+
+func (f notifierFunc) NotifyAbandonedCart(ctx context.Context, cart domain.AbandonedCart) error {
+	return f(ctx, cart)
+}
+
+func withLock(repo *mocks.RepositoryIfaceMock) {
+	repo.WithSweepLockMock.Set(func(_ context.Context, fn func() error) (bool, error) {
+		return true, fn()
+	})
+}
+
+func TestCartService_SweepCarts_ExpireReleasesStock(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	pc := mocks.NewClientIfaceMock(mc)
+	withLock(repo)
+
+	// a full batch is followed by another one
+	repo.ExpireCartsMock.Times(2).Set(func(_ context.Context, idle time.Duration, limit int) ([]postgres.ExpiredCart, error) {
+		require.Equal(t, 30*24*time.Hour, idle)
+		if repo.ExpireCartsAfterCounter() == 0 {
+			return []postgres.ExpiredCart{{UserID: 1, Items: []postgres.Position{{SkuID: 1001, Count: 2}}}}, nil
+		}
+		return nil, nil
+	})
+	pc.ReleaseStockMock.Expect(ctx, uint64(1001), uint64(2)).Return(nil)
+
+	cs := service.New(repo, pc, service.WithSweeper(service.SweepPolicy{CartTTL: 30 * 24 * time.Hour, Batch: 1}, nil))
+	res, err := cs.SweepCarts(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, service.SweepResult{Expired: 1}, res)
+}
+
+func TestCartService_SweepCarts_NotifiesAbandoned(t *testing.T) {
+	mc := minimock.NewController(t)
+	ctx := context.Background()
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	withLock(repo)
+	repo.AbandonedCartsMock.Return([]postgres.AbandonedCart{
+		{UserID: 1, UpdatedAt: at, Items: []postgres.Position{{SkuID: 1001, Count: 2}}},
+		{UserID: 2, UpdatedAt: at, Items: []postgres.Position{{SkuID: 1002, Count: 1}}},
+	}, nil)
+	// the failed one is left for the next sweep
+	repo.MarkAbandonedNotifiedMock.Expect(ctx, uint64(1), at).Return(nil)
+
+	var got []domain.AbandonedCart
+	notifier := notifierFunc(func(_ context.Context, cart domain.AbandonedCart) error {
+		if cart.UserID == 2 {
+			return errors.New("mail is down")
+		}
+		got = append(got, cart)
+		return nil
+	})
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc),
+		service.WithSweeper(service.SweepPolicy{AbandonedAfter: 24 * time.Hour, Batch: 2}, notifier))
+	res, err := cs.SweepCarts(ctx)
+
+	require.NoError(t, err)
+	require.Equal(t, service.SweepResult{Notified: 1}, res)
+	require.Equal(t, []domain.AbandonedCart{
+		{UserID: 1, UpdatedAt: at, Items: []domain.CartItem{{SkuID: 1001, Count: 2}}},
+	}, got)
+}
+
+func TestCartService_SweepCarts_Skipped(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	repo := mocks.NewRepositoryIfaceMock(mc)
+	repo.WithSweepLockMock.Return(false, nil)
+
+	cs := service.New(repo, mocks.NewClientIfaceMock(mc), service.WithSweeper(service.SweepPolicy{CartTTL: time.Hour}, nil))
+	res, err := cs.SweepCarts(context.Background())
+
+	require.NoError(t, err)
+	require.True(t, res.Skipped)
+}
@@ -5,6 +5,7 @@ import (
 	"regexp"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/verbovyar/OzonCart/internal/domain"
 )
 
 type Validator struct {
@@ -40,3 +41,8 @@ func (v *Validator) Struct(s any) error {
 func (v *Validator) ValidateID(id uint64) bool {
 	return id > 0
 }
+
+// ValidateUserID also rejects the IDs reserved for guest carts.
+func (v *Validator) ValidateUserID(id uint64) bool {
+	return id > 0 && id < domain.GuestCartIDBase
+}
@@ -0,0 +1,92 @@
+// Package money keeps prices as minor units of a currency and checks the
+// arithmetic on them for overflow.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+var (
+	ErrOverflow         = errors.New("money overflow")
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+)
+
+// Money is an amount in minor units (kopecks, cents) of an ISO 4217 currency.
+type Money struct {
+	Amount   uint64 `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func New(amount uint64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+func (m Money) String() string {
+	return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Add fails with ErrCurrencyMismatch for another currency and ErrOverflow
+// if the sum does not fit.
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+	sum, carry := bits.Add64(m.Amount, o.Amount, 0)
+	if carry != 0 {
+		return Money{}, ErrOverflow
+	}
+
+	return Money{Amount: sum, Currency: m.Currency}, nil
+}
+
+// Sub fails with ErrOverflow if o is more than m.
+func (m Money) Sub(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, o.Currency)
+	}
+	diff, borrow := bits.Sub64(m.Amount, o.Amount, 0)
+	if borrow != 0 {
+		return Money{}, ErrOverflow
+	}
+
+	return Money{Amount: diff, Currency: m.Currency}, nil
+}
+
+// Mul multiplies the amount by n, e.g. a unit price by a count.
+func (m Money) Mul(n uint64) (Money, error) {
+	hi, lo := bits.Mul64(m.Amount, n)
+	if hi != 0 {
+		return Money{}, ErrOverflow
+	}
+
+	return Money{Amount: lo, Currency: m.Currency}, nil
+}
+
+// MulDiv returns m * num / den rounded down without overflowing in between,
+// e.g. a percentage of a price.
+func (m Money) MulDiv(num, den uint64) (Money, error) {
+	if den == 0 {
+		return Money{}, ErrOverflow
+	}
+	hi, lo := bits.Mul64(m.Amount, num)
+	if hi >= den {
+		return Money{}, ErrOverflow
+	}
+	q, _ := bits.Div64(hi, lo, den)
+
+	return Money{Amount: q, Currency: m.Currency}, nil
+}
+
+// Min returns the smaller of two amounts in the same currency.
+func Min(a, b Money) Money {
+	if b.Amount < a.Amount {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,88 @@
+package money_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/pkg/money"
+)
+
+func TestMoney_Add(t *testing.T) {
+	sum, err := money.New(1500, "RUB").Add(money.New(900, "RUB"))
+	require.NoError(t, err)
+	require.Equal(t, money.New(2400, "RUB"), sum)
+
+	_, err = money.New(math.MaxUint64, "RUB").Add(money.New(1, "RUB"))
+	require.ErrorIs(t, err, money.ErrOverflow)
+
+	_, err = money.New(1500, "RUB").Add(money.New(900, "USD"))
+	require.ErrorIs(t, err, money.ErrCurrencyMismatch)
+}
+
+func TestMoney_Sub(t *testing.T) {
+	diff, err := money.New(1500, "RUB").Sub(money.New(900, "RUB"))
+	require.NoError(t, err)
+	require.Equal(t, money.New(600, "RUB"), diff)
+
+	_, err = money.New(900, "RUB").Sub(money.New(1500, "RUB"))
+	require.ErrorIs(t, err, money.ErrOverflow)
+}
+
+func TestMoney_Mul(t *testing.T) {
+	total, err := money.New(1500, "RUB").Mul(3)
+	require.NoError(t, err)
+	require.Equal(t, money.New(4500, "RUB"), total)
+
+	_, err = money.New(math.MaxUint64/2+1, "RUB").Mul(2)
+	require.ErrorIs(t, err, money.ErrOverflow)
+}
+
+func TestMoney_MulDiv(t *testing.T) {
+	part, err := money.New(3900, "RUB").MulDiv(10, 100)
+	require.NoError(t, err)
+	require.Equal(t, money.New(390, "RUB"), part)
+
+	// the intermediate product does not fit into 64 bits, the result does
+	part, err = money.New(math.MaxUint64, "RUB").MulDiv(50, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(math.MaxUint64/2), part.Amount)
+
+	_, err = money.New(math.MaxUint64, "RUB").MulDiv(3, 2)
+	require.ErrorIs(t, err, money.ErrOverflow)
+}
+
+func TestParseRates(t *testing.T) {
+	rates, err := money.ParseRates("usd/rub=92.5, EUR/RUB=101")
+	require.NoError(t, err)
+	require.Equal(t, money.StaticRates{
+		"USD/RUB": {Num: 925, Den: 10},
+		"EUR/RUB": {Num: 101, Den: 1},
+	}, rates)
+
+	for _, bad := range []string{"USD=92", "USD/RUB", "USD/RUB=abc", "USD/RUB=0", "USD/RUB=1.0000000001"} {
+		_, err := money.ParseRates(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestStaticRates_Convert(t *testing.T) {
+	ctx := context.Background()
+	rates := money.StaticRates{"USD/RUB": {Num: 925, Den: 10}}
+
+	rub, err := rates.Convert(ctx, money.New(200, "USD"), "RUB")
+	require.NoError(t, err)
+	require.Equal(t, money.New(18500, "RUB"), rub)
+
+	usd, err := rates.Convert(ctx, money.New(18500, "RUB"), "USD")
+	require.NoError(t, err)
+	require.Equal(t, money.New(200, "USD"), usd)
+
+	same, err := rates.Convert(ctx, money.New(100, "RUB"), "RUB")
+	require.NoError(t, err)
+	require.Equal(t, money.New(100, "RUB"), same)
+
+	_, err = rates.Convert(ctx, money.New(100, "EUR"), "RUB")
+	require.ErrorIs(t, err, money.ErrNoRate)
+}
@@ -0,0 +1,104 @@
+package money
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+var ErrNoRate = errors.New("no exchange rate")
+
+// RateProvider converts amounts between currencies. Implementations return
+// ErrNoRate for a pair they do not know.
+type RateProvider interface {
+	Convert(ctx context.Context, m Money, to string) (Money, error)
+}
+
+// Rate is an exchange rate as the fraction Num/Den, so "92.5" is 925/10.
+// It is applied to minor units, for currencies with different minor units
+// the rate includes the difference.
+type Rate struct {
+	Num uint64
+	Den uint64
+}
+
+// StaticRates is a RateProvider over a fixed table keyed "FROM/TO". A pair
+// missing from the table is converted with the inverse of "TO/FROM".
+type StaticRates map[string]Rate
+
+// ParseRates reads a comma separated table such as "USD/RUB=92.5,EUR/RUB=101".
+func ParseRates(s string) (StaticRates, error) {
+	rates := StaticRates{}
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		pair, value, ok := strings.Cut(entry, "=")
+		from, to, okPair := strings.Cut(strings.TrimSpace(pair), "/")
+		if !ok || !okPair || from == "" || to == "" {
+			return nil, fmt.Errorf("rate %q: want FROM/TO=value", entry)
+		}
+		rate, err := parseRate(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("rate %q: %w", entry, err)
+		}
+		rates[strings.ToUpper(from)+"/"+strings.ToUpper(to)] = rate
+	}
+
+	return rates, nil
+}
+
+// parseRate reads a positive decimal without losing precision.
+func parseRate(s string) (Rate, error) {
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" || len(frac) > 9 {
+		return Rate{}, errors.New("invalid number")
+	}
+
+	r := Rate{Den: 1}
+	for _, ch := range whole + frac {
+		if ch < '0' || ch > '9' {
+			return Rate{}, errors.New("invalid number")
+		}
+		hi, lo := bits.Mul64(r.Num, 10)
+		sum, carry := bits.Add64(lo, uint64(ch-'0'), 0)
+		if hi != 0 || carry != 0 {
+			return Rate{}, ErrOverflow
+		}
+		r.Num = sum
+	}
+	for range frac {
+		r.Den *= 10
+	}
+	if r.Num == 0 {
+		return Rate{}, errors.New("rate must be positive")
+	}
+
+	return r, nil
+}
+
+func (r StaticRates) Convert(_ context.Context, m Money, to string) (Money, error) {
+	if m.Currency == to {
+		return m, nil
+	}
+
+	rate, ok := r[m.Currency+"/"+to]
+	if !ok {
+		inverse, ok := r[to+"/"+m.Currency]
+		if !ok {
+			return Money{}, fmt.Errorf("%w: %s/%s", ErrNoRate, m.Currency, to)
+		}
+		rate = Rate{Num: inverse.Den, Den: inverse.Num}
+	}
+
+	converted, err := m.MulDiv(rate.Num, rate.Den)
+	if err != nil {
+		return Money{}, err
+	}
+	converted.Currency = to
+
+	return converted, nil
+}
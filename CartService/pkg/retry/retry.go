@@ -0,0 +1,157 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Policy retries an operation with exponential backoff and full jitter.
+type Policy struct {
+	// MaxAttempts counts the first call too, values below 1 mean one attempt.
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	// MaxElapsedTime stops retrying once the next sleep would cross it, zero disables the limit.
+	MaxElapsedTime time.Duration
+	// Retryable classifies errors, nil retries everything that is not Permanent.
+	Retryable func(error) bool
+}
+
+// RetryAfterError carries the delay the server asked for, e.g. in Retry-After.
+type RetryAfterError struct {
+	Err   error
+	After time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks err as not worth retrying. Do returns the wrapped error.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// Do calls op until it succeeds, returns a non-retryable error, the attempts
+// or the elapsed time run out, or ctx is done.
+func (p Policy) Do(ctx context.Context, op func(ctx context.Context) error) error {
+	start := time.Now()
+	attempts := max(p.MaxAttempts, 1)
+
+	for attempt := 0; ; attempt++ {
+		err := op(ctx)
+		if err == nil {
+			return nil
+		}
+
+		var perm *permanentError
+		if errors.As(err, &perm) {
+			return perm.err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if attempt+1 >= attempts || (p.Retryable != nil && !p.Retryable(err)) {
+			return err
+		}
+
+		delay := p.Backoff(attempt)
+		var ra *RetryAfterError
+		if errors.As(err, &ra) && ra.After > delay {
+			delay = ra.After
+		}
+		if p.MaxElapsedTime > 0 && time.Since(start)+delay > p.MaxElapsedTime {
+			return err
+		}
+
+		if err := Sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// Backoff returns a random delay in [0, min(MaxDelay, BaseDelay*2^attempt)].
+func (p Policy) Backoff(attempt int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	ceiling := p.BaseDelay
+	for i := 0; i < attempt && ceiling < math.MaxInt64/2; i++ {
+		ceiling *= 2
+		if p.MaxDelay > 0 && ceiling >= p.MaxDelay {
+			break
+		}
+	}
+	if p.MaxDelay > 0 && ceiling > p.MaxDelay {
+		ceiling = p.MaxDelay
+	}
+
+	return time.Duration(rand.Int64N(int64(ceiling) + 1))
+}
+
+// Sleep waits for d or until ctx is done, whichever comes first.
+func Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// ParseRetryAfter reads a Retry-After header in either delay-seconds or
+// HTTP-date form.
+func ParseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.ParseUint(v, 10, 32); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	at, err := http.ParseTime(v)
+	if err != nil {
+		return 0, false
+	}
+	if d := at.Sub(now); d > 0 {
+		return d, true
+	}
+
+	return 0, true
+}
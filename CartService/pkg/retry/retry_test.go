@@ -0,0 +1,124 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/pkg/retry"
+)
+
+var errTemporary = errors.New("temporary")
+
+func TestPolicy_RetriesUntilSuccess(t *testing.T) {
+	p := retry.Policy{MaxAttempts: 5, BaseDelay: time.Millisecond}
+
+	calls := 0
+	err := p.Do(context.Background(), func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return errTemporary
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
+
+func TestPolicy_StopsAfterMaxAttempts(t *testing.T) {
+	p := retry.Policy{MaxAttempts: 3}
+
+	calls := 0
+	err := p.Do(context.Background(), func(context.Context) error {
+		calls++
+		return errTemporary
+	})
+
+	require.ErrorIs(t, err, errTemporary)
+	require.Equal(t, 3, calls)
+}
+
+func TestPolicy_PermanentAndClassifiedErrors(t *testing.T) {
+	errFatal := errors.New("fatal")
+
+	calls := 0
+	err := retry.Policy{MaxAttempts: 5}.Do(context.Background(), func(context.Context) error {
+		calls++
+		return retry.Permanent(errFatal)
+	})
+	require.Equal(t, errFatal, err)
+	require.Equal(t, 1, calls)
+
+	calls = 0
+	p := retry.Policy{MaxAttempts: 5, Retryable: func(err error) bool { return errors.Is(err, errTemporary) }}
+	err = p.Do(context.Background(), func(context.Context) error {
+		calls++
+		return errFatal
+	})
+	require.ErrorIs(t, err, errFatal)
+	require.Equal(t, 1, calls)
+}
+
+func TestPolicy_HonoursRetryAfterAndMaxElapsed(t *testing.T) {
+	p := retry.Policy{MaxAttempts: 5, MaxElapsedTime: 50 * time.Millisecond}
+
+	calls := 0
+	start := time.Now()
+	err := p.Do(context.Background(), func(context.Context) error {
+		calls++
+		return &retry.RetryAfterError{Err: errTemporary, After: 20 * time.Millisecond}
+	})
+
+	require.ErrorIs(t, err, errTemporary)
+	require.Equal(t, 3, calls)
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestPolicy_SleepStopsOnContextCancel(t *testing.T) {
+	p := retry.Policy{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := p.Do(ctx, func(context.Context) error {
+		return &retry.RetryAfterError{Err: errTemporary, After: time.Hour}
+	})
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestPolicy_BackoffBounds(t *testing.T) {
+	p := retry.Policy{BaseDelay: 10 * time.Millisecond, MaxDelay: 80 * time.Millisecond}
+
+	for attempt := 0; attempt < 70; attempt++ {
+		ceiling := min(10*time.Millisecond<<min(attempt, 10), 80*time.Millisecond)
+		for i := 0; i < 20; i++ {
+			d := p.Backoff(attempt)
+			require.GreaterOrEqual(t, d, time.Duration(0))
+			require.LessOrEqual(t, d, ceiling)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	d, ok := retry.ParseRetryAfter("3", now)
+	require.True(t, ok)
+	require.Equal(t, 3*time.Second, d)
+
+	d, ok = retry.ParseRetryAfter("Mon, 01 Sep 2025 12:00:05 GMT", now)
+	require.True(t, ok)
+	require.Equal(t, 5*time.Second, d)
+
+	_, ok = retry.ParseRetryAfter("soon", now)
+	require.False(t, ok)
+
+	_, ok = retry.ParseRetryAfter("", now)
+	require.False(t, ok)
+}
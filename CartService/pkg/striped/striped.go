@@ -0,0 +1,57 @@
+package striped
+
+import "sync"
+
+// Mutex locks uint64 keys on a fixed set of stripes, so unrelated keys rarely
+// wait on each other while memory stays bounded. Keys on the same stripe are
+// serialized together, which is safe but not fair.
+type Mutex struct {
+	stripes []sync.Mutex
+}
+
+func New(stripes int) *Mutex {
+	if stripes <= 0 {
+		stripes = 1
+	}
+
+	return &Mutex{stripes: make([]sync.Mutex, stripes)}
+}
+
+func (m *Mutex) Lock(key uint64) {
+	m.stripe(key).Lock()
+}
+
+func (m *Mutex) Unlock(key uint64) {
+	m.stripe(key).Unlock()
+}
+
+// LockPair locks the stripes of both keys, in stripe order so that two calls
+// with the keys swapped do not deadlock. Keys sharing a stripe lock it once.
+func (m *Mutex) LockPair(a, b uint64) {
+	first, second := m.pair(a, b)
+	first.Lock()
+	if second != first {
+		second.Lock()
+	}
+}
+
+func (m *Mutex) UnlockPair(a, b uint64) {
+	first, second := m.pair(a, b)
+	if second != first {
+		second.Unlock()
+	}
+	first.Unlock()
+}
+
+func (m *Mutex) pair(a, b uint64) (*sync.Mutex, *sync.Mutex) {
+	n := uint64(len(m.stripes))
+	if a%n > b%n {
+		a, b = b, a
+	}
+
+	return m.stripe(a), m.stripe(b)
+}
+
+func (m *Mutex) stripe(key uint64) *sync.Mutex {
+	return &m.stripes[key%uint64(len(m.stripes))]
+}
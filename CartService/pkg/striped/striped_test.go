@@ -0,0 +1,54 @@
+package striped_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/verbovyar/OzonCart/pkg/striped"
+)
+
+func TestMutex_SerializesSameKey(t *testing.T) {
+	m := striped.New(16)
+	counters := make([]int, 4)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 1000; i++ {
+		key := uint64(i % 4)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Lock(key)
+			defer m.Unlock(key)
+			counters[key]++
+		}()
+	}
+	wg.Wait()
+
+	for key := uint64(0); key < 4; key++ {
+		require.Equal(t, 250, counters[key])
+	}
+}
+
+func TestMutex_LockPairInEitherOrder(t *testing.T) {
+	m := striped.New(4)
+	total := 0
+
+	var wg sync.WaitGroup
+	for i := 0; i < 1000; i++ {
+		a, b := uint64(1), uint64(i%8)
+		if i%2 == 1 {
+			a, b = b, a
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.LockPair(a, b)
+			defer m.UnlockPair(a, b)
+			total++
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 1000, total)
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/verbovyar/OzonCart/internal/handlers"
 	"github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
 	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
+	"github.com/verbovyar/OzonCart/pkg/retry"
 )
 
 //go test -timeout 60s -run Test_E2E_LocalPostgres_And_FakeProductService ./tests/e2e -v
@@ -63,6 +65,46 @@ func startFakeProductService(t *testing.T, token string) *httptest.Server {
 		_ = json.NewEncoder(w).Encode(p)
 	})
 
+	mux.HandleFunc("/list_skus", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Token string   `json:"token"`
+			SKUs  []uint64 `json:"skus"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "bad body", http.StatusBadRequest)
+			return
+		}
+		if req.Token != token {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		type item struct {
+			SKU uint64 `json:"sku"`
+			getProductResp
+		}
+		var resp struct {
+			Products []item `json:"products"`
+		}
+		for _, sku := range req.SKUs {
+			if p, ok := products[sku]; ok {
+				resp.Products = append(resp.Products, item{SKU: sku, getProductResp: p})
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+
+	stockOK := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	mux.HandleFunc("/reserve_stock", stockOK)
+	mux.HandleFunc("/release_stock", stockOK)
+	mux.HandleFunc("/commit_stock", stockOK)
+
 	return httptest.NewServer(mux)
 }
 
@@ -128,7 +170,7 @@ func Test_E2E_LocalPostgres_And_FakeProductService(t *testing.T) {
 	defer ps.Close()
 
 	repo := postgres.New(pool)
-	pc := service.NewClient(ps.URL, token, 1, 50*time.Millisecond)
+	pc := service.NewClient(ps.URL, token, retry.Policy{MaxAttempts: 1, BaseDelay: 50 * time.Millisecond})
 	cs := service.New(repo, pc)
 
 	mux := http.NewServeMux()
@@ -158,7 +200,7 @@ func Test_E2E_LocalPostgres_And_FakeProductService(t *testing.T) {
 			Count uint64 `json:"count"`
 			Price uint64 `json:"price"`
 		} `json:"items"`
-		TotalPrice uint64 `json:"total_price"`
+		TotalPrice money.Money `json:"total_price"`
 	}
 
 	log.Println("Test: doGet")
@@ -170,7 +212,7 @@ func Test_E2E_LocalPostgres_And_FakeProductService(t *testing.T) {
 	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
 	resp.Body.Close()
 	require.Len(t, body.Items, 2)
-	require.Equal(t, uint64(3900), body.TotalPrice)
+	require.Equal(t, uint64(3900), body.TotalPrice.Amount)
 
 	log.Println("Test: doDelete1")
 	// delete 1002
@@ -185,7 +227,7 @@ func Test_E2E_LocalPostgres_And_FakeProductService(t *testing.T) {
 	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
 	resp.Body.Close()
 	require.Len(t, body.Items, 1)
-	require.Equal(t, uint64(3000), body.TotalPrice)
+	require.Equal(t, uint64(3000), body.TotalPrice.Amount)
 
 	log.Println("Test: doDelete2")
 	// clear
@@ -7,6 +7,7 @@ import (
 
 	pgstore "github.com/verbovyar/OzonCart/internal/repositories/db/postgres"
 	"github.com/verbovyar/OzonCart/internal/service"
+	"github.com/verbovyar/OzonCart/pkg/money"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/stretchr/testify/require"
@@ -51,6 +52,23 @@ func (f *fakeProductClient) GetProduct(_ context.Context, skuID uint64) (*servic
 	return nil, service.ErrProductNotFound
 }
 
+func (f *fakeProductClient) GetProducts(_ context.Context, skus []uint64) (map[uint64]*service.Product, error) {
+	res := make(map[uint64]*service.Product, len(skus))
+	for _, sku := range skus {
+		if p, ok := f.bySKU[sku]; ok {
+			res[sku] = &service.Product{Name: p.Name, Price: p.Price}
+		}
+	}
+
+	return res, nil
+}
+
+func (f *fakeProductClient) ReserveStock(_ context.Context, _, _ uint64) error { return nil }
+
+func (f *fakeProductClient) ReleaseStock(_ context.Context, _, _ uint64) error { return nil }
+
+func (f *fakeProductClient) CommitStock(_ context.Context, _, _ uint64) error { return nil }
+
 func Test_CartService_Integration_With_LocalPostgres(t *testing.T) {
 	pool := mustNewPGPool(t)
 	defer pool.Close()
@@ -74,27 +92,27 @@ func Test_CartService_Integration_With_LocalPostgres(t *testing.T) {
 	)
 
 	// add 2 × 1001
-	require.NoError(t, cs.AddToCart(ctx, userID, 1001, 2))
+	require.NoError(t, cs.AddToCart(ctx, userID, 1001, 2, nil))
 	// add 1 × 1002
-	require.NoError(t, cs.AddToCart(ctx, userID, 1002, 1))
+	require.NoError(t, cs.AddToCart(ctx, userID, 1002, 1, nil))
 
 	// get -> 2 позиции, total = 2*1500 + 1*900 = 3900
 	res, err := cs.GetCart(ctx, userID)
 	require.NoError(t, err)
 	require.Len(t, res.Items, 2)
-	require.Equal(t, uint64(3900), res.TotalPrice)
+	require.Equal(t, money.New(3900, "RUB"), res.TotalPrice)
 
 	// delete 1002 -> остается только 1001 (2×1500 = 3000)
-	cs.DeleteItem(userID, 1002)
+	require.NoError(t, cs.DeleteItem(ctx, userID, 1002, nil))
 	res, err = cs.GetCart(ctx, userID)
 	require.NoError(t, err)
 	require.Len(t, res.Items, 1)
-	require.Equal(t, uint64(3000), res.TotalPrice)
+	require.Equal(t, money.New(3000, "RUB"), res.TotalPrice)
 
 	// clear -> пустая корзина, 200-ок в HTTP, здесь просто данные = 0
-	cs.ClearCart(userID)
+	require.NoError(t, cs.ClearCart(ctx, userID, nil))
 	res, err = cs.GetCart(ctx, userID)
 	require.NoError(t, err)
 	require.Equal(t, 0, len(res.Items))
-	require.Equal(t, uint64(0), res.TotalPrice)
+	require.Equal(t, money.New(0, "RUB"), res.TotalPrice)
 }
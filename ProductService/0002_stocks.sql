@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS stocks (
+    sku      BIGINT PRIMARY KEY REFERENCES products (sku) ON DELETE CASCADE,
+    total    BIGINT NOT NULL CHECK (total >= 0),
+    reserved BIGINT NOT NULL DEFAULT 0 CHECK (reserved >= 0),
+    CHECK (reserved <= total)
+);
+
+-- products listed before stocks were tracked get no row here, they cannot
+-- be reserved until the inventory import creates one
+
+-- +goose Down
+DROP TABLE IF EXISTS stocks;
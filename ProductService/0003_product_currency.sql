@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE products ALTER COLUMN price TYPE BIGINT;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB' CHECK (currency ~ '^[A-Z]{3}$');
+
+-- +goose Down
+ALTER TABLE products DROP COLUMN IF EXISTS currency;
+ALTER TABLE products ALTER COLUMN price TYPE INTEGER;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE products ADD COLUMN IF NOT EXISTS weight_grams BIGINT NOT NULL DEFAULT 0 CHECK (weight_grams >= 0);
+
+-- +goose Down
+ALTER TABLE products DROP COLUMN IF EXISTS weight_grams;
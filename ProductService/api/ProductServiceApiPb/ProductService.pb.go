@@ -0,0 +1,474 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v3.14.0
+// source: ProductService/api/ProductService.proto
+
+package ProductServiceApiPb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           uint64                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_ProductService_api_ProductService_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetProductRequest) GetSku() uint64 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+type ListSkusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skus          []uint64               `protobuf:"varint,1,rep,packed,name=skus,proto3" json:"skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkusRequest) Reset() {
+	*x = ListSkusRequest{}
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkusRequest) ProtoMessage() {}
+
+func (x *ListSkusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkusRequest.ProtoReflect.Descriptor instead.
+func (*ListSkusRequest) Descriptor() ([]byte, []int) {
+	return file_ProductService_api_ProductService_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListSkusRequest) GetSkus() []uint64 {
+	if x != nil {
+		return x.Skus
+	}
+	return nil
+}
+
+// Money is an amount in minor units (kopecks, cents) of an ISO 4217 currency.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_ProductService_api_ProductService_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Money) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Product struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sku   uint64                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// amount of unit_price, kept for clients that predate currencies
+	//
+	// Deprecated: Marked as deprecated in ProductService/api/ProductService.proto.
+	Price         uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	UnitPrice     *Money `protobuf:"bytes,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	WeightGrams   uint64 `protobuf:"varint,5,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_ProductService_api_ProductService_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Product) GetSku() uint64 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in ProductService/api/ProductService.proto.
+func (x *Product) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Product) GetUnitPrice() *Money {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *Product) GetWeightGrams() uint64 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+type ListSkusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSkusResponse) Reset() {
+	*x = ListSkusResponse{}
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSkusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSkusResponse) ProtoMessage() {}
+
+func (x *ListSkusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSkusResponse.ProtoReflect.Descriptor instead.
+func (*ListSkusResponse) Descriptor() ([]byte, []int) {
+	return file_ProductService_api_ProductService_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListSkusResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type StockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           uint64                 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockRequest) Reset() {
+	*x = StockRequest{}
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockRequest) ProtoMessage() {}
+
+func (x *StockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockRequest.ProtoReflect.Descriptor instead.
+func (*StockRequest) Descriptor() ([]byte, []int) {
+	return file_ProductService_api_ProductService_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StockRequest) GetSku() uint64 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *StockRequest) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockResponse) Reset() {
+	*x = StockResponse{}
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockResponse) ProtoMessage() {}
+
+func (x *StockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ProductService_api_ProductService_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockResponse.ProtoReflect.Descriptor instead.
+func (*StockResponse) Descriptor() ([]byte, []int) {
+	return file_ProductService_api_ProductService_proto_rawDescGZIP(), []int{6}
+}
+
+var File_ProductService_api_ProductService_proto protoreflect.FileDescriptor
+
+const file_ProductService_api_ProductService_proto_rawDesc = "" +
+	"\n" +
+	"'ProductService/api/ProductService.proto\x12\aproduct\"%\n" +
+	"\x11GetProductRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\x04R\x03sku\"%\n" +
+	"\x0fListSkusRequest\x12\x12\n" +
+	"\x04skus\x18\x01 \x03(\x04R\x04skus\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\x9b\x01\n" +
+	"\aProduct\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\x04R\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\x05price\x18\x03 \x01(\x04B\x02\x18\x01R\x05price\x12-\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\v2\x0e.product.MoneyR\tunitPrice\x12!\n" +
+	"\fweight_grams\x18\x05 \x01(\x04R\vweightGrams\"@\n" +
+	"\x10ListSkusResponse\x12,\n" +
+	"\bproducts\x18\x01 \x03(\v2\x10.product.ProductR\bproducts\"6\n" +
+	"\fStockRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\x04R\x03sku\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"\x0f\n" +
+	"\rStockResponse2\xc9\x02\n" +
+	"\x0eProductService\x12:\n" +
+	"\n" +
+	"GetProduct\x12\x1a.product.GetProductRequest\x1a\x10.product.Product\x12?\n" +
+	"\bListSkus\x12\x18.product.ListSkusRequest\x1a\x19.product.ListSkusResponse\x12=\n" +
+	"\fReserveStock\x12\x15.product.StockRequest\x1a\x16.product.StockResponse\x12=\n" +
+	"\fReleaseStock\x12\x15.product.StockRequest\x1a\x16.product.StockResponse\x12<\n" +
+	"\vCommitStock\x12\x15.product.StockRequest\x1a\x16.product.StockResponseB\x19Z\x17api/ProductServiceApiPbb\x06proto3"
+
+var (
+	file_ProductService_api_ProductService_proto_rawDescOnce sync.Once
+	file_ProductService_api_ProductService_proto_rawDescData []byte
+)
+
+func file_ProductService_api_ProductService_proto_rawDescGZIP() []byte {
+	file_ProductService_api_ProductService_proto_rawDescOnce.Do(func() {
+		file_ProductService_api_ProductService_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ProductService_api_ProductService_proto_rawDesc), len(file_ProductService_api_ProductService_proto_rawDesc)))
+	})
+	return file_ProductService_api_ProductService_proto_rawDescData
+}
+
+var file_ProductService_api_ProductService_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_ProductService_api_ProductService_proto_goTypes = []any{
+	(*GetProductRequest)(nil), // 0: product.GetProductRequest
+	(*ListSkusRequest)(nil),   // 1: product.ListSkusRequest
+	(*Money)(nil),             // 2: product.Money
+	(*Product)(nil),           // 3: product.Product
+	(*ListSkusResponse)(nil),  // 4: product.ListSkusResponse
+	(*StockRequest)(nil),      // 5: product.StockRequest
+	(*StockResponse)(nil),     // 6: product.StockResponse
+}
+var file_ProductService_api_ProductService_proto_depIdxs = []int32{
+	2, // 0: product.Product.unit_price:type_name -> product.Money
+	3, // 1: product.ListSkusResponse.products:type_name -> product.Product
+	0, // 2: product.ProductService.GetProduct:input_type -> product.GetProductRequest
+	1, // 3: product.ProductService.ListSkus:input_type -> product.ListSkusRequest
+	5, // 4: product.ProductService.ReserveStock:input_type -> product.StockRequest
+	5, // 5: product.ProductService.ReleaseStock:input_type -> product.StockRequest
+	5, // 6: product.ProductService.CommitStock:input_type -> product.StockRequest
+	3, // 7: product.ProductService.GetProduct:output_type -> product.Product
+	4, // 8: product.ProductService.ListSkus:output_type -> product.ListSkusResponse
+	6, // 9: product.ProductService.ReserveStock:output_type -> product.StockResponse
+	6, // 10: product.ProductService.ReleaseStock:output_type -> product.StockResponse
+	6, // 11: product.ProductService.CommitStock:output_type -> product.StockResponse
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_ProductService_api_ProductService_proto_init() }
+func file_ProductService_api_ProductService_proto_init() {
+	if File_ProductService_api_ProductService_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ProductService_api_ProductService_proto_rawDesc), len(file_ProductService_api_ProductService_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ProductService_api_ProductService_proto_goTypes,
+		DependencyIndexes: file_ProductService_api_ProductService_proto_depIdxs,
+		MessageInfos:      file_ProductService_api_ProductService_proto_msgTypes,
+	}.Build()
+	File_ProductService_api_ProductService_proto = out.File
+	file_ProductService_api_ProductService_proto_goTypes = nil
+	file_ProductService_api_ProductService_proto_depIdxs = nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"time"
+
+	"product/api/ProductServiceApiPb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type grpcServer struct {
+	ProductServiceApiPb.UnimplementedProductServiceServer
+
+	r *repo
+}
+
+func (s *grpcServer) GetProduct(ctx context.Context, in *ProductServiceApiPb.GetProductRequest) (*ProductServiceApiPb.Product, error) {
+	if in.Sku == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sku")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	pr, err := s.r.GetBySKU(ctx, in.Sku)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return toPbProduct(productItem{
+		SKU:         in.Sku,
+		Name:        pr.Name,
+		Price:       pr.Price,
+		Currency:    pr.Currency,
+		WeightGrams: pr.WeightGrams,
+	}), nil
+}
+
+func toPbProduct(p productItem) *ProductServiceApiPb.Product {
+	return &ProductServiceApiPb.Product{
+		Sku:         p.SKU,
+		Name:        p.Name,
+		Price:       p.Price,
+		UnitPrice:   &ProductServiceApiPb.Money{Amount: p.Price, Currency: p.Currency},
+		WeightGrams: p.WeightGrams,
+	}
+}
+
+func (s *grpcServer) ListSkus(ctx context.Context, in *ProductServiceApiPb.ListSkusRequest) (*ProductServiceApiPb.ListSkusResponse, error) {
+	if len(in.Skus) == 0 || len(in.Skus) > maxListSkus {
+		return nil, status.Error(codes.InvalidArgument, "invalid skus")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	products, err := s.r.ListBySKUs(ctx, in.Skus)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	out := make([]*ProductServiceApiPb.Product, 0, len(products))
+	for _, p := range products {
+		out = append(out, toPbProduct(p))
+	}
+
+	return &ProductServiceApiPb.ListSkusResponse{Products: out}, nil
+}
+
+func (s *grpcServer) ReserveStock(ctx context.Context, in *ProductServiceApiPb.StockRequest) (*ProductServiceApiPb.StockResponse, error) {
+	return stockCall(ctx, in, s.r.Reserve)
+}
+
+func (s *grpcServer) ReleaseStock(ctx context.Context, in *ProductServiceApiPb.StockRequest) (*ProductServiceApiPb.StockResponse, error) {
+	return stockCall(ctx, in, s.r.Release)
+}
+
+func (s *grpcServer) CommitStock(ctx context.Context, in *ProductServiceApiPb.StockRequest) (*ProductServiceApiPb.StockResponse, error) {
+	return stockCall(ctx, in, s.r.Commit)
+}
+
+func stockCall(ctx context.Context, in *ProductServiceApiPb.StockRequest, op func(ctx context.Context, sku, count uint64) error) (*ProductServiceApiPb.StockResponse, error) {
+	if in.Sku == 0 || in.Count == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid sku or count")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if err := op(ctx, in.Sku, in.Count); err != nil {
+		if errors.Is(err, errInsufficientStock) {
+			return nil, status.Error(codes.FailedPrecondition, "insufficient stock")
+		}
+		if errors.Is(err, errNoStock) {
+			return nil, status.Error(codes.NotFound, "no stock record")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &ProductServiceApiPb.StockResponse{}, nil
+}
+
+// authInterceptor expects the token in "authorization: Bearer <token>" metadata.
+func authInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if v := md.Get("authorization"); len(v) == 0 || v[0] != "Bearer "+token {
+			return nil, status.Error(codes.Unauthenticated, "unauthorized")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func runGrpc(addr, token string, r *repo) {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("grpc listen: %v", err)
+	}
+
+	srv := grpc.NewServer(grpc.UnaryInterceptor(authInterceptor(token)))
+	ProductServiceApiPb.RegisterProductServiceServer(srv, &grpcServer{r: r})
+
+	log.Printf("ProductService gRPC listening on %s", addr)
+	log.Fatal(srv.Serve(lis))
+}
@@ -112,7 +112,7 @@ func (v *ValidationRouter) ValidateGetCart(ctx context.Context, in *ValidationSe
 
 func toValResp(c *CartServiceApiPb.GetCartResponse) *ValidationServiceApiPb.GetCartResponse {
 	out := &ValidationServiceApiPb.GetCartResponse{
-		TotalPrice: c.TotalPrice,
+		TotalPrice: c.GetTotalPrice().GetAmount(),
 	}
 
 	for _, it := range c.Items {
@@ -120,7 +120,7 @@ func toValResp(c *CartServiceApiPb.GetCartResponse) *ValidationServiceApiPb.GetC
 			SkuId: it.SkuId,
 			Name:  it.Name,
 			Count: it.Count,
-			Price: it.Price,
+			Price: it.GetPrice().GetAmount(),
 		})
 	}
 
@@ -221,19 +221,72 @@ func (x *GetCartRequest) GetUserId() uint64 {
 	return 0
 }
 
+// Money is an amount in minor units (kopecks, cents) of an ISO 4217 currency.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        uint64                 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_infrastructure_cartServiceClient_api_CartService_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Money) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CartItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SkuId         uint64                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Count         uint64                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Price         uint64                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Price         *Money                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CartItem) Reset() {
 	*x = CartItem{}
-	mi := &file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes[4]
+	mi := &file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -245,7 +298,7 @@ func (x *CartItem) String() string {
 func (*CartItem) ProtoMessage() {}
 
 func (x *CartItem) ProtoReflect() protoreflect.Message {
-	mi := &file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes[4]
+	mi := &file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +311,7 @@ func (x *CartItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
 func (*CartItem) Descriptor() ([]byte, []int) {
-	return file_infrastructure_cartServiceClient_api_CartService_proto_rawDescGZIP(), []int{4}
+	return file_infrastructure_cartServiceClient_api_CartService_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CartItem) GetSkuId() uint64 {
@@ -282,24 +335,24 @@ func (x *CartItem) GetCount() uint64 {
 	return 0
 }
 
-func (x *CartItem) GetPrice() uint64 {
+func (x *CartItem) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
 }
 
 type GetCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalPrice    uint64                 `protobuf:"varint,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	TotalPrice    *Money                 `protobuf:"bytes,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetCartResponse) Reset() {
 	*x = GetCartResponse{}
-	mi := &file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes[5]
+	mi := &file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +364,7 @@ func (x *GetCartResponse) String() string {
 func (*GetCartResponse) ProtoMessage() {}
 
 func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes[5]
+	mi := &file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +377,7 @@ func (x *GetCartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCartResponse.ProtoReflect.Descriptor instead.
 func (*GetCartResponse) Descriptor() ([]byte, []int) {
-	return file_infrastructure_cartServiceClient_api_CartService_proto_rawDescGZIP(), []int{5}
+	return file_infrastructure_cartServiceClient_api_CartService_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetCartResponse) GetItems() []*CartItem {
@@ -334,11 +387,11 @@ func (x *GetCartResponse) GetItems() []*CartItem {
 	return nil
 }
 
-func (x *GetCartResponse) GetTotalPrice() uint64 {
+func (x *GetCartResponse) GetTotalPrice() *Money {
 	if x != nil {
 		return x.TotalPrice
 	}
-	return 0
+	return nil
 }
 
 var File_infrastructure_cartServiceClient_api_CartService_proto protoreflect.FileDescriptor
@@ -356,15 +409,18 @@ const file_infrastructure_cartServiceClient_api_CartService_proto_rawDesc = "" +
 	"\x10ClearCartRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\")\n" +
 	"\x0eGetCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\"a\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"n\n" +
 	"\bCartItem\x12\x15\n" +
 	"\x06sku_id\x18\x01 \x01(\x04R\x05skuId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x04R\x05count\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\x04R\x05price\"X\n" +
+	"\x05count\x18\x03 \x01(\x04R\x05count\x12!\n" +
+	"\x05price\x18\x04 \x01(\v2\v.cart.MoneyR\x05price\"e\n" +
 	"\x0fGetCartResponse\x12$\n" +
-	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\x12\x1f\n" +
-	"\vtotal_price\x18\x02 \x01(\x04R\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\x12,\n" +
+	"\vtotal_price\x18\x02 \x01(\v2\v.cart.MoneyR\n" +
 	"totalPrice2\xfb\x01\n" +
 	"\vCartService\x12:\n" +
 	"\tAddToCart\x12\x16.cart.AddToCartRequest\x1a\x15.cart.GetCartResponse\x12<\n" +
@@ -385,30 +441,33 @@ func file_infrastructure_cartServiceClient_api_CartService_proto_rawDescGZIP() [
 	return file_infrastructure_cartServiceClient_api_CartService_proto_rawDescData
 }
 
-var file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_infrastructure_cartServiceClient_api_CartService_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_infrastructure_cartServiceClient_api_CartService_proto_goTypes = []any{
 	(*AddToCartRequest)(nil),  // 0: cart.AddToCartRequest
 	(*DeleteItemRequest)(nil), // 1: cart.DeleteItemRequest
 	(*ClearCartRequest)(nil),  // 2: cart.ClearCartRequest
 	(*GetCartRequest)(nil),    // 3: cart.GetCartRequest
-	(*CartItem)(nil),          // 4: cart.CartItem
-	(*GetCartResponse)(nil),   // 5: cart.GetCartResponse
+	(*Money)(nil),             // 4: cart.Money
+	(*CartItem)(nil),          // 5: cart.CartItem
+	(*GetCartResponse)(nil),   // 6: cart.GetCartResponse
 }
 var file_infrastructure_cartServiceClient_api_CartService_proto_depIdxs = []int32{
-	4, // 0: cart.GetCartResponse.items:type_name -> cart.CartItem
-	0, // 1: cart.CartService.AddToCart:input_type -> cart.AddToCartRequest
-	1, // 2: cart.CartService.DeleteItem:input_type -> cart.DeleteItemRequest
-	2, // 3: cart.CartService.ClearCart:input_type -> cart.ClearCartRequest
-	3, // 4: cart.CartService.GetCart:input_type -> cart.GetCartRequest
-	5, // 5: cart.CartService.AddToCart:output_type -> cart.GetCartResponse
-	5, // 6: cart.CartService.DeleteItem:output_type -> cart.GetCartResponse
-	5, // 7: cart.CartService.ClearCart:output_type -> cart.GetCartResponse
-	5, // 8: cart.CartService.GetCart:output_type -> cart.GetCartResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4, // 0: cart.CartItem.price:type_name -> cart.Money
+	5, // 1: cart.GetCartResponse.items:type_name -> cart.CartItem
+	4, // 2: cart.GetCartResponse.total_price:type_name -> cart.Money
+	0, // 3: cart.CartService.AddToCart:input_type -> cart.AddToCartRequest
+	1, // 4: cart.CartService.DeleteItem:input_type -> cart.DeleteItemRequest
+	2, // 5: cart.CartService.ClearCart:input_type -> cart.ClearCartRequest
+	3, // 6: cart.CartService.GetCart:input_type -> cart.GetCartRequest
+	6, // 7: cart.CartService.AddToCart:output_type -> cart.GetCartResponse
+	6, // 8: cart.CartService.DeleteItem:output_type -> cart.GetCartResponse
+	6, // 9: cart.CartService.ClearCart:output_type -> cart.GetCartResponse
+	6, // 10: cart.CartService.GetCart:output_type -> cart.GetCartResponse
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_infrastructure_cartServiceClient_api_CartService_proto_init() }
@@ -422,7 +481,7 @@ func file_infrastructure_cartServiceClient_api_CartService_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_infrastructure_cartServiceClient_api_CartService_proto_rawDesc), len(file_infrastructure_cartServiceClient_api_CartService_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},